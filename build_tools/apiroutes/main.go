@@ -0,0 +1,283 @@
+// Command apiroutes writes internal/cli/schema_routes.go, which lists the API
+// requests each command source file makes: the method and path of every
+// client call, the filter[...] parameters it sets, and the attribute and
+// relationship keys of its request body. 'xbe schema openapi' builds its
+// paths from these routes.
+//
+// Run it with 'go generate ./internal/cli' after adding or renaming commands.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const outputName = "schema_routes.go"
+
+var (
+	clientMethods = map[string]string{
+		"Get":           "GET",
+		"Post":          "POST",
+		"PostWithQuery": "POST",
+		"Patch":         "PATCH",
+		"Delete":        "DELETE",
+	}
+	filterPattern = regexp.MustCompile(`^filter\[([^\]]+)\]$`)
+)
+
+type route struct {
+	method string
+	path   string
+}
+
+type fileRoutes struct {
+	routes        []route
+	filters       map[string]bool
+	attributes    map[string]bool
+	relationships map[string]bool
+}
+
+func main() {
+	dir := "internal/cli"
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	if err := run(dir); err != nil {
+		fmt.Fprintln(os.Stderr, "apiroutes:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	files := map[string]*fileRoutes{}
+	for _, path := range paths {
+		base := filepath.Base(path)
+		if strings.HasSuffix(base, "_test.go") || base == outputName {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		if found := collectRoutes(file); len(found.routes) > 0 {
+			files[strings.TrimSuffix(base, ".go")] = found
+		}
+	}
+
+	stems := make([]string, 0, len(files))
+	for stem := range files {
+		stems = append(stems, stem)
+	}
+	sort.Strings(stems)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by build_tools/apiroutes; DO NOT EDIT.\n\n")
+	buf.WriteString("package cli\n\n")
+	buf.WriteString("// apiRoutes lists the API requests made by each command source file.\n")
+	buf.WriteString("var apiRoutes = []apiRoute{\n")
+	for _, stem := range stems {
+		found := files[stem]
+		for _, r := range found.routes {
+			fmt.Fprintf(&buf, "\t{Source: %q, Method: %q, Path: %q", stem, r.method, r.path)
+			collection := !strings.Contains(r.path, "{")
+			if r.method == "GET" && collection && len(found.filters) > 0 {
+				fmt.Fprintf(&buf, ", Filters: %s", stringSlice(found.filters))
+			}
+			if r.method == "POST" || r.method == "PATCH" {
+				if len(found.attributes) > 0 {
+					fmt.Fprintf(&buf, ", Attributes: %s", stringSlice(found.attributes))
+				}
+				if len(found.relationships) > 0 {
+					fmt.Fprintf(&buf, ", Relationships: %s", stringSlice(found.relationships))
+				}
+			}
+			buf.WriteString("},\n")
+		}
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, outputName), source, 0o644)
+}
+
+func stringSlice(set map[string]bool) string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, strconv.Quote(value))
+	}
+	sort.Strings(values)
+	return "[]string{" + strings.Join(values, ", ") + "}"
+}
+
+func collectRoutes(file *ast.File) *fileRoutes {
+	found := &fileRoutes{filters: map[string]bool{}, attributes: map[string]bool{}, relationships: map[string]bool{}}
+	seen := map[route]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			selector, ok := node.Fun.(*ast.SelectorExpr)
+			if !ok || len(node.Args) < 2 {
+				return true
+			}
+			method, ok := clientMethods[selector.Sel.Name]
+			if !ok {
+				return true
+			}
+			if receiver, ok := selector.X.(*ast.Ident); !ok || receiver.Name != "client" {
+				return true
+			}
+			path, ok := routePath(node.Args[1])
+			if !ok {
+				return true
+			}
+			r := route{method: method, path: path}
+			if !seen[r] {
+				seen[r] = true
+				found.routes = append(found.routes, r)
+			}
+		case *ast.BasicLit:
+			if value, ok := stringLiteral(node); ok {
+				if match := filterPattern.FindStringSubmatch(value); match != nil {
+					found.filters[match[1]] = true
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range node.Lhs {
+				if index, ok := lhs.(*ast.IndexExpr); ok {
+					if set := found.bodySet(index.X); set != nil {
+						if key, ok := stringLiteral(index.Index); ok {
+							set[key] = true
+						}
+					}
+				}
+				if i < len(node.Rhs) {
+					if set := found.bodySet(lhs); set != nil {
+						addCompositeKeys(node.Rhs[i], set)
+					}
+				}
+			}
+		case *ast.KeyValueExpr:
+			if key, ok := stringLiteral(node.Key); ok {
+				switch key {
+				case "attributes":
+					addCompositeKeys(node.Value, found.attributes)
+				case "relationships":
+					addCompositeKeys(node.Value, found.relationships)
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// bodySet returns the set collecting keys for a variable named like
+// attributes or relationships.
+func (f *fileRoutes) bodySet(expr ast.Expr) map[string]bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	name := strings.ToLower(ident.Name)
+	switch {
+	case strings.HasPrefix(name, "attr"):
+		return f.attributes
+	case strings.HasPrefix(name, "relationship") || name == "rels":
+		return f.relationships
+	}
+	return nil
+}
+
+func addCompositeKeys(expr ast.Expr, set map[string]bool) {
+	composite, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return
+	}
+	for _, element := range composite.Elts {
+		if pair, ok := element.(*ast.KeyValueExpr); ok {
+			if key, ok := stringLiteral(pair.Key); ok {
+				set[key] = true
+			}
+		}
+	}
+}
+
+// routePath turns "/v1/brokers/"+opts.ID into "/v1/brokers/{id}". Paths
+// built from other variables are skipped.
+func routePath(expr ast.Expr) (string, bool) {
+	var parts []ast.Expr
+	var flatten func(ast.Expr)
+	flatten = func(expr ast.Expr) {
+		if binary, ok := expr.(*ast.BinaryExpr); ok && binary.Op == token.ADD {
+			flatten(binary.X)
+			flatten(binary.Y)
+			return
+		}
+		parts = append(parts, expr)
+	}
+	flatten(expr)
+
+	var path strings.Builder
+	ids := 0
+	for _, part := range parts {
+		if value, ok := stringLiteral(part); ok {
+			path.WriteString(value)
+			continue
+		}
+		if !strings.HasSuffix(path.String(), "/") || !isIDExpr(part) {
+			return "", false
+		}
+		ids++
+		if ids == 1 {
+			path.WriteString("{id}")
+		} else {
+			fmt.Fprintf(&path, "{id%d}", ids)
+		}
+	}
+	result, _, _ := strings.Cut(path.String(), "?")
+	return result, strings.HasPrefix(result, "/v1/")
+}
+
+func isIDExpr(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return strings.HasSuffix(strings.ToLower(expr.Name), "id")
+	case *ast.SelectorExpr:
+		return strings.HasSuffix(strings.ToLower(expr.Sel.Name), "id")
+	case *ast.IndexExpr:
+		ident, ok := expr.X.(*ast.Ident)
+		return ok && ident.Name == "args"
+	case *ast.CallExpr:
+		// url.PathEscape(id)
+		return len(expr.Args) == 1 && isIDExpr(expr.Args[0])
+	}
+	return false
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(literal.Value)
+	return value, err == nil
+}
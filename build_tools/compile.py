@@ -1498,6 +1498,91 @@ def build_command_resource_links(conn: sqlite3.Connection, repo_root: Path) -> N
                 )
 
 
+def compact_search_text(value: str) -> str:
+    return re.sub(r"[^a-z0-9]+", "", value.lower())
+
+
+def build_search_index(conn: sqlite3.Connection) -> None:
+    """Build the FTS5 tables behind `xbe knowledge search`.
+
+    search_index holds one row per searchable item and is ranked with BM25.
+    search_vocab exposes its terms for typo correction, and search_trigrams
+    indexes each distinct name part (resource, field, flag, command word) with
+    separators removed so "jobprod" finds job-production-plans.
+    """
+    conn.executescript(
+        """
+        DROP TABLE IF EXISTS search_vocab;
+        DROP TABLE IF EXISTS search_index;
+        DROP TABLE IF EXISTS search_trigrams;
+        CREATE VIRTUAL TABLE search_index USING fts5(
+            kind UNINDEXED,
+            name,
+            detail,
+            body,
+            tokenize = 'unicode61 remove_diacritics 2'
+        );
+        CREATE VIRTUAL TABLE search_vocab USING fts5vocab(search_index, 'row');
+        CREATE VIRTUAL TABLE search_trigrams USING fts5(
+            name UNINDEXED,
+            compact,
+            tokenize = 'trigram'
+        );
+        """
+    )
+
+    items: list[tuple[str, str, str, str]] = []
+    for name, label_fields in conn.execute("SELECT name, label_fields FROM resources"):
+        labels = ", ".join(json.loads(label_fields or "[]"))
+        items.append(("resource", name, labels, ""))
+    for full_path, description, side_effects, validation_notes in conn.execute(
+        "SELECT full_path, description, side_effects, validation_notes FROM commands"
+    ):
+        body = " ".join(part for part in (side_effects, validation_notes) if part)
+        items.append(("command", full_path, description or "", body))
+    for resource, name, kind, description in conn.execute(
+        "SELECT resource, name, kind, description FROM resource_fields"
+    ):
+        items.append(("field", f"{resource}.{name}", kind or "", description or ""))
+    for name, full_path, description in conn.execute(
+        """
+        SELECT f.name, c.full_path, f.description
+        FROM flags f
+        JOIN commands c ON c.id = f.command_id
+        """
+    ):
+        items.append(("flag", name, full_path, description or ""))
+    for source, relationship, target, edge_kind in conn.execute(
+        "SELECT source_resource, relationship, target_resource, edge_kind FROM resource_graph_edges"
+    ):
+        items.append(("relationship", f"{source}.{relationship}", f"{target} ({edge_kind})", ""))
+    for (name,) in conn.execute("SELECT DISTINCT summary_resource FROM summary_resource_targets"):
+        items.append(("summary", name, "", ""))
+    for summary, name, kind in conn.execute("SELECT summary_resource, name, kind FROM summary_dimensions"):
+        items.append(("summary_dimension", f"{summary}.{name}", kind or "", ""))
+    for summary, name in conn.execute("SELECT summary_resource, name FROM summary_metrics"):
+        items.append(("summary_metric", f"{summary}.{name}", "", ""))
+
+    conn.executemany(
+        "INSERT INTO search_index (kind, name, detail, body) VALUES (?, ?, ?, ?)",
+        items,
+    )
+    names = sorted(
+        {
+            part.lstrip("-")
+            for _, name, _, _ in items
+            for part in re.split(r"[ .]+", name)
+            if len(compact_search_text(part)) >= 3
+        }
+    )
+    conn.executemany(
+        "INSERT INTO search_trigrams (name, compact) VALUES (?, ?)",
+        [(name, compact_search_text(name)) for name in names],
+    )
+    conn.execute("INSERT INTO search_index (search_index) VALUES ('optimize')")
+    conn.execute("INSERT INTO search_trigrams (search_trigrams) VALUES ('optimize')")
+
+
 def main() -> None:
     parser = argparse.ArgumentParser(description="Compile Cartographer artifacts into SQLite")
     parser.add_argument("--config", default="config.yaml", help="Path to config.yaml")
@@ -1580,6 +1665,7 @@ def main() -> None:
         upsert_artifact(conn, artifact)
         inserted += 1
 
+    build_search_index(conn)
     conn.commit()
     conn.close()
     embedded_db_path = repo_root / "internal" / "cli" / "knowledge_db" / "knowledge.sqlite"
@@ -0,0 +1,212 @@
+// Command rowschemas writes internal/cli/schema_rows.go, which maps each
+// command source file to the Go type it prints with writeJSON. 'xbe schema
+// commands' reflects over these types to describe --json output.
+//
+// Run it with 'go generate ./internal/cli' after adding or renaming commands.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const outputName = "schema_rows.go"
+
+func main() {
+	dir := "internal/cli"
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	if err := run(dir); err != nil {
+		fmt.Fprintln(os.Stderr, "rowschemas:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	files := map[string]*ast.File{}
+	structs := map[string]bool{}
+	results := map[string]ast.Expr{}
+	for _, path := range paths {
+		base := filepath.Base(path)
+		if strings.HasSuffix(base, "_test.go") || base == outputName {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		files[strings.TrimSuffix(base, ".go")] = file
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						if _, ok := typeSpec.Type.(*ast.StructType); ok {
+							structs[typeSpec.Name.Name] = true
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil && decl.Type.Results != nil && len(decl.Type.Results.List) > 0 {
+					results[decl.Name.Name] = decl.Type.Results.List[0].Type
+				}
+			}
+		}
+	}
+
+	types := map[string]string{}
+	for stem, file := range files {
+		if !strings.HasPrefix(stem, "do_") && !strings.HasSuffix(stem, "_list") {
+			continue
+		}
+		if typeName := printedType(file, structs, results); typeName != "" {
+			types[stem] = typeName
+		}
+	}
+
+	stems := make([]string, 0, len(types))
+	for stem := range types {
+		stems = append(stems, stem)
+	}
+	sort.Strings(stems)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by build_tools/rowschemas; DO NOT EDIT.\n\n")
+	buf.WriteString("package cli\n\nimport \"reflect\"\n\n")
+	buf.WriteString("// commandOutputTypes maps command source files to the type they print with --json.\n")
+	buf.WriteString("var commandOutputTypes = map[string]reflect.Type{\n")
+	for _, stem := range stems {
+		fmt.Fprintf(&buf, "\t%q: reflect.TypeOf(%s),\n", stem, zeroValue(types[stem]))
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, outputName), source, 0o644)
+}
+
+func zeroValue(typeName string) string {
+	if strings.HasPrefix(typeName, "[]") {
+		return "(" + typeName + ")(nil)"
+	}
+	return typeName + "{}"
+}
+
+// printedType returns the type of the first value passed to writeJSON whose
+// type can be resolved: a named struct or a slice of one.
+func printedType(file *ast.File, structs map[string]bool, results map[string]ast.Expr) string {
+	var found string
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || found != "" {
+			continue
+		}
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			if found != "" {
+				return false
+			}
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 {
+				return true
+			}
+			if name, ok := call.Fun.(*ast.Ident); !ok || name.Name != "writeJSON" {
+				return true
+			}
+			arg, ok := call.Args[1].(*ast.Ident)
+			if !ok {
+				return true
+			}
+			found = resolveIdent(fn.Body, arg.Name, structs, results)
+			return found == ""
+		})
+	}
+	return found
+}
+
+func resolveIdent(body *ast.BlockStmt, name string, structs map[string]bool, results map[string]ast.Expr) string {
+	var found string
+	ast.Inspect(body, func(node ast.Node) bool {
+		if found != "" {
+			return false
+		}
+		switch stmt := node.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range stmt.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || ident.Name != name || i >= len(stmt.Rhs) {
+					continue
+				}
+				found = typeOfExpr(stmt.Rhs[i], structs, results)
+			}
+		case *ast.ValueSpec:
+			for _, ident := range stmt.Names {
+				if ident.Name != name {
+					continue
+				}
+				if stmt.Type != nil {
+					found = typeName(stmt.Type, structs)
+				} else if len(stmt.Values) > 0 {
+					found = typeOfExpr(stmt.Values[0], structs, results)
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func typeOfExpr(expr ast.Expr, structs map[string]bool, results map[string]ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.CompositeLit:
+		return typeName(expr.Type, structs)
+	case *ast.UnaryExpr:
+		return typeOfExpr(expr.X, structs, results)
+	case *ast.CallExpr:
+		fn, ok := expr.Fun.(*ast.Ident)
+		if !ok {
+			return ""
+		}
+		if fn.Name == "make" && len(expr.Args) > 0 {
+			return typeName(expr.Args[0], structs)
+		}
+		if result, ok := results[fn.Name]; ok {
+			return typeName(result, structs)
+		}
+	}
+	return ""
+}
+
+func typeName(expr ast.Expr, structs map[string]bool) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if structs[expr.Name] {
+			return expr.Name
+		}
+	case *ast.StarExpr:
+		return typeName(expr.X, structs)
+	case *ast.ArrayType:
+		if expr.Len == nil {
+			if elem := typeName(expr.Elt, structs); elem != "" && !strings.HasPrefix(elem, "[]") {
+				return "[]" + elem
+			}
+		}
+	}
+	return ""
+}
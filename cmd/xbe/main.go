@@ -33,7 +33,9 @@ func main() {
 	}
 
 	if err := cli.ExecuteContext(ctx, tp); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		if !cli.ErrorReported(err) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(cli.ExitCode(err))
 	}
 }
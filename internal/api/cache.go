@@ -0,0 +1,301 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachePolicy controls the on-disk response cache for GET requests.
+//
+// Responses are keyed by the full request URL (base URL, path and query) and
+// the identity of the token that fetched them, so records visible to one
+// user are never served to another. An entry younger than its resource's TTL
+// is returned without a request; an older one is revalidated with
+// If-None-Match / If-Modified-Since and reused when the server answers 304.
+type CachePolicy struct {
+	// Dir holds the cache entries.
+	Dir string
+	// DefaultTTL applies to resources without an entry in TTLs. Zero means
+	// every use is revalidated.
+	DefaultTTL time.Duration
+	// TTLs maps resource types (path segments such as "material-types") to
+	// how long their responses are reused without revalidation.
+	TTLs map[string]time.Duration
+	// Refresh revalidates every entry regardless of age.
+	Refresh bool
+}
+
+// DefaultCacheTTLs returns the reuse windows for slowly changing reference data.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"material-types":          time.Hour,
+		"unit-of-measures":        24 * time.Hour,
+		"cost-codes":              time.Hour,
+		"trailer-classifications": 24 * time.Hour,
+		"service-types":           24 * time.Hour,
+		"languages":               24 * time.Hour,
+		"glossary-terms":          24 * time.Hour,
+	}
+}
+
+// TTL returns how long responses for resource are reused without revalidation.
+func (p CachePolicy) TTL(resource string) time.Duration {
+	if ttl, ok := p.TTLs[resource]; ok {
+		return ttl
+	}
+	return p.DefaultTTL
+}
+
+var (
+	cachePolicyMu sync.RWMutex
+	cachePolicy   *CachePolicy
+)
+
+// SetCachePolicy enables the response cache for clients created afterwards;
+// nil disables it.
+func SetCachePolicy(policy *CachePolicy) {
+	cachePolicyMu.Lock()
+	defer cachePolicyMu.Unlock()
+	cachePolicy = policy
+}
+
+// CurrentCachePolicy returns the cache policy applied to new clients, or nil.
+func CurrentCachePolicy() *CachePolicy {
+	cachePolicyMu.RLock()
+	defer cachePolicyMu.RUnlock()
+	return cachePolicy
+}
+
+// CacheEntry is one cached response as stored on disk.
+type CacheEntry struct {
+	URL          string    `json:"url"`
+	Resource     string    `json:"resource"`
+	Status       int       `json:"status"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	StoredAt     time.Time `json:"stored_at"`
+	Body         []byte    `json:"body"`
+}
+
+// CacheCounters counts cache outcomes across invocations.
+type CacheCounters struct {
+	Hits          int `json:"hits"`
+	Revalidations int `json:"revalidations"`
+	Misses        int `json:"misses"`
+}
+
+const (
+	cacheEntriesDir = "entries"
+	cacheStatsFile  = "stats.json"
+)
+
+// cachedGet serves a GET from the cache when fresh, revalidates it when
+// stale, and stores cacheable responses.
+func (c *Client) cachedGet(ctx context.Context, policy *CachePolicy, target string) ([]byte, int, error) {
+	resource := CacheResource(target)
+	path := cacheEntryPath(policy.Dir, target, c.Token)
+	now := time.Now()
+
+	entry, found := readCacheEntry(path)
+	if found && !policy.Refresh && now.Sub(entry.StoredAt) < policy.TTL(resource) {
+		recordCacheOutcome(policy.Dir, func(counters *CacheCounters) { counters.Hits++ })
+		return entry.Body, entry.Status, nil
+	}
+
+	header := http.Header{}
+	if found {
+		if entry.ETag != "" {
+			header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+	body, status, respHeader, err := c.sendWithRetries(ctx, http.MethodGet, target, nil, header)
+	if err != nil {
+		return body, status, err
+	}
+	if found && status == http.StatusNotModified {
+		entry.StoredAt = now
+		writeCacheEntry(path, entry)
+		recordCacheOutcome(policy.Dir, func(counters *CacheCounters) { counters.Revalidations++ })
+		return entry.Body, entry.Status, nil
+	}
+
+	recordCacheOutcome(policy.Dir, func(counters *CacheCounters) { counters.Misses++ })
+	etag, lastModified := respHeader.Get("ETag"), respHeader.Get("Last-Modified")
+	if status == http.StatusOK && (etag != "" || lastModified != "" || policy.TTL(resource) > 0) {
+		writeCacheEntry(path, CacheEntry{
+			URL:          target,
+			Resource:     resource,
+			Status:       status,
+			ETag:         etag,
+			LastModified: lastModified,
+			StoredAt:     now,
+			Body:         body,
+		})
+	}
+	return body, status, nil
+}
+
+// CacheResource returns the resource type a request URL addresses: the first
+// path segment after /v1/.
+func CacheResource(target string) string {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return ""
+	}
+	path := parsed.Path
+	if i := strings.Index(path, "/v1/"); i >= 0 {
+		path = path[i+len("/v1/"):]
+	}
+	segment, _, _ := strings.Cut(strings.TrimLeft(path, "/"), "/")
+	return segment
+}
+
+func cacheEntryPath(dir, target, token string) string {
+	tokenSum := sha256.Sum256([]byte(token))
+	sum := sha256.Sum256([]byte(hex.EncodeToString(tokenSum[:8]) + " " + target))
+	key := hex.EncodeToString(sum[:])
+	return filepath.Join(dir, cacheEntriesDir, key[:2], key+".json")
+}
+
+func readCacheEntry(path string) (CacheEntry, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return CacheEntry{}, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return CacheEntry{}, false
+	}
+	return entry, true
+}
+
+func writeCacheEntry(path string, entry CacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	writeCacheFile(path, data)
+}
+
+// writeCacheFile writes through a temporary file so concurrent invocations
+// never read a partial entry. Cache write failures are not request failures.
+func writeCacheFile(path string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+var cacheStatsMu sync.Mutex
+
+func recordCacheOutcome(dir string, update func(*CacheCounters)) {
+	cacheStatsMu.Lock()
+	defer cacheStatsMu.Unlock()
+	counters := ReadCacheCounters(dir)
+	update(&counters)
+	data, err := json.Marshal(counters)
+	if err != nil {
+		return
+	}
+	writeCacheFile(filepath.Join(dir, cacheStatsFile), data)
+}
+
+// ReadCacheCounters returns the hit, revalidation and miss counts recorded in dir.
+func ReadCacheCounters(dir string) CacheCounters {
+	var counters CacheCounters
+	if data, err := os.ReadFile(filepath.Join(dir, cacheStatsFile)); err == nil {
+		_ = json.Unmarshal(data, &counters)
+	}
+	return counters
+}
+
+// CacheEntries lists the entries stored in dir, ordered by URL.
+func CacheEntries(dir string) ([]CacheEntry, error) {
+	var entries []CacheEntry
+	err := filepath.WalkDir(filepath.Join(dir, cacheEntriesDir), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+		if entry, ok := readCacheEntry(path); ok {
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	sort.Slice(entries, func(i, j int) bool { return entries[i].URL < entries[j].URL })
+	return entries, err
+}
+
+// ClearCache removes cached entries. With resources, only entries for those
+// resource types are removed; otherwise the counters are reset too. It
+// returns the number of entries removed.
+func ClearCache(dir string, resources []string) (int, error) {
+	only := map[string]bool{}
+	for _, resource := range resources {
+		only[resource] = true
+	}
+	removed := 0
+	err := filepath.WalkDir(filepath.Join(dir, cacheEntriesDir), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+		if len(only) > 0 {
+			entry, ok := readCacheEntry(path)
+			if ok && !only[entry.Resource] {
+				return nil
+			}
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	if err != nil {
+		return removed, err
+	}
+	if len(only) == 0 {
+		if err := os.Remove(filepath.Join(dir, cacheStatsFile)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCachedGetRevalidatesWithETag(t *testing.T) {
+	calls, notModified := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"1"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	policy := &CachePolicy{Dir: t.TempDir(), TTLs: map[string]time.Duration{"cost-codes": time.Hour}}
+	client := NewClient(server.URL, "token")
+	client.Cache = policy
+
+	for i := 0; i < 2; i++ {
+		body, status, err := client.Get(context.Background(), "/v1/brokers", nil)
+		if err != nil || status != http.StatusOK || string(body) != `{"data":[{"id":"1"}]}` {
+			t.Fatalf("get %d = %q, %d, %v", i, body, status, err)
+		}
+	}
+	if calls != 2 || notModified != 1 {
+		t.Fatalf("calls = %d, not modified = %d; want 2, 1", calls, notModified)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Get(context.Background(), "/v1/cost-codes", nil); err != nil {
+			t.Fatalf("get cost-codes: %v", err)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("calls = %d; a fresh cost-codes entry should be reused", calls)
+	}
+
+	policy.Refresh = true
+	if _, _, err := client.Get(context.Background(), "/v1/cost-codes", nil); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if calls != 4 || notModified != 2 {
+		t.Fatalf("refresh: calls = %d, not modified = %d", calls, notModified)
+	}
+
+	counters := ReadCacheCounters(policy.Dir)
+	if counters.Hits != 1 || counters.Revalidations != 2 || counters.Misses != 2 {
+		t.Fatalf("counters = %+v", counters)
+	}
+}
+
+func TestCacheKeyedByToken(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	policy := &CachePolicy{Dir: t.TempDir(), DefaultTTL: time.Hour}
+	for _, token := range []string{"alice", "bob", "alice"} {
+		client := NewClient(server.URL, token)
+		client.Cache = policy
+		if _, _, err := client.Get(context.Background(), "/v1/brokers", nil); err != nil {
+			t.Fatalf("get: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2", calls)
+	}
+
+	entries, err := CacheEntries(policy.Dir)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("entries = %d, %v", len(entries), err)
+	}
+	if removed, err := ClearCache(policy.Dir, []string{"cost-codes"}); err != nil || removed != 0 {
+		t.Fatalf("clear cost-codes = %d, %v", removed, err)
+	}
+	if removed, err := ClearCache(policy.Dir, nil); err != nil || removed != 2 {
+		t.Fatalf("clear = %d, %v", removed, err)
+	}
+}
+
+func TestCacheClearedByWrite(t *testing.T) {
+	gets := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets++
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "token")
+	client.Cache = &CachePolicy{Dir: t.TempDir(), DefaultTTL: time.Hour}
+	ctx := context.Background()
+	for _, path := range []string{"/v1/brokers", "/v1/brokers/1", "/v1/cost-codes"} {
+		if _, _, err := client.Get(ctx, path, nil); err != nil {
+			t.Fatalf("get %s: %v", path, err)
+		}
+	}
+	if _, _, err := client.Patch(ctx, "/v1/brokers/1", []byte(`{}`)); err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+	for _, path := range []string{"/v1/brokers", "/v1/brokers/1", "/v1/cost-codes"} {
+		if _, _, err := client.Get(ctx, path, nil); err != nil {
+			t.Fatalf("get %s: %v", path, err)
+		}
+	}
+	if gets != 5 {
+		t.Fatalf("gets = %d, want the brokers entries refetched and cost-codes reused", gets)
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -29,6 +30,9 @@ type Client struct {
 	BaseURL    string
 	Token      string
 	HTTPClient *http.Client
+	Retry      RetryPolicy
+	// Cache, when set, serves and stores GET responses on disk.
+	Cache *CachePolicy
 }
 
 func NewClient(baseURL, token string) *Client {
@@ -43,21 +47,27 @@ func NewClient(baseURL, token string) *Client {
 
 	// Wrap transport with telemetry instrumentation if available
 	if telemetryProvider != nil {
-		httpClient.Transport = telemetryProvider.HTTPTransport(http.DefaultTransport)
+		httpClient.Transport = telemetryProvider.HTTPTransport(retryAttributeTransport{base: http.DefaultTransport})
 	}
 
 	return &Client{
 		BaseURL:    strings.TrimRight(baseURL, "/"),
 		Token:      strings.TrimSpace(token),
 		HTTPClient: httpClient,
+		Retry:      retryPolicy,
+		Cache:      CurrentCachePolicy(),
 	}
 }
 
 // Get performs a GET request to the given path with query params.
+//
+// When the context carries PaginationOverrides and path addresses a collection,
+// every page is fetched and merged (see GetAll).
 func (c *Client) Get(ctx context.Context, path string, query url.Values) ([]byte, int, error) {
-	base, err := url.Parse(c.BaseURL)
-	if err != nil {
-		return nil, 0, fmt.Errorf("invalid base url: %w", err)
+	if overrides, ok := PaginationOverridesFromContext(ctx); ok && isCollectionPath(path) {
+		body, status, err := c.GetAll(ctx, path, query, overrides.PageSize, overrides.MaxItems)
+		recordResponse(ctx, body, err)
+		return body, status, err
 	}
 
 	if query == nil {
@@ -66,37 +76,22 @@ func (c *Client) Get(ctx context.Context, path string, query url.Values) ([]byte
 	ApplySparseFieldOverrides(ctx, path, query)
 	ApplyMetaOverrides(ctx, query)
 
-	path = "/" + strings.TrimLeft(path, "/")
-	base.Path = strings.TrimRight(base.Path, "/") + path
-	base.RawQuery = query.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base.String(), nil)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	if c.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.Token)
-	}
-	req.Header.Set("Accept", "application/vnd.api+json")
-	req.Header.Set("User-Agent", "xbe-cli/"+version.String())
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer resp.Body.Close()
+	body, status, err := c.getPage(ctx, path, query)
+	recordResponse(ctx, body, err)
+	return body, status, err
+}
 
-	body, err := io.ReadAll(resp.Body)
+func (c *Client) getPage(ctx context.Context, path string, query url.Values) ([]byte, int, error) {
+	base, err := url.Parse(c.BaseURL)
 	if err != nil {
-		return nil, resp.StatusCode, err
+		return nil, 0, fmt.Errorf("invalid base url: %w", err)
 	}
 
-	if resp.StatusCode >= http.StatusBadRequest {
-		return body, resp.StatusCode, fmt.Errorf("request failed: %s", resp.Status)
-	}
+	path = "/" + strings.TrimLeft(path, "/")
+	base.Path = strings.TrimRight(base.Path, "/") + path
+	base.RawQuery = query.Encode()
 
-	return body, resp.StatusCode, nil
+	return c.send(ctx, http.MethodGet, base.String(), nil)
 }
 
 // Post performs a POST request to the given path with a JSON body.
@@ -119,6 +114,12 @@ func (c *Client) Delete(ctx context.Context, path string) ([]byte, int, error) {
 	return c.doWithBody(ctx, http.MethodDelete, path, nil)
 }
 
+// Do performs a request with any method, optional query params and an
+// optional JSON body.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, jsonBody []byte) ([]byte, int, error) {
+	return c.doWithBodyAndQuery(ctx, method, path, query, jsonBody)
+}
+
 func (c *Client) doWithBody(ctx context.Context, method, path string, body []byte) ([]byte, int, error) {
 	return c.doWithBodyAndQuery(ctx, method, path, nil, body)
 }
@@ -135,14 +136,92 @@ func (c *Client) doWithBodyAndQuery(ctx context.Context, method, path string, qu
 		base.RawQuery = query.Encode()
 	}
 
+	respBody, status, err := c.send(ctx, method, base.String(), body)
+	recordResponse(ctx, respBody, err)
+	return respBody, status, err
+}
+
+// send performs the request, retrying transient failures according to c.Retry,
+// and reports the outcome to any request observer on ctx.
+func (c *Client) send(ctx context.Context, method, target string, body []byte) ([]byte, int, error) {
+	if ValidateOnlyFromContext(ctx) && method != http.MethodGet {
+		return nil, 0, ErrValidateOnly
+	}
+	if handler, ok := DryRunFromContext(ctx); ok && method != http.MethodGet && method != http.MethodHead {
+		return c.dryRun(ctx, handler, method, target, body)
+	}
+
+	if err := checkReadOnly(method, target); err != nil {
+		return nil, 0, err
+	}
+
+	var respBody []byte
+	var status int
+	var err error
+	if method == http.MethodGet && c.Cache != nil {
+		respBody, status, err = c.cachedGet(ctx, c.Cache, target)
+	} else {
+		respBody, status, _, err = c.sendWithRetries(ctx, method, target, body, nil)
+		if c.Cache != nil && err == nil && status >= 200 && status < 300 {
+			// A write makes any cached response for the resource stale.
+			_, _ = ClearCache(c.Cache.Dir, []string{CacheResource(target)})
+		}
+	}
+	if observer, ok := RequestObserverFromContext(ctx); ok {
+		observer(RequestRecord{
+			Method:       method,
+			URL:          target,
+			Body:         body,
+			Status:       status,
+			ResponseBody: respBody,
+			Err:          err,
+		})
+	}
+	return respBody, status, err
+}
+
+func (c *Client) sendWithRetries(ctx context.Context, method, target string, body []byte, header http.Header) ([]byte, int, http.Header, error) {
+	attempt := 0
+	for {
+		respBody, status, respHeader, retryAfter, err := c.sendOnce(withRetryAttempt(ctx, attempt), method, target, body, header)
+		if !c.shouldRetry(ctx, method, attempt, status, err) {
+			return respBody, status, respHeader, err
+		}
+
+		attempt++
+		delay := c.Retry.backoff(attempt)
+		if retryAfter > 0 {
+			if retryAfter > maxRetryAfter {
+				return respBody, status, respHeader, fmt.Errorf("%w (retry after %s)", err, retryAfter)
+			}
+			delay = retryAfter
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return respBody, status, respHeader, err
+		}
+	}
+}
+
+func (c *Client) shouldRetry(ctx context.Context, method string, attempt, status int, err error) bool {
+	if attempt >= c.Retry.MaxRetries || !c.Retry.allowsMethod(method) {
+		return false
+	}
+	if status == 0 {
+		return retryableError(ctx, err)
+	}
+	return retryableStatus(status)
+}
+
+// newRequest builds the HTTP request exactly as it is sent, headers included.
+func (c *Client) newRequest(ctx context.Context, method, target string, body []byte) (*http.Request, error) {
 	var bodyReader io.Reader
 	if body != nil {
-		bodyReader = strings.NewReader(string(body))
+		bodyReader = bytes.NewReader(body)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, base.String(), bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, target, bodyReader)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	if c.Token != "" {
@@ -153,21 +232,35 @@ func (c *Client) doWithBodyAndQuery(ctx context.Context, method, path string, qu
 		req.Header.Set("Content-Type", "application/vnd.api+json")
 	}
 	req.Header.Set("User-Agent", "xbe-cli/"+version.String())
+	return req, nil
+}
+
+// sendOnce sends a single attempt. header adds request headers, such as the
+// conditional headers of a cache revalidation.
+func (c *Client) sendOnce(ctx context.Context, method, target string, body []byte, header http.Header) ([]byte, int, http.Header, time.Duration, error) {
+	req, err := c.newRequest(ctx, method, target, body)
+	if err != nil {
+		return nil, 0, nil, 0, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, nil, 0, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp.StatusCode, err
+		return nil, resp.StatusCode, resp.Header, 0, err
 	}
 
 	if resp.StatusCode >= http.StatusBadRequest {
-		return respBody, resp.StatusCode, fmt.Errorf("request failed: %s", resp.Status)
+		retryAfter, _ := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		return respBody, resp.StatusCode, resp.Header, retryAfter, newAPIError(resp.StatusCode, resp.Status, respBody)
 	}
 
-	return respBody, resp.StatusCode, nil
+	return respBody, resp.StatusCode, resp.Header, 0, nil
 }
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ErrDryRun is returned in place of a write request's response when the
+// context is in dry-run mode.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// DryRunHandler receives each request that would have been sent, with the
+// body that would have been sent.
+type DryRunHandler func(req *http.Request, body []byte)
+
+type dryRunKey struct{}
+
+// WithDryRun makes the client hand write requests to handler instead of
+// sending them. Reads still go to the server, so commands that fetch a record
+// before writing build the same request a real run would.
+func WithDryRun(ctx context.Context, handler DryRunHandler) context.Context {
+	if handler == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, dryRunKey{}, handler)
+}
+
+func DryRunFromContext(ctx context.Context) (DryRunHandler, bool) {
+	handler, ok := ctx.Value(dryRunKey{}).(DryRunHandler)
+	return handler, ok && handler != nil
+}
+
+func (c *Client) dryRun(ctx context.Context, handler DryRunHandler, method, target string, body []byte) ([]byte, int, error) {
+	req, err := c.newRequest(ctx, method, target, body)
+	if err != nil {
+		return nil, 0, err
+	}
+	handler(req, body)
+	return nil, 0, ErrDryRun
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDryRunSendsOnlyReads(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, _ = w.Write([]byte(`{"data":{"id":"5","type":"action-items"}}`))
+	}))
+	defer server.Close()
+
+	var seen []*http.Request
+	ctx := WithDryRun(context.Background(), func(req *http.Request, body []byte) {
+		seen = append(seen, req)
+	})
+	client := NewClient(server.URL, "secret")
+
+	body, status, err := client.Get(ctx, "/v1/action-items/5", nil)
+	if err != nil || status != http.StatusOK || string(body) != `{"data":{"id":"5","type":"action-items"}}` {
+		t.Fatalf("unexpected dry-run read: %s %d %v", body, status, err)
+	}
+	_, _, err = client.Patch(ctx, "/v1/action-items/5", []byte(`{"data":{}}`))
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Fatalf("requests reaching the server = %v, want only the GET", methods)
+	}
+	if len(seen) != 1 || seen[0].Method != http.MethodPatch || seen[0].Header.Get("Authorization") != "Bearer secret" {
+		t.Fatalf("unexpected requests: %+v", seen)
+	}
+}
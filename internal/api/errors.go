@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ErrorSource identifies the part of the request an error refers to.
+type ErrorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// ErrorObject is a single entry of a JSON:API errors array.
+type ErrorObject struct {
+	Status string       `json:"status,omitempty"`
+	Code   string       `json:"code,omitempty"`
+	Title  string       `json:"title,omitempty"`
+	Detail string       `json:"detail,omitempty"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+func (e *ErrorObject) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Status json.RawMessage `json:"status"`
+		Code   json.RawMessage `json:"code"`
+		Title  string          `json:"title"`
+		Detail string          `json:"detail"`
+		Source *ErrorSource    `json:"source"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.Status = looseString(raw.Status)
+	e.Code = looseString(raw.Code)
+	e.Title = raw.Title
+	e.Detail = raw.Detail
+	e.Source = raw.Source
+	return nil
+}
+
+// Message returns the most descriptive text available for the error.
+func (e ErrorObject) Message() string {
+	if detail := strings.TrimSpace(e.Detail); detail != "" {
+		return detail
+	}
+	return strings.TrimSpace(e.Title)
+}
+
+// APIError is returned for responses with a 4xx or 5xx status.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Errors     []ErrorObject
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return "request failed: " + e.Status
+}
+
+func newAPIError(statusCode int, status string, body []byte) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Status:     status,
+		Errors:     ParseErrorObjects(body),
+		Body:       body,
+	}
+}
+
+// ParseErrorObjects decodes the errors array of a JSON:API error document.
+// It returns nil when the body is not a JSON:API error document.
+func ParseErrorObjects(body []byte) []ErrorObject {
+	var doc struct {
+		Errors []ErrorObject `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil
+	}
+	return doc.Errors
+}
+
+func looseString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	return strings.TrimSpace(string(raw))
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseErrorObjects(t *testing.T) {
+	body := []byte(`{"errors":[{"status":422,"code":"100","title":"is invalid","detail":"goal-quantity - must be positive","source":{"pointer":"/data/attributes/goal-quantity"}}]}`)
+	items := ParseErrorObjects(body)
+	if len(items) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(items))
+	}
+	item := items[0]
+	if item.Status != "422" || item.Code != "100" {
+		t.Fatalf("unexpected status/code: %q/%q", item.Status, item.Code)
+	}
+	if item.Source == nil || item.Source.Pointer != "/data/attributes/goal-quantity" {
+		t.Fatalf("unexpected source: %+v", item.Source)
+	}
+	if item.Message() != "goal-quantity - must be positive" {
+		t.Fatalf("unexpected message: %q", item.Message())
+	}
+	if ParseErrorObjects([]byte("<html>oops</html>")) != nil {
+		t.Fatalf("expected nil for non-JSON body")
+	}
+}
+
+func TestClientReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"already exists"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "")
+	_, _, err := client.Post(context.Background(), "/v1/brokers", []byte(`{}`))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusConflict || len(apiErr.Errors) != 1 {
+		t.Fatalf("unexpected api error: %+v", apiErr)
+	}
+	if err.Error() != "request failed: 409 Conflict" {
+		t.Fatalf("unexpected message: %q", err.Error())
+	}
+}
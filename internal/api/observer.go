@@ -0,0 +1,32 @@
+package api
+
+import "context"
+
+// RequestRecord describes one completed request (after retries).
+type RequestRecord struct {
+	Method       string
+	URL          string
+	Body         []byte
+	Status       int
+	ResponseBody []byte
+	Err          error
+}
+
+// RequestObserver is called after each request completes. It may be called
+// from several goroutines at once.
+type RequestObserver func(record RequestRecord)
+
+type requestObserverKey struct{}
+
+// WithRequestObserver reports every request sent with ctx to observer.
+func WithRequestObserver(ctx context.Context, observer RequestObserver) context.Context {
+	if observer == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, requestObserverKey{}, observer)
+}
+
+func RequestObserverFromContext(ctx context.Context) (RequestObserver, bool) {
+	observer, ok := ctx.Value(requestObserverKey{}).(RequestObserver)
+	return observer, ok && observer != nil
+}
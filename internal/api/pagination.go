@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultPageSize is the page size used when walking every page of a list
+// endpoint and the caller did not request a specific page[limit].
+const DefaultPageSize = 100
+
+type paginationKey struct{}
+
+// PaginationOverrides controls automatic pagination for collection GETs.
+type PaginationOverrides struct {
+	All      bool
+	MaxItems int
+	PageSize int
+}
+
+// Enabled reports whether the overrides request walking multiple pages.
+func (p PaginationOverrides) Enabled() bool {
+	return p.All || p.MaxItems > 0
+}
+
+func WithPaginationOverrides(ctx context.Context, overrides PaginationOverrides) context.Context {
+	if !overrides.Enabled() {
+		return ctx
+	}
+	return context.WithValue(ctx, paginationKey{}, overrides)
+}
+
+func PaginationOverridesFromContext(ctx context.Context) (PaginationOverrides, bool) {
+	value := ctx.Value(paginationKey{})
+	if value == nil {
+		return PaginationOverrides{}, false
+	}
+	overrides, ok := value.(PaginationOverrides)
+	return overrides, ok
+}
+
+type listPage struct {
+	Data     json.RawMessage   `json:"data"`
+	Included []json.RawMessage `json:"included"`
+	Meta     json.RawMessage   `json:"meta,omitempty"`
+	Links    map[string]any    `json:"links,omitempty"`
+}
+
+type mergedListPage struct {
+	Data     []json.RawMessage `json:"data"`
+	Included []json.RawMessage `json:"included"`
+	Meta     json.RawMessage   `json:"meta,omitempty"`
+}
+
+type resourceIdentity struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// GetAll walks page[limit]/page[offset] pages of a list endpoint and returns a
+// single JSON:API document whose data and included arrays are merged across
+// pages. Included records are de-duplicated by type and ID. A maxItems of zero
+// means no limit.
+func (c *Client) GetAll(ctx context.Context, path string, query url.Values, pageSize, maxItems int) ([]byte, int, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query = cloneValues(query)
+	ApplySparseFieldOverrides(ctx, path, query)
+	ApplyMetaOverrides(ctx, query)
+
+	if value, err := strconv.Atoi(query.Get("page[limit]")); err == nil && value > 0 {
+		pageSize = value
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	offset := 0
+	if value, err := strconv.Atoi(query.Get("page[offset]")); err == nil && value > 0 {
+		offset = value
+	}
+
+	merged := mergedListPage{
+		Data:     []json.RawMessage{},
+		Included: []json.RawMessage{},
+	}
+	seenIncluded := map[resourceIdentity]bool{}
+	status := 0
+
+	for {
+		limit := pageSize
+		if maxItems > 0 {
+			remaining := maxItems - len(merged.Data)
+			if remaining < limit {
+				limit = remaining
+			}
+		}
+		query.Set("page[limit]", strconv.Itoa(limit))
+		query.Set("page[offset]", strconv.Itoa(offset))
+
+		body, code, err := c.getPage(ctx, path, query)
+		status = code
+		if err != nil {
+			return body, code, err
+		}
+
+		var page listPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return body, code, err
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(page.Data, &items); err != nil {
+			// Not a collection document; hand it back untouched.
+			return body, code, nil
+		}
+		if merged.Meta == nil {
+			merged.Meta = page.Meta
+		}
+
+		merged.Data = append(merged.Data, items...)
+		for _, inc := range page.Included {
+			var identity resourceIdentity
+			if err := json.Unmarshal(inc, &identity); err == nil && identity.Type != "" {
+				if seenIncluded[identity] {
+					continue
+				}
+				seenIncluded[identity] = true
+			}
+			merged.Included = append(merged.Included, inc)
+		}
+
+		offset += len(items)
+		if page.last(len(items), offset) {
+			break
+		}
+		if maxItems > 0 && len(merged.Data) >= maxItems {
+			break
+		}
+	}
+
+	if maxItems > 0 && len(merged.Data) > maxItems {
+		merged.Data = merged.Data[:maxItems]
+	}
+
+	payload, err := json.Marshal(merged)
+	if err != nil {
+		return nil, status, err
+	}
+	return payload, status, nil
+}
+
+// IsLastPage reports whether the list page in body ends a page[offset] walk
+// once offset records have been read.
+func IsLastPage(body []byte, offset int) bool {
+	var page listPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return true
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(page.Data, &items); err != nil {
+		return true
+	}
+	return page.last(len(items), offset)
+}
+
+// last reports whether a page holding count records ends the walk. A short
+// page does not: servers may cap page[limit] below what was asked for. The
+// walk ends on an empty page, a missing links.next, or once offset reaches
+// meta.record-count.
+func (p listPage) last(count, offset int) bool {
+	if count == 0 {
+		return true
+	}
+	if p.Links != nil && p.Links["next"] == nil {
+		return true
+	}
+	var meta struct {
+		RecordCount *int `json:"record-count"`
+	}
+	if len(p.Meta) > 0 && json.Unmarshal(p.Meta, &meta) == nil && meta.RecordCount != nil {
+		return offset >= *meta.RecordCount
+	}
+	return false
+}
+
+// isCollectionPath reports whether the path addresses a resource collection
+// (e.g. /v1/brokers) rather than a single record or nested action.
+func isCollectionPath(path string) bool {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return false
+	}
+	parts := strings.Split(trimmed, "/")
+	if parts[0] == "v1" {
+		parts = parts[1:]
+	}
+	return len(parts) == 1 && parts[0] != ""
+}
+
+func cloneValues(values url.Values) url.Values {
+	out := make(url.Values, len(values))
+	for key, items := range values {
+		out[key] = append([]string(nil), items...)
+	}
+	return out
+}
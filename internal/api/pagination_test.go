@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func newPagingServer(t *testing.T, total int) (*httptest.Server, *[]string) {
+	t.Helper()
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("page[limit]"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("page[offset]"))
+		data := []map[string]any{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			data = append(data, map[string]any{"id": strconv.Itoa(i + 1), "type": "brokers"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data":     data,
+			"included": []map[string]any{{"id": "1", "type": "customers"}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGetWithPaginationOverridesMergesPages(t *testing.T) {
+	server, requests := newPagingServer(t, 7)
+	client := NewClient(server.URL, "")
+
+	ctx := WithPaginationOverrides(context.Background(), PaginationOverrides{All: true, PageSize: 3})
+	body, _, err := client.Get(ctx, "/v1/brokers", nil)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+
+	var doc struct {
+		Data     []map[string]any `json:"data"`
+		Included []map[string]any `json:"included"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(doc.Data) != 7 {
+		t.Fatalf("expected 7 records, got %d", len(doc.Data))
+	}
+	if len(doc.Included) != 1 {
+		t.Fatalf("expected included to be de-duplicated, got %d", len(doc.Included))
+	}
+	// Without links or a record count, only an empty page ends the walk.
+	if len(*requests) != 4 {
+		t.Fatalf("expected 4 page requests, got %d", len(*requests))
+	}
+}
+
+func TestGetAllContinuesPastCappedPages(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// The server returns at most 2 records whatever page[limit] asks for.
+		offset, _ := strconv.Atoi(r.URL.Query().Get("page[offset]"))
+		data := []map[string]any{}
+		for i := offset; i < offset+2 && i < 5; i++ {
+			data = append(data, map[string]any{"id": strconv.Itoa(i + 1), "type": "brokers"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data, "meta": map[string]any{"record-count": 5}})
+	}))
+	t.Cleanup(server.Close)
+
+	body, _, err := NewClient(server.URL, "").GetAll(context.Background(), "/v1/brokers", nil, 100, 0)
+	if err != nil {
+		t.Fatalf("get all: %v", err)
+	}
+	var doc struct {
+		Data []map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(doc.Data) != 5 || requests != 3 {
+		t.Fatalf("got %d records in %d requests, want 5 in 3", len(doc.Data), requests)
+	}
+}
+
+func TestGetAllHonorsMaxItems(t *testing.T) {
+	server, requests := newPagingServer(t, 50)
+	client := NewClient(server.URL, "")
+
+	body, _, err := client.GetAll(context.Background(), "/v1/brokers", nil, 4, 10)
+	if err != nil {
+		t.Fatalf("get all: %v", err)
+	}
+	var doc struct {
+		Data []map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(doc.Data) != 10 {
+		t.Fatalf("expected 10 records, got %d", len(doc.Data))
+	}
+	last := (*requests)[len(*requests)-1]
+	if want := fmt.Sprintf("page%%5Blimit%%5D=%d&page%%5Boffset%%5D=%d", 2, 8); last != want {
+		t.Fatalf("expected final request %q, got %q", want, last)
+	}
+}
+
+func TestGetSkipsPaginationForSingleRecords(t *testing.T) {
+	server, requests := newPagingServer(t, 3)
+	client := NewClient(server.URL, "")
+
+	ctx := WithPaginationOverrides(context.Background(), PaginationOverrides{All: true})
+	if _, _, err := client.Get(ctx, "/v1/brokers/1", nil); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(*requests) != 1 || (*requests)[0] != "" {
+		t.Fatalf("expected a single unpaged request, got %v", *requests)
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ErrReadOnly is returned for write requests while read-only mode is on.
+var ErrReadOnly = errors.New("read-only mode")
+
+var (
+	readOnlyMu sync.RWMutex
+	readOnly   bool
+)
+
+// SetReadOnly turns read-only mode on or off for all clients. In read-only
+// mode every method other than GET and HEAD is refused before it is sent.
+func SetReadOnly(enabled bool) {
+	readOnlyMu.Lock()
+	defer readOnlyMu.Unlock()
+	readOnly = enabled
+}
+
+// ReadOnly reports whether read-only mode is on.
+func ReadOnly() bool {
+	readOnlyMu.RLock()
+	defer readOnlyMu.RUnlock()
+	return readOnly
+}
+
+func checkReadOnly(method, target string) error {
+	if !ReadOnly() || method == http.MethodGet || method == http.MethodHead {
+		return nil
+	}
+	return fmt.Errorf("%w: refusing %s %s", ErrReadOnly, method, target)
+}
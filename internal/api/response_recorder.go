@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+type responseRecorderKey struct{}
+
+// ResponseRecorder keeps the most recent successful response body so output
+// renderers can look at the raw JSON:API document (e.g. its included records).
+type ResponseRecorder struct {
+	mu   sync.Mutex
+	body []byte
+}
+
+// Last returns the most recently recorded response body.
+func (r *ResponseRecorder) Last() []byte {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.body
+}
+
+func (r *ResponseRecorder) record(body []byte) {
+	if r == nil || len(body) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.body = append([]byte(nil), body...)
+}
+
+func WithResponseRecorder(ctx context.Context, recorder *ResponseRecorder) context.Context {
+	if recorder == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, responseRecorderKey{}, recorder)
+}
+
+func ResponseRecorderFromContext(ctx context.Context) (*ResponseRecorder, bool) {
+	recorder, ok := ctx.Value(responseRecorderKey{}).(*ResponseRecorder)
+	return recorder, ok && recorder != nil
+}
+
+func recordResponse(ctx context.Context, body []byte, err error) {
+	if err != nil {
+		return
+	}
+	if recorder, ok := ResponseRecorderFromContext(ctx); ok {
+		recorder.record(body)
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxRetryAfter caps how long a server-provided Retry-After may stall a command.
+const maxRetryAfter = 2 * time.Minute
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts after the first request.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry; it doubles per attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff between attempts.
+	MaxDelay time.Duration
+	// RetryPOST allows retrying POST requests, which are not idempotent.
+	RetryPOST bool
+	// RetryPATCH allows retrying PATCH requests. Repeating an update is not
+	// safe when the server derives changes from the current state.
+	RetryPATCH bool
+}
+
+// DefaultRetryPolicy returns the policy used when nothing is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   30 * time.Second,
+	}
+}
+
+// Package-level retry policy applied to new clients
+var retryPolicy = DefaultRetryPolicy()
+
+// SetRetryPolicy sets the retry policy used by clients created afterwards.
+func SetRetryPolicy(policy RetryPolicy) {
+	retryPolicy = policy
+}
+
+// CurrentRetryPolicy returns the retry policy applied to new clients.
+func CurrentRetryPolicy() RetryPolicy {
+	return retryPolicy
+}
+
+func (p RetryPolicy) allowsMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPatch:
+		return p.RetryPATCH
+	case http.MethodPost:
+		return p.RetryPOST
+	default:
+		return false
+	}
+}
+
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryableError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before retry number attempt (1-based), using
+// exponential growth with jitter over the upper half of the window.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryPolicy().BaseDelay
+	}
+	delay := base << (attempt - 1)
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay <= 0) {
+		delay = p.MaxDelay
+	}
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		delay := when.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type retryAttemptKey struct{}
+
+func withRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+// retryAttributeTransport sits inside the otelhttp transport and annotates the
+// span it created with the retry attempt that produced the request.
+type retryAttributeTransport struct {
+	base http.RoundTripper
+}
+
+func (t retryAttributeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if attempt, ok := req.Context().Value(retryAttemptKey{}).(int); ok {
+		span := trace.SpanFromContext(req.Context())
+		span.SetAttributes(attribute.Int("http.request.resend_count", attempt))
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newFlakyServer(t *testing.T, failures int, status int) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func fastRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+}
+
+func TestGetRetriesTransientStatus(t *testing.T) {
+	server, calls := newFlakyServer(t, 2, http.StatusServiceUnavailable)
+	client := NewClient(server.URL, "")
+	client.Retry = fastRetryPolicy()
+
+	_, status, err := client.Get(context.Background(), "/v1/brokers", nil)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestGetStopsAfterMaxRetries(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusTooManyRequests)
+	client := NewClient(server.URL, "")
+	client.Retry = fastRetryPolicy()
+
+	_, status, err := client.Get(context.Background(), "/v1/brokers", nil)
+	if err == nil {
+		t.Fatalf("expected error after exhausting retries")
+	}
+	if status != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", status)
+	}
+	if *calls != 4 {
+		t.Fatalf("expected 4 attempts, got %d", *calls)
+	}
+}
+
+func TestPostRetriesOnlyWhenOptedIn(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusBadGateway)
+	client := NewClient(server.URL, "")
+	client.Retry = fastRetryPolicy()
+
+	if _, _, err := client.Post(context.Background(), "/v1/brokers", []byte(`{}`)); err == nil {
+		t.Fatalf("expected POST to fail without retries")
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *calls)
+	}
+
+	client.Retry.RetryPOST = true
+	if _, _, err := client.Post(context.Background(), "/v1/brokers", []byte(`{}`)); err != nil {
+		t.Fatalf("expected POST to succeed with retries: %v", err)
+	}
+}
+
+func TestPatchRetriesOnlyWhenOptedIn(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable)
+	client := NewClient(server.URL, "")
+	client.Retry = fastRetryPolicy()
+
+	if _, _, err := client.Patch(context.Background(), "/v1/brokers/1", []byte(`{}`)); err == nil {
+		t.Fatalf("expected PATCH to fail without retries")
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *calls)
+	}
+
+	client.Retry.RetryPATCH = true
+	if _, _, err := client.Patch(context.Background(), "/v1/brokers/1", []byte(`{}`)); err != nil {
+		t.Fatalf("expected PATCH to succeed with retries: %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if delay, ok := parseRetryAfter("7", now); !ok || delay != 7*time.Second {
+		t.Fatalf("expected 7s, got %v (%t)", delay, ok)
+	}
+	date := now.Add(90 * time.Second).Format(http.TimeFormat)
+	if delay, ok := parseRetryAfter(date, now); !ok || delay != 90*time.Second {
+		t.Fatalf("expected 90s, got %v (%t)", delay, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Fatalf("expected invalid Retry-After to be ignored")
+	}
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrValidateOnly is returned instead of sending a write when the context is
+// marked validate-only. Reaching it means the caller got through its checks,
+// including any lookups, without errors.
+var ErrValidateOnly = errors.New("request not sent: validate only")
+
+type validateOnlyKey struct{}
+
+// WithValidateOnly marks ctx so the client stops before any write. Reads still
+// go to the server so commands can run the checks that depend on them.
+func WithValidateOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, validateOnlyKey{}, true)
+}
+
+// ValidateOnlyFromContext reports whether ctx is marked validate-only.
+func ValidateOnlyFromContext(ctx context.Context) bool {
+	value, _ := ctx.Value(validateOnlyKey{}).(bool)
+	return value
+}
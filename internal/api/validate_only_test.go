@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidateOnlyStopsAtWrites(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, _ = w.Write([]byte(`{"data":{"id":"5","type":"action-items"}}`))
+	}))
+	defer server.Close()
+
+	ctx := WithValidateOnly(context.Background())
+	client := NewClient(server.URL, "secret")
+
+	body, status, err := client.Get(ctx, "/v1/action-items/5", nil)
+	if err != nil || status != http.StatusOK || string(body) != `{"data":{"id":"5","type":"action-items"}}` {
+		t.Fatalf("validate-only read: %s %d %v", body, status, err)
+	}
+	if _, _, err := client.Post(ctx, "/v1/action-items", []byte(`{"data":{}}`)); !errors.Is(err, ErrValidateOnly) {
+		t.Fatalf("expected ErrValidateOnly, got %v", err)
+	}
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Fatalf("requests reaching the server = %v, want only the GET", methods)
+	}
+}
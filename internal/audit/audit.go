@@ -0,0 +1,227 @@
+// Package audit keeps an append-only local log of mutating CLI commands in
+// ~/.config/xbe/audit.jsonl.
+package audit
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/xbe-inc/xbe-cli/internal/config"
+)
+
+// LogEnv overrides the audit log location; "off" disables auditing.
+const LogEnv = "XBE_AUDIT_LOG"
+
+// Redacted replaces secret values in flags and request bodies.
+const Redacted = "[REDACTED]"
+
+// ErrEntryNotFound is returned when no entry matches an ID.
+var ErrEntryNotFound = errors.New("audit entry not found")
+
+// Entry records one invocation of a mutating command.
+type Entry struct {
+	ID         string            `json:"id"`
+	Time       time.Time         `json:"time"`
+	User       string            `json:"user,omitempty"`
+	Host       string            `json:"host,omitempty"`
+	Profile    string            `json:"profile,omitempty"`
+	BaseURL    string            `json:"base_url,omitempty"`
+	Command    string            `json:"command"`
+	Args       []string          `json:"args,omitempty"`
+	Flags      map[string]string `json:"flags,omitempty"`
+	Requests   []Request         `json:"requests"`
+	IDs        []string          `json:"ids,omitempty"`
+	Status     string            `json:"status"`
+	ExitCode   int               `json:"exit_code"`
+	Error      string            `json:"error,omitempty"`
+	ReplayOf   string            `json:"replay_of,omitempty"`
+	DurationMS int64             `json:"duration_ms"`
+}
+
+// Request records one write request sent by a command.
+type Request struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Status int             `json:"status"`
+	ID     string          `json:"id,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// Failed reports whether the request did not succeed.
+func (r Request) Failed() bool {
+	return r.Status == 0 || r.Status >= 400
+}
+
+// Enabled reports whether audit logging is on.
+func Enabled() bool {
+	return !strings.EqualFold(strings.TrimSpace(os.Getenv(LogEnv)), "off")
+}
+
+// Path returns the audit log location.
+func Path() string {
+	if value := strings.TrimSpace(os.Getenv(LogEnv)); value != "" && !strings.EqualFold(value, "off") {
+		return value
+	}
+	configPath := config.Path()
+	if configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(configPath), "audit.jsonl")
+}
+
+// NewID returns a sortable, unique entry ID.
+func NewID(now time.Time) string {
+	suffix := make([]byte, 3)
+	_, _ = rand.Read(suffix)
+	return now.UTC().Format("20060102T150405") + "-" + hex.EncodeToString(suffix)
+}
+
+// Append writes entry as one line at the end of the log.
+func Append(entry Entry) error {
+	path := Path()
+	if path == "" {
+		return errors.New("unable to determine audit log path")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// Load returns all entries, oldest first. Lines that cannot be parsed are
+// skipped.
+func Load() ([]Entry, error) {
+	file, err := os.Open(Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// Find returns the entry with the given ID or unique ID prefix.
+func Find(id string) (Entry, error) {
+	id = strings.TrimSpace(id)
+	entries, err := Load()
+	if err != nil {
+		return Entry{}, err
+	}
+	var matches []Entry
+	for _, entry := range entries {
+		if entry.ID == id {
+			return entry, nil
+		}
+		if id != "" && strings.HasPrefix(entry.ID, id) {
+			matches = append(matches, entry)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return Entry{}, fmt.Errorf("%w: %s", ErrEntryNotFound, id)
+	case 1:
+		return matches[0], nil
+	default:
+		return Entry{}, fmt.Errorf("audit ID prefix %q matches %d entries", id, len(matches))
+	}
+}
+
+// IsSecretName reports whether a flag or attribute name holds a secret.
+func IsSecretName(name string) bool {
+	name = strings.ToLower(name)
+	for _, marker := range []string{"token", "password", "secret", "api-key", "api_key"} {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactBody replaces secret-looking attribute values in a JSON body and
+// reports whether anything was replaced.
+func RedactBody(body []byte) (json.RawMessage, bool) {
+	if len(body) == 0 {
+		return nil, false
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		// Keep the log line valid JSON; such bodies are stored as a string.
+		payload, _ := json.Marshal(string(body))
+		return payload, false
+	}
+	if !redactValue(value) {
+		return json.RawMessage(body), false
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return json.RawMessage(body), false
+	}
+	return payload, true
+}
+
+// ContainsRedacted reports whether a recorded body had secrets removed.
+func ContainsRedacted(body json.RawMessage) bool {
+	return strings.Contains(string(body), `"`+Redacted+`"`)
+}
+
+func redactValue(value any) bool {
+	redacted := false
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if IsSecretName(key) {
+				if _, ok := nested.(string); ok {
+					typed[key] = Redacted
+					redacted = true
+					continue
+				}
+			}
+			if redactValue(nested) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, nested := range typed {
+			if redactValue(nested) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAppendAndFind(t *testing.T) {
+	t.Setenv(LogEnv, filepath.Join(t.TempDir(), "audit.jsonl"))
+
+	first := Entry{ID: NewID(time.Now()), Command: "do cost-codes create", Status: "ok"}
+	second := Entry{ID: "20260301T141500-abcdef", Command: "do cost-codes update", Status: "error"}
+	for _, entry := range []Entry{first, second} {
+		if err := Append(entry); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+
+	entries, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].ID != first.ID {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	found, err := Find("20260301T1415")
+	if err != nil || found.Command != "do cost-codes update" {
+		t.Fatalf("expected prefix lookup to find second entry, got %+v, %v", found, err)
+	}
+	if _, err := Find("nope"); err == nil {
+		t.Fatal("expected error for unknown ID")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"data":{"attributes":{"name":"Ann","password":"hunter2"}}}`)
+	redacted, changed := RedactBody(body)
+	if !changed || !ContainsRedacted(redacted) {
+		t.Fatalf("expected password to be redacted: %s", redacted)
+	}
+
+	plain := []byte(`{"data":{"attributes":{"name":"Ann"}}}`)
+	unchanged, changed := RedactBody(plain)
+	if changed || string(unchanged) != string(plain) {
+		t.Fatalf("expected body without secrets to be kept as-is: %s", unchanged)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	t.Setenv(LogEnv, "off")
+	if Enabled() {
+		t.Fatal("expected XBE_AUDIT_LOG=off to disable auditing")
+	}
+}
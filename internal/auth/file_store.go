@@ -70,11 +70,24 @@ func (s *fileStore) load() (fileConfig, error) {
 	return config, nil
 }
 
+// save rewrites the tokens key while preserving other sections of the file
+// (profiles, telemetry, ...).
 func (s *fileStore) save(config fileConfig) error {
 	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
 		return err
 	}
-	data, err := json.MarshalIndent(config, "", "  ")
+	raw := map[string]json.RawMessage{}
+	if content, err := os.ReadFile(s.path); err == nil && len(content) > 0 {
+		if err := json.Unmarshal(content, &raw); err != nil {
+			return err
+		}
+	}
+	tokens, err := json.Marshal(config.Tokens)
+	if err != nil {
+		return err
+	}
+	raw["tokens"] = tokens
+	data, err := json.MarshalIndent(raw, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/xbe-inc/xbe-cli/internal/config"
 	"github.com/zalando/go-keyring"
 )
 
@@ -25,7 +26,9 @@ const (
 	TokenSourceNone     TokenSource = "none"
 )
 
-// ResolveToken returns the token for a base URL, honoring flag/env/store precedence.
+// ResolveToken returns the token for a base URL, honoring flag/env/profile/store
+// precedence. When the active profile targets baseURL, its token reference wins
+// over the token stored for the URL.
 func ResolveToken(baseURL, flagToken string) (string, TokenSource, error) {
 	if strings.TrimSpace(flagToken) != "" {
 		return normalizeToken(flagToken), TokenSourceFlag, nil
@@ -37,7 +40,25 @@ func ResolveToken(baseURL, flagToken string) (string, TokenSource, error) {
 	store := DefaultStore()
 	normalized := NormalizeBaseURL(baseURL)
 
-	if token, source, err := store.Get(normalized); err == nil {
+	_, profile, ok, err := config.ActiveProfile()
+	if err != nil {
+		return "", TokenSourceNone, err
+	}
+	if ok && profileMatchesBaseURL(profile, normalized) {
+		if envName, isEnv := strings.CutPrefix(profile.TokenRef, "env:"); isEnv {
+			if value := strings.TrimSpace(os.Getenv(envName)); value != "" {
+				return normalizeToken(value), TokenSourceEnv, nil
+			}
+			return "", TokenSourceNone, ErrNotFound
+		}
+	}
+
+	key, err := StoreKey(normalized)
+	if err != nil {
+		return "", TokenSourceNone, err
+	}
+
+	if token, source, err := store.Get(key); err == nil {
 		token = normalizeToken(token)
 		if token == "" {
 			return "", TokenSourceNone, ErrNotFound
@@ -50,6 +71,32 @@ func ResolveToken(baseURL, flagToken string) (string, TokenSource, error) {
 	}
 }
 
+// StoreKey returns the auth store key for a base URL. When the active profile
+// targets baseURL with a store:KEY token reference, KEY is used; otherwise the
+// normalized base URL is the key.
+func StoreKey(baseURL string) (string, error) {
+	normalized := NormalizeBaseURL(baseURL)
+	_, profile, ok, err := config.ActiveProfile()
+	if err != nil {
+		return "", err
+	}
+	if !ok || !profileMatchesBaseURL(profile, normalized) {
+		return normalized, nil
+	}
+	ref := strings.TrimPrefix(strings.TrimSpace(profile.TokenRef), "store:")
+	if ref == "" || strings.HasPrefix(ref, "env:") {
+		return normalized, nil
+	}
+	return ref, nil
+}
+
+func profileMatchesBaseURL(profile config.Profile, baseURL string) bool {
+	if strings.TrimSpace(profile.BaseURL) == "" {
+		return true
+	}
+	return NormalizeBaseURL(profile.BaseURL) == baseURL
+}
+
 // EnvToken returns a token from environment variables, if present.
 func EnvToken() (string, bool) {
 	if value := strings.TrimSpace(os.Getenv("XBE_TOKEN")); value != "" {
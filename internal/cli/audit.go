@@ -0,0 +1,391 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/audit"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+)
+
+type auditListRow struct {
+	ID       string   `json:"id"`
+	Time     string   `json:"time"`
+	Status   string   `json:"status"`
+	Command  string   `json:"command"`
+	BaseURL  string   `json:"base_url,omitempty"`
+	Requests int      `json:"requests"`
+	Failed   int      `json:"failed_requests"`
+	IDs      []string `json:"ids,omitempty"`
+}
+
+type auditReplayResult struct {
+	Request int    `json:"request"`
+	Method  string `json:"method"`
+	Path    string `json:"path"`
+	Status  int    `json:"status"`
+	ID      string `json:"id,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect and replay the local log of do commands",
+	Long: `Inspect and replay the local audit log of mutating commands.
+
+Every 'xbe do' command appends an entry to ~/.config/xbe/audit.jsonl with the
+command path, flags (secrets redacted), user, profile, base URL, each write
+request body, response status, and created or updated IDs. Dry runs are not
+logged.
+
+Set XBE_AUDIT_LOG to use a different file, or XBE_AUDIT_LOG=off to disable
+logging.`,
+	Example: `  # Recent mutations
+  xbe audit list
+
+  # Inspect one entry
+  xbe audit show 20260301T141500-a1b2c3
+
+  # Re-send only the failed requests of a batch
+  xbe audit replay 20260301T141500-a1b2c3 --failed --confirm`,
+	Annotations: map[string]string{"group": GroupUtility},
+}
+
+var auditListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List audit log entries (newest first)",
+	Example: `  xbe audit list
+  xbe audit list --failed --limit 5
+  xbe audit list --command cost-codes --json`,
+	Args: cobra.NoArgs,
+	RunE: runAuditList,
+}
+
+var auditShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show an audit log entry",
+	Long: `Show an audit log entry, including each write request it sent.
+
+The ID may be abbreviated to any unique prefix.`,
+	Example: `  xbe audit show 20260301T141500-a1b2c3
+  xbe audit show 20260301T1415 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAuditShow,
+}
+
+var auditReplayCmd = &cobra.Command{
+	Use:   "replay <id>",
+	Short: "Re-send write requests from an audit log entry",
+	Long: `Re-send the write requests recorded in an audit log entry.
+
+By default every recorded request is re-sent to the entry's base URL. Use
+--failed to re-send only requests that failed, or --request to pick requests
+by number (as shown by 'xbe audit show'). Requests whose bodies had secrets
+redacted cannot be replayed; re-run the original command instead.
+
+Replays are themselves recorded in the audit log.`,
+	Example: `  # Preview what would be re-sent
+  xbe audit replay 20260301T141500-a1b2c3 --failed --dry-run
+
+  # Re-send requests 2 and 5
+  xbe audit replay 20260301T141500-a1b2c3 --request 2,5 --confirm`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAuditReplay,
+}
+
+func init() {
+	auditListCmd.Flags().Int("limit", 20, "Maximum entries to show (0 for all)")
+	auditListCmd.Flags().String("command", "", "Only entries whose command contains this text")
+	auditListCmd.Flags().Bool("failed", false, "Only entries that failed")
+	auditListCmd.Flags().Bool("json", false, "Output JSON")
+
+	auditShowCmd.Flags().Bool("json", false, "Output JSON")
+
+	auditReplayCmd.Flags().Bool("failed", false, "Only re-send requests that failed")
+	auditReplayCmd.Flags().String("request", "", "Comma-separated request numbers to re-send")
+	auditReplayCmd.Flags().Bool("confirm", false, "Confirm re-sending the requests")
+	auditReplayCmd.Flags().Bool("json", false, "Output JSON")
+	auditReplayCmd.Flags().String("base-url", "", "API base URL (default: the entry's base URL)")
+	auditReplayCmd.Flags().String("token", "", "API token (optional)")
+	initDryRunFlags(auditReplayCmd)
+
+	auditCmd.AddCommand(auditListCmd)
+	auditCmd.AddCommand(auditShowCmd)
+	auditCmd.AddCommand(auditReplayCmd)
+	rootCmd.AddCommand(auditCmd)
+}
+
+func runAuditList(cmd *cobra.Command, _ []string) error {
+	entries, err := audit.Load()
+	if err != nil {
+		return err
+	}
+	filter := strings.TrimSpace(getStringFlag(cmd, "command"))
+	failedOnly := getBoolFlag(cmd, "failed")
+	limit := getIntFlag(cmd, "limit")
+
+	rows := []auditListRow{}
+	for _, entry := range sortedAuditEntries(entries) {
+		if filter != "" && !strings.Contains(entry.Command, filter) {
+			continue
+		}
+		if failedOnly && entry.Status == "ok" {
+			continue
+		}
+		row := auditListRow{
+			ID:       entry.ID,
+			Time:     entry.Time.Local().Format("2006-01-02 15:04:05"),
+			Status:   entry.Status,
+			Command:  entry.Command,
+			BaseURL:  entry.BaseURL,
+			Requests: len(entry.Requests),
+			IDs:      entry.IDs,
+		}
+		for _, request := range entry.Requests {
+			if request.Failed() {
+				row.Failed++
+			}
+		}
+		rows = append(rows, row)
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+	}
+
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(cmd.OutOrStdout(), rows)
+	}
+	if len(rows) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No audit entries found.")
+		return nil
+	}
+	writer := newTabWriter(cmd)
+	fmt.Fprintln(writer, "ID\tTIME\tSTATUS\tCOMMAND\tREQUESTS\tIDS")
+	for _, row := range rows {
+		requests := strconv.Itoa(row.Requests)
+		if row.Failed > 0 {
+			requests = fmt.Sprintf("%d (%d failed)", row.Requests, row.Failed)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", row.ID, row.Time, row.Status, row.Command, requests, truncateString(strings.Join(row.IDs, ","), 40))
+	}
+	return writer.Flush()
+}
+
+func runAuditShow(cmd *cobra.Command, args []string) error {
+	entry, err := audit.Find(args[0])
+	if err != nil {
+		return err
+	}
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(cmd.OutOrStdout(), entry)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "ID: %s\n", entry.ID)
+	fmt.Fprintf(out, "Time: %s\n", entry.Time.Local().Format("2006-01-02 15:04:05 MST"))
+	fmt.Fprintf(out, "Command: xbe %s\n", strings.TrimSpace(entry.Command+" "+strings.Join(entry.Args, " ")))
+	fmt.Fprintf(out, "Status: %s (exit %d)\n", entry.Status, entry.ExitCode)
+	if entry.Error != "" {
+		fmt.Fprintf(out, "Error: %s\n", entry.Error)
+	}
+	if entry.ReplayOf != "" {
+		fmt.Fprintf(out, "Replay Of: %s\n", entry.ReplayOf)
+	}
+	fmt.Fprintf(out, "User: %s@%s\n", entry.User, entry.Host)
+	if entry.Profile != "" {
+		fmt.Fprintf(out, "Profile: %s\n", entry.Profile)
+	}
+	fmt.Fprintf(out, "Base URL: %s\n", entry.BaseURL)
+	fmt.Fprintf(out, "Duration: %dms\n", entry.DurationMS)
+	if len(entry.Flags) > 0 {
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Flags:")
+		names := make([]string, 0, len(entry.Flags))
+		for name := range entry.Flags {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(out, "  --%s=%s\n", name, entry.Flags[name])
+		}
+	}
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "Requests (%d):\n", len(entry.Requests))
+	for idx, request := range entry.Requests {
+		line := fmt.Sprintf("  %d. %s %s -> %s", idx+1, request.Method, request.URL, auditStatusText(request.Status))
+		if request.ID != "" {
+			line += " id=" + request.ID
+		}
+		fmt.Fprintln(out, line)
+		if request.Error != "" {
+			fmt.Fprintf(out, "     error: %s\n", request.Error)
+		}
+		if len(request.Body) > 0 {
+			fmt.Fprintf(out, "     body: %s\n", string(request.Body))
+		}
+	}
+	return nil
+}
+
+func runAuditReplay(cmd *cobra.Command, args []string) error {
+	entry, err := audit.Find(args[0])
+	if err != nil {
+		return err
+	}
+	if activeAudit != nil {
+		activeAudit.entry.ReplayOf = entry.ID
+	}
+
+	// Replaying re-runs the original command's writes, so the policy for that
+	// command applies.
+	if err := enforcePolicy(cmd, entry.Command, entry.Args); err != nil {
+		return err
+	}
+
+	selected, err := selectAuditRequests(entry, getStringFlag(cmd, "request"), getBoolFlag(cmd, "failed"))
+	if err != nil {
+		return err
+	}
+	if len(selected) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No requests to replay.")
+		return nil
+	}
+	dryRun := getBoolFlag(cmd, "dry-run")
+	if !dryRun && !getBoolFlag(cmd, "confirm") {
+		return fmt.Errorf("--confirm is required to re-send %d request(s) (use --dry-run to preview)", len(selected))
+	}
+
+	baseURL := strings.TrimRight(strings.TrimSpace(getStringFlag(cmd, "base-url")), "/")
+	if baseURL == "" {
+		baseURL = entry.BaseURL
+	}
+	if baseURL == "" {
+		baseURL = defaultBaseURL()
+	}
+	token := strings.TrimSpace(getStringFlag(cmd, "token"))
+	if token == "" {
+		resolved, _, err := auth.ResolveToken(baseURL, "")
+		if err != nil {
+			if errors.Is(err, auth.ErrNotFound) {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Authentication required. Run 'xbe auth login' first.")
+			}
+			return err
+		}
+		token = resolved
+	}
+	client := api.NewClient(baseURL, token)
+
+	results := []auditReplayResult{}
+	failed := 0
+	for _, number := range selected {
+		request := entry.Requests[number-1]
+		path, query, err := auditRequestPath(request.URL)
+		if err != nil {
+			return err
+		}
+		result := auditReplayResult{Request: number, Method: request.Method, Path: path}
+		var body []byte
+		if len(request.Body) > 0 {
+			body = request.Body
+		}
+		respBody, status, err := client.Do(cmd.Context(), request.Method, path, query, body)
+		if errors.Is(err, api.ErrDryRun) {
+			continue
+		}
+		result.Status = status
+		if err != nil {
+			result.Error = err.Error()
+			failed++
+		} else if request.Method == http.MethodDelete {
+			result.ID = request.ID
+		} else {
+			result.ID = createdResourceID(respBody)
+		}
+		results = append(results, result)
+	}
+	if dryRun {
+		return nil
+	}
+
+	if getBoolFlag(cmd, "json") {
+		if err := writeJSON(cmd.OutOrStdout(), results); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			line := fmt.Sprintf("%d. %s %s -> %s", result.Request, result.Method, result.Path, auditStatusText(result.Status))
+			if result.ID != "" {
+				line += " id=" + result.ID
+			}
+			if result.Error != "" {
+				line += " (" + result.Error + ")"
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), line)
+		}
+	}
+	if failed > 0 {
+		return &reportedError{err: fmt.Errorf("%d of %d replayed requests failed", failed, len(results))}
+	}
+	return nil
+}
+
+// selectAuditRequests returns 1-based request numbers to replay.
+func selectAuditRequests(entry audit.Entry, requestList string, failedOnly bool) ([]int, error) {
+	var numbers []int
+	if strings.TrimSpace(requestList) != "" {
+		for _, part := range parseCSV(requestList) {
+			number, err := strconv.Atoi(part)
+			if err != nil || number < 1 || number > len(entry.Requests) {
+				return nil, fmt.Errorf("invalid --request value %q (entry has %d requests)", part, len(entry.Requests))
+			}
+			numbers = append(numbers, number)
+		}
+	} else {
+		for idx := range entry.Requests {
+			numbers = append(numbers, idx+1)
+		}
+	}
+
+	selected := []int{}
+	for _, number := range numbers {
+		request := entry.Requests[number-1]
+		if failedOnly && !request.Failed() {
+			continue
+		}
+		if audit.ContainsRedacted(request.Body) {
+			return nil, fmt.Errorf("request %d had secrets redacted and cannot be replayed; re-run 'xbe %s' instead", number, entry.Command)
+		}
+		selected = append(selected, number)
+	}
+	return selected, nil
+}
+
+func auditRequestPath(rawURL string) (string, url.Values, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid recorded URL %q: %w", rawURL, err)
+	}
+	path := parsed.Path
+	if idx := strings.Index(path, "/v1/"); idx > 0 {
+		path = path[idx:]
+	}
+	var query url.Values
+	if parsed.RawQuery != "" {
+		query = parsed.Query()
+	}
+	return path, query, nil
+}
+
+func auditStatusText(status int) string {
+	if status == 0 {
+		return "no response"
+	}
+	return fmt.Sprintf("%d %s", status, http.StatusText(status))
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"os/user"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/audit"
+	"github.com/xbe-inc/xbe-cli/internal/config"
+)
+
+type auditRecorder struct {
+	mu    sync.Mutex
+	cmd   *cobra.Command
+	start time.Time
+	entry audit.Entry
+}
+
+var activeAudit *auditRecorder
+
+// startAudit begins recording a do command (or audit replay) so
+// finalizeAudit can append it to the local audit log.
+func startAudit(cmd *cobra.Command, args []string) {
+	activeAudit = nil
+	if !audit.Enabled() || !isAuditedCommand(cmd) || cliDryRunRequested(cmd) || wantsCommandMetadata(cmd) {
+		return
+	}
+	now := time.Now()
+	recorder := &auditRecorder{
+		cmd:   cmd,
+		start: now,
+		entry: audit.Entry{
+			ID:       audit.NewID(now),
+			Time:     now.UTC(),
+			User:     currentUsername(),
+			Profile:  config.ActiveProfileName(),
+			BaseURL:  strings.TrimSpace(getStringFlag(cmd, "base-url")),
+			Command:  strings.TrimPrefix(cmd.CommandPath(), "xbe "),
+			Args:     append([]string(nil), args...),
+			Flags:    auditFlags(cmd),
+			Requests: []audit.Request{},
+		},
+	}
+	if host, err := os.Hostname(); err == nil {
+		recorder.entry.Host = host
+	}
+	activeAudit = recorder
+	cmd.SetContext(api.WithRequestObserver(cmd.Context(), recorder.observe))
+}
+
+func isAuditedCommand(cmd *cobra.Command) bool {
+	parts := strings.Fields(cmd.CommandPath())
+	if len(parts) >= 3 && parts[1] == "do" {
+		return true
+	}
+	return len(parts) == 3 && parts[1] == "audit" && parts[2] == "replay"
+}
+
+// observe records write requests; reads made along the way are not logged.
+func (r *auditRecorder) observe(record api.RequestRecord) {
+	if record.Method == http.MethodGet || record.Method == http.MethodHead {
+		return
+	}
+	request := audit.Request{
+		Method: record.Method,
+		URL:    record.URL,
+		Status: record.Status,
+	}
+	request.Body, _ = audit.RedactBody(record.Body)
+	if record.Err != nil {
+		request.Error = record.Err.Error()
+	} else if record.Method != http.MethodDelete {
+		request.ID = createdResourceID(record.ResponseBody)
+	} else if parsed, err := url.Parse(record.URL); err == nil {
+		request.ID = parsed.Path[strings.LastIndex(parsed.Path, "/")+1:]
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entry.Requests = append(r.entry.Requests, request)
+	if request.ID != "" && !contains(r.entry.IDs, request.ID) {
+		r.entry.IDs = append(r.entry.IDs, request.ID)
+	}
+}
+
+// finalizeAudit appends the recorded entry. Failing to write the log never
+// changes the command result; a warning is printed instead.
+func finalizeAudit(cmdErr error) {
+	recorder := activeAudit
+	activeAudit = nil
+	if recorder == nil {
+		return
+	}
+	recorder.mu.Lock()
+	entry := recorder.entry
+	recorder.mu.Unlock()
+
+	entry.DurationMS = time.Since(recorder.start).Milliseconds()
+	entry.Status = "ok"
+	if cmdErr != nil {
+		entry.Status = "error"
+		entry.Error = cmdErr.Error()
+	}
+	entry.ExitCode = ExitCode(cmdErr)
+	if err := audit.Append(entry); err != nil {
+		recorder.cmd.PrintErrf("Warning: could not write audit log: %v\n", err)
+	}
+}
+
+// auditFlags returns the flags set on the command line with secrets redacted.
+func auditFlags(cmd *cobra.Command) map[string]string {
+	flags := map[string]string{}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if audit.IsSecretName(flag.Name) {
+			flags[flag.Name] = audit.Redacted
+			return
+		}
+		flags[flag.Name] = flag.Value.String()
+	})
+	if len(flags) == 0 {
+		return nil
+	}
+	return flags
+}
+
+func currentUsername() string {
+	if current, err := user.Current(); err == nil && current.Username != "" {
+		return current.Username
+	}
+	return os.Getenv("USER")
+}
+
+func sortedAuditEntries(entries []audit.Entry) []audit.Entry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+	return entries
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/xbe-inc/xbe-cli/internal/api"
 	"github.com/xbe-inc/xbe-cli/internal/auth"
+	"github.com/xbe-inc/xbe-cli/internal/config"
 )
 
 var authCmd = &cobra.Command{
@@ -34,8 +35,9 @@ If secure storage is unavailable, tokens are stored in ~/.config/xbe/config.json
 Token Resolution Order:
   1. --token flag (highest priority)
   2. XBE_TOKEN or XBE_API_TOKEN environment variable
-  3. System keychain
-  4. Config file (lowest priority)`,
+  3. Active profile token reference (see 'xbe config profiles')
+  4. System keychain
+  5. Config file (lowest priority)`,
 	Annotations: map[string]string{"group": GroupAuth},
 }
 
@@ -67,7 +69,10 @@ for different XBE environments (e.g., staging vs production).`,
   op read "op://Vault/XBE/token" | xbe auth login --token-stdin
 
   # Store token for a different environment
-  xbe auth login --base-url https://staging.x-b-e.com`,
+  xbe auth login --base-url https://staging.x-b-e.com
+
+  # Store the token referenced by a profile
+  xbe auth login --profile staging`,
 	RunE: runAuthLogin,
 }
 
@@ -169,8 +174,12 @@ func runAuthLogin(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	key, err := auth.StoreKey(normalized)
+	if err != nil {
+		return err
+	}
 	store := auth.DefaultStore()
-	if err := store.Set(normalized, token); err != nil {
+	if err := store.Set(key, token); err != nil {
 		return err
 	}
 
@@ -191,6 +200,9 @@ func runAuthStatus(cmd *cobra.Command, _ []string) error {
 	}
 
 	fmt.Fprintf(cmd.OutOrStdout(), "Base URL: %s\n", normalized)
+	if name := config.ActiveProfileName(); name != "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "Profile: %s\n", name)
+	}
 	if token == "" {
 		fmt.Fprintln(cmd.OutOrStdout(), "Token: not set")
 		return nil
@@ -205,9 +217,13 @@ func runAuthLogout(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 	normalized := auth.NormalizeBaseURL(baseURL)
+	key, err := auth.StoreKey(normalized)
+	if err != nil {
+		return err
+	}
 
 	store := auth.DefaultStore()
-	if err := store.Delete(normalized); err != nil {
+	if err := store.Delete(key); err != nil {
 		if errors.Is(err, auth.ErrNotFound) {
 			fmt.Fprintln(cmd.OutOrStdout(), "No token found")
 			return nil
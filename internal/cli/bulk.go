@@ -0,0 +1,576 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+)
+
+const (
+	defaultBulkConcurrency = 4
+	// bulkRequiredAnnotation holds a flag's required marker while --from-file
+	// is active; required flags are then checked per row instead of on the
+	// command line.
+	bulkRequiredAnnotation = "xbe_bulk_required"
+)
+
+// errInvalidInput marks errors caused by user input that was rejected before
+// any request was sent.
+var errInvalidInput = errors.New("invalid input")
+
+// bulkFlags control --from-file itself and are not copied to each row.
+var bulkFlags = map[string]bool{
+	"from-file":    true,
+	"concurrency":  true,
+	"results-file": true,
+}
+
+// bulkIgnoredFlags apply to the whole run and are never set from file columns.
+var bulkIgnoredFlags = map[string]bool{
+	"json":     true,
+	"base-url": true,
+	"token":    true,
+	"no-auth":  true,
+	"help":     true,
+}
+
+type bulkRow struct {
+	Number int
+	Values map[string]string
+}
+
+type bulkResult struct {
+	Row    int               `json:"row"`
+	Status string            `json:"status"`
+	ID     string            `json:"id,omitempty"`
+	Input  map[string]string `json:"input"`
+	Error  *errorPayloadBody `json:"error,omitempty"`
+}
+
+type bulkSummary struct {
+	Total       int    `json:"total"`
+	Succeeded   int    `json:"succeeded"`
+	Failed      int    `json:"failed"`
+	Invalid     int    `json:"invalid,omitempty"`
+	DryRun      int    `json:"dry_run,omitempty"`
+	ResultsFile string `json:"results_file"`
+	FailedFile  string `json:"failed_file,omitempty"`
+}
+
+// attachBulkFlags adds --from-file support to every do create/update command.
+func attachBulkFlags(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	for _, child := range cmd.Commands() {
+		attachBulkFlags(child)
+	}
+	if !isBulkCommand(cmd) {
+		return
+	}
+	flags := cmd.Flags()
+	if flags.Lookup("from-file") == nil {
+		flags.String("from-file", "", "Create or update one record per row of a .csv or .jsonl file (columns map to flags)")
+	}
+	if flags.Lookup("concurrency") == nil {
+		flags.Int("concurrency", defaultBulkConcurrency, "Maximum concurrent requests with --from-file")
+	}
+	if flags.Lookup("results-file") == nil {
+		flags.String("results-file", "", "Per-row results file for --from-file (default <file>.results.jsonl)")
+	}
+}
+
+func isBulkCommand(cmd *cobra.Command) bool {
+	if cmd.HasSubCommands() || (cmd.Name() != "create" && cmd.Name() != "update") {
+		return false
+	}
+	parts := strings.Fields(cmd.CommandPath())
+	return len(parts) == 4 && parts[1] == "do"
+}
+
+func bulkInputRequested(cmd *cobra.Command) bool {
+	return strings.TrimSpace(getStringFlag(cmd, "from-file")) != ""
+}
+
+// prepareBulkInput defers required-flag checks to each row when --from-file
+// is given, since the values come from the file rather than the command line.
+func prepareBulkInput(cmd *cobra.Command) {
+	if !bulkInputRequested(cmd) {
+		return
+	}
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if required, ok := flag.Annotations[cobra.BashCompOneRequiredFlag]; ok {
+			flag.Annotations[bulkRequiredAnnotation] = required
+			delete(flag.Annotations, cobra.BashCompOneRequiredFlag)
+		}
+	})
+}
+
+// runBulkFromFile validates every row of --from-file, then runs the command
+// once per row with bounded concurrency and writes a per-row results file.
+func runBulkFromFile(cmd *cobra.Command, args []string, run func(*cobra.Command, []string) error) error {
+	if len(args) > 0 {
+		return fmt.Errorf("%w: positional arguments cannot be combined with --from-file (use an id column)", errInvalidInput)
+	}
+	path := strings.TrimSpace(getStringFlag(cmd, "from-file"))
+	concurrency := getIntFlag(cmd, "concurrency")
+	if concurrency < 1 {
+		return fmt.Errorf("%w: --concurrency must be at least 1", errInvalidInput)
+	}
+	resultsPath := strings.TrimSpace(getStringFlag(cmd, "results-file"))
+	if resultsPath == "" {
+		resultsPath = bulkSiblingPath(path, "results")
+	}
+
+	rows, err := readBulkRows(path)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%w: %s contains no rows", errInvalidInput, path)
+	}
+	if err := checkBulkColumns(cmd, rows); err != nil {
+		return err
+	}
+
+	results := make([]bulkResult, len(rows))
+	invalid := 0
+	for idx, row := range rows {
+		results[idx] = bulkResult{Row: row.Number, Status: "pending", Input: row.Values}
+		err := runBulkRow(api.WithValidateOnly(cmd.Context()), cmd, row, run)
+		if errors.Is(err, auth.ErrNotFound) {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Authentication required. Run 'xbe auth login' first.")
+			return err
+		}
+		if err != nil && !errors.Is(err, api.ErrValidateOnly) {
+			results[idx].Status = "invalid"
+			results[idx].Error = bulkErrorBody(cmd, err)
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		for idx := range results {
+			if results[idx].Status == "pending" {
+				results[idx].Status = "not_sent"
+			}
+		}
+		for _, result := range results {
+			if result.Error != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "row %d: %s\n", result.Row, result.Error.Message)
+			}
+		}
+		if err := writeBulkResults(resultsPath, results); err != nil {
+			return err
+		}
+		summary := bulkSummary{Total: len(rows), Invalid: invalid, ResultsFile: resultsPath}
+		if err := writeBulkSummary(cmd, summary); err != nil {
+			return err
+		}
+		return &reportedError{err: fmt.Errorf("%w: %d of %d rows failed validation; nothing was sent", errInvalidInput, invalid, len(rows))}
+	}
+
+	runBulkRows(cmd, rows, results, run, concurrency)
+
+	summary := bulkSummary{Total: len(rows), ResultsFile: resultsPath}
+	failedRows := []bulkRow{}
+	for idx, result := range results {
+		if result.Error != nil {
+			summary.Failed++
+			failedRows = append(failedRows, rows[idx])
+			continue
+		}
+		if result.Status == "dry_run" {
+			summary.DryRun++
+			continue
+		}
+		summary.Succeeded++
+	}
+	if err := writeBulkResults(resultsPath, results); err != nil {
+		return err
+	}
+	if len(failedRows) > 0 {
+		summary.FailedFile = bulkSiblingPath(path, "failed")
+		if err := writeBulkRows(summary.FailedFile, failedRows); err != nil {
+			return err
+		}
+	}
+	if err := writeBulkSummary(cmd, summary); err != nil {
+		return err
+	}
+	if summary.Failed > 0 {
+		return &reportedError{err: fmt.Errorf("%d of %d rows failed", summary.Failed, summary.Total)}
+	}
+	return nil
+}
+
+func runBulkRows(cmd *cobra.Command, rows []bulkRow, results []bulkResult, run func(*cobra.Command, []string) error, concurrency int) {
+	status := cmd.Name() + "d"
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrency && worker < len(rows); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				recorder := &api.ResponseRecorder{}
+				ctx := api.WithResponseRecorder(cmd.Context(), recorder)
+				err := runBulkRow(ctx, cmd, rows[idx], run)
+				if errors.Is(err, api.ErrDryRun) {
+					results[idx].Status = "dry_run"
+					continue
+				}
+				if err != nil {
+					results[idx].Status = "failed"
+					results[idx].Error = bulkErrorBody(cmd, err)
+					continue
+				}
+				results[idx].Status = status
+				results[idx].ID = createdResourceID(recorder.Last())
+			}
+		}()
+	}
+	for idx := range rows {
+		if cmd.Context().Err() != nil {
+			results[idx].Status = "failed"
+			results[idx].Error = bulkErrorBody(cmd, cmd.Context().Err())
+			continue
+		}
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// runBulkRow runs the command for one row on a private copy of the command so
+// rows can execute concurrently without sharing flag state.
+func runBulkRow(ctx context.Context, cmd *cobra.Command, row bulkRow, run func(*cobra.Command, []string) error) error {
+	clone, args, err := newBulkInvocation(cmd, row)
+	if err != nil {
+		return err
+	}
+	if err := clone.ValidateArgs(args); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidInput, err)
+	}
+	if err := clone.ValidateRequiredFlags(); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidInput, err)
+	}
+	if err := clone.ValidateFlagGroups(); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidInput, err)
+	}
+	clone.SetOut(io.Discard)
+	clone.SetErr(io.Discard)
+	clone.SetContext(ctx)
+	return run(clone, args)
+}
+
+func newBulkInvocation(cmd *cobra.Command, row bulkRow) (*cobra.Command, []string, error) {
+	clone := &cobra.Command{
+		Use:  cmd.Use,
+		Args: cmd.Args,
+	}
+	flags := clone.Flags()
+	var cloneErr error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if cloneErr != nil || bulkFlags[flag.Name] {
+			return
+		}
+		copied, err := cloneFlag(flag)
+		if err != nil {
+			cloneErr = err
+			return
+		}
+		flags.AddFlag(copied)
+	})
+	if cloneErr != nil {
+		return nil, nil, cloneErr
+	}
+
+	var args []string
+	columns := make([]string, 0, len(row.Values))
+	for column := range row.Values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	for _, column := range columns {
+		value := row.Values[column]
+		if value == "" {
+			continue
+		}
+		if column == "id" && flags.Lookup("id") == nil {
+			args = append(args, value)
+			continue
+		}
+		if err := flags.Set(column, value); err != nil {
+			return nil, nil, fmt.Errorf("%w: --%s: %v", errInvalidInput, column, err)
+		}
+	}
+	return clone, args, nil
+}
+
+// cloneFlag copies a flag with a fresh value of the same type, keeping any
+// value given on the command line as the default for every row.
+func cloneFlag(flag *pflag.Flag) (*pflag.Flag, error) {
+	scratch := pflag.NewFlagSet("bulk", pflag.ContinueOnError)
+	switch flag.Value.Type() {
+	case "bool":
+		scratch.Bool(flag.Name, false, flag.Usage)
+	case "int":
+		scratch.Int(flag.Name, 0, flag.Usage)
+	case "float64":
+		scratch.Float64(flag.Name, 0, flag.Usage)
+	case "stringSlice":
+		scratch.StringSlice(flag.Name, nil, flag.Usage)
+	case "stringArray":
+		scratch.StringArray(flag.Name, nil, flag.Usage)
+	default:
+		scratch.String(flag.Name, "", flag.Usage)
+	}
+	copied := scratch.Lookup(flag.Name)
+	if flag.DefValue != "" && flag.DefValue != "[]" {
+		if err := copied.Value.Set(strings.Trim(flag.DefValue, "[]")); err != nil {
+			return nil, err
+		}
+	}
+	copied.DefValue = flag.DefValue
+	if flag.Changed {
+		if err := setFlagFromValue(copied, flag.Value); err != nil {
+			return nil, err
+		}
+		copied.Changed = true
+	}
+	copied.Annotations = map[string][]string{}
+	for key, value := range flag.Annotations {
+		if key == bulkRequiredAnnotation {
+			key = cobra.BashCompOneRequiredFlag
+		}
+		copied.Annotations[key] = value
+	}
+	return copied, nil
+}
+
+func setFlagFromValue(target *pflag.Flag, source pflag.Value) error {
+	if slice, ok := source.(pflag.SliceValue); ok {
+		if targetSlice, ok := target.Value.(pflag.SliceValue); ok {
+			return targetSlice.Replace(slice.GetSlice())
+		}
+	}
+	return target.Value.Set(source.String())
+}
+
+// checkBulkColumns rejects columns that do not match a flag of the command.
+func checkBulkColumns(cmd *cobra.Command, rows []bulkRow) error {
+	allowID := strings.Contains(cmd.Use, "<id>")
+	unknown := []string{}
+	seen := map[string]bool{}
+	for _, row := range rows {
+		for column := range row.Values {
+			if seen[column] {
+				continue
+			}
+			seen[column] = true
+			if column == "id" && allowID {
+				continue
+			}
+			if bulkFlags[column] || bulkIgnoredFlags[column] || cmd.LocalFlags().Lookup(column) == nil {
+				unknown = append(unknown, column)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("%w: unknown columns for %s: %s (columns must match command flags)", errInvalidInput, cmd.CommandPath(), strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func readBulkRows(path string) ([]bulkRow, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readBulkCSV(file, ',')
+	case ".tsv":
+		return readBulkCSV(file, '\t')
+	case ".jsonl", ".ndjson":
+		return readBulkJSONL(file)
+	default:
+		return nil, fmt.Errorf("%w: --from-file must be a .csv, .tsv or .jsonl file", errInvalidInput)
+	}
+}
+
+func readBulkCSV(r io.Reader, comma rune) ([]bulkRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidInput, err)
+	}
+	for idx := range header {
+		header[idx] = normalizeBulkColumn(header[idx])
+	}
+
+	rows := []bulkRow{}
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidInput, err)
+		}
+		if len(record) != len(header) {
+			return nil, fmt.Errorf("%w: row %d has %d columns, header has %d", errInvalidInput, number, len(record), len(header))
+		}
+		values := map[string]string{}
+		for idx, column := range header {
+			values[column] = strings.TrimSpace(record[idx])
+		}
+		rows = append(rows, bulkRow{Number: number, Values: values})
+	}
+	return rows, nil
+}
+
+func readBulkJSONL(r io.Reader) ([]bulkRow, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	rows := []bulkRow{}
+	for number, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		decoder := json.NewDecoder(strings.NewReader(line))
+		decoder.UseNumber()
+		var object map[string]any
+		if err := decoder.Decode(&object); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", errInvalidInput, number+1, err)
+		}
+		values := map[string]string{}
+		for key, value := range object {
+			if value == nil {
+				continue
+			}
+			values[normalizeBulkColumn(key)] = bulkCellValue(value)
+		}
+		rows = append(rows, bulkRow{Number: number + 1, Values: values})
+	}
+	return rows, nil
+}
+
+func normalizeBulkColumn(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	value = strings.TrimPrefix(value, "--")
+	return strings.ReplaceAll(value, "_", "-")
+}
+
+func bulkCellValue(value any) string {
+	switch typed := value.(type) {
+	case []any:
+		parts := make([]string, 0, len(typed))
+		for _, item := range typed {
+			parts = append(parts, bulkCellValue(item))
+		}
+		return strings.Join(parts, ",")
+	case map[string]any:
+		payload, _ := json.Marshal(typed)
+		return string(payload)
+	default:
+		return formatOutputCell(typed)
+	}
+}
+
+func bulkErrorBody(cmd *cobra.Command, err error) *errorPayloadBody {
+	body := errorPayload(cmd, err)["error"]
+	if errors.Is(err, errInvalidInput) {
+		body.Type = errorCategoryValidation
+		body.ExitCode = ExitValidation
+	}
+	return &body
+}
+
+// createdResourceID extracts data.id from a JSON:API response body.
+func createdResourceID(body []byte) string {
+	var resp struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return ""
+	}
+	return resp.Data.ID
+}
+
+func bulkSiblingPath(path, suffix string) string {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	return base + "." + suffix + ".jsonl"
+}
+
+func writeBulkResults(path string, results []bulkResult) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	for _, result := range results {
+		if err := encoder.Encode(result); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// writeBulkRows writes rows back out as JSONL so they can be passed to
+// --from-file again.
+func writeBulkRows(path string, rows []bulkRow) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	for _, row := range rows {
+		if err := encoder.Encode(row.Values); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+func writeBulkSummary(cmd *cobra.Command, summary bulkSummary) error {
+	out := cmd.OutOrStdout()
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(out, summary)
+	}
+	if summary.Invalid > 0 {
+		fmt.Fprintf(out, "Validation failed for %d of %d rows; nothing was sent.\n", summary.Invalid, summary.Total)
+	} else if summary.DryRun > 0 {
+		fmt.Fprintf(out, "Dry run: printed %d of %d rows; nothing was sent.\n", summary.DryRun, summary.Total)
+	} else {
+		fmt.Fprintf(out, "Processed %d rows: %d succeeded, %d failed.\n", summary.Total, summary.Succeeded, summary.Failed)
+	}
+	fmt.Fprintf(out, "Results: %s\n", summary.ResultsFile)
+	if summary.FailedFile != "" {
+		fmt.Fprintf(out, "Re-run failed rows with: xbe %s --from-file %s\n", strings.TrimPrefix(cmd.CommandPath(), "xbe "), summary.FailedFile)
+	}
+	return nil
+}
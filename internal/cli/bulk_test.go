@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestReadBulkRowsNormalizesColumns(t *testing.T) {
+	rows, err := readBulkCSV(strings.NewReader("Cost_Code,--description\nMAT-1, Materials \n"), ',')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Values["cost-code"] != "MAT-1" || rows[0].Values["description"] != "Materials" {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+
+	rows, err = readBulkJSONL(strings.NewReader("{\"name\":\"Sand\",\"tags\":[\"a\",\"b\"],\"active\":true,\"skip\":null}\n\n{\"name\":\"Gravel\"}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[1].Number != 3 {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+	values := rows[0].Values
+	if values["tags"] != "a,b" || values["active"] != "true" {
+		t.Fatalf("unexpected values: %+v", values)
+	}
+	if _, ok := values["skip"]; ok {
+		t.Fatalf("expected null values to be skipped")
+	}
+}
+
+func TestNewBulkInvocationCopiesFlags(t *testing.T) {
+	cmd := &cobra.Command{Use: "update <id>", Args: cobra.ExactArgs(1)}
+	cmd.Flags().String("name", "", "")
+	cmd.Flags().Bool("active", true, "")
+	cmd.Flags().String("customer", "", "")
+	cmd.Flags().StringSlice("tags", nil, "")
+	if err := cmd.Flags().Set("customer", "12"); err != nil {
+		t.Fatal(err)
+	}
+
+	clone, args, err := newBulkInvocation(cmd, bulkRow{Values: map[string]string{"id": "7", "name": "Sand", "tags": "a,b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args) != 1 || args[0] != "7" {
+		t.Fatalf("unexpected args: %v", args)
+	}
+	if got, _ := clone.Flags().GetString("name"); got != "Sand" || !clone.Flags().Changed("name") {
+		t.Fatalf("expected name to be set from the row, got %q", got)
+	}
+	if got, _ := clone.Flags().GetString("customer"); got != "12" {
+		t.Fatalf("expected command-line customer to carry over, got %q", got)
+	}
+	if got, _ := clone.Flags().GetBool("active"); !got || clone.Flags().Changed("active") {
+		t.Fatalf("expected active to keep its default")
+	}
+	if got, _ := clone.Flags().GetStringSlice("tags"); len(got) != 2 {
+		t.Fatalf("unexpected tags: %v", got)
+	}
+	if got, _ := cmd.Flags().GetString("name"); got != "" {
+		t.Fatalf("original command flags must not change, got %q", got)
+	}
+}
@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+const (
+	cacheEnv     = "XBE_CACHE"
+	cacheDirEnv  = "XBE_CACHE_DIR"
+	cacheTTLEnv  = "XBE_CACHE_TTL"
+	cacheTTLsEnv = "XBE_CACHE_TTLS"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect and clear the API response cache",
+	Long: `Inspect and clear the on-disk API response cache.
+
+The cache is opt-in: pass --cache or set XBE_CACHE=1. GET responses are then
+stored per base URL, token, and full query. A response is reused without a
+request while younger than its resource's TTL, and otherwise revalidated with
+If-None-Match / If-Modified-Since so unchanged data is not downloaded again.
+
+Reference data has TTLs by default (material-types, cost-codes: 1h;
+unit-of-measures, trailer-classifications, service-types, languages,
+glossary-terms: 24h); everything else is revalidated on every use.
+
+Settings:
+  --no-cache         Bypass the cache for one command (wins over XBE_CACHE)
+  --refresh          Revalidate every cached response regardless of age
+  XBE_CACHE_DIR      Cache location (default: <user cache dir>/xbe/http)
+  XBE_CACHE_TTL      TTL for resources without their own (default 0)
+  XBE_CACHE_TTLS     Per-resource TTLs, e.g. "cost-codes=10m,customers=1h"`,
+	Example: `  # Cache reference data for a session
+  export XBE_CACHE=1
+  xbe view material-types list
+
+  # Force fresh data once
+  xbe view cost-codes list --refresh
+
+  # See what is cached
+  xbe cache stats
+
+  # Drop cached cost codes
+  xbe cache clear cost-codes`,
+	Annotations: map[string]string{"group": GroupUtility},
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show cache size, entries per resource, and hit counts",
+	Example: `  xbe cache stats
+  xbe cache stats --json`,
+	Args: cobra.NoArgs,
+	RunE: runCacheStats,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear [resource...]",
+	Short: "Remove cached responses",
+	Long: `Remove cached responses.
+
+With resource names, only their entries are removed; otherwise the whole
+cache and its counters are cleared.`,
+	Example: `  xbe cache clear
+  xbe cache clear cost-codes material-types`,
+	RunE: runCacheClear,
+}
+
+func init() {
+	cacheStatsCmd.Flags().Bool("json", false, "Output JSON")
+	cacheClearCmd.Flags().Bool("json", false, "Output JSON")
+
+	cacheCmd.AddCommand(cacheStatsCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
+
+func initCacheFlags(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	if flags.Lookup("cache") == nil {
+		flags.Bool("cache", false, "Cache GET responses on disk and revalidate them with ETags (env "+cacheEnv+")")
+	}
+	if flags.Lookup("no-cache") == nil {
+		flags.Bool("no-cache", false, "Bypass the response cache for this command")
+	}
+	if flags.Lookup("refresh") == nil {
+		flags.Bool("refresh", false, "Revalidate cached responses regardless of TTL (enables the cache)")
+	}
+}
+
+func responseCacheDir() string {
+	if value := strings.TrimSpace(os.Getenv(cacheDirEnv)); value != "" {
+		return value
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil || cacheDir == "" {
+		cacheDir = os.TempDir()
+	}
+	return filepath.Join(cacheDir, "xbe", "http")
+}
+
+// applyCachePolicy enables the API response cache when --cache, --refresh,
+// or XBE_CACHE asks for it and --no-cache does not.
+func applyCachePolicy(cmd *cobra.Command) error {
+	api.SetCachePolicy(nil)
+	if getBoolFlag(cmd, "no-cache") {
+		return nil
+	}
+	enabled := getBoolFlag(cmd, "cache") || getBoolFlag(cmd, "refresh")
+	if !enabled {
+		if value := strings.TrimSpace(os.Getenv(cacheEnv)); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("%w: %s must be true or false", errInvalidInput, cacheEnv)
+			}
+			enabled = parsed
+		}
+	}
+	if !enabled {
+		return nil
+	}
+	policy, err := cachePolicyFromEnv()
+	if err != nil {
+		return err
+	}
+	policy.Refresh = getBoolFlag(cmd, "refresh")
+	api.SetCachePolicy(&policy)
+	return nil
+}
+
+func cachePolicyFromEnv() (api.CachePolicy, error) {
+	policy := api.CachePolicy{Dir: responseCacheDir(), TTLs: api.DefaultCacheTTLs()}
+	if value := strings.TrimSpace(os.Getenv(cacheTTLEnv)); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			return policy, fmt.Errorf("%w: %s must be a duration such as 5m", errInvalidInput, cacheTTLEnv)
+		}
+		policy.DefaultTTL = ttl
+	}
+	ttls, err := parseCacheTTLs(os.Getenv(cacheTTLsEnv))
+	if err != nil {
+		return policy, err
+	}
+	for resource, ttl := range ttls {
+		policy.TTLs[resource] = ttl
+	}
+	return policy, nil
+}
+
+// parseCacheTTLs parses "resource=duration" pairs separated by commas.
+func parseCacheTTLs(raw string) (map[string]time.Duration, error) {
+	ttls := map[string]time.Duration{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		resource, value, ok := strings.Cut(pair, "=")
+		ttl, err := time.ParseDuration(strings.TrimSpace(value))
+		if !ok || strings.TrimSpace(resource) == "" || err != nil || ttl < 0 {
+			return nil, fmt.Errorf("%w: %s entry %q must look like cost-codes=10m", errInvalidInput, cacheTTLsEnv, pair)
+		}
+		ttls[strings.TrimSpace(resource)] = ttl
+	}
+	return ttls, nil
+}
+
+type cacheResourceStats struct {
+	Resource string `json:"resource"`
+	Entries  int    `json:"entries"`
+	Fresh    int    `json:"fresh"`
+	Bytes    int    `json:"bytes"`
+	TTL      string `json:"ttl"`
+}
+
+type cacheStats struct {
+	Dir           string               `json:"dir"`
+	Enabled       bool                 `json:"enabled"`
+	Entries       int                  `json:"entries"`
+	Bytes         int                  `json:"bytes"`
+	Hits          int                  `json:"hits"`
+	Revalidations int                  `json:"revalidations"`
+	Misses        int                  `json:"misses"`
+	Resources     []cacheResourceStats `json:"resources"`
+}
+
+func runCacheStats(cmd *cobra.Command, _ []string) error {
+	policy, err := cachePolicyFromEnv()
+	if err != nil {
+		return err
+	}
+	entries, err := api.CacheEntries(policy.Dir)
+	if err != nil {
+		return err
+	}
+	counters := api.ReadCacheCounters(policy.Dir)
+	stats := cacheStats{
+		Dir:           policy.Dir,
+		Enabled:       api.CurrentCachePolicy() != nil,
+		Entries:       len(entries),
+		Hits:          counters.Hits,
+		Revalidations: counters.Revalidations,
+		Misses:        counters.Misses,
+		Resources:     []cacheResourceStats{},
+	}
+	byResource := map[string]*cacheResourceStats{}
+	now := time.Now()
+	for _, entry := range entries {
+		row := byResource[entry.Resource]
+		if row == nil {
+			row = &cacheResourceStats{Resource: entry.Resource, TTL: policy.TTL(entry.Resource).String()}
+			byResource[entry.Resource] = row
+		}
+		row.Entries++
+		row.Bytes += len(entry.Body)
+		if now.Sub(entry.StoredAt) < policy.TTL(entry.Resource) {
+			row.Fresh++
+		}
+		stats.Bytes += len(entry.Body)
+	}
+	for _, row := range byResource {
+		stats.Resources = append(stats.Resources, *row)
+	}
+	sort.Slice(stats.Resources, func(i, j int) bool { return stats.Resources[i].Resource < stats.Resources[j].Resource })
+
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(cmd.OutOrStdout(), stats)
+	}
+	out := cmd.OutOrStdout()
+	enabled := "no (use --cache or " + cacheEnv + "=1)"
+	if stats.Enabled {
+		enabled = "yes"
+	}
+	fmt.Fprintf(out, "Dir: %s\n", stats.Dir)
+	fmt.Fprintf(out, "Enabled: %s\n", enabled)
+	fmt.Fprintf(out, "Entries: %d (%s)\n", stats.Entries, formatCacheBytes(stats.Bytes))
+	fmt.Fprintf(out, "Hits: %d  Revalidated: %d  Misses: %d\n", stats.Hits, stats.Revalidations, stats.Misses)
+	if len(stats.Resources) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out)
+	writer := newTabWriter(cmd)
+	fmt.Fprintln(writer, "RESOURCE\tENTRIES\tFRESH\tSIZE\tTTL")
+	for _, row := range stats.Resources {
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%s\t%s\n", row.Resource, row.Entries, row.Fresh, formatCacheBytes(row.Bytes), row.TTL)
+	}
+	return writer.Flush()
+}
+
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	dir := responseCacheDir()
+	removed, err := api.ClearCache(dir, args)
+	if err != nil {
+		return err
+	}
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(cmd.OutOrStdout(), map[string]any{"dir": dir, "removed": removed})
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Removed %d cached responses from %s\n", removed, dir)
+	return nil
+}
+
+func formatCacheBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
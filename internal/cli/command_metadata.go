@@ -29,6 +29,9 @@ func applyCommandMetadataSupport(root *cobra.Command) {
 	attachMetadataFlags(doCmd)
 	attachMetadataFlags(summarizeCmd)
 
+	attachBulkFlags(doCmd)
+	attachWatchFlags(viewCmd)
+
 	wrapCommandTree(viewCmd)
 	wrapCommandTree(doCmd)
 	wrapCommandTree(summarizeCmd)
@@ -75,7 +78,7 @@ func wrapCommand(cmd *cobra.Command) {
 	if cmd.Args != nil {
 		originalArgs := cmd.Args
 		cmd.Args = func(cmd *cobra.Command, args []string) error {
-			if wantsCommandMetadata(cmd) {
+			if wantsCommandMetadata(cmd) || bulkInputRequested(cmd) {
 				return nil
 			}
 			return originalArgs(cmd, args)
@@ -84,12 +87,31 @@ func wrapCommand(cmd *cobra.Command) {
 
 	if cmd.RunE != nil {
 		originalRunE := cmd.RunE
+		wrapped := cmd
+		// run resolves name references in ID flags before each invocation,
+		// including every bulk row and watch poll.
+		run := func(cmd *cobra.Command, args []string) error {
+			resource, _ := resourceForCommandPath(wrapped)
+			if err := resolveNameRefFlags(cmd, resource); err != nil {
+				return err
+			}
+			return originalRunE(cmd, args)
+		}
 		cmd.RunE = func(cmd *cobra.Command, args []string) error {
 			handled, err := handleCommandMetadataFlags(cmd)
 			if handled || err != nil {
 				return err
 			}
-			return originalRunE(cmd, args)
+			if err := enforcePolicy(cmd, cmd.CommandPath(), args); err != nil {
+				return err
+			}
+			if bulkInputRequested(cmd) {
+				return runBulkFromFile(cmd, args, run)
+			}
+			if watchRequested(cmd) {
+				return runWatch(cmd, args, run)
+			}
+			return run(cmd, args)
 		}
 		return
 	}
@@ -105,6 +127,10 @@ func wrapCommand(cmd *cobra.Command) {
 			if handled {
 				return
 			}
+			if err := enforcePolicy(cmd, cmd.CommandPath(), args); err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err)
+				return
+			}
 			originalRun(cmd, args)
 		}
 		return
@@ -0,0 +1,331 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+)
+
+const (
+	// completionCacheTTL keeps ID completions fresh enough to pick up new
+	// records while sparing the API a request on every <TAB>.
+	completionCacheTTL   = 5 * time.Minute
+	completionFetchLimit = 100
+	completionTimeout    = 3 * time.Second
+)
+
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish|powershell>",
+	Short: "Generate shell completion scripts",
+	Long: `Generate a shell completion script for xbe.
+
+Completions cover commands, flags, and enum values. Flags and arguments that
+take record IDs (--broker, --customer, --job-production-plan, show <id>, ...)
+complete from the API: the matching list endpoint is queried with the
+resource's label fields, and each ID is offered with its label as the
+description. Results are cached on disk for five minutes per server and
+resource; ID completion is skipped silently when no token is available.
+
+Setup:
+  bash        source <(xbe completion bash)
+              (or save to /etc/bash_completion.d/xbe; needs bash-completion)
+  zsh         xbe completion zsh > "${fpath[1]}/_xbe"
+              (run 'autoload -U compinit; compinit' once if not already)
+  fish        xbe completion fish > ~/.config/fish/completions/xbe.fish
+  powershell  xbe completion powershell | Out-String | Invoke-Expression`,
+	Example: `  # Load bash completions in the current shell
+  source <(xbe completion bash)
+
+  # Install zsh completions
+  xbe completion zsh > "${fpath[1]}/_xbe"
+
+  # Install fish completions
+  xbe completion fish > ~/.config/fish/completions/xbe.fish`,
+	Annotations:           map[string]string{"group": GroupUtility},
+	Args:                  cobra.ExactArgs(1),
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	DisableFlagsInUseLine: true,
+	RunE:                  runCompletion,
+}
+
+func init() {
+	completionCmd.Flags().Bool("no-descriptions", false, "Omit completion descriptions")
+	rootCmd.AddCommand(completionCmd)
+}
+
+func runCompletion(cmd *cobra.Command, args []string) error {
+	noDescriptions, _ := cmd.Flags().GetBool("no-descriptions")
+	out := cmd.OutOrStdout()
+	root := cmd.Root()
+	switch args[0] {
+	case "bash":
+		return root.GenBashCompletionV2(out, !noDescriptions)
+	case "zsh":
+		if noDescriptions {
+			return root.GenZshCompletionNoDesc(out)
+		}
+		return root.GenZshCompletion(out)
+	case "fish":
+		return root.GenFishCompletion(out, !noDescriptions)
+	case "powershell":
+		if noDescriptions {
+			return root.GenPowerShellCompletion(out)
+		}
+		return root.GenPowerShellCompletionWithDesc(out)
+	default:
+		return fmt.Errorf("%w: unsupported shell %q (use bash, zsh, fish, or powershell)", errInvalidInput, args[0])
+	}
+}
+
+// isCompletionRequest reports whether the shell is asking for completions,
+// which is the only time ID completion functions need to be registered.
+func isCompletionRequest() bool {
+	if len(os.Args) < 2 {
+		return false
+	}
+	return os.Args[1] == cobra.ShellCompRequestCmd || os.Args[1] == cobra.ShellCompNoDescRequestCmd
+}
+
+// registerIDCompletions attaches API-backed completions to ID flags and
+// <id> arguments of view, do, and summarize commands.
+func registerIDCompletions(root *cobra.Command) {
+	resources, err := loadResourceMap()
+	if err != nil {
+		return
+	}
+	var visit func(cmd *cobra.Command, resource string)
+	visit = func(cmd *cobra.Command, resource string) {
+		for _, child := range cmd.Commands() {
+			childResource := resource
+			if cmd == viewCmd || cmd == doCmd {
+				childResource = child.Name()
+			}
+			visit(child, childResource)
+		}
+		if !cmd.Runnable() {
+			return
+		}
+		cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+			if flag.Value.Type() != "string" && flag.Value.Type() != "stringSlice" {
+				return
+			}
+			if target := idFlagResource(flag.Name, resource, resources); target != "" {
+				_ = cmd.RegisterFlagCompletionFunc(flag.Name, completeResourceIDs(target))
+			}
+		})
+		if cmd.ValidArgsFunction == nil && usesIDArg(cmd.Use) {
+			if _, ok := resources.Resources[resource]; ok {
+				complete := completeResourceIDs(resource)
+				cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+					if len(args) > 0 {
+						return nil, cobra.ShellCompDirectiveNoFileComp
+					}
+					return complete(cmd, args, toComplete)
+				}
+			}
+		}
+	}
+	for _, group := range []*cobra.Command{viewCmd, doCmd, summarizeCmd} {
+		visit(group, "")
+	}
+}
+
+func usesIDArg(use string) bool {
+	fields := strings.Fields(use)
+	return len(fields) > 1 && strings.HasPrefix(fields[1], "<") && strings.HasSuffix(fields[1], "id>")
+}
+
+// idFlagResource maps an ID flag to the resource it references: first via
+// the command resource's relationship of that name, then by pluralizing the
+// flag name. Only resources with label fields qualify.
+func idFlagResource(flagName, resource string, resources resourceMap) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(flagName, "-ids"), "-id")
+	candidates := []string{}
+	if relation, ok := resources.Relationships[resource][name]; ok && len(relation.Resources) == 1 {
+		candidates = append(candidates, relation.Resources[0])
+	}
+	candidates = append(candidates, pluralizeToken(name))
+	for _, candidate := range candidates {
+		if spec, ok := resources.Resources[candidate]; ok && len(spec.LabelFields) > 0 {
+			return candidate
+		}
+	}
+	return ""
+}
+
+type completionEntry struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+type completionCache struct {
+	FetchedAt time.Time         `json:"fetched_at"`
+	Entries   []completionEntry `json:"entries"`
+}
+
+func completeResourceIDs(resource string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		baseURL := defaultBaseURL()
+		if flag := cmd.Flags().Lookup("base-url"); flag != nil && flag.Value.String() != "" {
+			baseURL = flag.Value.String()
+		}
+		// Comma-separated flags complete the last element.
+		prefix, word := "", toComplete
+		if i := strings.LastIndex(toComplete, ","); i >= 0 {
+			prefix, word = toComplete[:i+1], toComplete[i+1:]
+		}
+		// Typed text narrows the records by label, and after name: completes
+		// to name references. Digits are an ID prefix the shell matches.
+		search, nameRef := strings.CutPrefix(word, nameRefPrefix)
+		if _, err := strconv.Atoi(search); err == nil && !nameRef {
+			search = ""
+		}
+		entries, err := resourceCompletionEntries(cmd.Context(), baseURL, resource, strings.TrimSpace(search))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			switch {
+			case nameRef:
+				if entry.Label != "" {
+					completions = append(completions, prefix+nameRefPrefix+entry.Label+"\t"+entry.ID)
+				}
+			case entry.Label != "":
+				completions = append(completions, prefix+entry.ID+"\t"+entry.Label)
+			default:
+				completions = append(completions, prefix+entry.ID)
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// resourceCompletionEntries returns the first records of resource, or with a
+// search, the records whose label fields match it.
+func resourceCompletionEntries(ctx context.Context, baseURL, resource, search string) ([]completionEntry, error) {
+	token, _, err := auth.ResolveToken(baseURL, "")
+	if err != nil || token == "" {
+		return nil, fmt.Errorf("no token for %s", baseURL)
+	}
+	path := completionCachePath(baseURL, token, resource, search)
+	if cached, ok := readCompletionCache(path, time.Now()); ok {
+		return cached, nil
+	}
+	resources, err := loadResourceMap()
+	if err != nil {
+		return nil, err
+	}
+	labels := resources.Resources[resource].LabelFields
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+	client := api.NewClient(baseURL, token)
+	if search != "" {
+		// The same label-field (or q) lookup that resolves name references.
+		resolver := &nameResolver{baseURL: baseURL, client: client}
+		candidates, err := resolver.listCandidates(ctx, resource, search, labels)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]completionEntry, 0, len(candidates))
+		for _, candidate := range candidates {
+			entries = append(entries, completionEntry{ID: candidate.ID, Label: candidate.Label})
+		}
+		writeCompletionCache(path, completionCache{FetchedAt: time.Now(), Entries: entries})
+		return entries, nil
+	}
+	query := url.Values{}
+	query.Set("page[limit]", fmt.Sprint(completionFetchLimit))
+	query.Set("fields["+resource+"]", strings.Join(labels, ","))
+	body, _, err := client.Get(ctx, "/v1/"+resource, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp jsonAPIResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	entries := make([]completionEntry, 0, len(resp.Data))
+	for _, record := range resp.Data {
+		entries = append(entries, completionEntry{ID: record.ID, Label: completionLabel(record.Attributes, labels)})
+	}
+	writeCompletionCache(path, completionCache{FetchedAt: time.Now(), Entries: entries})
+	return entries, nil
+}
+
+func completionLabel(attributes map[string]any, labels []string) string {
+	parts := []string{}
+	for _, label := range labels {
+		if value := strings.TrimSpace(stringAttr(attributes, label)); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	// Tabs and newlines would break the shell's id<TAB>description format.
+	return strings.Join(strings.Fields(strings.Join(parts, " - ")), " ")
+}
+
+func completionCachePath(baseURL, token, resource, search string) string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil || cacheDir == "" {
+		cacheDir = os.TempDir()
+	}
+	name := resource
+	if search != "" {
+		name += "-" + fmt.Sprintf("%x", sha256.Sum256([]byte(strings.ToLower(search))))[:12]
+	}
+	return filepath.Join(cacheDir, "xbe", "completion", cacheIdentity(baseURL, token), name+".json")
+}
+
+// cacheIdentity names the cached lookups of one server and token, so
+// records visible to one profile are never offered to another.
+func cacheIdentity(baseURL, token string) string {
+	tokenSum := sha256.Sum256([]byte(token))
+	sum := sha256.Sum256([]byte(strings.TrimRight(baseURL, "/") + " " + fmt.Sprintf("%x", tokenSum[:8])))
+	return fmt.Sprintf("%x", sum)[:12]
+}
+
+func readCompletionCache(path string, now time.Time) ([]completionEntry, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var cache completionCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, false
+	}
+	if now.Sub(cache.FetchedAt) > completionCacheTTL {
+		return nil, false
+	}
+	return cache.Entries, true
+}
+
+func writeCompletionCache(path string, cache completionCache) {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return
+	}
+	_ = os.Rename(tmp, path)
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestIDFlagResource(t *testing.T) {
+	resources := resourceMap{
+		Resources: map[string]resourceSpec{
+			"brokers":   {LabelFields: []string{"company-name"}},
+			"customers": {LabelFields: []string{"company-name"}},
+			"users":     {LabelFields: []string{"name"}},
+			"statuses":  {},
+		},
+		Relationships: map[string]map[string]relationshipSpec{
+			"projects": {"owner": {Resources: []string{"users"}}},
+		},
+	}
+	cases := []struct{ flag, resource, want string }{
+		{"broker", "projects", "brokers"},
+		{"customer-id", "projects", "customers"},
+		{"broker-ids", "projects", "brokers"},
+		{"owner", "projects", "users"},
+		{"status", "projects", ""},
+		{"limit", "projects", ""},
+	}
+	for _, tc := range cases {
+		if got := idFlagResource(tc.flag, tc.resource, resources); got != tc.want {
+			t.Errorf("idFlagResource(%q) = %q, want %q", tc.flag, got, tc.want)
+		}
+	}
+}
+
+func TestCompletionCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "brokers.json")
+	now := time.Now()
+	entries := []completionEntry{{ID: "12", Label: "Acme"}}
+	writeCompletionCache(path, completionCache{FetchedAt: now, Entries: entries})
+	if got, ok := readCompletionCache(path, now.Add(time.Minute)); !ok || !reflect.DeepEqual(got, entries) {
+		t.Fatalf("fresh cache = %v, %v", got, ok)
+	}
+	if _, ok := readCompletionCache(path, now.Add(completionCacheTTL+time.Second)); ok {
+		t.Fatal("expired cache was used")
+	}
+}
+
+func TestCompleteResourceIDsSearchesLabels(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("XBE_TOKEN", "test")
+	var searches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searches = append(searches, r.URL.Query().Get("filter[company-name]"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"12","type":"brokers","attributes":{"company-name":"Acme Paving"}}]}`))
+	}))
+	defer server.Close()
+
+	cmd := &cobra.Command{Use: "list"}
+	cmd.Flags().String("base-url", server.URL, "API base URL")
+	complete := completeResourceIDs("brokers")
+	cases := []struct {
+		toComplete string
+		want       []string
+	}{
+		{"7,Acm", []string{"7,12\tAcme Paving"}},
+		{"name:Acm", []string{"name:Acme Paving\t12"}},
+		{"1", []string{"12\tAcme Paving"}},
+		{"Acm", []string{"12\tAcme Paving"}},
+	}
+	for _, tc := range cases {
+		if got, _ := complete(cmd, nil, tc.toComplete); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("complete(%q) = %q, want %q", tc.toComplete, got, tc.want)
+		}
+	}
+	// "Acm" is searched once and then cached; a numeric prefix lists unfiltered.
+	if !reflect.DeepEqual(searches, []string{"Acm", ""}) {
+		t.Errorf("searches = %q", searches)
+	}
+}
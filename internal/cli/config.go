@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/config"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage CLI configuration and profiles",
+	Long: `Manage CLI configuration stored in ~/.config/xbe/config.json.
+
+Profiles bundle a base URL, a token reference, default --broker/--customer
+filters for list commands, and an output format. Select a profile per command
+with --profile, per shell with XBE_PROFILE, or persistently with
+'xbe config profiles use <name>'.
+
+Precedence:
+  Base URL: --base-url > XBE_BASE_URL > profile > https://server.x-b-e.com
+  Token:    --token > XBE_TOKEN > profile token reference > token stored for the base URL`,
+	Example: `  # Create a staging profile and switch to it
+  xbe config profiles create staging --base-url https://staging.x-b-e.com --use
+
+  # Run one command against another profile
+  xbe view brokers list --profile production`,
+	Annotations: map[string]string{"group": GroupUtility},
+}
+
+func init() {
+	configCmd.AddCommand(newConfigProfilesCmd())
+	rootCmd.AddCommand(configCmd)
+}
+
+func initProfileFlag(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	if flags.Lookup("profile") == nil {
+		flags.String("profile", "", "Configuration profile to use (env "+config.ProfileEnv+")")
+	}
+}
+
+// applyProfile activates the selected profile and fills in defaults for flags
+// the user did not set: --base-url, --output, and --broker/--customer on view
+// list commands.
+func applyProfile(cmd *cobra.Command) error {
+	if name := strings.TrimSpace(getStringFlag(cmd, "profile")); name != "" {
+		config.SetActiveProfile(name)
+	}
+	if isConfigCommand(cmd) {
+		return nil
+	}
+	_, profile, ok, err := config.ActiveProfile()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	// The --base-url default was computed at startup from current_profile;
+	// recompute it for the profile actually selected so its token never goes
+	// to another profile's server.
+	if !baseURLFromEnv() {
+		baseURL := profile.BaseURL
+		if baseURL == "" {
+			baseURL = builtinBaseURL
+		}
+		if err := setDefaultFlag(cmd, "base-url", baseURL); err != nil {
+			return err
+		}
+	}
+	if profile.Output != "" && commandSupportsJSON(cmd) && !flagChanged(cmd, "json") && strings.TrimSpace(getStringFlag(cmd, "jq")) == "" {
+		if err := setDefaultFlag(cmd, "output", profile.Output); err != nil {
+			return err
+		}
+	}
+	if isListCommand(cmd) {
+		if err := setDefaultFlag(cmd, "broker", profile.Broker); err != nil {
+			return err
+		}
+		if err := setDefaultFlag(cmd, "customer", profile.Customer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDefaultFlag sets a flag value when the flag exists and was not given.
+func setDefaultFlag(cmd *cobra.Command, name, value string) error {
+	if value == "" || flagChanged(cmd, name) {
+		return nil
+	}
+	if cmd.Flags().Lookup(name) != nil {
+		return cmd.Flags().Set(name, value)
+	}
+	if cmd.InheritedFlags().Lookup(name) != nil {
+		return cmd.InheritedFlags().Set(name, value)
+	}
+	return nil
+}
+
+func isConfigCommand(cmd *cobra.Command) bool {
+	for current := cmd; current != nil; current = current.Parent() {
+		if current == configCmd {
+			return true
+		}
+	}
+	return false
+}
+
+func baseURLFromEnv() bool {
+	return strings.TrimSpace(os.Getenv("XBE_BASE_URL")) != "" || strings.TrimSpace(os.Getenv("XBE_API_BASE_URL")) != ""
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/config"
+)
+
+type profileRow struct {
+	Name     string `json:"name"`
+	Current  bool   `json:"current"`
+	BaseURL  string `json:"base_url,omitempty"`
+	TokenRef string `json:"token_ref,omitempty"`
+	Broker   string `json:"broker,omitempty"`
+	Customer string `json:"customer,omitempty"`
+	Output   string `json:"output,omitempty"`
+}
+
+func newConfigProfilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "profiles",
+		Aliases: []string{"profile"},
+		Short:   "Manage named profiles",
+		Long: `Manage named profiles for switching between environments and accounts.
+
+Token references:
+  (empty)      use the token stored for the profile base URL (xbe auth login)
+  env:NAME     read the token from environment variable NAME
+  store:KEY    use the token stored under KEY; 'xbe auth login --profile <name>'
+               stores the token under this key`,
+	}
+	cmd.AddCommand(newConfigProfilesCreateCmd())
+	cmd.AddCommand(newConfigProfilesUseCmd())
+	cmd.AddCommand(newConfigProfilesListCmd())
+	cmd.AddCommand(newConfigProfilesDeleteCmd())
+	return cmd
+}
+
+func newConfigProfilesCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create or replace a profile",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Staging with its own stored token
+  xbe config profiles create staging --base-url https://staging.x-b-e.com --token-ref store:staging
+  xbe auth login --profile staging
+
+  # Local server with a token from the environment and JSON output
+  xbe config profiles create local --base-url http://localhost:3000 --token-ref env:XBE_LOCAL_TOKEN --output-format json
+
+  # Production for one broker account
+  xbe config profiles create acme --broker 12 --token-ref store:acme --use`,
+		RunE: runConfigProfilesCreate,
+	}
+	cmd.Flags().String("base-url", "", "API base URL for this profile")
+	cmd.Flags().String("token-ref", "", "Token reference (env:NAME or store:KEY)")
+	cmd.Flags().String("broker", "", "Default --broker filter for list commands")
+	cmd.Flags().String("customer", "", "Default --customer filter for list commands")
+	cmd.Flags().String("output-format", "", "Default --output for commands: table, json, yaml, csv, tsv, ndjson")
+	cmd.Flags().Bool("use", false, "Make this the current profile")
+	return cmd
+}
+
+func newConfigProfilesUseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "use <name>",
+		Short:   "Set the current profile",
+		Args:    cobra.ExactArgs(1),
+		Example: `  xbe config profiles use staging`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[0])
+			if err := config.UseProfile(name); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Using profile %s\n", name)
+			return nil
+		},
+	}
+}
+
+func newConfigProfilesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List profiles",
+		Args:    cobra.NoArgs,
+		Example: `  xbe config profiles list --json`,
+		RunE:    runConfigProfilesList,
+	}
+	cmd.Flags().Bool("json", false, "Output JSON")
+	return cmd
+}
+
+func newConfigProfilesDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete <name>",
+		Short:   "Delete a profile",
+		Args:    cobra.ExactArgs(1),
+		Example: `  xbe config profiles delete staging`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[0])
+			if err := config.DeleteProfile(name); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted profile %s\n", name)
+			return nil
+		},
+	}
+}
+
+func runConfigProfilesCreate(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSpace(args[0])
+	profile := config.Profile{
+		BaseURL:  strings.TrimRight(strings.TrimSpace(getStringFlag(cmd, "base-url")), "/"),
+		TokenRef: strings.TrimSpace(getStringFlag(cmd, "token-ref")),
+		Broker:   strings.TrimSpace(getStringFlag(cmd, "broker")),
+		Customer: strings.TrimSpace(getStringFlag(cmd, "customer")),
+		Output:   strings.ToLower(strings.TrimSpace(getStringFlag(cmd, "output-format"))),
+	}
+	if profile.Output != "" && (!validOutputFormat(outputFormat(profile.Output)) || outputFormat(profile.Output) == outputTemplate) {
+		return fmt.Errorf("invalid --output-format value %q (use table, json, yaml, csv, tsv, ndjson)", profile.Output)
+	}
+	if strings.HasPrefix(profile.TokenRef, "env:") && strings.TrimPrefix(profile.TokenRef, "env:") == "" {
+		return errors.New("--token-ref env: requires a variable name")
+	}
+
+	if err := config.SaveProfile(name, profile); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Saved profile %s\n", name)
+	if getBoolFlag(cmd, "use") {
+		if err := config.UseProfile(name); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Using profile %s\n", name)
+	}
+	return nil
+}
+
+func runConfigProfilesList(cmd *cobra.Command, _ []string) error {
+	names, profiles, current, err := config.ListProfiles()
+	if err != nil {
+		return err
+	}
+	active := config.ActiveProfileName()
+	if active == "" {
+		active = current
+	}
+
+	rows := make([]profileRow, 0, len(names))
+	for _, name := range names {
+		profile := profiles[name]
+		rows = append(rows, profileRow{
+			Name:     name,
+			Current:  name == active,
+			BaseURL:  profile.BaseURL,
+			TokenRef: profile.TokenRef,
+			Broker:   profile.Broker,
+			Customer: profile.Customer,
+			Output:   profile.Output,
+		})
+	}
+
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(cmd.OutOrStdout(), rows)
+	}
+	if len(rows) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No profiles found. Create one with 'xbe config profiles create <name>'.")
+		return nil
+	}
+
+	writer := newTabWriter(cmd)
+	fmt.Fprintln(writer, "CURRENT\tNAME\tBASE URL\tTOKEN REF\tBROKER\tCUSTOMER\tOUTPUT")
+	for _, row := range rows {
+		marker := ""
+		if row.Current {
+			marker = "*"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", marker, row.Name, row.BaseURL, row.TokenRef, row.Broker, row.Customer, row.Output)
+	}
+	return writer.Flush()
+}
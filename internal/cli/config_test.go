@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/config"
+)
+
+func TestApplyProfileResolvesBaseURLForSelectedProfile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("XBE_BASE_URL", "")
+	t.Setenv("XBE_API_BASE_URL", "")
+	t.Setenv(config.ProfileEnv, "")
+	if err := config.SaveProfile("prod", config.Profile{BaseURL: "https://prod.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveProfile("local", config.Profile{TokenRef: "env:LOCAL_TOKEN"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.UseProfile("prod"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		config.SetActiveProfile("")
+		_ = config.DeleteProfile("prod")
+		_ = config.DeleteProfile("local")
+	})
+
+	run := func(args ...string) string {
+		t.Helper()
+		config.SetActiveProfile("")
+		// Flag defaults are computed at startup from current_profile.
+		cmd := &cobra.Command{Use: "show"}
+		cmd.Flags().String("profile", "", "")
+		cmd.Flags().String("base-url", defaultBaseURL(), "")
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatal(err)
+		}
+		if err := applyProfile(cmd); err != nil {
+			t.Fatalf("applyProfile(%v): %v", args, err)
+		}
+		return getStringFlag(cmd, "base-url")
+	}
+
+	if got := run(); got != "https://prod.example.com" {
+		t.Fatalf("current profile base-url = %q", got)
+	}
+	if got := run("--profile", "local"); got != builtinBaseURL {
+		t.Fatalf("--profile local base-url = %q, want %q", got, builtinBaseURL)
+	}
+	if got := run("--profile", "local", "--base-url", "https://other.example.com"); got != "https://other.example.com" {
+		t.Fatalf("explicit base-url = %q", got)
+	}
+}
@@ -8,13 +8,35 @@ var doCmd = &cobra.Command{
 	Long: `Create, update, and delete XBE resources.
 
 The do command provides write access to XBE platform data. Unlike view commands,
-these operations modify data and require authentication.`,
+these operations modify data and require authentication.
+
+Bulk create/update:
+  Every create and update command accepts --from-file rows.csv|rows.jsonl.
+  Columns map onto the command's flags (an "id" column supplies the record ID
+  for update). All rows are validated before anything is sent; rows are then
+  submitted with --concurrency workers and per-row results (created IDs and
+  structured errors) are written to --results-file. Failed rows are written
+  to <file>.failed.jsonl so they can be re-run.
+
+Dry run:
+  --dry-run prints each HTTP request (method, URL, headers with the token
+  redacted, and JSON:API body) instead of sending it. Nothing is sent to the
+  server; reads a command makes first are printed and answered with an empty
+  document. Use --dry-run-format curl for a runnable curl command or json for
+  one JSON object per request.`,
 	Example: `  xbe do customers create --name "Acme Corp"      # Create
   xbe do projects update 123 --status complete    # Update
-  xbe do posts delete 456 --confirm               # Delete`,
+  xbe do posts delete 456 --confirm               # Delete
+
+  # Create one cost code per CSV row (columns: code,description,customer)
+  xbe do cost-codes create --from-file cost-codes.csv --concurrency 8
+
+  # Show the request an update would send, as curl
+  xbe do job-production-plans update 123 --goal-quantity 1000 --dry-run --dry-run-format curl`,
 	Annotations: map[string]string{"group": GroupCore},
 }
 
 func init() {
+	initDryRunFlags(doCmd)
 	rootCmd.AddCommand(doCmd)
 }
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+const redactedToken = "[REDACTED]"
+
+type dryRunRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    any               `json:"body,omitempty"`
+}
+
+func initDryRunFlags(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	if flags.Lookup("dry-run") == nil {
+		flags.Bool("dry-run", false, "Print the HTTP request instead of sending it")
+	}
+	if flags.Lookup("dry-run-format") == nil {
+		flags.String("dry-run-format", "", "Dry-run rendering: http (default), curl, json (default with --json)")
+	}
+}
+
+// cliDryRunRequested reports whether the global --dry-run is set. A few
+// export commands define their own --dry-run, an attribute asking the server
+// to validate without exporting; it shadows the global flag and is sent to
+// the API like any other attribute.
+func cliDryRunRequested(cmd *cobra.Command) bool {
+	if cmd.LocalNonPersistentFlags().Lookup("dry-run") != nil {
+		return false
+	}
+	return getBoolFlag(cmd, "dry-run")
+}
+
+// applyDryRun routes every write request of a do command to a printer instead
+// of the network when --dry-run is set.
+func applyDryRun(cmd *cobra.Command) error {
+	if !cliDryRunRequested(cmd) {
+		return nil
+	}
+	format := strings.ToLower(strings.TrimSpace(getStringFlag(cmd, "dry-run-format")))
+	if format == "" {
+		format = "http"
+		if getBoolFlag(cmd, "json") {
+			format = "json"
+		}
+	}
+	switch format {
+	case "http", "curl", "json":
+	default:
+		return fmt.Errorf("invalid --dry-run-format value %q (use http, curl, json)", format)
+	}
+
+	out := cmd.OutOrStdout()
+	var mu sync.Mutex
+	first := true
+	handler := func(req *http.Request, body []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !first && format != "json" {
+			fmt.Fprintln(out)
+		}
+		first = false
+		switch format {
+		case "curl":
+			renderDryRunCurl(out, req, body)
+		case "json":
+			_ = writeJSONCompact(out, newDryRunRequest(req, body))
+		default:
+			renderDryRunHTTP(out, req, body)
+		}
+	}
+	cmd.SetContext(api.WithDryRun(cmd.Context(), handler))
+	return nil
+}
+
+func newDryRunRequest(req *http.Request, body []byte) dryRunRequest {
+	request := dryRunRequest{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: map[string]string{},
+	}
+	for name := range req.Header {
+		request.Headers[name] = redactHeader(name, req.Header.Get(name))
+	}
+	if len(body) > 0 {
+		var value any
+		if err := json.Unmarshal(body, &value); err == nil {
+			request.Body = value
+		} else {
+			request.Body = string(body)
+		}
+	}
+	return request
+}
+
+func renderDryRunHTTP(out io.Writer, req *http.Request, body []byte) {
+	fmt.Fprintf(out, "%s %s\n", req.Method, req.URL.String())
+	for _, name := range sortedHeaderNames(req.Header) {
+		fmt.Fprintf(out, "%s: %s\n", name, redactHeader(name, req.Header.Get(name)))
+	}
+	if len(body) > 0 {
+		fmt.Fprintln(out)
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, body, "", "  "); err == nil {
+			fmt.Fprintln(out, pretty.String())
+		} else {
+			fmt.Fprintln(out, string(body))
+		}
+	}
+}
+
+// renderDryRunCurl prints a runnable curl command. The token is read from
+// $XBE_TOKEN rather than printed.
+func renderDryRunCurl(out io.Writer, req *http.Request, body []byte) {
+	parts := []string{"curl -X " + req.Method + " " + shellQuote(req.URL.String())}
+	for _, name := range sortedHeaderNames(req.Header) {
+		if strings.EqualFold(name, "Authorization") {
+			parts = append(parts, `-H "Authorization: Bearer $XBE_TOKEN"`)
+			continue
+		}
+		parts = append(parts, "-H "+shellQuote(name+": "+req.Header.Get(name)))
+	}
+	if len(body) > 0 {
+		parts = append(parts, "--data-raw "+shellQuote(string(body)))
+	}
+	fmt.Fprintln(out, strings.Join(parts, " \\\n  "))
+}
+
+func redactHeader(name, value string) string {
+	if !strings.EqualFold(name, "Authorization") {
+		return value
+	}
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " " + redactedToken
+	}
+	return redactedToken
+}
+
+func sortedHeaderNames(header http.Header) []string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func writeJSONCompact(out io.Writer, value any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(value)
+}
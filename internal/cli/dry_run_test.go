@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Export commands with their own --dry-run ask the server to validate; the
+// request must still be sent rather than printed by the global --dry-run.
+func TestExportDryRunIsSentToServer(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("XDG_CACHE_HOME", dir)
+
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"9","type":"ozinga-tk-batch-file-exports","attributes":{"dry-run":true}}}`))
+	}))
+	defer server.Close()
+
+	cmd, _, err := rootCmd.Find([]string{"do", "ozinga-tk-batch-file-exports", "create"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = cmd.Flags().Set("dry-run", "false")
+		cmd.Flags().Lookup("dry-run").Changed = false
+		rootCmd.SetArgs(nil)
+		rootCmd.SetOut(nil)
+	})
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs([]string{"do", "ozinga-tk-batch-file-exports", "create",
+		"--organization-invoices-batch-file", "123", "--dry-run",
+		"--base-url", server.URL, "--token", "test", "--json"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if cliDryRunRequested(cmd) {
+		t.Error("the local --dry-run was taken for the global one")
+	}
+	if !strings.Contains(body, `"dry-run":true`) {
+		t.Errorf("request body = %q, want the dry-run attribute sent", body)
+	}
+}
@@ -0,0 +1,386 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+	"github.com/xbe-inc/xbe-cli/internal/policy"
+)
+
+// Exit codes returned by the CLI. Scripts and agents can branch on these.
+const (
+	ExitOK         = 0
+	ExitError      = 1
+	ExitAuth       = 3
+	ExitValidation = 4
+	ExitNotFound   = 5
+	ExitConflict   = 6
+	ExitRateLimit  = 7
+	ExitServer     = 8
+	ExitPolicy     = 9
+)
+
+type errorCategory string
+
+const (
+	errorCategoryGeneric    errorCategory = "error"
+	errorCategoryAuth       errorCategory = "auth"
+	errorCategoryValidation errorCategory = "validation"
+	errorCategoryNotFound   errorCategory = "not_found"
+	errorCategoryConflict   errorCategory = "conflict"
+	errorCategoryRateLimit  errorCategory = "rate_limit"
+	errorCategoryServer     errorCategory = "server"
+	errorCategoryPolicy     errorCategory = "policy"
+)
+
+// reportedError marks an error whose details were already written for the user.
+type reportedError struct {
+	err error
+}
+
+func (e *reportedError) Error() string { return e.err.Error() }
+func (e *reportedError) Unwrap() error { return e.err }
+
+// ErrorReported reports whether the CLI already printed err, so callers
+// should not print it again.
+func ErrorReported(err error) bool {
+	var reported *reportedError
+	return errors.As(err, &reported)
+}
+
+// ExitCode maps an error returned by Execute to a process exit code.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	switch categorizeError(err) {
+	case errorCategoryAuth:
+		return ExitAuth
+	case errorCategoryValidation:
+		return ExitValidation
+	case errorCategoryNotFound:
+		return ExitNotFound
+	case errorCategoryConflict:
+		return ExitConflict
+	case errorCategoryRateLimit:
+		return ExitRateLimit
+	case errorCategoryServer:
+		return ExitServer
+	case errorCategoryPolicy:
+		return ExitPolicy
+	default:
+		return ExitError
+	}
+}
+
+func categorizeError(err error) errorCategory {
+	if errors.Is(err, auth.ErrNotFound) {
+		return errorCategoryAuth
+	}
+	if errors.Is(err, errInvalidInput) {
+		return errorCategoryValidation
+	}
+	if errors.Is(err, policy.ErrDenied) || errors.Is(err, api.ErrReadOnly) {
+		return errorCategoryPolicy
+	}
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		return errorCategoryGeneric
+	}
+	switch {
+	case apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden:
+		return errorCategoryAuth
+	case apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnprocessableEntity:
+		return errorCategoryValidation
+	case apiErr.StatusCode == http.StatusNotFound:
+		return errorCategoryNotFound
+	case apiErr.StatusCode == http.StatusConflict:
+		return errorCategoryConflict
+	case apiErr.StatusCode == http.StatusTooManyRequests:
+		return errorCategoryRateLimit
+	case apiErr.StatusCode >= http.StatusInternalServerError:
+		return errorCategoryServer
+	default:
+		return errorCategoryGeneric
+	}
+}
+
+type errorCapture struct {
+	cmd    *cobra.Command
+	stderr io.Writer
+	stdout io.Writer
+	held   *heldWriter
+}
+
+var activeErrorCapture *errorCapture
+
+// captureCommandErrors routes stderr of view/do/summarize commands through a
+// heldWriter, so the raw response body and error line a command prints just
+// before failing can be replaced by a structured report.
+func captureCommandErrors(cmd *cobra.Command) {
+	if !isAPICommand(cmd) {
+		return
+	}
+	capture := &errorCapture{
+		cmd:    cmd,
+		stderr: cmd.ErrOrStderr(),
+		stdout: cmd.OutOrStdout(),
+	}
+	capture.held = &heldWriter{out: capture.stderr}
+	cmd.SetErr(capture.held)
+	activeErrorCapture = capture
+}
+
+// heldWriter passes writes through one write late, and holds a JSON:API
+// error document together with the write after it. Commands print a failed
+// response's body and then the error in single writes right before
+// returning; everything else, including progress, reaches the terminal as
+// it happens.
+type heldWriter struct {
+	out  io.Writer
+	body []byte
+	last []byte
+}
+
+func (w *heldWriter) Write(p []byte) (int, error) {
+	switch {
+	case isErrorDocument(p):
+		if err := w.flush(); err != nil {
+			return 0, err
+		}
+		w.body = append(w.body, p...)
+	case w.body != nil && w.last == nil:
+		w.last = append([]byte{}, p...)
+	default:
+		if err := w.flush(); err != nil {
+			return 0, err
+		}
+		w.last = append([]byte{}, p...)
+	}
+	return len(p), nil
+}
+
+func (w *heldWriter) flush() error {
+	for _, held := range [][]byte{w.body, w.last} {
+		if len(held) == 0 {
+			continue
+		}
+		if _, err := w.out.Write(held); err != nil {
+			return err
+		}
+	}
+	w.body, w.last = nil, nil
+	return nil
+}
+
+// printed reports whether the last write was err's message.
+func (w *heldWriter) printed(err error) bool {
+	return w.last != nil && strings.TrimRight(string(w.last), "\n") == err.Error()
+}
+
+// drop discards the held error document, and the last write when it was
+// err's message, for a caller that reports err in its own form.
+func (w *heldWriter) drop(err error) {
+	if w.printed(err) {
+		w.last = nil
+	}
+	w.body = nil
+}
+
+func isErrorDocument(p []byte) bool {
+	p = bytes.TrimSpace(p)
+	return len(p) > 0 && p[0] == '{' && api.ParseErrorObjects(p) != nil
+}
+
+func isAPICommand(cmd *cobra.Command) bool {
+	parts := strings.Fields(cmd.CommandPath())
+	if len(parts) < 3 {
+		return false
+	}
+	switch parts[1] {
+	case "view", "do", "summarize":
+		return true
+	default:
+		return false
+	}
+}
+
+func finalizeErrors(cmdErr error) error {
+	capture := activeErrorCapture
+	activeErrorCapture = nil
+	if capture == nil {
+		return cmdErr
+	}
+	cmd := capture.cmd
+	cmd.SetErr(capture.stderr)
+
+	held := capture.held
+	if errors.Is(cmdErr, api.ErrDryRun) {
+		// The command stopped at the write it would have sent; that is the
+		// expected outcome of --dry-run, not a failure.
+		held.drop(cmdErr)
+		_ = held.flush()
+		return nil
+	}
+	if cmdErr == nil || ErrorReported(cmdErr) {
+		_ = held.flush()
+		return cmdErr
+	}
+
+	var apiErr *api.APIError
+	structured := errors.As(cmdErr, &apiErr) && len(apiErr.Errors) > 0
+	printed := held.printed(cmdErr)
+	if structured || getBoolFlag(cmd, "json") {
+		// The report below replaces what the command printed.
+		held.drop(cmdErr)
+	}
+	_ = held.flush()
+
+	if getBoolFlag(cmd, "json") {
+		if err := writeJSONOutput(capture.stdout, errorPayload(cmd, cmdErr)); err != nil {
+			return cmdErr
+		}
+		return &reportedError{err: cmdErr}
+	}
+	if !structured {
+		if printed {
+			// Most commands print their error before returning it; don't
+			// let the caller print it a second time.
+			return &reportedError{err: cmdErr}
+		}
+		return cmdErr
+	}
+
+	fmt.Fprintln(capture.stderr, cmdErr.Error())
+	for _, item := range apiErr.Errors {
+		message := item.Message()
+		if location := errorLocation(cmd, item); location != "" {
+			message = location + ": " + message
+		}
+		fmt.Fprintln(capture.stderr, "  "+message)
+	}
+	return &reportedError{err: cmdErr}
+}
+
+type errorPayloadItem struct {
+	Status    string `json:"status,omitempty"`
+	Code      string `json:"code,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Flag      string `json:"flag,omitempty"`
+}
+
+type errorPayloadBody struct {
+	Type     errorCategory      `json:"type"`
+	ExitCode int                `json:"exit_code"`
+	Status   int                `json:"status,omitempty"`
+	Message  string             `json:"message"`
+	Errors   []errorPayloadItem `json:"errors,omitempty"`
+}
+
+func errorPayload(cmd *cobra.Command, err error) map[string]errorPayloadBody {
+	body := errorPayloadBody{
+		Type:     categorizeError(err),
+		ExitCode: ExitCode(err),
+		Message:  err.Error(),
+	}
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		body.Status = apiErr.StatusCode
+		for _, item := range apiErr.Errors {
+			entry := errorPayloadItem{
+				Status: item.Status,
+				Code:   item.Code,
+				Title:  item.Title,
+				Detail: item.Detail,
+			}
+			if item.Source != nil {
+				entry.Pointer = item.Source.Pointer
+				entry.Parameter = item.Source.Parameter
+			}
+			entry.Flag = flagForErrorSource(cmd, item.Source)
+			body.Errors = append(body.Errors, entry)
+		}
+	}
+	return map[string]errorPayloadBody{"error": body}
+}
+
+func errorLocation(cmd *cobra.Command, item api.ErrorObject) string {
+	if flag := flagForErrorSource(cmd, item.Source); flag != "" {
+		return flag
+	}
+	if item.Source == nil {
+		return ""
+	}
+	if item.Source.Pointer != "" {
+		return item.Source.Pointer
+	}
+	return item.Source.Parameter
+}
+
+// flagForErrorSource maps a JSON:API error source back to the CLI flag that
+// supplied it, e.g. /data/attributes/goal-quantity -> --goal-quantity.
+func flagForErrorSource(cmd *cobra.Command, source *api.ErrorSource) string {
+	if cmd == nil || source == nil {
+		return ""
+	}
+	name := ""
+	isAttribute := false
+	if pointer := strings.Trim(source.Pointer, "/"); pointer != "" {
+		parts := strings.Split(pointer, "/")
+		if len(parts) < 3 || parts[0] != "data" {
+			return ""
+		}
+		switch parts[1] {
+		case "attributes":
+			isAttribute = true
+		case "relationships":
+		default:
+			return ""
+		}
+		name = parts[2]
+	} else if parameter := strings.TrimSpace(source.Parameter); parameter != "" {
+		name = parameter
+		if strings.HasPrefix(name, "filter[") && strings.HasSuffix(name, "]") {
+			name = strings.TrimSuffix(strings.TrimPrefix(name, "filter["), "]")
+		}
+	}
+	name = normalizeFieldName(name)
+	if name == "" {
+		return ""
+	}
+
+	if isAttribute {
+		if resource, ok := resourceForCommandPath(cmd); ok {
+			if resourceMap, err := loadResourceMap(); err == nil {
+				if spec, ok := resourceMap.Resources[resource]; ok && !contains(spec.Attributes, name) {
+					return ""
+				}
+			}
+		}
+	}
+	if cmd.Flags().Lookup(name) != nil {
+		return "--" + name
+	}
+	return ""
+}
+
+func resourceForCommandPath(cmd *cobra.Command) (string, bool) {
+	parts := strings.Fields(cmd.CommandPath())
+	if len(parts) < 4 {
+		return "", false
+	}
+	if parts[1] != "view" && parts[1] != "do" {
+		return "", false
+	}
+	return parts[2], true
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+func TestCaptureCommandErrorsStreamsStderr(t *testing.T) {
+	newList := func(stderr *bytes.Buffer) *cobra.Command {
+		root := &cobra.Command{Use: "xbe"}
+		view := &cobra.Command{Use: "view"}
+		brokers := &cobra.Command{Use: "brokers"}
+		list := &cobra.Command{Use: "list"}
+		root.AddCommand(view)
+		view.AddCommand(brokers)
+		brokers.AddCommand(list)
+		list.SetErr(stderr)
+		list.SetOut(&bytes.Buffer{})
+		captureCommandErrors(list)
+		return list
+	}
+
+	var stderr bytes.Buffer
+	cmd := newList(&stderr)
+	fmt.Fprintln(cmd.ErrOrStderr(), "page 1 of 3")
+	fmt.Fprintln(cmd.ErrOrStderr(), "page 2 of 3")
+	if stderr.String() != "page 1 of 3\n" {
+		t.Fatalf("stderr before exit = %q, want progress as it happens", stderr.String())
+	}
+	apiErr := &api.APIError{StatusCode: 422, Status: "422 Unprocessable Entity", Errors: []api.ErrorObject{{Detail: "is invalid"}}}
+	fmt.Fprintln(cmd.ErrOrStderr(), `{"errors":[{"status":"422","detail":"is invalid"}]}`)
+	fmt.Fprintln(cmd.ErrOrStderr(), apiErr)
+	if err := finalizeErrors(apiErr); !ErrorReported(err) {
+		t.Fatalf("finalizeErrors = %v, want it reported", err)
+	}
+	if want := "page 1 of 3\npage 2 of 3\nrequest failed: 422 Unprocessable Entity\n  is invalid\n"; stderr.String() != want {
+		t.Fatalf("stderr = %q, want %q", stderr.String(), want)
+	}
+
+	stderr.Reset()
+	cmd = newList(&stderr)
+	plain := errors.New("--broker is required")
+	fmt.Fprintln(cmd.ErrOrStderr(), plain)
+	if err := finalizeErrors(plain); !ErrorReported(err) || stderr.String() != "--broker is required\n" {
+		t.Fatalf("printed error = %v, stderr %q", err, stderr.String())
+	}
+
+	stderr.Reset()
+	newList(&stderr)
+	if err := finalizeErrors(plain); ErrorReported(err) || stderr.Len() != 0 {
+		t.Fatalf("unprinted error = %v, stderr %q", err, stderr.String())
+	}
+}
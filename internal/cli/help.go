@@ -249,6 +249,8 @@ func customHelpFunc(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(out)
 		printTimeNotes(out)
 		fmt.Fprintln(out)
+		printExitCodes(out)
+		fmt.Fprintln(out)
 		printAuthOverview(out)
 		fmt.Fprintln(out)
 		printRunHelp(out)
@@ -346,7 +348,7 @@ func printQuickStart(out io.Writer) {
 func printBootstrapLoop(out io.Writer) {
 	fmt.Fprintln(out, "BOOTSTRAP LOOP (for unknown tasks):")
 	fmt.Fprintln(out, "  0) Orient:   xbe knowledge guide")
-	fmt.Fprintln(out, "  1) Find:     xbe knowledge search <term>")
+	fmt.Fprintln(out, "  1) Find:     xbe knowledge search <term>   (or: xbe knowledge suggest \"<question>\")")
 	fmt.Fprintln(out, "  2) Inspect:  xbe knowledge resource <resource>")
 	fmt.Fprintln(out, "  3) Choose:   xbe knowledge commands --resource <resource> [--kind view|do|summarize]")
 	fmt.Fprintln(out, "  4) Verify:   xbe <view|do|summarize> <resource> <action> --help")
@@ -357,7 +359,7 @@ func printBootstrapLoop(out io.Writer) {
 
 func printCommandGrammar(out io.Writer) {
 	fmt.Fprintln(out, "COMMAND GRAMMAR:")
-	fmt.Fprintln(out, "  knowledge  xbe knowledge|kb <guide|search|resources|resource|commands|fields|flags|relations|neighbors|metapath|filters|summaries|client-routes> [filters]")
+	fmt.Fprintln(out, "  knowledge  xbe knowledge|kb <guide|suggest|search|resources|resource|commands|fields|flags|relations|neighbors|metapath|path|graph|sql|diff|filters|summaries|client-routes> [filters]")
 	fmt.Fprintln(out, "  read       xbe view <resource> <list|show> [flags]")
 	fmt.Fprintln(out, "  write      xbe do <resource> <create|update|delete|action> [flags]")
 	fmt.Fprintln(out, "  analyze    xbe summarize <summary> create [flags]")
@@ -367,6 +369,7 @@ func printCommandGrammar(out io.Writer) {
 func printKnowledgeTools(out io.Writer) {
 	fmt.Fprintln(out, "KNOWLEDGE TOOLS (what they answer):")
 	fmt.Fprintln(out, "  guide      first-run playbook + non-obvious naming rules")
+	fmt.Fprintln(out, "  suggest    turn a plain-English question into ranked, filled-in commands")
 	fmt.Fprintln(out, "  search     find resources/commands/fields/summaries by term")
 	fmt.Fprintln(out, "  resource   see fields, relationships, summaries, commands for one resource")
 	fmt.Fprintln(out, "  commands   list CLI commands + permissions/side effects/validation")
@@ -375,6 +378,10 @@ func printKnowledgeTools(out io.Writer) {
 	fmt.Fprintln(out, "  neighbors  rank next-best resources to explore")
 	fmt.Fprintln(out, "  filters    infer multi-hop filter paths from commands")
 	fmt.Fprintln(out, "  metapath   similarity via shared features")
+	fmt.Fprintln(out, "  path       plan a multi-hop join between two resources + the commands to walk it")
+	fmt.Fprintln(out, "  graph      export a resource neighborhood as DOT, Mermaid, or GraphML")
+	fmt.Fprintln(out, "  sql        read-only SQL (or an interactive console) over the knowledge database")
+	fmt.Fprintln(out, "  diff       changelog of resources, commands, and flags against another CLI version")
 	fmt.Fprintln(out, "  fields     list fields + owning resources")
 	fmt.Fprintln(out, "  summaries  list summary resources + group-by/metrics")
 	fmt.Fprintln(out, "  client-routes  list client app routes, params, and curated docs (e.g. jump-to)")
@@ -771,12 +778,19 @@ func printSubcommandsInTree(out io.Writer, cmd *cobra.Command, indent string) {
 func printGlobalFlags(out io.Writer) {
 	fmt.Fprintln(out, "GLOBAL FLAGS:")
 	fmt.Fprintln(out, "  --json               machine-readable output")
-	fmt.Fprintln(out, "  --output             output format: table (default), json, yaml")
-	fmt.Fprintln(out, "  --jq                 jq-style filter for JSON/YAML output (--jq implies JSON if --output is unset)")
+	fmt.Fprintln(out, "  --output             output format: table (default), json, yaml, csv, tsv, ndjson")
+	fmt.Fprintln(out, "  --jq                 jq-style filter for machine output (--jq implies JSON if --output is unset)")
+	fmt.Fprintln(out, "  --template-file      Go text/template file for output (or --output 'template={{...}}')")
 	fmt.Fprintln(out, "  --client-url         output client app URL(s) for view list/show")
 	fmt.Fprintln(out, "  --limit/--offset/--sort  pagination for list commands")
+	fmt.Fprintln(out, "  --all/--max-items    fetch and merge every page for view list commands")
 	fmt.Fprintln(out, "  --fields             sparse fieldsets for list/show")
 	fmt.Fprintln(out, "  --base-url/--token/--no-auth  auth/targeting")
+	fmt.Fprintln(out, "  --profile            named profile (xbe config profiles; env XBE_PROFILE)")
+	fmt.Fprintln(out, "  --read-only          refuse every non-GET API request (XBE_READ_ONLY; policy file via XBE_POLICY)")
+	fmt.Fprintln(out, "  --dry-run            do commands: print the HTTP request instead of sending it (--dry-run-format http|curl|json)")
+	fmt.Fprintln(out, "  --from-file          do create/update: one record per row of a .csv/.jsonl file (--concurrency, --results-file)")
+	fmt.Fprintln(out, "  --retries/--retry-max-delay/--retry-post/--retry-patch  retry transient API failures (XBE_RETRIES, XBE_RETRY_MAX_DELAY, XBE_RETRY_POST, XBE_RETRY_PATCH)")
 	fmt.Fprintln(out, "  -h, --help           show help for any command")
 }
 
@@ -793,17 +807,23 @@ func printTimeNotes(out io.Writer) {
 	fmt.Fprintln(out, "  Timestamps are UTC unless explicitly labeled as local.")
 }
 
+func printExitCodes(out io.Writer) {
+	fmt.Fprintln(out, "EXIT CODES:")
+	fmt.Fprintln(out, "  0 ok, 1 error, 3 auth, 4 validation, 5 not found, 6 conflict, 7 rate limit, 8 server, 9 policy")
+	fmt.Fprintln(out, "  With --json, API errors are printed as {\"error\": {...}} with flag names for invalid fields")
+}
+
 func printAuthOverview(out io.Writer) {
 	fmt.Fprintln(out, "AUTH:")
 	fmt.Fprintln(out, "  xbe auth status | login | logout | whoami")
-	fmt.Fprintln(out, "  Token precedence: --token > XBE_TOKEN/XBE_API_TOKEN > keychain > config")
+	fmt.Fprintln(out, "  Token precedence: --token > XBE_TOKEN/XBE_API_TOKEN > profile token ref > keychain > config")
 }
 
 func printRunHelp(out io.Writer) {
 	fmt.Fprintln(out, "RUN HELP:")
 	fmt.Fprintln(out, "  xbe <command> --help")
 	fmt.Fprintln(out, "  xbe <command> <subcommand> --help")
-	fmt.Fprintln(out, "  Tip: use --output yaml or --jq '<filter>' for filtered machine output")
+	fmt.Fprintln(out, "  Tip: use --output yaml|csv|ndjson or --jq '<filter>' for filtered machine output")
 }
 
 func printUsage(out io.Writer, cmd *cobra.Command) {
@@ -997,7 +1017,7 @@ func printSubcommandsGrouped(out io.Writer, cmd *cobra.Command) {
 // Global flags that appear on most commands (documented in root help)
 var (
 	paginationFlags = map[string]bool{"limit": true, "offset": true, "sort": true}
-	outputFlags     = map[string]bool{"json": true, "output": true, "jq": true, "client-url": true}
+	outputFlags     = map[string]bool{"json": true, "output": true, "jq": true, "template-file": true, "client-url": true}
 	connectionFlags = map[string]bool{"base-url": true, "token": true, "no-auth": true}
 	sparseFlags     = map[string]bool{"fields": true}
 )
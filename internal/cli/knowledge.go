@@ -45,7 +45,19 @@ Alias: xbe kb ...`,
   xbe knowledge neighbors jobs --limit 20
 
   # Show multi-hop filter paths inferred from commands
-  xbe knowledge filters --resource jobs`,
+  xbe knowledge filters --resource jobs
+
+  # Plan a join from time cards to invoices
+  xbe knowledge path time-cards invoices
+
+  # Query the knowledge database directly
+  xbe knowledge sql "SELECT name, label_fields FROM resources LIMIT 5"
+
+  # What changed since the previously installed version
+  xbe knowledge diff --against previous
+
+  # Turn a question into a command
+  xbe knowledge suggest "tons hauled per trucker last week for broker 12"`,
 	Annotations: map[string]string{"group": GroupKnowledge},
 }
 
@@ -67,6 +79,11 @@ func init() {
 	knowledgeCmd.AddCommand(newKnowledgeSummariesCmd())
 	knowledgeCmd.AddCommand(newKnowledgeNeighborsCmd())
 	knowledgeCmd.AddCommand(newKnowledgeMetapathCmd())
+	knowledgeCmd.AddCommand(newKnowledgePathCmd())
+	knowledgeCmd.AddCommand(newKnowledgeGraphCmd())
+	knowledgeCmd.AddCommand(newKnowledgeSQLCmd())
+	knowledgeCmd.AddCommand(newKnowledgeDiffCmd())
+	knowledgeCmd.AddCommand(newKnowledgeSuggestCmd())
 	knowledgeCmd.AddCommand(newKnowledgeFiltersCmd())
 	knowledgeCmd.AddCommand(newKnowledgeClientRoutesCmd())
 
@@ -0,0 +1,387 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// knowledgeDiffCategory describes how to load one kind of knowledge entry
+// for comparison. The query returns the entry's key first and then the
+// columns named in columns; breaking decides whether a change to one column
+// can break existing callers.
+type knowledgeDiffCategory struct {
+	name     string
+	table    string
+	query    string
+	columns  []string
+	breaking func(column, before, after string) bool
+}
+
+type knowledgeDiffRow struct {
+	Change   string `json:"change"`
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	Detail   string `json:"detail,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+var knowledgeDiffCategories = []knowledgeDiffCategory{
+	{
+		name:    "resource",
+		table:   "resources",
+		query:   `SELECT name, COALESCE(label_fields, ''), COALESCE(server_types, '') FROM resources`,
+		columns: []string{"label_fields", "server_types"},
+	},
+	{
+		name:    "field",
+		table:   "resource_fields",
+		query:   `SELECT resource || '.' || name, kind, COALESCE(description, '') FROM resource_fields`,
+		columns: []string{"kind", "description"},
+		breaking: func(column, _, _ string) bool {
+			return column == "kind"
+		},
+	},
+	{
+		name:  "relationship",
+		table: "resource_field_targets",
+		query: `
+SELECT resource || '.' || field, GROUP_CONCAT(target_resource, ',')
+FROM (SELECT resource, field, target_resource FROM resource_field_targets ORDER BY target_resource)
+GROUP BY resource, field`,
+		columns: []string{"targets"},
+		breaking: func(_, _, _ string) bool {
+			return true
+		},
+	},
+	{
+		name:    "command",
+		table:   "commands",
+		query:   `SELECT full_path, description, COALESCE(permissions, ''), COALESCE(side_effects, '') FROM commands`,
+		columns: []string{"description", "permissions", "side_effects"},
+	},
+	{
+		name:  "flag",
+		table: "flags",
+		query: `
+SELECT c.full_path || ' ' || f.name, f.type, f.required, COALESCE(f.default_value, ''), COALESCE(f.aliases, ''), f.description
+FROM flags f
+JOIN commands c ON c.id = f.command_id`,
+		columns: []string{"type", "required", "default", "aliases", "description"},
+		breaking: func(column, before, after string) bool {
+			switch column {
+			case "type":
+				return true
+			case "required":
+				return after == "1"
+			case "aliases":
+				return !containsAllAliases(after, before)
+			}
+			return false
+		},
+	},
+	{
+		name:  "filter-path",
+		table: "command_filter_paths",
+		query: `
+SELECT c.full_path || ' ' || p.flag_name || ' ' || p.path, p.target_resource, COALESCE(p.target_field, ''), p.match_kind, COALESCE(p.modifier, '')
+FROM command_filter_paths p
+JOIN commands c ON c.id = p.command_id`,
+		columns: []string{"target_resource", "target_field", "match_kind", "modifier"},
+		breaking: func(column, _, _ string) bool {
+			return column == "target_resource" || column == "target_field"
+		},
+	},
+	{
+		name:    "summary-metric",
+		table:   "summary_metrics",
+		query:   `SELECT summary_resource || '.' || name, source_path FROM summary_metrics`,
+		columns: []string{"source_path"},
+		breaking: func(_, _, _ string) bool {
+			return true
+		},
+	},
+	{
+		name:    "summary-dimension",
+		table:   "summary_dimensions",
+		query:   `SELECT summary_resource || '.' || name || ' (' || kind || ')', source_path FROM summary_dimensions`,
+		columns: []string{"source_path"},
+		breaking: func(_, _, _ string) bool {
+			return true
+		},
+	},
+}
+
+func newKnowledgeDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff --against <path-or-version>",
+		Short: "Compare the knowledge database with another CLI version",
+		Long: `Compare this CLI's knowledge database with another copy and list what was
+added, removed, or changed.
+
+--against takes a path to a knowledge.sqlite file or a CLI version. The
+installed release keeps a copy in the cache directory
+(~/.cache/xbe/knowledge/knowledge-<version>.sqlite on Linux), and the copy
+replaced by the most recent upgrade is available as "previous". Copies from
+older releases are removed on upgrade.
+
+Categories:
+  resource, field, relationship, command, flag, filter-path,
+  summary-metric, summary-dimension
+
+Entries are matched by name (command path, "command --flag", resource.field),
+so a renamed flag shows as one removal and one addition. A change is marked
+breaking when scripts written against the older version may stop working:
+removed entries, flag type changes, flags that became required or lost an
+alias, field kind changes, and retargeted relationships or filter paths.
+
+--limit and --offset do not apply.`,
+		Args: cobra.NoArgs,
+		RunE: runKnowledgeDiff,
+		Example: `  # What changed since the version this one replaced
+  xbe knowledge diff --against previous
+
+  # Only flag and command changes
+  xbe knowledge diff --against previous --category flag,command
+
+  # Fail a rollout check when flags or commands were removed
+  xbe knowledge diff --against previous --breaking --fail-on-breaking
+
+  # Compare with a database file
+  xbe knowledge diff --against ./knowledge.sqlite --json`,
+	}
+	cmd.Flags().String("against", "", "Knowledge database path or CLI version to compare with (required)")
+	cmd.Flags().String("category", "", "Comma-separated categories to include")
+	cmd.Flags().String("change", "", "Comma-separated change types to include (added, removed, changed)")
+	cmd.Flags().Bool("breaking", false, "Only show breaking changes")
+	cmd.Flags().Bool("fail-on-breaking", false, "Exit with an error when any breaking change is found")
+	return cmd
+}
+
+func runKnowledgeDiff(cmd *cobra.Command, _ []string) error {
+	against := strings.TrimSpace(getStringFlag(cmd, "against"))
+	if err := ensureNotEmpty(against, "--against"); err != nil {
+		return err
+	}
+	categoryNames := make([]string, 0, len(knowledgeDiffCategories))
+	for _, category := range knowledgeDiffCategories {
+		categoryNames = append(categoryNames, category.name)
+	}
+	categories, err := validateCSVEnum("--category", getStringFlag(cmd, "category"), allowedValues(categoryNames...))
+	if err != nil {
+		return err
+	}
+	changes, err := validateCSVEnum("--change", getStringFlag(cmd, "change"), allowedValues("added", "removed", "changed"))
+	if err != nil {
+		return err
+	}
+
+	againstPath, err := resolveKnowledgeDiffPath(against)
+	if err != nil {
+		return err
+	}
+	currentPath, err := resolveKnowledgeDBPath(cmd)
+	if err != nil {
+		return err
+	}
+	current, err := openReadOnlySQLite(currentPath)
+	if err != nil {
+		return err
+	}
+	defer current.Close()
+	previous, err := openReadOnlySQLite(againstPath)
+	if err != nil {
+		return err
+	}
+	defer previous.Close()
+
+	ctx := context.Background()
+	rows := []knowledgeDiffRow{}
+	for _, category := range knowledgeDiffCategories {
+		if len(categories) > 0 && !containsString(categories, category.name) {
+			continue
+		}
+		before, err := loadKnowledgeDiffEntries(ctx, previous, category)
+		if err != nil {
+			return fmt.Errorf("read %s from %s: %w", category.table, againstPath, err)
+		}
+		after, err := loadKnowledgeDiffEntries(ctx, current, category)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", category.table, err)
+		}
+		rows = append(rows, diffKnowledgeEntries(category, before, after)...)
+	}
+
+	filtered := rows[:0]
+	breaking := 0
+	for _, row := range rows {
+		if len(changes) > 0 && !containsString(changes, row.Change) {
+			continue
+		}
+		if getBoolFlag(cmd, "breaking") && !row.Breaking {
+			continue
+		}
+		if row.Breaking {
+			breaking++
+		}
+		filtered = append(filtered, row)
+	}
+	rows = filtered
+
+	if getBoolFlag(cmd, "json") {
+		setOutputColumns([]string{"change", "category", "name", "detail", "breaking"})
+		if err := renderKnowledgeJSON(cmd, rows); err != nil {
+			return err
+		}
+	} else {
+		if len(rows) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No differences.")
+		} else {
+			w := newTabWriter(cmd)
+			fmt.Fprintln(w, "CHANGE\tCATEGORY\tNAME\tDETAIL\tBREAKING")
+			for _, row := range rows {
+				mark := ""
+				if row.Breaking {
+					mark = "yes"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", row.Change, row.Category, row.Name, row.Detail, mark)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if breaking > 0 && getBoolFlag(cmd, "fail-on-breaking") {
+		return fmt.Errorf("%d breaking knowledge changes against %s", breaking, against)
+	}
+	return nil
+}
+
+// resolveKnowledgeDiffPath accepts a database path or a version with a
+// cached snapshot ("previous", "0.41.0", "v0.41.0").
+func resolveKnowledgeDiffPath(against string) (string, error) {
+	if info, err := os.Stat(against); err == nil && !info.IsDir() {
+		return against, nil
+	}
+	dir := knowledgeCacheDir()
+	path := knowledgeSnapshotPath(dir, against)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	available := []string{}
+	matches, _ := filepath.Glob(filepath.Join(dir, "knowledge-*.sqlite"))
+	for _, match := range matches {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(match), "knowledge-"), ".sqlite")
+		available = append(available, name)
+	}
+	sort.Strings(available)
+	if len(available) == 0 {
+		return "", fmt.Errorf("no knowledge database found for %q: pass a path to a knowledge.sqlite file (no cached versions in %s yet)", against, dir)
+	}
+	return "", fmt.Errorf("no knowledge database found for %q; cached versions: %s", against, strings.Join(available, ", "))
+}
+
+func loadKnowledgeDiffEntries(ctx context.Context, db *sql.DB, category knowledgeDiffCategory) (map[string][]string, error) {
+	entries := map[string][]string{}
+	var exists int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE name = ?`, category.table).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		return entries, nil
+	}
+	rows, err := db.QueryContext(ctx, category.query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := make([]sql.NullString, len(category.columns)+1)
+		pointers := make([]any, len(values))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		attrs := make([]string, len(category.columns))
+		for i := range attrs {
+			attrs[i] = values[i+1].String
+		}
+		entries[values[0].String] = attrs
+	}
+	return entries, rows.Err()
+}
+
+func diffKnowledgeEntries(category knowledgeDiffCategory, before, after map[string][]string) []knowledgeDiffRow {
+	names := make([]string, 0, len(before)+len(after))
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	rows := []knowledgeDiffRow{}
+	for _, name := range names {
+		old, hadOld := before[name]
+		current, hasCurrent := after[name]
+		switch {
+		case !hadOld:
+			rows = append(rows, knowledgeDiffRow{Change: "added", Category: category.name, Name: name})
+		case !hasCurrent:
+			rows = append(rows, knowledgeDiffRow{Change: "removed", Category: category.name, Name: name, Breaking: true})
+		default:
+			var details []string
+			breaking := false
+			for i, column := range category.columns {
+				if old[i] == current[i] {
+					continue
+				}
+				details = append(details, fmt.Sprintf("%s: %s -> %s", column, diffValue(old[i]), diffValue(current[i])))
+				if category.breaking != nil && category.breaking(column, old[i], current[i]) {
+					breaking = true
+				}
+			}
+			if len(details) > 0 {
+				rows = append(rows, knowledgeDiffRow{Change: "changed", Category: category.name, Name: name, Detail: strings.Join(details, "; "), Breaking: breaking})
+			}
+		}
+	}
+	return rows
+}
+
+func diffValue(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" {
+		return `""`
+	}
+	if len(value) > 60 {
+		return fmt.Sprintf("%q", value[:57]+"...")
+	}
+	return fmt.Sprintf("%q", value)
+}
+
+// containsAllAliases reports whether every alias in needles (a JSON array, as
+// stored in flags.aliases) is still present in haystack.
+func containsAllAliases(haystack, needles string) bool {
+	var have, want []string
+	_ = json.Unmarshal([]byte(haystack), &have)
+	_ = json.Unmarshal([]byte(needles), &want)
+	for _, needle := range want {
+		if !containsString(have, needle) {
+			return false
+		}
+	}
+	return true
+}
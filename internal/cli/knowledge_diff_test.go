@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDiffKnowledgeEntries(t *testing.T) {
+	var flagCategory knowledgeDiffCategory
+	for _, category := range knowledgeDiffCategories {
+		if category.name == "flag" {
+			flagCategory = category
+		}
+	}
+	before := map[string][]string{
+		"view jobs list --broker":   {"string", "0", "", `["broker-id"]`, "Broker ID"},
+		"view jobs list --customer": {"string", "0", "", "", "Customer ID"},
+		"view jobs list --status":   {"string", "0", "", "", "Status"},
+	}
+	after := map[string][]string{
+		"view jobs list --broker":   {"string", "0", "", "", "Broker ID"},
+		"view jobs list --customer": {"string", "1", "", "", "Customer ID (required)"},
+		"view jobs list --trucker":  {"string", "0", "", "", "Trucker ID"},
+	}
+	got := diffKnowledgeEntries(flagCategory, before, after)
+	want := []knowledgeDiffRow{
+		{Change: "changed", Category: "flag", Name: "view jobs list --broker", Detail: `aliases: "[\"broker-id\"]" -> ""`, Breaking: true},
+		{Change: "changed", Category: "flag", Name: "view jobs list --customer", Detail: `required: "0" -> "1"; description: "Customer ID" -> "Customer ID (required)"`, Breaking: true},
+		{Change: "removed", Category: "flag", Name: "view jobs list --status", Breaking: true},
+		{Change: "added", Category: "flag", Name: "view jobs list --trucker"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("diffKnowledgeEntries =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestPruneKnowledgeSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"knowledge-0.40.0.sqlite", "knowledge-0.41.0.sqlite", "knowledge-0.42.0.sqlite", "knowledge-previous.sqlite", "knowledge.sqlite"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pruneKnowledgeSnapshots(dir, knowledgeSnapshotPath(dir, "v0.42.0"), knowledgeSnapshotPath(dir, "previous"))
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+	}
+	want := []string{"knowledge-0.42.0.sqlite", "knowledge-previous.sqlite", "knowledge.sqlite"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("cache = %v, want %v", got, want)
+	}
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/xbe-inc/xbe-cli/internal/version"
+
 	_ "embed"
 )
 
@@ -29,11 +32,7 @@ func ensureEmbeddedKnowledgeDB() (string, error) {
 			embeddedKnowledgeDBErr = errors.New("embedded knowledge database missing")
 			return
 		}
-		cacheDir, err := os.UserCacheDir()
-		if err != nil || cacheDir == "" {
-			cacheDir = os.TempDir()
-		}
-		dir := filepath.Join(cacheDir, "xbe", "knowledge")
+		dir := knowledgeCacheDir()
 		if err := os.MkdirAll(dir, 0o755); err != nil {
 			embeddedKnowledgeDBErr = fmt.Errorf("prepare knowledge cache: %w", err)
 			return
@@ -47,6 +46,10 @@ func ensureEmbeddedKnowledgeDB() (string, error) {
 			embeddedKnowledgeDBPath = path
 			return
 		}
+		snapshotKnowledgeDB(dir)
+		if _, err := os.Stat(path); err == nil {
+			_ = os.Rename(path, knowledgeSnapshotPath(dir, "previous"))
+		}
 
 		tmpPath := path + ".tmp"
 		if err := os.WriteFile(tmpPath, embeddedKnowledgeDB, 0o644); err != nil {
@@ -68,6 +71,53 @@ func ensureEmbeddedKnowledgeDB() (string, error) {
 	return embeddedKnowledgeDBPath, embeddedKnowledgeDBErr
 }
 
+func knowledgeCacheDir() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil || cacheDir == "" {
+		cacheDir = os.TempDir()
+	}
+	return filepath.Join(cacheDir, "xbe", "knowledge")
+}
+
+// knowledgeSnapshotPath is where a released version keeps its own copy of
+// the knowledge database, so `xbe knowledge diff --against <version>` still
+// finds it after an upgrade replaces knowledge.sqlite. The copy replaced by
+// the last upgrade is kept as "previous"; snapshots of other versions are
+// pruned so the cache does not grow with every release.
+func knowledgeSnapshotPath(dir, release string) string {
+	release = strings.TrimPrefix(strings.TrimSpace(release), "v")
+	return filepath.Join(dir, "knowledge-"+release+".sqlite")
+}
+
+func snapshotKnowledgeDB(dir string) {
+	release := version.String()
+	if release == "" || release == "dev" {
+		return
+	}
+	path := knowledgeSnapshotPath(dir, release)
+	pruneKnowledgeSnapshots(dir, path, knowledgeSnapshotPath(dir, "previous"))
+	if info, err := os.Stat(path); err == nil && info.Size() == int64(len(embeddedKnowledgeDB)) {
+		return
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, embeddedKnowledgeDB, 0o644); err != nil {
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+	}
+}
+
+// pruneKnowledgeSnapshots removes every snapshot in dir except keep.
+func pruneKnowledgeSnapshots(dir string, keep ...string) {
+	matches, _ := filepath.Glob(filepath.Join(dir, "knowledge-*.sqlite"))
+	for _, match := range matches {
+		if !slices.Contains(keep, match) {
+			_ = os.Remove(match)
+		}
+	}
+}
+
 func fileUpToDate(path, hashPath, expectedHash string, expectedSize int) bool {
 	info, err := os.Stat(path)
 	if err != nil || info.Size() != int64(expectedSize) {
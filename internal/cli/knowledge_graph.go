@@ -0,0 +1,321 @@
+package cli
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type knowledgeGraphNode struct {
+	Name    string `json:"name"`
+	Summary bool   `json:"summary,omitempty"`
+	Seed    bool   `json:"seed,omitempty"`
+	Depth   int    `json:"depth"`
+}
+
+type knowledgeGraph struct {
+	Nodes []knowledgeGraphNode   `json:"nodes"`
+	Edges []knowledgeRelationRow `json:"edges"`
+}
+
+func newKnowledgeGraphCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Export the resource graph as DOT, Mermaid, or GraphML",
+		Long: `Export resource relationships and summary links as a diagram.
+
+Without --resource the whole graph is exported. With --resource, the export
+is the neighborhood of those resources: every resource within --depth hops
+(following edges in either direction) and all edges among them.
+
+Formats:
+  dot       Graphviz (render with: dot -Tsvg -o graph.svg)
+  mermaid   Mermaid erDiagram, for Markdown runbooks
+  graphml   GraphML XML, for yEd, Gephi, or networkx
+
+Relationship edges point from the resource holding the relationship to its
+target (time-cards -> customers). Summary edges point from a summary resource
+to the resource it summarizes and are drawn dashed.
+
+--json prints the nodes and edges instead of a diagram. --limit and --offset
+do not apply.`,
+		RunE: runKnowledgeGraph,
+		Example: `  # Diagram the neighborhood around jobs and time cards
+  xbe knowledge graph --resource jobs,time-cards --format mermaid
+
+  # Two hops out from job production plans, relationships only
+  xbe knowledge graph --resource job-production-plans --depth 2 --kinds relationship
+
+  # Render with Graphviz
+  xbe knowledge graph --resource invoices | dot -Tsvg -o invoices.svg`,
+	}
+	cmd.Flags().String("format", "dot", "Diagram format (dot, mermaid, graphml)")
+	cmd.Flags().String("resource", "", "Comma-separated resources to center the graph on (default: whole graph)")
+	cmd.Flags().Int("depth", 1, "Hops to include around --resource")
+	cmd.Flags().String("kinds", "", "Comma-separated edge kinds to include (relationship, summary; default both)")
+	return cmd
+}
+
+func runKnowledgeGraph(cmd *cobra.Command, _ []string) error {
+	format, err := validateEnum("--format", getStringFlag(cmd, "format"), allowedValues("dot", "mermaid", "graphml"))
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = "dot"
+	}
+	kinds, err := validateCSVEnum("--kinds", getStringFlag(cmd, "kinds"), allowedValues("relationship", "summary"))
+	if err != nil {
+		return err
+	}
+	depth := getIntFlag(cmd, "depth")
+	if depth < 0 {
+		return fmt.Errorf("--depth must be 0 or greater")
+	}
+
+	db, dbPath, err := openKnowledgeDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var seeds []string
+	for _, raw := range parseCSVFilter(getStringFlag(cmd, "resource")) {
+		resource, err := normalizeKnowledgeResourceArg(cmd, db, dbPath, raw, "--resource")
+		if err != nil {
+			return err
+		}
+		seeds = append(seeds, resource)
+	}
+
+	ctx := context.Background()
+	querySQL := `
+SELECT source_resource, relationship, target_resource, edge_kind, COALESCE(condition, '')
+FROM resource_graph_edges`
+	args := []any{}
+	if len(kinds) > 0 {
+		querySQL += " WHERE edge_kind IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(kinds)), ", ") + ")"
+		for _, kind := range kinds {
+			args = append(args, kind)
+		}
+	}
+	querySQL += " ORDER BY source_resource, relationship, target_resource"
+	rows, err := queryContext(ctx, db, querySQL, args...)
+	if err != nil {
+		return checkDBError(err, dbPath)
+	}
+	var edges []knowledgeRelationRow
+	for rows.Next() {
+		var edge knowledgeRelationRow
+		if err := rows.Scan(&edge.Source, &edge.Relation, &edge.Target, &edge.EdgeKind, &edge.Condition); err != nil {
+			rows.Close()
+			return checkDBError(err, dbPath)
+		}
+		edges = append(edges, edge)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return checkDBError(err, dbPath)
+	}
+
+	graph := buildKnowledgeGraph(edges, seeds, depth)
+	if len(graph.Nodes) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No relationships found.")
+		return nil
+	}
+
+	if getBoolFlag(cmd, "json") {
+		return renderKnowledgeJSON(cmd, graph)
+	}
+	out := cmd.OutOrStdout()
+	switch format {
+	case "mermaid":
+		writeGraphMermaid(out, graph)
+	case "graphml":
+		writeGraphML(out, graph)
+	default:
+		writeGraphDOT(out, graph)
+	}
+	return nil
+}
+
+// buildKnowledgeGraph keeps the nodes within depth hops of the seeds
+// (ignoring edge direction) and the edges among them. With no seeds it keeps
+// everything.
+func buildKnowledgeGraph(edges []knowledgeRelationRow, seeds []string, depth int) knowledgeGraph {
+	summaries := map[string]bool{}
+	for _, edge := range edges {
+		if edge.EdgeKind == "summary" {
+			summaries[edge.Source] = true
+		}
+	}
+
+	distance := map[string]int{}
+	if len(seeds) == 0 {
+		for _, edge := range edges {
+			distance[edge.Source] = 0
+			distance[edge.Target] = 0
+		}
+	} else {
+		neighbors := map[string][]string{}
+		for _, edge := range edges {
+			neighbors[edge.Source] = append(neighbors[edge.Source], edge.Target)
+			neighbors[edge.Target] = append(neighbors[edge.Target], edge.Source)
+		}
+		frontier := []string{}
+		for _, seed := range seeds {
+			if _, ok := distance[seed]; !ok {
+				distance[seed] = 0
+				frontier = append(frontier, seed)
+			}
+		}
+		for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+			var next []string
+			for _, node := range frontier {
+				for _, neighbor := range neighbors[node] {
+					if _, ok := distance[neighbor]; !ok {
+						distance[neighbor] = hop
+						next = append(next, neighbor)
+					}
+				}
+			}
+			frontier = next
+		}
+	}
+
+	seedSet := map[string]bool{}
+	for _, seed := range seeds {
+		seedSet[seed] = true
+	}
+	graph := knowledgeGraph{Nodes: []knowledgeGraphNode{}, Edges: []knowledgeRelationRow{}}
+	for name, hops := range distance {
+		graph.Nodes = append(graph.Nodes, knowledgeGraphNode{Name: name, Summary: summaries[name], Seed: seedSet[name], Depth: hops})
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		if graph.Nodes[i].Depth != graph.Nodes[j].Depth {
+			return graph.Nodes[i].Depth < graph.Nodes[j].Depth
+		}
+		return graph.Nodes[i].Name < graph.Nodes[j].Name
+	})
+	for _, edge := range edges {
+		_, sourceIn := distance[edge.Source]
+		_, targetIn := distance[edge.Target]
+		if sourceIn && targetIn {
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	return graph
+}
+
+func writeGraphDOT(w io.Writer, graph knowledgeGraph) {
+	fmt.Fprintln(w, "digraph xbe {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintln(w, `  node [shape=box, fontname="Helvetica"];`)
+	fmt.Fprintln(w, `  edge [fontname="Helvetica", fontsize=10];`)
+	for _, node := range graph.Nodes {
+		attrs := []string{}
+		if node.Summary {
+			attrs = append(attrs, "shape=note")
+		}
+		if node.Seed {
+			attrs = append(attrs, "style=filled", `fillcolor="#dddddd"`)
+		}
+		if len(attrs) == 0 {
+			fmt.Fprintf(w, "  %s;\n", dotQuote(node.Name))
+			continue
+		}
+		fmt.Fprintf(w, "  %s [%s];\n", dotQuote(node.Name), strings.Join(attrs, ", "))
+	}
+	for _, edge := range graph.Edges {
+		label := edge.Relation
+		if edge.Condition != "" {
+			label += " (" + edge.Condition + ")"
+		}
+		attrs := "label=" + dotQuote(label)
+		if edge.EdgeKind == "summary" {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(w, "  %s -> %s [%s];\n", dotQuote(edge.Source), dotQuote(edge.Target), attrs)
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func dotQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// writeGraphMermaid writes an erDiagram. A relationship is many-to-one from
+// the resource holding it to its target; summary links are drawn as
+// non-identifying (dashed) relationships.
+func writeGraphMermaid(w io.Writer, graph knowledgeGraph) {
+	fmt.Fprintln(w, "erDiagram")
+	connected := map[string]bool{}
+	for _, edge := range graph.Edges {
+		connected[edge.Source] = true
+		connected[edge.Target] = true
+		line := "}o--||"
+		if edge.EdgeKind == "summary" {
+			line = "}o..||"
+		}
+		fmt.Fprintf(w, "  %s %s %s : %s\n", mermaidEntity(edge.Source), line, mermaidEntity(edge.Target), mermaidQuote(edge.Relation))
+	}
+	for _, node := range graph.Nodes {
+		if !connected[node.Name] {
+			fmt.Fprintf(w, "  %s\n", mermaidEntity(node.Name))
+		}
+	}
+}
+
+func mermaidEntity(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func mermaidQuote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "'") + `"`
+}
+
+func writeGraphML(w io.Writer, graph knowledgeGraph) {
+	fmt.Fprintln(w, `<?xml version="1.0" encoding="UTF-8"?>`)
+	fmt.Fprintln(w, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	fmt.Fprintln(w, `  <key id="summary" for="node" attr.name="summary" attr.type="boolean"/>`)
+	fmt.Fprintln(w, `  <key id="seed" for="node" attr.name="seed" attr.type="boolean"/>`)
+	fmt.Fprintln(w, `  <key id="depth" for="node" attr.name="depth" attr.type="int"/>`)
+	fmt.Fprintln(w, `  <key id="relation" for="edge" attr.name="relation" attr.type="string"/>`)
+	fmt.Fprintln(w, `  <key id="kind" for="edge" attr.name="kind" attr.type="string"/>`)
+	fmt.Fprintln(w, `  <key id="condition" for="edge" attr.name="condition" attr.type="string"/>`)
+	fmt.Fprintln(w, `  <graph id="xbe" edgedefault="directed">`)
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(w, "    <node id=\"%s\">\n", xmlEscape(node.Name))
+		fmt.Fprintf(w, "      <data key=\"summary\">%t</data>\n", node.Summary)
+		fmt.Fprintf(w, "      <data key=\"seed\">%t</data>\n", node.Seed)
+		fmt.Fprintf(w, "      <data key=\"depth\">%d</data>\n", node.Depth)
+		fmt.Fprintln(w, "    </node>")
+	}
+	for i, edge := range graph.Edges {
+		fmt.Fprintf(w, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\">\n", i, xmlEscape(edge.Source), xmlEscape(edge.Target))
+		fmt.Fprintf(w, "      <data key=\"relation\">%s</data>\n", xmlEscape(edge.Relation))
+		fmt.Fprintf(w, "      <data key=\"kind\">%s</data>\n", xmlEscape(edge.EdgeKind))
+		if edge.Condition != "" {
+			fmt.Fprintf(w, "      <data key=\"condition\">%s</data>\n", xmlEscape(edge.Condition))
+		}
+		fmt.Fprintln(w, "    </edge>")
+	}
+	fmt.Fprintln(w, "  </graph>")
+	fmt.Fprintln(w, "</graphml>")
+}
+
+func xmlEscape(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
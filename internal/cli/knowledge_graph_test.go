@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBuildKnowledgeGraph(t *testing.T) {
+	edges := []knowledgeRelationRow{
+		{Source: "time-cards", Relation: "customer", Target: "customers", EdgeKind: "relationship"},
+		{Source: "time-card-invoices", Relation: "time-card", Target: "time-cards", EdgeKind: "relationship"},
+		{Source: "time-card-invoices", Relation: "invoice", Target: "invoices", EdgeKind: "relationship"},
+		{Source: "time-card-summaries", Relation: "summarizes", Target: "time-cards", EdgeKind: "summary"},
+	}
+
+	graph := buildKnowledgeGraph(edges, []string{"time-cards"}, 1)
+	names := []string{}
+	for _, node := range graph.Nodes {
+		names = append(names, node.Name)
+	}
+	if got := strings.Join(names, ","); got != "time-cards,customers,time-card-invoices,time-card-summaries" {
+		t.Fatalf("nodes = %s", got)
+	}
+	if len(graph.Edges) != 3 {
+		t.Fatalf("edges = %+v", graph.Edges)
+	}
+	if !graph.Nodes[0].Seed || !graph.Nodes[3].Summary {
+		t.Fatalf("nodes = %+v", graph.Nodes)
+	}
+
+	if graph := buildKnowledgeGraph(edges, []string{"time-cards"}, 2); len(graph.Nodes) != 5 || len(graph.Edges) != 4 {
+		t.Fatalf("depth 2 graph = %+v", graph)
+	}
+	if graph := buildKnowledgeGraph(edges, nil, 0); len(graph.Nodes) != 5 {
+		t.Fatalf("whole graph = %+v", graph)
+	}
+}
+
+func TestWriteGraphFormats(t *testing.T) {
+	graph := knowledgeGraph{
+		Nodes: []knowledgeGraphNode{{Name: "time-card-summaries", Summary: true, Seed: true}, {Name: "time-cards", Depth: 1}},
+		Edges: []knowledgeRelationRow{{Source: "time-card-summaries", Relation: "summarizes", Target: "time-cards", EdgeKind: "summary", Condition: `kind = "a<b"`}},
+	}
+
+	var dot bytes.Buffer
+	writeGraphDOT(&dot, graph)
+	if !strings.Contains(dot.String(), `"time-card-summaries" -> "time-cards" [label="summarizes (kind = \"a<b\")", style=dashed];`) {
+		t.Fatalf("dot = %s", dot.String())
+	}
+
+	var mermaid bytes.Buffer
+	writeGraphMermaid(&mermaid, graph)
+	if got := mermaid.String(); got != "erDiagram\n  time-card-summaries }o..|| time-cards : \"summarizes\"\n" {
+		t.Fatalf("mermaid = %q", got)
+	}
+
+	var graphml bytes.Buffer
+	writeGraphML(&graphml, graph)
+	if !strings.Contains(graphml.String(), `<data key="condition">kind = &#34;a&lt;b&#34;</data>`) {
+		t.Fatalf("graphml = %s", graphml.String())
+	}
+}
@@ -0,0 +1,503 @@
+package cli
+
+import (
+	"container/heap"
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type knowledgePathStep struct {
+	From       string `json:"from"`
+	Relation   string `json:"relation"`
+	To         string `json:"to"`
+	Direction  string `json:"direction"`
+	Command    string `json:"command"`
+	Filter     string `json:"filter,omitempty"`
+	Fields     string `json:"fields,omitempty"`
+	ClientSide bool   `json:"client_side,omitempty"`
+	Yields     string `json:"yields"`
+}
+
+type knowledgePathRow struct {
+	From  string              `json:"from"`
+	To    string              `json:"to"`
+	Hops  int                 `json:"hops"`
+	Cost  float64             `json:"cost"`
+	Steps []knowledgePathStep `json:"steps"`
+	Final string              `json:"final,omitempty"`
+}
+
+func newKnowledgePathCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "path <from> <to>",
+		Short: "Plan a multi-hop join between two resources",
+		Long: `Find the cheapest chain of relationships from one resource to another and
+show the view commands that walk it against the API.
+
+Each hop follows a relationship in one of two directions:
+  forward   from.relation points at to: read <relation>-id from the record
+            with 'view <from> show --fields <relation>'.
+  reverse   to.relation points at from: list the related records with
+            'view <to> list --<relation> <id>'. When the list command has no
+            such filter, the step lists with --fields and filters client-side
+            with --jq, which is marked in the output and costs more.
+
+Joining siblings through a shared parent (forward into customers, then
+reverse out to every record of that customer) costs more the more resources
+reference the parent, so plans prefer specific join resources over hubs like
+users and brokers. Use --avoid to rule out resources entirely and --paths to
+see alternatives.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runKnowledgePath,
+		Example: `  # How do I get from a time card to its invoice?
+  xbe knowledge path time-cards invoices
+
+  # Show three alternative routes
+  xbe knowledge path time-cards invoices --paths 3
+
+  # Never route through users or brokers
+  xbe knowledge path job-sites truckers --avoid users,brokers`,
+	}
+	cmd.Flags().Int("paths", 1, "Number of alternative paths to show, cheapest first")
+	cmd.Flags().Int("max-hops", 6, "Maximum relationships in a path")
+	cmd.Flags().String("avoid", "", "Comma-separated resources the path must not pass through")
+	return cmd
+}
+
+func runKnowledgePath(cmd *cobra.Command, args []string) error {
+	pathCount := getIntFlag(cmd, "paths")
+	if pathCount <= 0 {
+		return fmt.Errorf("--paths must be at least 1")
+	}
+	maxHops := getIntFlag(cmd, "max-hops")
+	if maxHops <= 0 {
+		return fmt.Errorf("--max-hops must be at least 1")
+	}
+
+	db, dbPath, err := openKnowledgeDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	from, err := normalizeKnowledgeResourceArg(cmd, db, dbPath, args[0], "from")
+	if err != nil {
+		return err
+	}
+	to, err := normalizeKnowledgeResourceArg(cmd, db, dbPath, args[1], "to")
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return fmt.Errorf("from and to are the same resource (%s)", from)
+	}
+	avoid := map[string]bool{}
+	for _, raw := range parseCSVFilter(getStringFlag(cmd, "avoid")) {
+		resource, err := normalizeKnowledgeResourceArg(cmd, db, dbPath, raw, "--avoid")
+		if err != nil {
+			return err
+		}
+		if resource == from || resource == to {
+			return fmt.Errorf("--avoid cannot include %s", resource)
+		}
+		avoid[resource] = true
+	}
+
+	ctx := context.Background()
+	graph, err := loadJoinGraph(ctx, db)
+	if err != nil {
+		return checkDBError(err, dbPath)
+	}
+	graph.avoid = avoid
+
+	found := graph.kShortestPaths(from, to, pathCount, maxHops)
+	if len(found) == 0 {
+		return fmt.Errorf("no path from %s to %s within %d hops", from, to, maxHops)
+	}
+	results := make([]knowledgePathRow, 0, len(found))
+	for _, path := range found {
+		results = append(results, graph.describePath(from, to, path))
+	}
+
+	if getBoolFlag(cmd, "json") {
+		return renderKnowledgeJSON(cmd, results)
+	}
+
+	out := cmd.OutOrStdout()
+	for i, row := range results {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		chain := []string{row.From}
+		for _, step := range row.Steps {
+			chain = append(chain, step.To)
+		}
+		hops := "hops"
+		if row.Hops == 1 {
+			hops = "hop"
+		}
+		fmt.Fprintf(out, "Path %d: %s (%d %s, cost %.1f)\n", i+1, strings.Join(chain, " -> "), row.Hops, hops, row.Cost)
+		for j, step := range row.Steps {
+			edge := fmt.Sprintf("%s.%s -> %s", step.From, step.Relation, step.To)
+			if step.Direction == "reverse" {
+				edge = fmt.Sprintf("%s <- %s.%s", step.From, step.To, step.Relation)
+			}
+			fmt.Fprintf(out, "  %d. %s\n", j+1, edge)
+			fmt.Fprintf(out, "     %s\n", step.Command)
+			switch {
+			case step.ClientSide && step.Direction == "reverse":
+				fmt.Fprintf(out, "     (no --%s filter on 'view %s list'; filtered client-side)\n", step.Relation, step.To)
+			case step.ClientSide:
+				fmt.Fprintf(out, "     (no 'view %s show'; filtered client-side)\n", step.From)
+			}
+		}
+		if row.Final != "" {
+			fmt.Fprintf(out, "  Then: %s\n", row.Final)
+		}
+	}
+	return nil
+}
+
+// joinEdge is one traversable direction of a relationship. For a reverse
+// edge, to.relation points at from, and filter names the flag on
+// 'view <to> list' that selects by it (empty when there is none).
+type joinEdge struct {
+	id         int
+	from       string
+	to         string
+	relation   string
+	reverse    bool
+	filter     string
+	cost       float64
+	hubPenalty float64
+}
+
+type joinGraph struct {
+	edges    []joinEdge
+	out      map[string][]int
+	showable map[string]bool
+	avoid    map[string]bool
+}
+
+// loadJoinGraph builds both directions of every relationship edge. Reverse
+// edges need the target's list command and cost more without a filter flag
+// for the relationship.
+func loadJoinGraph(ctx context.Context, db *sql.DB) (*joinGraph, error) {
+	type relationship struct{ source, relation, target string }
+	rows, err := queryContext(ctx, db, `
+SELECT DISTINCT source_resource, relationship, target_resource
+FROM resource_graph_edges
+WHERE edge_kind = 'relationship'
+ORDER BY source_resource, relationship, target_resource`)
+	if err != nil {
+		return nil, err
+	}
+	var relationships []relationship
+	for rows.Next() {
+		var rel relationship
+		if err := rows.Scan(&rel.source, &rel.relation, &rel.target); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		relationships = append(relationships, rel)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Commands and their filters come from the command tree rather than the
+	// database, which does not record show commands that fall back to list.
+	listFilters := map[string]map[string]string{}
+	showable := map[string]bool{}
+	if viewCmd, _, err := rootCmd.Find([]string{"view"}); err == nil && viewCmd != rootCmd {
+		for _, resourceCmd := range viewCmd.Commands() {
+			resource := resourceCmd.Name()
+			for _, verbCmd := range resourceCmd.Commands() {
+				switch verbCmd.Name() {
+				case "show":
+					showable[resource] = true
+				case "list":
+					filters := map[string]string{}
+					verbCmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+						filters[flag.Name] = flag.Name
+						if base, ok := strings.CutSuffix(flag.Name, "-id"); ok {
+							if _, exists := filters[base]; !exists {
+								filters[base] = flag.Name
+							}
+						}
+					})
+					listFilters[resource] = filters
+				}
+			}
+		}
+	}
+
+	inDegree := map[string]int{}
+	for _, rel := range relationships {
+		inDegree[rel.target]++
+	}
+
+	graph := &joinGraph{out: map[string][]int{}, showable: showable}
+	add := func(edge joinEdge) {
+		edge.id = len(graph.edges)
+		graph.edges = append(graph.edges, edge)
+		graph.out[edge.from] = append(graph.out[edge.from], edge.id)
+	}
+	for _, rel := range relationships {
+		if rel.source == rel.target {
+			continue
+		}
+		add(joinEdge{from: rel.source, to: rel.target, relation: rel.relation, cost: 1.5})
+		filters, listable := listFilters[rel.source]
+		if !listable {
+			continue
+		}
+		edge := joinEdge{
+			from:       rel.target,
+			to:         rel.source,
+			relation:   rel.relation,
+			reverse:    true,
+			filter:     filters[rel.relation],
+			cost:       1,
+			hubPenalty: 0.75 * math.Log2(1+float64(inDegree[rel.target])),
+		}
+		if edge.filter == "" {
+			edge.cost++
+		}
+		add(edge)
+	}
+	return graph, nil
+}
+
+// stepCost prices edge id taken after edge prev (-1 at the start). A
+// forward hop followed by a reverse hop joins siblings through a shared
+// parent, which is rarely what is wanted when the parent is a hub like
+// brokers, so it pays the parent's hub penalty. Turning straight back along
+// the same relationship is not allowed.
+func (g *joinGraph) stepCost(prev, id int) (float64, bool) {
+	edge := g.edges[id]
+	if prev < 0 {
+		return edge.cost, true
+	}
+	last := g.edges[prev]
+	if last.relation == edge.relation && last.reverse != edge.reverse {
+		return 0, false
+	}
+	if edge.reverse && !last.reverse {
+		return edge.cost + edge.hubPenalty, true
+	}
+	return edge.cost, true
+}
+
+func (g *joinGraph) pathCost(path []int) float64 {
+	cost := 0.0
+	prev := -1
+	for _, id := range path {
+		step, _ := g.stepCost(prev, id)
+		cost += step
+		prev = id
+	}
+	return cost
+}
+
+// shortestPath runs Dijkstra from start to goal over arrival edges, since
+// the cost of a hop depends on the hop before it. prev is the edge that
+// reached start (-1 for none). Removed edges and blocked nodes are skipped
+// and no node is visited twice. It returns edge ids, or nil when goal is
+// unreachable.
+func (g *joinGraph) shortestPath(start, goal string, prev int, removed map[int]bool, blocked map[string]bool, maxHops int) []int {
+	type state struct {
+		cost float64
+		hops int
+		via  int
+	}
+	onPath := func(best map[int]state, id int, node string) bool {
+		for ; id != prev; id = best[id].via {
+			if g.edges[id].to == node {
+				return true
+			}
+		}
+		return node == start
+	}
+	best := map[int]state{prev: {via: prev}}
+	done := map[int]bool{}
+	queue := &joinQueue{{edge: prev}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(joinQueueItem)
+		if done[item.edge] {
+			continue
+		}
+		done[item.edge] = true
+		node := start
+		if item.edge != prev {
+			node = g.edges[item.edge].to
+		}
+		if node == goal {
+			var path []int
+			for id := item.edge; id != prev; id = best[id].via {
+				path = append([]int{id}, path...)
+			}
+			return path
+		}
+		current := best[item.edge]
+		if current.hops >= maxHops {
+			continue
+		}
+		for _, id := range g.out[node] {
+			edge := g.edges[id]
+			if removed[id] || blocked[edge.to] || g.avoid[edge.to] || done[id] {
+				continue
+			}
+			step, ok := g.stepCost(item.edge, id)
+			if !ok || onPath(best, item.edge, edge.to) {
+				continue
+			}
+			cost := current.cost + step
+			if seen, ok := best[id]; ok && seen.cost <= cost {
+				continue
+			}
+			best[id] = state{cost: cost, hops: current.hops + 1, via: item.edge}
+			heap.Push(queue, joinQueueItem{edge: id, cost: cost})
+		}
+	}
+	return nil
+}
+
+// kShortestPaths returns up to k loopless paths in order of cost (Yen's
+// algorithm).
+func (g *joinGraph) kShortestPaths(start, goal string, k, maxHops int) [][]int {
+	first := g.shortestPath(start, goal, -1, nil, nil, maxHops)
+	if first == nil {
+		return nil
+	}
+	paths := [][]int{first}
+	var candidates [][]int
+	seen := map[string]bool{joinPathKey(first): true}
+	for len(paths) < k {
+		last := paths[len(paths)-1]
+		for i := range last {
+			spurNode := g.edges[last[i]].from
+			root := last[:i]
+			removed := map[int]bool{}
+			for _, path := range paths {
+				if len(path) > i && joinPathKey(path[:i]) == joinPathKey(root) {
+					removed[path[i]] = true
+				}
+			}
+			blocked := map[string]bool{}
+			for _, id := range root {
+				blocked[g.edges[id].from] = true
+			}
+			prev := -1
+			if i > 0 {
+				prev = root[i-1]
+			}
+			spur := g.shortestPath(spurNode, goal, prev, removed, blocked, maxHops-i)
+			if spur == nil {
+				continue
+			}
+			candidate := append(append([]int{}, root...), spur...)
+			if key := joinPathKey(candidate); !seen[key] {
+				seen[key] = true
+				candidates = append(candidates, candidate)
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return g.pathCost(candidates[a]) < g.pathCost(candidates[b])
+		})
+		paths = append(paths, candidates[0])
+		candidates = candidates[1:]
+	}
+	return paths
+}
+
+func joinPathKey(path []int) string {
+	parts := make([]string, len(path))
+	for i, id := range path {
+		parts[i] = fmt.Sprint(id)
+	}
+	return strings.Join(parts, ",")
+}
+
+// describePath turns edge ids into runnable steps. A forward hop out of
+// records that the previous step already listed is folded into that list's
+// --fields, so the related ids come back with it.
+func (g *joinGraph) describePath(from, to string, path []int) knowledgePathRow {
+	row := knowledgePathRow{From: from, To: to, Hops: len(path), Cost: math.Round(g.pathCost(path)*100) / 100}
+	fields := make([][]string, len(path))
+	for i, id := range path {
+		edge := g.edges[id]
+		step := knowledgePathStep{From: edge.from, Relation: edge.relation, To: edge.to}
+		fields[i] = []string{edge.relation}
+		if edge.reverse {
+			step.Direction = "reverse"
+			step.Yields = edge.to
+			if edge.filter != "" {
+				step.Filter = "--" + edge.filter
+			} else {
+				step.ClientSide = true
+			}
+		} else {
+			step.Direction = "forward"
+			step.Yields = edge.relation + "-id"
+			if i > 0 && row.Steps[i-1].Direction == "reverse" {
+				fields[i-1] = append(fields[i-1], edge.relation)
+				fields[i] = nil
+			} else if !g.showable[edge.from] {
+				step.ClientSide = true
+			}
+		}
+		row.Steps = append(row.Steps, step)
+	}
+
+	for i := range row.Steps {
+		step := &row.Steps[i]
+		step.Fields = strings.Join(fields[i], ",")
+		idArg := "<" + singularizeWord(step.From) + "-id>"
+		switch {
+		case step.Direction == "forward" && fields[i] == nil:
+			step.Command = fmt.Sprintf("read .[].%q from the step %d output", step.Yields, i)
+		case step.Direction == "forward" && !step.ClientSide:
+			step.Command = fmt.Sprintf("xbe view %s show %s --fields %s --jq '.%q'", step.From, idArg, step.Fields, step.Yields)
+		case step.Direction == "forward":
+			step.Command = fmt.Sprintf("xbe view %s list --all --fields %s --jq 'map(select(.id == %q)) | .[].%q'", step.From, step.Fields, idArg, step.Yields)
+		case step.Filter != "":
+			step.Command = fmt.Sprintf("xbe view %s list %s %s --all --fields %s --json", step.To, step.Filter, idArg, step.Fields)
+		default:
+			step.Command = fmt.Sprintf("xbe view %s list --all --fields %s --jq 'map(select(.%q == %q))'", step.To, step.Fields, step.Relation+"-id", idArg)
+		}
+	}
+	if last := g.edges[path[len(path)-1]]; !last.reverse && g.showable[to] {
+		row.Final = fmt.Sprintf("xbe view %s show <%s-id> --json", to, last.relation)
+	}
+	return row
+}
+
+type joinQueueItem struct {
+	edge int
+	cost float64
+}
+
+type joinQueue []joinQueueItem
+
+func (q joinQueue) Len() int           { return len(q) }
+func (q joinQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q joinQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *joinQueue) Push(x any)        { *q = append(*q, x.(joinQueueItem)) }
+func (q *joinQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testJoinGraph(edges ...joinEdge) *joinGraph {
+	graph := &joinGraph{out: map[string][]int{}, showable: map[string]bool{}}
+	for _, edge := range edges {
+		edge.id = len(graph.edges)
+		graph.edges = append(graph.edges, edge)
+		graph.out[edge.from] = append(graph.out[edge.from], edge.id)
+	}
+	return graph
+}
+
+func TestJoinGraphPrefersJoinResourceOverHub(t *testing.T) {
+	graph := testJoinGraph(
+		// time-cards.customer -> customers and back.
+		joinEdge{from: "time-cards", to: "customers", relation: "customer", cost: 1.5},
+		joinEdge{from: "customers", to: "time-cards", relation: "customer", reverse: true, cost: 1, hubPenalty: 5},
+		// invoices.buyer -> customers, reversed.
+		joinEdge{from: "customers", to: "invoices", relation: "buyer", reverse: true, filter: "buyer", cost: 1, hubPenalty: 5},
+		// time-card-invoices.time-card -> time-cards, reversed; .invoice -> invoices.
+		joinEdge{from: "time-cards", to: "time-card-invoices", relation: "time-card", reverse: true, cost: 2, hubPenalty: 1},
+		joinEdge{from: "time-card-invoices", to: "invoices", relation: "invoice", cost: 1.5},
+	)
+
+	paths := graph.kShortestPaths("time-cards", "invoices", 3, 6)
+	want := [][]int{{3, 4}, {0, 2}}
+	if !reflect.DeepEqual(paths, want) {
+		t.Fatalf("kShortestPaths = %v, want %v", paths, want)
+	}
+	if got := graph.pathCost(paths[1]); got != 7.5 {
+		t.Fatalf("sibling join cost = %v, want 7.5", got)
+	}
+
+	graph.avoid = map[string]bool{"time-card-invoices": true}
+	if paths := graph.kShortestPaths("time-cards", "invoices", 1, 6); !reflect.DeepEqual(paths, [][]int{{0, 2}}) {
+		t.Fatalf("with avoid = %v", paths)
+	}
+	if paths := graph.kShortestPaths("time-cards", "invoices", 1, 1); paths != nil {
+		t.Fatalf("max hops 1 = %v, want none", paths)
+	}
+}
+
+func TestJoinGraphNoUTurn(t *testing.T) {
+	// file-attachments.attached-to is polymorphic: projects or time-cards.
+	graph := testJoinGraph(
+		joinEdge{from: "projects", to: "file-attachments", relation: "attached-to", reverse: true, filter: "attached-to", cost: 1},
+		joinEdge{from: "file-attachments", to: "time-cards", relation: "attached-to", cost: 1.5},
+	)
+	if paths := graph.kShortestPaths("projects", "time-cards", 1, 6); paths != nil {
+		t.Fatalf("kShortestPaths = %v, want none", paths)
+	}
+}
+
+func TestDescribePathFoldsForwardHop(t *testing.T) {
+	graph := testJoinGraph(
+		joinEdge{from: "time-cards", to: "time-card-invoices", relation: "time-card", reverse: true, cost: 2},
+		joinEdge{from: "time-card-invoices", to: "invoices", relation: "invoice", cost: 1.5},
+	)
+	graph.showable["invoices"] = true
+	row := graph.describePath("time-cards", "invoices", []int{0, 1})
+	if row.Steps[0].Fields != "time-card,invoice" || !row.Steps[0].ClientSide {
+		t.Fatalf("first step = %+v", row.Steps[0])
+	}
+	if row.Steps[1].Yields != "invoice-id" || row.Final != "xbe view invoices show <invoice-id> --json" {
+		t.Fatalf("row = %+v", row)
+	}
+}
@@ -2,25 +2,56 @@ package cli
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/spf13/cobra"
 )
 
 type knowledgeSearchResult struct {
-	Kind   string `json:"kind"`
-	Name   string `json:"name"`
-	Detail string `json:"detail,omitempty"`
+	Kind    string  `json:"kind"`
+	Name    string  `json:"name"`
+	Detail  string  `json:"detail,omitempty"`
+	Snippet string  `json:"snippet,omitempty"`
+	Score   float64 `json:"score"`
 }
 
+// knowledgeSearchKinds maps --kind values to the kinds stored in search_index.
+var knowledgeSearchKinds = map[string]string{
+	"resources":     "resource",
+	"commands":      "command",
+	"fields":        "field",
+	"flags":         "flag",
+	"relationships": "relationship",
+	"summaries":     "summary",
+	"dimensions":    "summary_dimension",
+	"metrics":       "summary_metric",
+}
+
+// Snippets are highlighted with control characters so brackets already in
+// the text are not mistaken for matches, then rewritten for display.
+const (
+	searchHighlightStart = "\x02"
+	searchHighlightEnd   = "\x03"
+)
+
 func newKnowledgeSearchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "search <query>",
 		Short: "Search across resources, commands, fields, and summaries",
 		Long: `Search the knowledge graph by free text.
 
+Results from every kind are ranked together by relevance (BM25), with name
+matches weighted above descriptions. Each word matches as a prefix, so
+"job prod" finds job-production-plans. Matched words are shown in [brackets].
+
+When nothing matches, the search retries with typo tolerance: run-together
+names ("jobprod") are matched by trigram, and misspelled words ("tikcet") are
+corrected to the closest known term.
+
 Use this when you do not know the exact resource or command name yet.
 Then pivot to:
   - xbe knowledge resource <name>
@@ -34,7 +65,10 @@ Then pivot to:
   xbe knowledge search job --kind resources,commands
 
   # Search only relationship edges
-  xbe knowledge search trucker --kind relationships`,
+  xbe knowledge search trucker --kind relationships
+
+  # Misspellings still find results
+  xbe knowledge search tikcet`,
 	}
 	cmd.Flags().String("kind", "", "Comma-separated kinds to search (resources,commands,fields,flags,relationships,summaries,dimensions,metrics)")
 	return cmd
@@ -45,7 +79,7 @@ func runKnowledgeSearch(cmd *cobra.Command, args []string) error {
 	if query == "" {
 		return fmt.Errorf("query is required")
 	}
-	kinds, err := validateCSVEnum(
+	kindValues, err := validateCSVEnum(
 		"--kind",
 		getStringFlag(cmd, "kind"),
 		allowedValues("resources", "commands", "fields", "flags", "relationships", "summaries", "dimensions", "metrics"),
@@ -53,10 +87,13 @@ func runKnowledgeSearch(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	includeAll := len(kinds) == 0
-	kindSet := map[string]bool{}
-	for _, kind := range kinds {
-		kindSet[kind] = true
+	kinds := make([]string, 0, len(kindValues))
+	for _, value := range kindValues {
+		kinds = append(kinds, knowledgeSearchKinds[value])
+	}
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return fmt.Errorf("query must contain letters or digits")
 	}
 
 	db, dbPath, err := openKnowledgeDB(cmd)
@@ -65,211 +102,401 @@ func runKnowledgeSearch(cmd *cobra.Command, args []string) error {
 	}
 	defer db.Close()
 
-	pattern := "%" + query + "%"
 	ctx := context.Background()
-	results := make([]knowledgeSearchResult, 0, 128)
+	if err := requireSearchIndex(ctx, db); err != nil {
+		return err
+	}
 
-	shouldSearch := func(kind string) bool {
-		if includeAll {
-			return true
+	plan, err := planKnowledgeSearch(ctx, db, terms, kinds)
+	if err != nil {
+		return checkDBError(err, dbPath)
+	}
+	if plan.match == "" {
+		if getBoolFlag(cmd, "json") {
+			return renderKnowledgeJSON(cmd, []knowledgeSearchResult{})
 		}
-		return kindSet[kind]
+		fmt.Fprintln(cmd.ErrOrStderr(), "No matches found.")
+		return nil
 	}
 
-	if shouldSearch("resources") {
-		rows, err := queryContext(ctx, db, "SELECT name FROM resources WHERE name LIKE ? ORDER BY name", pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
-		}
-		defer rows.Close()
-		for rows.Next() {
-			var name string
-			if err := rows.Scan(&name); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "resource", Name: name})
-		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
-		}
+	limit := getIntFlag(cmd, "limit")
+	offset := getIntFlag(cmd, "offset")
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = -1
+	}
+	results, err := querySearchIndex(ctx, db, plan, kinds, limit, offset)
+	if err != nil {
+		return checkDBError(err, dbPath)
 	}
 
-	if shouldSearch("commands") {
-		rows, err := queryContext(ctx, db, "SELECT full_path, description FROM commands WHERE full_path LIKE ? OR description LIKE ? ORDER BY full_path", pattern, pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
-		}
-		defer rows.Close()
-		for rows.Next() {
-			var name, desc string
-			if err := rows.Scan(&name, &desc); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "command", Name: name, Detail: desc})
-		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
-		}
+	if getBoolFlag(cmd, "json") {
+		return renderKnowledgeJSON(cmd, results)
 	}
 
-	if shouldSearch("fields") {
-		rows, err := queryContext(ctx, db, "SELECT resource, name, kind FROM resource_fields WHERE name LIKE ? OR resource LIKE ? ORDER BY resource, name", pattern, pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
-		}
-		defer rows.Close()
-		for rows.Next() {
-			var resource, name, kind string
-			if err := rows.Scan(&resource, &name, &kind); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "field", Name: resource + "." + name, Detail: kind})
-		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
-		}
+	if plan.rewritten != "" {
+		fmt.Fprintf(cmd.ErrOrStderr(), "No exact matches for %q; showing results for %q.\n", query, plan.rewritten)
+	}
+	if len(results) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No matches found.")
+		return nil
 	}
+	w := newTabWriter(cmd)
+	fmt.Fprintln(w, "KIND\tNAME\tDETAIL\tMATCH")
+	for _, row := range results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", row.Kind, row.Name, row.Detail, row.Snippet)
+	}
+	return w.Flush()
+}
 
-	if shouldSearch("flags") {
-		rows, err := queryContext(ctx, db, `
-SELECT f.name, c.full_path, f.description
-FROM flags f
-JOIN commands c ON c.id = f.command_id
-WHERE f.name LIKE ? OR f.description LIKE ?
-ORDER BY f.name, c.full_path`, pattern, pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
-		}
-		defer rows.Close()
-		for rows.Next() {
-			var name, cmdPath, desc string
-			if err := rows.Scan(&name, &cmdPath, &desc); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "flag", Name: name, Detail: cmdPath})
-			if desc != "" {
-				results = append(results, knowledgeSearchResult{Kind: "flag_desc", Name: name, Detail: desc})
-			}
-		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
-		}
+func requireSearchIndex(ctx context.Context, db *sql.DB) error {
+	var count int
+	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE name IN ('search_index', 'search_vocab', 'search_trigrams')").Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count < 3 {
+		return fmt.Errorf("knowledge database has no search index; rebuild it with build_tools/compile.py")
 	}
+	return nil
+}
 
-	if shouldSearch("relationships") {
-		rows, err := queryContext(ctx, db, `
-SELECT source_resource, relationship, target_resource, edge_kind
-FROM resource_graph_edges
-WHERE source_resource LIKE ? OR target_resource LIKE ? OR relationship LIKE ?
-ORDER BY source_resource, relationship`, pattern, pattern, pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
-		}
-		defer rows.Close()
-		for rows.Next() {
-			var source, rel, target, kind string
-			if err := rows.Scan(&source, &rel, &target, &kind); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "relationship", Name: source + "." + rel, Detail: target + " (" + kind + ")"})
-		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
-		}
+// knowledgeSearchPlan is the FTS5 expression to run and the terms it was
+// built from. Rewritten is set when a typo fallback replaced the query.
+type knowledgeSearchPlan struct {
+	match     string
+	terms     []string
+	rewritten string
+}
+
+// planKnowledgeSearch picks the FTS5 expression to run, trying the query as
+// typed, then a trigram match on names, then spelling corrections. It
+// returns an empty plan when nothing matches.
+func planKnowledgeSearch(ctx context.Context, db *sql.DB, terms []string, kinds []string) (knowledgeSearchPlan, error) {
+	match := searchMatchExpression(terms)
+	nameMatch, err := searchIndexHasMatches(ctx, db, "name : ("+match+")", kinds)
+	if err != nil || nameMatch {
+		return knowledgeSearchPlan{match: match, terms: terms}, err
 	}
 
-	if shouldSearch("summaries") {
-		rows, err := queryContext(ctx, db, "SELECT DISTINCT summary_resource FROM summary_resource_targets WHERE summary_resource LIKE ? ORDER BY summary_resource", pattern)
+	// Run-together or partial names: "jobprod" -> job-production-plan.
+	if name, err := trigramSearchName(ctx, db, strings.Join(terms, "")); err != nil {
+		return knowledgeSearchPlan{}, err
+	} else if name != "" {
+		nameTerms := searchTerms(name)
+		phrase := searchPhraseExpression(nameTerms)
+		found, err := searchIndexHasMatches(ctx, db, phrase, kinds)
 		if err != nil {
-			return checkDBError(err, dbPath)
+			return knowledgeSearchPlan{}, err
 		}
-		defer rows.Close()
-		for rows.Next() {
-			var name string
-			if err := rows.Scan(&name); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "summary", Name: name})
+		if found {
+			return knowledgeSearchPlan{match: phrase, terms: nameTerms, rewritten: name}, nil
 		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
+	}
+
+	// Matches only in details and descriptions.
+	found, err := searchIndexHasMatches(ctx, db, match, kinds)
+	if err != nil || found {
+		return knowledgeSearchPlan{match: match, terms: terms}, err
+	}
+
+	// Misspelled words: "tikcet" -> ticket.
+	vocabulary, err := loadSearchVocabulary(ctx, db)
+	if err != nil {
+		return knowledgeSearchPlan{}, err
+	}
+	corrected := make([]string, len(terms))
+	changed := false
+	for i, term := range terms {
+		corrected[i] = correctSearchTerm(term, vocabulary)
+		changed = changed || corrected[i] != term
+	}
+	if !changed {
+		return knowledgeSearchPlan{}, nil
+	}
+	match = searchMatchExpression(corrected)
+	found, err = searchIndexHasMatches(ctx, db, match, kinds)
+	if err != nil || !found {
+		return knowledgeSearchPlan{}, err
+	}
+	return knowledgeSearchPlan{match: match, terms: corrected, rewritten: strings.Join(corrected, " ")}, nil
+}
+
+// searchTerms lowercases a query and splits it into words the way the
+// unicode61 tokenizer does, so "job_production-plans" becomes three terms.
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// searchMatchExpression requires every term, each as a prefix. Whole-word
+// matches are listed too so BM25 scores them above prefix-only matches.
+// Plural terms are searched as their singular so "tickets" finds
+// ticket-reports.
+func searchMatchExpression(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if singular := singularizeWord(term); singular != "" {
+			term = singular
 		}
+		parts = append(parts, `("`+term+`" OR "`+term+`"*)`)
 	}
+	return strings.Join(parts, " AND ")
+}
 
-	if shouldSearch("dimensions") {
-		rows, err := queryContext(ctx, db, "SELECT summary_resource, name, kind FROM summary_dimensions WHERE summary_resource LIKE ? OR name LIKE ? ORDER BY summary_resource, name", pattern, pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
+// searchExactNames lists the names that exactly spell the query, in singular
+// and plural, so "cost code" puts the cost-codes resource first.
+func searchExactNames(terms []string) []string {
+	name := strings.Join(terms, "-")
+	names := []string{name, name + "s"}
+	if singular := singularizeWord(name); singular != name && singular != "" {
+		names = append(names, singular)
+	}
+	return names
+}
+
+// searchPhraseExpression matches the terms as a consecutive phrase within a
+// name, with the last term as a prefix.
+func searchPhraseExpression(terms []string) string {
+	return `name : "` + strings.Join(terms, " ") + `"*`
+}
+
+func searchKindFilter(kinds []string) (string, []any) {
+	if len(kinds) == 0 {
+		return "", nil
+	}
+	args := make([]any, 0, len(kinds))
+	for _, kind := range kinds {
+		args = append(args, kind)
+	}
+	return " AND kind IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(kinds)), ", ") + ")", args
+}
+
+func searchIndexHasMatches(ctx context.Context, db *sql.DB, match string, kinds []string) (bool, error) {
+	filter, filterArgs := searchKindFilter(kinds)
+	args := append([]any{match}, filterArgs...)
+	rows, err := queryContext(ctx, db, "SELECT 1 FROM search_index WHERE search_index MATCH ?"+filter+" LIMIT 1", args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	found := rows.Next()
+	return found, rows.Err()
+}
+
+func querySearchIndex(ctx context.Context, db *sql.DB, plan knowledgeSearchPlan, kinds []string, limit, offset int) ([]knowledgeSearchResult, error) {
+	filter, filterArgs := searchKindFilter(kinds)
+	exactNames := searchExactNames(plan.terms)
+	args := []any{searchHighlightStart, searchHighlightEnd, searchHighlightStart, searchHighlightEnd, plan.match}
+	args = append(args, filterArgs...)
+	for _, name := range exactNames {
+		args = append(args, name)
+	}
+	args = append(args, limit, offset)
+	// A resource named by the query comes first. After that, column weights
+	// favour names over details and descriptions, and resources and commands
+	// get a small boost so they lead ties with fields and flags.
+	rows, err := queryContext(ctx, db, `
+SELECT kind, name, detail,
+  snippet(search_index, 3, ?, ?, '…', 12),
+  snippet(search_index, 2, ?, ?, '…', 12),
+  bm25(search_index, 0.0, 10.0, 4.0, 1.0) * CASE kind
+    WHEN 'resource' THEN 1.5
+    WHEN 'command' THEN 1.2
+    ELSE 1.0
+  END AS score
+FROM search_index
+WHERE search_index MATCH ?`+filter+`
+ORDER BY kind = 'resource' AND name IN (`+strings.TrimSuffix(strings.Repeat("?, ", len(exactNames)), ", ")+`) DESC, score, length(name), name
+LIMIT ? OFFSET ?`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []knowledgeSearchResult{}
+	for rows.Next() {
+		var row knowledgeSearchResult
+		var bodySnippet, detailSnippet string
+		var score float64
+		if err := rows.Scan(&row.Kind, &row.Name, &row.Detail, &bodySnippet, &detailSnippet, &score); err != nil {
+			return nil, err
 		}
-		defer rows.Close()
-		for rows.Next() {
-			var summary, name, kind string
-			if err := rows.Scan(&summary, &name, &kind); err != nil {
-				return checkDBError(err, dbPath)
-			}
-			results = append(results, knowledgeSearchResult{Kind: "summary_dimension", Name: summary + "." + name, Detail: kind})
+		switch {
+		case strings.Contains(bodySnippet, searchHighlightStart):
+			row.Snippet = formatSearchSnippet(bodySnippet)
+		case strings.Contains(detailSnippet, searchHighlightStart):
+			row.Snippet = formatSearchSnippet(detailSnippet)
 		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
+		// bm25() is lower for better matches; report higher-is-better.
+		row.Score = -score
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+func formatSearchSnippet(snippet string) string {
+	snippet = strings.Join(strings.Fields(snippet), " ")
+	return strings.NewReplacer(searchHighlightStart, "[", searchHighlightEnd, "]").Replace(snippet)
+}
+
+// trigramSearchName returns the shortest known name part containing the
+// compacted query, e.g. "jobprod" -> "job-production-plan".
+func trigramSearchName(ctx context.Context, db *sql.DB, compact string) (string, error) {
+	if len(compact) < 3 {
+		return "", nil
+	}
+	rows, err := queryContext(ctx, db, `
+SELECT name
+FROM search_trigrams
+WHERE compact MATCH ?
+ORDER BY length(compact), name
+LIMIT 1`, `"`+compact+`"`)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	names, err := collectStrings(rows)
+	if err != nil || len(names) == 0 {
+		return "", err
+	}
+	return names[0], nil
+}
+
+type searchVocabularyTerm struct {
+	term string
+	docs int
+}
+
+func loadSearchVocabulary(ctx context.Context, db *sql.DB) ([]searchVocabularyTerm, error) {
+	rows, err := queryContext(ctx, db, "SELECT term, doc FROM search_vocab")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []searchVocabularyTerm
+	for rows.Next() {
+		var term searchVocabularyTerm
+		if err := rows.Scan(&term.term, &term.docs); err != nil {
+			return nil, err
 		}
+		out = append(out, term)
 	}
+	return out, rows.Err()
+}
 
-	if shouldSearch("metrics") {
-		rows, err := queryContext(ctx, db, "SELECT summary_resource, name FROM summary_metrics WHERE summary_resource LIKE ? OR name LIKE ? ORDER BY summary_resource, name", pattern, pattern)
-		if err != nil {
-			return checkDBError(err, dbPath)
+// correctSearchTerm returns the vocabulary term closest to term, or term
+// itself when it already prefixes a known term or nothing is close enough.
+// Candidates must be within a small edit distance (transpositions count as
+// one edit) of the term or of a same-length prefix, and are ranked by edit
+// distance, then trigram similarity, then how common they are.
+func correctSearchTerm(term string, vocabulary []searchVocabularyTerm) string {
+	if len(term) < 3 {
+		return term
+	}
+	for _, entry := range vocabulary {
+		if strings.HasPrefix(entry.term, term) {
+			return term
 		}
-		defer rows.Close()
-		for rows.Next() {
-			var summary, name string
-			if err := rows.Scan(&summary, &name); err != nil {
-				return checkDBError(err, dbPath)
+	}
+	maxEdits := 1
+	if len(term) > 5 {
+		maxEdits = 2
+	}
+	type candidate struct {
+		term       string
+		distance   int
+		similarity float64
+		docs       int
+	}
+	var candidates []candidate
+	for _, entry := range vocabulary {
+		distance := editDistance(term, entry.term)
+		if len(entry.term) > len(term) {
+			if prefixDistance := editDistance(term, entry.term[:len(term)]); prefixDistance < distance {
+				distance = prefixDistance
 			}
-			results = append(results, knowledgeSearchResult{Kind: "summary_metric", Name: summary + "." + name})
 		}
-		if err := rows.Err(); err != nil {
-			return checkDBError(err, dbPath)
+		if distance > maxEdits {
+			continue
 		}
+		candidates = append(candidates, candidate{
+			term:       entry.term,
+			distance:   distance,
+			similarity: trigramSimilarity(term, entry.term),
+			docs:       entry.docs,
+		})
 	}
-
-	if len(results) == 0 {
-		fmt.Fprintln(cmd.OutOrStdout(), "No matches found.")
-		return nil
+	if len(candidates) == 0 {
+		return term
 	}
-
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].Kind == results[j].Kind {
-			return results[i].Name < results[j].Name
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.distance != b.distance {
+			return a.distance < b.distance
 		}
-		return results[i].Kind < results[j].Kind
+		if a.similarity != b.similarity {
+			return a.similarity > b.similarity
+		}
+		if a.docs != b.docs {
+			return a.docs > b.docs
+		}
+		return a.term < b.term
 	})
+	return candidates[0].term
+}
 
-	limit := getIntFlag(cmd, "limit")
-	offset := getIntFlag(cmd, "offset")
-	if offset < 0 {
-		offset = 0
-	}
-	if limit <= 0 {
-		limit = len(results)
+// trigramSimilarity is the Jaccard similarity of the padded trigram sets of
+// a and b.
+func trigramSimilarity(a, b string) float64 {
+	setA, setB := trigrams(a), trigrams(b)
+	if len(setA) == 0 || len(setB) == 0 {
+		return 0
 	}
-	start := offset
-	if start > len(results) {
-		start = len(results)
-	}
-	end := start + limit
-	if end > len(results) {
-		end = len(results)
+	shared := 0
+	for gram := range setA {
+		if setB[gram] {
+			shared++
+		}
 	}
-	results = results[start:end]
+	return float64(shared) / float64(len(setA)+len(setB)-shared)
+}
 
-	if getBoolFlag(cmd, "json") {
-		return renderKnowledgeJSON(cmd, results)
+func trigrams(value string) map[string]bool {
+	runes := []rune("  " + value + " ")
+	out := make(map[string]bool, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		out[string(runes[i:i+3])] = true
 	}
+	return out
+}
 
-	w := newTabWriter(cmd)
-	fmt.Fprintln(w, "KIND\tNAME\tDETAIL")
-	for _, row := range results {
-		fmt.Fprintf(w, "%s\t%s\t%s\n", row.Kind, row.Name, row.Detail)
+// editDistance is the optimal string alignment distance: insertions,
+// deletions, substitutions, and adjacent transpositions each cost one.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
 	}
-	return w.Flush()
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(rb)]
 }
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	got := searchTerms("  Job_Production-plans.status ")
+	want := []string{"job", "production", "plans", "status"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("searchTerms = %v, want %v", got, want)
+	}
+}
+
+func TestSearchMatchExpression(t *testing.T) {
+	got := searchMatchExpression([]string{"tickets", "job"})
+	want := `("ticket" OR "ticket"*) AND ("job" OR "job"*)`
+	if got != want {
+		t.Fatalf("searchMatchExpression = %s, want %s", got, want)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"ticket", "ticket", 0},
+		{"tikcet", "ticket", 1},
+		{"prodution", "production", 1},
+		{"broker", "trucker", 3},
+		{"", "job", 3},
+	}
+	for _, tc := range cases {
+		if got := editDistance(tc.a, tc.b); got != tc.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCorrectSearchTerm(t *testing.T) {
+	vocabulary := []searchVocabularyTerm{
+		{term: "ticket", docs: 40},
+		{term: "tickets", docs: 5},
+		{term: "thicket", docs: 1},
+		{term: "production", docs: 90},
+		{term: "job", docs: 300},
+	}
+	cases := map[string]string{
+		"tikcet":   "ticket",
+		"prodcut":  "production",
+		"job":      "job",
+		"tick":     "tick",
+		"zzzzzzzz": "zzzzzzzz",
+	}
+	for term, want := range cases {
+		if got := correctSearchTerm(term, vocabulary); got != want {
+			t.Errorf("correctSearchTerm(%q) = %q, want %q", term, got, want)
+		}
+	}
+}
+
+func TestKnowledgeSearchWithoutMatches(t *testing.T) {
+	t.Setenv(knowledgeDBEnv, filepath.Join("knowledge_db", "knowledge.sqlite"))
+	search := func(args ...string) (string, string) {
+		t.Helper()
+		cmd := newKnowledgeSearchCmd()
+		cmd.Flags().String("db", "", "")
+		cmd.Flags().Bool("json", false, "")
+		cmd.Flags().Int("limit", 50, "")
+		cmd.Flags().Int("offset", 0, "")
+		var stdout, stderr bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatal(err)
+		}
+		if err := runKnowledgeSearch(cmd, cmd.Flags().Args()); err != nil {
+			t.Fatal(err)
+		}
+		return stdout.String(), stderr.String()
+	}
+
+	if stdout, stderr := search("zzzqqqxx", "--json"); stdout != "[]\n" || stderr != "" {
+		t.Fatalf("--json stdout %q, stderr %q", stdout, stderr)
+	}
+	if stdout, stderr := search("zzzqqqxx"); stdout != "" || stderr != "No matches found.\n" {
+		t.Fatalf("table stdout %q, stderr %q", stdout, stderr)
+	}
+}
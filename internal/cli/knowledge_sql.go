@@ -0,0 +1,616 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// sqlResult is one query's columns and rows, values converted to JSON types.
+type sqlResult struct {
+	columns   []string
+	rows      [][]any
+	truncated bool
+}
+
+func newKnowledgeSQLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sql [query]",
+		Short: "Run read-only SQL against the knowledge database",
+		Long: `Run SQL against the knowledge database on a read-only connection.
+
+With a query argument, runs that one statement and prints the rows through the
+standard output options (--json, --output yaml|csv|..., --jq). Without one, it
+opens an interactive console when stdin is a terminal, or runs the
+semicolon-separated statements read from stdin.
+
+Only SELECT, WITH, VALUES, EXPLAIN, and schema PRAGMAs (table_info,
+index_list, ...) are allowed; each statement is cancelled after --timeout.
+--limit caps the rows printed (0 for no cap) and --offset skips rows.
+
+Dot commands (as the query argument or in the console):
+  .tables            List tables and views
+  .schema [name]     Show CREATE statements, optionally for one table or view
+  .help              Show console help
+  .quit              Leave the console`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runKnowledgeSQL,
+		Example: `  # Commands with the most flags
+  xbe knowledge sql "SELECT c.full_path, COUNT(*) AS flags FROM commands c JOIN flags f ON f.command_id = c.id GROUP BY c.id ORDER BY flags DESC LIMIT 10"
+
+  # Inspect a table
+  xbe knowledge sql ".schema resource_fields"
+
+  # Pipe results through jq
+  xbe knowledge sql "SELECT name FROM resources" --jq '.[].name'
+
+  # Interactive console
+  xbe knowledge sql`,
+	}
+	cmd.Flags().Duration("timeout", 10*time.Second, "Cancel a statement that runs longer than this")
+	return cmd
+}
+
+func runKnowledgeSQL(cmd *cobra.Command, args []string) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than 0")
+	}
+
+	db, err := openReadOnlyKnowledgeDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	console := &sqlConsole{
+		name:    "knowledge",
+		db:      db,
+		timeout: timeout,
+		limit:   getIntFlag(cmd, "limit"),
+		offset:  getIntFlag(cmd, "offset"),
+		json:    getBoolFlag(cmd, "json"),
+		out:     cmd.OutOrStdout(),
+		errOut:  cmd.ErrOrStderr(),
+	}
+	if len(args) == 1 {
+		statements := splitSQLStatements(args[0])
+		if len(statements) == 0 {
+			return fmt.Errorf("query is required")
+		}
+		if len(statements) > 1 {
+			return fmt.Errorf("run one statement at a time (found %d)", len(statements))
+		}
+		console.single = true
+		return console.run(cmd.Context(), statements[0])
+	}
+
+	if _, buffered := cmd.Context().Value(outputSettingsKey).(outputSettings); buffered {
+		return fmt.Errorf("--output, --jq and --template-file need a query argument: xbe knowledge sql \"<query>\"")
+	}
+	return console.runInput(cmd.Context(), cmd.InOrStdin())
+}
+
+// openReadOnlyKnowledgeDB opens the knowledge database with SQLite's
+// read-only mode and query_only set, so no statement can change it.
+func openReadOnlyKnowledgeDB(cmd *cobra.Command) (*sql.DB, error) {
+	path, err := resolveKnowledgeDBPath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return openReadOnlySQLite(path)
+}
+
+func openReadOnlySQLite(path string) (*sql.DB, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolve database path %s", path)
+	}
+	if _, err := os.Stat(absPath); err != nil {
+		return nil, fmt.Errorf("database not found at %s", path)
+	}
+	dsn := (&url.URL{Scheme: "file", Path: filepath.ToSlash(absPath), RawQuery: "mode=ro&_pragma=query_only(1)"}).String()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	db.SetMaxOpenConns(1)
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	return db, nil
+}
+
+var (
+	sqlLeadingKeyword = regexp.MustCompile(`^(?i)\s*([a-z]+)`)
+	sqlPragmaName     = regexp.MustCompile(`^(?i)\s*pragma\s+(?:[a-z_]+\.)?([a-z_]+)\s*(\(|$)`)
+	sqlAttach         = regexp.MustCompile(`(?i)\b(attach|detach)\b`)
+)
+
+var readOnlySQLPragmas = map[string]bool{
+	"table_info":       true,
+	"table_xinfo":      true,
+	"table_list":       true,
+	"index_list":       true,
+	"index_info":       true,
+	"index_xinfo":      true,
+	"foreign_key_list": true,
+	"database_list":    true,
+	"compile_options":  true,
+	"function_list":    true,
+}
+
+// checkReadOnlySQL rejects statements other than queries and schema
+// PRAGMAs. The connection is read-only regardless; this gives a clear error
+// up front and keeps ATTACH from opening other files.
+func checkReadOnlySQL(statement string) error {
+	match := sqlLeadingKeyword.FindStringSubmatch(stripSQLComments(statement))
+	if match == nil {
+		return fmt.Errorf("not a SQL statement")
+	}
+	keyword := strings.ToLower(match[1])
+	switch keyword {
+	case "select", "with", "values", "explain":
+	case "pragma":
+		pragma := sqlPragmaName.FindStringSubmatch(stripSQLComments(statement))
+		if pragma == nil || !readOnlySQLPragmas[strings.ToLower(pragma[1])] {
+			return fmt.Errorf("only schema PRAGMAs are allowed (%s)", strings.Join(sortedKeys(readOnlySQLPragmas), ", "))
+		}
+	default:
+		return fmt.Errorf("%s statements are not allowed; the database is read-only", strings.ToUpper(keyword))
+	}
+	if sqlAttach.MatchString(stripSQLStrings(statement)) {
+		return fmt.Errorf("ATTACH and DETACH are not allowed")
+	}
+	return nil
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type sqlConsole struct {
+	// name labels the interactive console ("knowledge", "mirror").
+	name    string
+	db      *sql.DB
+	timeout time.Duration
+	limit   int
+	offset  int
+	json    bool
+	single  bool
+	out     io.Writer
+	errOut  io.Writer
+}
+
+// run executes one statement or dot command and prints the result.
+func (c *sqlConsole) run(ctx context.Context, statement string) error {
+	statement = strings.TrimSpace(statement)
+	if strings.HasPrefix(statement, ".") {
+		return c.dotCommand(ctx, statement)
+	}
+	if err := checkReadOnlySQL(statement); err != nil {
+		return err
+	}
+	result, err := c.query(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return c.print(result)
+}
+
+func (c *sqlConsole) query(ctx context.Context, statement string, args ...any) (sqlResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return sqlResult{}, sqlQueryError(ctx, err, c.timeout)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return sqlResult{}, err
+	}
+	result := sqlResult{columns: columns, rows: [][]any{}}
+	skipped := 0
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return sqlResult{}, err
+		}
+		if skipped < c.offset {
+			skipped++
+			continue
+		}
+		if c.limit > 0 && len(result.rows) == c.limit {
+			result.truncated = true
+			break
+		}
+		for i, value := range values {
+			values[i] = sqlJSONValue(value)
+		}
+		result.rows = append(result.rows, values)
+	}
+	if err := rows.Err(); err != nil {
+		return sqlResult{}, sqlQueryError(ctx, err, c.timeout)
+	}
+	return result, nil
+}
+
+func sqlQueryError(ctx context.Context, err error, timeout time.Duration) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("statement cancelled after %s (raise --timeout)", timeout)
+	}
+	return err
+}
+
+func sqlJSONValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		if utf8.Valid(v) {
+			return string(v)
+		}
+		return base64.StdEncoding.EncodeToString(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+func (c *sqlConsole) print(result sqlResult) error {
+	if c.json {
+		records := make([]map[string]any, 0, len(result.rows))
+		for _, row := range result.rows {
+			record := make(map[string]any, len(row))
+			for i, column := range result.columns {
+				record[column] = row[i]
+			}
+			records = append(records, record)
+		}
+		if c.single {
+			setOutputColumns(result.columns)
+		}
+		payload, err := json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(c.out, string(payload))
+	} else {
+		w := tabwriter.NewWriter(c.out, 2, 4, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(result.columns, "\t"))
+		for _, row := range result.rows {
+			cells := make([]string, len(row))
+			for i, value := range row {
+				cells[i] = sqlDisplayValue(value)
+			}
+			fmt.Fprintln(w, strings.Join(cells, "\t"))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.errOut, "(%d rows)\n", len(result.rows))
+	}
+	if result.truncated {
+		fmt.Fprintf(c.errOut, "Stopped after %d rows; use --limit to change or --limit 0 for all.\n", c.limit)
+	}
+	return nil
+}
+
+func sqlDisplayValue(value any) string {
+	if value == nil {
+		return "NULL"
+	}
+	text := fmt.Sprint(value)
+	return strings.NewReplacer("\t", " ", "\n", " ", "\r", " ").Replace(text)
+}
+
+func (c *sqlConsole) dotCommand(ctx context.Context, line string) error {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case ".tables":
+		result, err := c.query(ctx, `
+SELECT name, type
+FROM sqlite_master
+WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite_%'
+ORDER BY type, name`)
+		if err != nil {
+			return err
+		}
+		result = withoutFTSShadowTables(result)
+		if c.json {
+			return c.print(result)
+		}
+		for _, row := range result.rows {
+			fmt.Fprintf(c.out, "%s\t%s\n", row[0], row[1])
+		}
+		return nil
+	case ".schema":
+		query := `
+SELECT name, type, sql
+FROM sqlite_master
+WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%'`
+		args := []any{}
+		if len(fields) > 1 {
+			query += " AND (name = ? OR tbl_name = ?)"
+			args = append(args, fields[1], fields[1])
+		}
+		query += " ORDER BY type = 'table' DESC, type = 'view' DESC, tbl_name, name"
+		result, err := c.query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		result = withoutFTSShadowTables(result)
+		if len(result.rows) == 0 && len(fields) > 1 {
+			return fmt.Errorf("no table or view named %q (try .tables)", fields[1])
+		}
+		if c.json {
+			return c.print(result)
+		}
+		for _, row := range result.rows {
+			fmt.Fprintf(c.out, "%s;\n", strings.TrimSpace(fmt.Sprint(row[2])))
+		}
+		return nil
+	case ".help":
+		fmt.Fprintln(c.out, `Enter SQL terminated by ";". Dot commands:
+  .tables            List tables and views
+  .schema [name]     Show CREATE statements
+  .help              Show this help
+  .quit              Leave the console`)
+		return nil
+	case ".quit", ".exit":
+		if c.single {
+			return nil
+		}
+		return io.EOF
+	default:
+		return fmt.Errorf("unknown command %s (try .help)", fields[0])
+	}
+}
+
+// withoutFTSShadowTables drops the internal tables SQLite keeps for each
+// FTS5 table (search_index_data, search_index_idx, ...).
+func withoutFTSShadowTables(result sqlResult) sqlResult {
+	virtual := []string{}
+	for _, row := range result.rows {
+		if sqlText, ok := row[len(row)-1].(string); ok && strings.HasPrefix(strings.ToUpper(sqlText), "CREATE VIRTUAL TABLE") {
+			virtual = append(virtual, fmt.Sprint(row[0]))
+		}
+	}
+	filtered := result
+	filtered.rows = [][]any{}
+	for _, row := range result.rows {
+		name := fmt.Sprint(row[0])
+		shadow := false
+		for _, table := range virtual {
+			if strings.HasPrefix(name, table+"_") {
+				shadow = true
+				break
+			}
+		}
+		if !shadow {
+			filtered.rows = append(filtered.rows, row)
+		}
+	}
+	return filtered
+}
+
+// runInput opens the console when in is a terminal and otherwise runs the
+// semicolon-separated statements read from in.
+func (c *sqlConsole) runInput(ctx context.Context, in io.Reader) error {
+	if file, ok := in.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		return c.repl(ctx, in)
+	}
+	script, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	for _, statement := range splitSQLStatements(string(script)) {
+		if err := c.run(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *sqlConsole) repl(ctx context.Context, in io.Reader) error {
+	fmt.Fprintf(c.out, "xbe %s console (read-only). End statements with \";\"; .help for commands, .quit to leave.\n", c.name)
+	reader := bufio.NewReader(in)
+	var pending strings.Builder
+	for {
+		if pending.Len() == 0 {
+			fmt.Fprint(c.out, "xbe> ")
+		} else {
+			fmt.Fprint(c.out, "...> ")
+		}
+		line, readErr := reader.ReadString('\n')
+		if pending.Len() == 0 && strings.HasPrefix(strings.TrimSpace(line), ".") {
+			if err := c.dotCommand(ctx, strings.TrimSpace(line)); errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				fmt.Fprintln(c.errOut, "Error:", err)
+			}
+		} else {
+			pending.WriteString(line)
+			if statements, complete := completeSQLStatements(pending.String()); complete {
+				pending.Reset()
+				for _, statement := range statements {
+					if err := c.run(ctx, statement); err != nil {
+						fmt.Fprintln(c.errOut, "Error:", err)
+					}
+				}
+			}
+		}
+		if readErr != nil {
+			if readErr == io.EOF {
+				fmt.Fprintln(c.out)
+				return nil
+			}
+			return readErr
+		}
+	}
+}
+
+// completeSQLStatements splits text into statements and reports whether the
+// last one is terminated by a semicolon.
+func completeSQLStatements(text string) ([]string, bool) {
+	trimmed := strings.TrimSpace(stripSQLComments(text))
+	if trimmed == "" {
+		return nil, true
+	}
+	if !strings.HasSuffix(trimmed, ";") {
+		return nil, false
+	}
+	return splitSQLStatements(text), true
+}
+
+// splitSQLStatements splits on semicolons outside string literals, quoted
+// identifiers, and comments, dropping empty statements.
+func splitSQLStatements(text string) []string {
+	var statements []string
+	var current strings.Builder
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" && strings.TrimSpace(stripSQLComments(statement)) != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+	scanSQL(text, func(segment string, kind sqlSegmentKind) {
+		if kind == sqlCode {
+			for {
+				idx := strings.IndexByte(segment, ';')
+				if idx < 0 {
+					break
+				}
+				current.WriteString(segment[:idx])
+				flush()
+				segment = segment[idx+1:]
+			}
+		}
+		current.WriteString(segment)
+	})
+	flush()
+	return statements
+}
+
+func stripSQLComments(text string) string {
+	var b strings.Builder
+	scanSQL(text, func(segment string, kind sqlSegmentKind) {
+		if kind != sqlComment {
+			b.WriteString(segment)
+		} else {
+			b.WriteString(" ")
+		}
+	})
+	return b.String()
+}
+
+func stripSQLStrings(text string) string {
+	var b strings.Builder
+	scanSQL(text, func(segment string, kind sqlSegmentKind) {
+		if kind == sqlCode {
+			b.WriteString(segment)
+		} else {
+			b.WriteString(" ")
+		}
+	})
+	return b.String()
+}
+
+type sqlSegmentKind int
+
+const (
+	sqlCode sqlSegmentKind = iota
+	sqlQuoted
+	sqlComment
+)
+
+// scanSQL walks text and reports runs of code, quoted text ('...', "...",
+// `...`, [...]), and comments (-- and /* */).
+func scanSQL(text string, emit func(segment string, kind sqlSegmentKind)) {
+	start := 0
+	for i := 0; i < len(text); {
+		var end int
+		var kind sqlSegmentKind
+		switch {
+		case text[i] == '\'' || text[i] == '"' || text[i] == '`' || text[i] == '[':
+			closer := text[i]
+			if closer == '[' {
+				closer = ']'
+			}
+			end = i + 1
+			for end < len(text) {
+				if text[end] == closer {
+					if closer != ']' && end+1 < len(text) && text[end+1] == closer {
+						end += 2
+						continue
+					}
+					end++
+					break
+				}
+				end++
+			}
+			kind = sqlQuoted
+		case strings.HasPrefix(text[i:], "--"):
+			end = strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = len(text)
+			} else {
+				end += i
+			}
+			kind = sqlComment
+		case strings.HasPrefix(text[i:], "/*"):
+			end = strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				end = len(text)
+			} else {
+				end += i + 4
+			}
+			kind = sqlComment
+		default:
+			i++
+			continue
+		}
+		if start < i {
+			emit(text[start:i], sqlCode)
+		}
+		emit(text[i:end], kind)
+		i, start = end, end
+	}
+	if start < len(text) {
+		emit(text[start:], sqlCode)
+	}
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitSQLStatements(t *testing.T) {
+	got := splitSQLStatements("SELECT 'a;b' AS x; -- trailing; comment\nSELECT \"c;\" /* ; */ FROM t;;  ")
+	want := []string{"SELECT 'a;b' AS x", "-- trailing; comment\nSELECT \"c;\" /* ; */ FROM t"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("splitSQLStatements = %q, want %q", got, want)
+	}
+	if _, complete := completeSQLStatements("SELECT 1 -- done;"); complete {
+		t.Fatalf("comment semicolon should not complete a statement")
+	}
+}
+
+func TestCheckReadOnlySQL(t *testing.T) {
+	allowed := []string{
+		"select * from resources",
+		"  /* note */ WITH x AS (SELECT 1) SELECT * FROM x",
+		"PRAGMA table_info(resources)",
+		"explain query plan select 1",
+		"SELECT 'attach' AS word",
+	}
+	for _, statement := range allowed {
+		if err := checkReadOnlySQL(statement); err != nil {
+			t.Errorf("checkReadOnlySQL(%q) = %v", statement, err)
+		}
+	}
+	rejected := []string{
+		"DELETE FROM resources",
+		"ATTACH 'other.db' AS other",
+		"PRAGMA query_only = 0",
+		"PRAGMA writable_schema(1)",
+		"-- select\nDROP TABLE resources",
+	}
+	for _, statement := range rejected {
+		if err := checkReadOnlySQL(statement); err == nil {
+			t.Errorf("checkReadOnlySQL(%q) allowed", statement)
+		}
+	}
+}
@@ -0,0 +1,828 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type knowledgeSuggestion struct {
+	Command     string                `json:"command"`
+	CommandLine string                `json:"command_line"`
+	Description string                `json:"description"`
+	Score       float64               `json:"score"`
+	Flags       []knowledgeSuggestArg `json:"flags"`
+}
+
+type knowledgeSuggestArg struct {
+	Flag        string `json:"flag"`
+	Value       string `json:"value"`
+	Reason      string `json:"reason"`
+	Description string `json:"description,omitempty"`
+}
+
+// suggestQuestion is what the planner understood from the question.
+type suggestQuestion struct {
+	terms     []string
+	groupBy   []suggestHint
+	entities  []suggestEntity
+	dates     *suggestDateRange
+	aggregate string
+}
+
+// suggestHint is a phrase that may name a dimension, with the longest
+// reading first ("job site" -> job_site, site).
+type suggestHint struct {
+	names  []string
+	phrase string
+}
+
+type suggestEntity struct {
+	suggestHint
+	id string
+}
+
+type suggestDateRange struct {
+	from, to time.Time
+	phrase   string
+}
+
+type suggestCommand struct {
+	path        string
+	description string
+	resource    []string
+	words       map[string]bool
+	descWords   map[string]bool
+	flags       map[string]suggestFlag
+	dimensions  []string
+	metrics     []string
+	fieldFlags  map[string]string
+}
+
+type suggestFlag struct {
+	name        string
+	description string
+	required    bool
+}
+
+var (
+	suggestStopWords = stringSet("a", "an", "the", "of", "in", "on", "at", "to", "for", "from", "with",
+		"and", "or", "what", "which", "who", "how", "is", "are", "was", "were", "do", "does", "did",
+		"show", "me", "give", "get", "list", "find", "i", "we", "our", "my", "all", "each", "per", "by",
+		"id", "there", "that", "this", "these", "those", "last", "between",
+		"grouped", "group", "broken", "down")
+	suggestSynonyms = map[string]string{
+		"truck":   "trucker",
+		"hauler":  "trucker",
+		"tonnage": "ton",
+		"hauled":  "haul",
+		"hauling": "haul",
+		"trip":    "cycle",
+		"day":     "date",
+		"daily":   "date",
+		"monthly": "month",
+		"hourly":  "hour",
+		"yearly":  "year",
+	}
+	suggestAggregates = map[string]string{
+		"total":   "sum",
+		"sum":     "sum",
+		"average": "mean",
+		"avg":     "mean",
+		"mean":    "mean",
+		"median":  "median",
+		"count":   "count",
+		"number":  "count",
+		"many":    "count",
+		"minimum": "min",
+		"min":     "min",
+		"maximum": "max",
+		"max":     "max",
+	}
+	suggestAggregateParts = stringSet("sum", "mean", "median", "count", "min", "max", "decile", "p90", "total", "pct")
+	suggestGenericWords   = stringSet("view", "do", "summarize", "list", "show", "create", "update", "delete")
+	suggestISODate        = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+	suggestLastNDays      = regexp.MustCompile(`\b(?:last|past) (\d+) days?\b`)
+	suggestHelpName       = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+func stringSet(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+func newKnowledgeSuggestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suggest <question>",
+		Short: "Suggest commands that answer a question",
+		Long: `Suggest CLI commands for a plain-English question, with flags filled in.
+
+Scoring is deterministic and offline (no model calls). The question is read
+for:
+  grouping     "per trucker", "by month"        -> --group-by
+  measures     "tons", "cycle minutes"          -> --metrics (summary metrics)
+  aggregates   total, average, median, count    -> prefer the matching metric
+  IDs          "broker 12", "job site 5"        -> --filter broker=12 or --broker 12
+  dates        last week, this month, yesterday, last 30 days, 2025-01-31
+
+Each command is scored against its summary dimensions and metrics, its
+flags, and the fields its filters reach (command_field_links), plus the words
+in its path and description. The top suggestions show the command line and
+why each flag was chosen. Placeholders like <value> mark required flags the
+question did not fill.
+
+Dates are resolved against today's date in local time. Shows 5 suggestions
+unless --limit is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runKnowledgeSuggest,
+		Example: `  # Summary with grouping, metric, ID filter, and date range
+  xbe knowledge suggest "tons hauled per trucker last week for broker 12"
+
+  # List with filters
+  xbe knowledge suggest "jobs for customer 42 this month"
+
+  # Machine-readable plan
+  xbe knowledge suggest "average cycle minutes by material site" --json`,
+	}
+	return cmd
+}
+
+func runKnowledgeSuggest(cmd *cobra.Command, args []string) error {
+	if err := ensureNotEmpty(args[0], "question"); err != nil {
+		return err
+	}
+	limit := 5
+	if cmd.Flags().Changed("limit") {
+		limit = getIntFlag(cmd, "limit")
+	}
+	offset := getIntFlag(cmd, "offset")
+
+	db, dbPath, err := openKnowledgeDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	commands, err := loadSuggestCommands(context.Background(), db)
+	if err != nil {
+		return checkDBError(err, dbPath)
+	}
+	question := parseSuggestQuestion(args[0], time.Now())
+	suggestions := rankSuggestions(question, commands)
+	if offset > 0 {
+		if offset >= len(suggestions) {
+			suggestions = nil
+		} else {
+			suggestions = suggestions[offset:]
+		}
+	}
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	if suggestions == nil {
+		suggestions = []knowledgeSuggestion{}
+	}
+
+	if getBoolFlag(cmd, "json") {
+		return renderKnowledgeJSON(cmd, suggestions)
+	}
+	out := cmd.OutOrStdout()
+	if len(suggestions) == 0 {
+		fmt.Fprintf(out, "No command matched. Try: xbe knowledge search %s\n", shellQuote(args[0]))
+		return nil
+	}
+	for i, suggestion := range suggestions {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "%d. %s\n", offset+i+1, suggestion.CommandLine)
+		fmt.Fprintf(out, "   %s (score %.1f)\n", suggestion.Description, suggestion.Score)
+		w := newTabWriter(cmd)
+		for _, arg := range suggestion.Flags {
+			explanation := arg.Reason
+			if arg.Description != "" {
+				explanation += " - " + arg.Description
+			}
+			fmt.Fprintf(w, "   --%s %s\t%s\n", arg.Flag, arg.Value, explanation)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadSuggestCommands(ctx context.Context, db *sql.DB) ([]*suggestCommand, error) {
+	byID := map[string]*suggestCommand{}
+	var commands []*suggestCommand
+	rows, err := queryContext(ctx, db, `SELECT id, full_path, description FROM commands ORDER BY full_path`)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var id string
+		command := &suggestCommand{flags: map[string]suggestFlag{}, fieldFlags: map[string]string{}}
+		if err := rows.Scan(&id, &command.path, &command.description); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if fields := strings.Fields(command.path); len(fields) > 1 {
+			for _, word := range searchTerms(fields[1]) {
+				command.resource = append(command.resource, suggestNormalizeWord(word))
+			}
+		}
+		command.words = suggestWordSet(strings.Join(strings.Fields(command.path)[1:], " "))
+		for word := range suggestGenericWords {
+			delete(command.words, word)
+		}
+		command.descWords = suggestWordSet(command.description)
+		byID[id] = command
+		commands = append(commands, command)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	load := func(query string, apply func(command *suggestCommand, values []string)) error {
+		rows, err := queryContext(ctx, db, query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			values := make([]sql.NullString, 3)
+			if err := rows.Scan(&id, &values[0], &values[1], &values[2]); err != nil {
+				return err
+			}
+			if command := byID[id]; command != nil {
+				apply(command, []string{values[0].String, values[1].String, values[2].String})
+			}
+		}
+		return rows.Err()
+	}
+	if err := load(`SELECT command_id, name, description, required FROM flags`, func(command *suggestCommand, values []string) {
+		name := strings.TrimPrefix(values[0], "--")
+		command.flags[name] = suggestFlag{name: name, description: strings.TrimSuffix(values[1], "."), required: values[2] == "1"}
+	}); err != nil {
+		return nil, err
+	}
+	if err := load(`SELECT command_id, name, '', '' FROM command_summary_dimensions ORDER BY name`, func(command *suggestCommand, values []string) {
+		command.dimensions = append(command.dimensions, values[0])
+	}); err != nil {
+		return nil, err
+	}
+	if err := load(`SELECT command_id, name, '', '' FROM command_summary_metrics ORDER BY name`, func(command *suggestCommand, values []string) {
+		command.metrics = append(command.metrics, values[0])
+	}); err != nil {
+		return nil, err
+	}
+	// Databases built without the server's summary definitions have no
+	// dimensions or metrics; the summarize commands list theirs in their help,
+	// and the summarize help describes each one in a line.
+	for _, command := range commands {
+		fields := strings.Fields(command.path)
+		if fields[0] != "summarize" || len(fields) < 2 || len(command.dimensions) > 0 || len(command.metrics) > 0 {
+			continue
+		}
+		if found, _, err := rootCmd.Find(fields); err == nil {
+			command.dimensions, command.metrics = summaryHelpNames(found.Long)
+		}
+		for _, line := range strings.Split(summarizeCmd.Long, "\n") {
+			if name, text, ok := strings.Cut(strings.TrimSpace(line), " "); ok && name == fields[1] {
+				for word := range suggestWordSet(text) {
+					command.descWords[word] = true
+				}
+			}
+		}
+	}
+	if err := load(`SELECT command_id, flag_name, field, resource FROM command_field_links`, func(command *suggestCommand, values []string) {
+		flag := strings.TrimPrefix(values[0], "--")
+		for _, key := range []string{suggestKey(values[1]), suggestKey(singularizeWord(values[2]))} {
+			if _, ok := command.fieldFlags[key]; !ok && key != "" {
+				command.fieldFlags[key] = flag
+			}
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return commands, nil
+}
+
+// summaryHelpNames reads the "Group-by attributes" and "Metrics" sections of
+// a summarize command's help. Entries are either one name per line followed
+// by a description, or comma-separated lists of names.
+func summaryHelpNames(help string) (dimensions, metrics []string) {
+	var section *[]string
+	seen := map[*[]string]map[string]bool{&dimensions: {}, &metrics: {}}
+	for _, line := range strings.Split(help, "\n") {
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") {
+			switch {
+			case strings.HasPrefix(line, "Group-by attributes"):
+				section = &dimensions
+			case strings.HasPrefix(line, "Metrics"):
+				section = &metrics
+			default:
+				section = nil
+			}
+			continue
+		}
+		if section == nil {
+			continue
+		}
+		text := strings.TrimSpace(line)
+		if _, rest, ok := strings.Cut(text, ":"); ok {
+			text = rest
+		}
+		fields := strings.Fields(text)
+		var names []string
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) == 1 || strings.HasPrefix(strings.TrimSpace(text), fields[0]+"  "):
+			names = fields[:1]
+		case strings.Contains(text, ","):
+			names = strings.Split(text, ",")
+		}
+		for _, name := range names {
+			name = strings.TrimSpace(name)
+			if suggestHelpName.MatchString(name) && !seen[section][name] {
+				seen[section][name] = true
+				*section = append(*section, name)
+			}
+		}
+	}
+	sort.Strings(dimensions)
+	sort.Strings(metrics)
+	return dimensions, metrics
+}
+
+// parseSuggestQuestion pulls grouping, IDs, dates, and aggregate words out
+// of the question; what is left are the terms matched against metrics and
+// command text.
+func parseSuggestQuestion(text string, now time.Time) suggestQuestion {
+	q := suggestQuestion{}
+	text = strings.ToLower(text)
+	text, q.dates = extractSuggestDates(text, now)
+
+	tokens := searchTerms(text)
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if _, err := strconv.Atoi(token); err == nil {
+			words := previousSuggestWords(tokens[:i])
+			if len(words) == 0 {
+				continue
+			}
+			q.entities = append(q.entities, suggestEntity{suggestHint: newSuggestHint(words), id: token})
+			q.terms = removeSuggestTerm(q.terms, suggestNormalizeWord(words[len(words)-1]))
+			continue
+		}
+		if token == "per" || token == "by" || token == "each" {
+			var words []string
+			for j := i + 1; j < len(tokens) && len(words) < 2; j++ {
+				if suggestStopWords[tokens[j]] && suggestSynonyms[tokens[j]] == "" {
+					break
+				}
+				if _, err := strconv.Atoi(tokens[j]); err == nil {
+					break
+				}
+				words = append(words, tokens[j])
+			}
+			if len(words) > 0 {
+				q.groupBy = append(q.groupBy, newSuggestHint(words))
+				i += len(words)
+			}
+			continue
+		}
+		if aggregate, ok := suggestAggregates[token]; ok {
+			if q.aggregate == "" {
+				q.aggregate = aggregate
+			}
+			continue
+		}
+		if suggestStopWords[token] {
+			continue
+		}
+		q.terms = append(q.terms, suggestNormalizeWord(token))
+	}
+	return q
+}
+
+// previousSuggestWords returns the one or two words naming the thing an ID
+// belongs to ("broker 12", "job site id 5").
+func previousSuggestWords(tokens []string) []string {
+	end := len(tokens)
+	if end > 0 && tokens[end-1] == "id" {
+		end--
+	}
+	var words []string
+	for i := end - 1; i >= 0 && len(words) < 2; i-- {
+		if suggestStopWords[tokens[i]] {
+			break
+		}
+		words = append([]string{tokens[i]}, words...)
+	}
+	return words
+}
+
+func newSuggestHint(words []string) suggestHint {
+	hint := suggestHint{phrase: strings.Join(words, " ")}
+	for start := 0; start < len(words); start++ {
+		parts := make([]string, 0, len(words)-start)
+		for _, word := range words[start:] {
+			parts = append(parts, suggestNormalizeWord(word))
+		}
+		hint.names = append(hint.names, strings.Join(parts, "_"))
+	}
+	return hint
+}
+
+func suggestNormalizeWord(word string) string {
+	if synonym, ok := suggestSynonyms[word]; ok {
+		return synonym
+	}
+	if singular := singularizeWord(word); singular != "" {
+		word = singular
+	}
+	if synonym, ok := suggestSynonyms[word]; ok {
+		return synonym
+	}
+	return word
+}
+
+func removeSuggestTerm(terms []string, term string) []string {
+	for i := len(terms) - 1; i >= 0; i-- {
+		if terms[i] == term {
+			return append(terms[:i], terms[i+1:]...)
+		}
+	}
+	return terms
+}
+
+func extractSuggestDates(text string, now time.Time) (string, *suggestDateRange) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if dates := suggestISODate.FindAllString(text, 2); len(dates) > 0 {
+		from, errFrom := time.Parse("2006-01-02", dates[0])
+		to, errTo := time.Parse("2006-01-02", dates[len(dates)-1])
+		if errFrom == nil && errTo == nil {
+			if to.Before(from) {
+				from, to = to, from
+			}
+			return suggestISODate.ReplaceAllString(text, " "), &suggestDateRange{from: from, to: to, phrase: strings.Join(dates, " to ")}
+		}
+	}
+	if match := suggestLastNDays.FindStringSubmatch(text); match != nil {
+		days, _ := strconv.Atoi(match[1])
+		if days > 0 {
+			return strings.Replace(text, match[0], " ", 1), &suggestDateRange{from: today.AddDate(0, 0, 1-days), to: today, phrase: match[0]}
+		}
+	}
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	yearStart := time.Date(today.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	phrases := []struct {
+		phrase   string
+		from, to time.Time
+	}{
+		{"yesterday", today.AddDate(0, 0, -1), today.AddDate(0, 0, -1)},
+		{"today", today, today},
+		{"last week", weekStart.AddDate(0, 0, -7), weekStart.AddDate(0, 0, -1)},
+		{"this week", weekStart, today},
+		{"last month", monthStart.AddDate(0, -1, 0), monthStart.AddDate(0, 0, -1)},
+		{"this month", monthStart, today},
+		{"last year", yearStart.AddDate(-1, 0, 0), yearStart.AddDate(0, 0, -1)},
+		{"this year", yearStart, today},
+	}
+	for _, candidate := range phrases {
+		if strings.Contains(text, candidate.phrase) {
+			return strings.Replace(text, candidate.phrase, " ", 1), &suggestDateRange{from: candidate.from, to: candidate.to, phrase: candidate.phrase}
+		}
+	}
+	return text, nil
+}
+
+func rankSuggestions(q suggestQuestion, commands []*suggestCommand) []knowledgeSuggestion {
+	var suggestions []knowledgeSuggestion
+	for _, command := range commands {
+		if suggestion, ok := scoreSuggestion(q, command); ok {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Command < suggestions[j].Command
+	})
+	return suggestions
+}
+
+// scoreSuggestion fills in a command's flags from the question and scores
+// how much of the question it answers. Unanswered parts of the question and
+// required flags left as placeholders cost points. A command must match a
+// term, metric or dimension of the question; entity and date filters alone
+// fit most list commands.
+func scoreSuggestion(q suggestQuestion, command *suggestCommand) (knowledgeSuggestion, bool) {
+	score := 0.0
+	matched := false
+	var args []knowledgeSuggestArg
+	addArg := func(flag, value, reason string) {
+		args = append(args, knowledgeSuggestArg{Flag: flag, Value: value, Reason: reason, Description: command.flags[flag].description})
+	}
+	_, hasFilter := command.flags["filter"]
+	summary := len(command.dimensions) > 0 && hasFilter
+
+	if len(q.groupBy) > 0 {
+		var groups []string
+		var phrases []string
+		if _, ok := command.flags["group-by"]; ok {
+			for _, hint := range q.groupBy {
+				if dimension := matchSuggestName(hint.names, command.dimensions); dimension != "" {
+					groups = append(groups, dimension)
+					phrases = append(phrases, fmt.Sprintf("%q", hint.phrase))
+					score += 4
+					continue
+				}
+				score--
+			}
+		} else {
+			score -= float64(len(q.groupBy))
+		}
+		if len(groups) > 0 {
+			matched = true
+			addArg("group-by", strings.Join(groups, ","), "grouped by "+strings.Join(phrases, ", "))
+		}
+	}
+
+	covered := map[string]bool{}
+	if _, ok := command.flags["metrics"]; ok && len(command.metrics) > 0 {
+		var chosen []string
+		for _, term := range q.terms {
+			if covered[term] {
+				continue
+			}
+			metric, terms, metricScore := bestSuggestMetric(term, q, command.metrics)
+			if metric == "" {
+				continue
+			}
+			for _, covers := range terms {
+				covered[covers] = true
+			}
+			chosen = append(chosen, metric)
+			score += metricScore
+		}
+		if len(chosen) > 0 {
+			matched = true
+			var words []string
+			for _, term := range q.terms {
+				if covered[term] {
+					words = append(words, term)
+				}
+			}
+			addArg("metrics", strings.Join(chosen, ","), fmt.Sprintf("measures %q", strings.Join(words, " ")))
+		}
+	}
+
+	for _, entity := range q.entities {
+		if summary {
+			if dimension := matchSuggestName(entity.names, command.dimensions); dimension != "" {
+				addArg("filter", dimension+"="+entity.id, fmt.Sprintf("%q", entity.phrase+" "+entity.id))
+				score += 3
+				continue
+			}
+		} else if flag := matchSuggestFlag(entity.names, command); flag != "" {
+			addArg(flag, entity.id, fmt.Sprintf("%q", entity.phrase+" "+entity.id))
+			score += 3
+			continue
+		}
+		score--
+	}
+
+	if q.dates != nil {
+		reason := fmt.Sprintf("%q (%s to %s)", q.dates.phrase, q.dates.from.Format("2006-01-02"), q.dates.to.Format("2006-01-02"))
+		if summary && containsString(command.dimensions, "date") {
+			addArg("filter", "date_min="+q.dates.from.Format("2006-01-02"), reason)
+			addArg("filter", "date_max="+q.dates.to.Format("2006-01-02"), reason)
+			score++
+		} else if base := suggestDateFlag(command); base != "" {
+			from, to := q.dates.from.Format("2006-01-02"), q.dates.to.Format("2006-01-02")
+			if strings.HasSuffix(base, "-at") {
+				from, to = q.dates.from.Format(time.RFC3339), q.dates.to.Add(24*time.Hour-time.Second).Format(time.RFC3339)
+			}
+			addArg(base+"-min", from, reason)
+			addArg(base+"-max", to, reason)
+			score++
+		} else {
+			score -= 0.5
+		}
+	}
+
+	for _, term := range q.terms {
+		if covered[term] {
+			continue
+		}
+		if command.words[term] {
+			score += 1.5
+			matched = true
+		} else if command.descWords[term] {
+			score += 0.5
+		}
+	}
+	if len(command.resource) > 0 {
+		named := true
+		for _, word := range command.resource {
+			if !containsString(q.terms, word) {
+				named = false
+				break
+			}
+		}
+		if named {
+			score += 3
+			matched = true
+		}
+	}
+	if !matched || score < 1 {
+		return knowledgeSuggestion{}, false
+	}
+
+	set := map[string]bool{}
+	for _, arg := range args {
+		set[arg.Flag] = true
+	}
+	var required []string
+	for name, flag := range command.flags {
+		if flag.required && !set[name] {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+	for _, name := range required {
+		addArg(name, "<value>", "required")
+		score -= 0.5
+	}
+	if strings.HasPrefix(command.path, "do ") {
+		score -= 1.5
+	} else if strings.HasSuffix(command.path, " show") {
+		score -= 0.5
+	}
+	if score < 1 {
+		return knowledgeSuggestion{}, false
+	}
+
+	line := []string{"xbe", command.path}
+	for _, arg := range args {
+		value := arg.Value
+		if value != "<value>" && strings.ContainsAny(value, " '\"$*?|&;<>()") {
+			value = shellQuote(value)
+		}
+		line = append(line, "--"+arg.Flag, value)
+	}
+	return knowledgeSuggestion{
+		Command:     command.path,
+		CommandLine: strings.Join(line, " "),
+		Description: command.description,
+		Score:       score,
+		Flags:       args,
+	}, true
+}
+
+// bestSuggestMetric picks the metric covering the most question terms,
+// starting from term. A metric only counts when the question names at least
+// half of its measure words, so "jobs" alone does not pick
+// job_site_wait_time_median. The aggregate word decides between tons_sum and
+// tons_mean (median stands in for mean); without one, sums win, then medians.
+func bestSuggestMetric(term string, q suggestQuestion, metrics []string) (string, []string, float64) {
+	best, bestScore := "", 0.0
+	var bestTerms []string
+	for _, metric := range metrics {
+		parts := suggestMetricParts(metric)
+		if !parts[term] {
+			continue
+		}
+		measures := 0
+		for part := range parts {
+			if !suggestAggregateParts[part] {
+				measures++
+			}
+		}
+		var terms []string
+		for _, candidate := range q.terms {
+			if parts[candidate] && !containsString(terms, candidate) {
+				terms = append(terms, candidate)
+			}
+		}
+		if measures == 0 || 2*len(terms) < measures {
+			continue
+		}
+		score := 2 * float64(len(terms)*len(terms)) / float64(measures)
+		switch {
+		case q.aggregate != "" && parts[q.aggregate]:
+			score += 0.5
+		case q.aggregate == "mean" && parts["median"]:
+			score += 0.25
+		case q.aggregate == "" && parts["sum"]:
+			score += 0.25
+		case q.aggregate == "" && parts["median"]:
+			score += 0.2
+		}
+		if best == "" || score > bestScore || (score == bestScore && metric < best) {
+			best, bestScore, bestTerms = metric, score, terms
+		}
+	}
+	return best, bestTerms, bestScore
+}
+
+func suggestMetricParts(metric string) map[string]bool {
+	parts := map[string]bool{}
+	for _, part := range strings.Split(metric, "_") {
+		parts[suggestNormalizeWord(part)] = true
+	}
+	return parts
+}
+
+func matchSuggestName(names []string, candidates []string) string {
+	for _, name := range names {
+		for _, candidate := range candidates {
+			if suggestKey(candidate) == name {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
+
+func matchSuggestFlag(names []string, command *suggestCommand) string {
+	for _, name := range names {
+		flag := strings.ReplaceAll(name, "_", "-")
+		if _, ok := command.flags[flag]; ok {
+			return flag
+		}
+		if flag, ok := command.fieldFlags[name]; ok {
+			return flag
+		}
+	}
+	return ""
+}
+
+// suggestDateFlag finds a --X-min/--X-max pair for a date (-date, -on) or
+// timestamp (-at), preferring -date, then -on.
+func suggestDateFlag(command *suggestCommand) string {
+	var bases []string
+	for name := range command.flags {
+		base, ok := strings.CutSuffix(name, "-min")
+		if !ok {
+			continue
+		}
+		if _, ok := command.flags[base+"-max"]; !ok {
+			continue
+		}
+		if strings.HasSuffix(base, "date") || strings.HasSuffix(base, "-at") || strings.HasSuffix(base, "-on") {
+			bases = append(bases, base)
+		}
+	}
+	rank := func(base string) int {
+		switch {
+		case strings.HasSuffix(base, "date"):
+			return 0
+		case strings.HasSuffix(base, "-on"):
+			return 1
+		}
+		return 2
+	}
+	sort.Slice(bases, func(i, j int) bool {
+		if rank(bases[i]) != rank(bases[j]) {
+			return rank(bases[i]) < rank(bases[j])
+		}
+		return bases[i] < bases[j]
+	})
+	if len(bases) == 0 {
+		return ""
+	}
+	return bases[0]
+}
+
+func suggestKey(value string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(value)), "-", "_")
+}
+
+func suggestWordSet(text string) map[string]bool {
+	words := map[string]bool{}
+	for _, word := range searchTerms(text) {
+		words[suggestNormalizeWord(word)] = true
+	}
+	return words
+}
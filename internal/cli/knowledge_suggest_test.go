@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestParseSuggestQuestion(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.UTC) // Wednesday
+	q := parseSuggestQuestion("Total tons hauled per job site last week for broker 12", now)
+	if !reflect.DeepEqual(q.terms, []string{"ton", "haul"}) {
+		t.Fatalf("terms = %v", q.terms)
+	}
+	if len(q.groupBy) != 1 || !reflect.DeepEqual(q.groupBy[0].names, []string{"job_site", "site"}) {
+		t.Fatalf("groupBy = %+v", q.groupBy)
+	}
+	if len(q.entities) != 1 || q.entities[0].id != "12" || q.entities[0].names[0] != "broker" {
+		t.Fatalf("entities = %+v", q.entities)
+	}
+	if q.aggregate != "sum" {
+		t.Fatalf("aggregate = %q", q.aggregate)
+	}
+	if q.dates == nil || q.dates.from.Format("2006-01-02") != "2026-10-05" || q.dates.to.Format("2006-01-02") != "2026-10-11" {
+		t.Fatalf("dates = %+v", q.dates)
+	}
+}
+
+func TestScoreSuggestionFillsSummaryFlags(t *testing.T) {
+	command := &suggestCommand{
+		path:        "summarize lane-summary create",
+		description: "Create a lane summary",
+		words:       map[string]bool{"lane": true, "summary": true},
+		flags: map[string]suggestFlag{
+			"group-by": {name: "group-by"},
+			"metrics":  {name: "metrics"},
+			"filter":   {name: "filter"},
+		},
+		dimensions: []string{"broker", "date", "trucker"},
+		metrics:    []string{"cycle_minutes_median", "job_site_wait_time_median", "tons_mean", "tons_sum"},
+	}
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.UTC)
+	suggestion, ok := scoreSuggestion(parseSuggestQuestion("tons hauled per trucker yesterday for broker 12", now), command)
+	if !ok {
+		t.Fatalf("expected a suggestion")
+	}
+	want := "xbe summarize lane-summary create --group-by trucker --metrics tons_sum --filter broker=12 --filter date_min=2026-10-13 --filter date_max=2026-10-13"
+	if suggestion.CommandLine != want {
+		t.Fatalf("command line = %s\nwant %s", suggestion.CommandLine, want)
+	}
+
+	suggestion, _ = scoreSuggestion(parseSuggestQuestion("average tons for jobs", now), command)
+	if suggestion.Flags[0].Value != "tons_mean" {
+		t.Fatalf("metrics = %+v", suggestion.Flags)
+	}
+}
+
+func TestSuggestAgainstKnowledgeDB(t *testing.T) {
+	t.Setenv(knowledgeDBEnv, filepath.Join("knowledge_db", "knowledge.sqlite"))
+	db, _, err := openKnowledgeDB(&cobra.Command{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	commands, err := loadSuggestCommands(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.UTC)
+
+	// A broker ID and a date range fit nearly every list command; on their
+	// own they must not make one a suggestion. The summary's dimensions and
+	// metrics come from its help when the database has none.
+	suggestions := rankSuggestions(parseSuggestQuestion("tons hauled per trucker last week for broker 12", now), commands)
+	want := "xbe summarize lane-summary create --group-by trucker --metrics tons_sum --filter broker=12 --filter date_min=2026-10-05 --filter date_max=2026-10-11"
+	if len(suggestions) == 0 || suggestions[0].CommandLine != want {
+		t.Fatalf("suggestions = %+v, want %s first", suggestions, want)
+	}
+	for _, suggestion := range suggestions {
+		if strings.HasPrefix(suggestion.CommandLine, "xbe view ") {
+			t.Errorf("unrelated suggestion %s (score %.1f)", suggestion.CommandLine, suggestion.Score)
+		}
+	}
+
+	suggestions = rankSuggestions(parseSuggestQuestion("time cards for broker 12 last week", now), commands)
+	want = "xbe view time-cards list --broker 12 --shift-date-min 2026-10-05 --shift-date-max 2026-10-11"
+	if len(suggestions) == 0 || suggestions[0].CommandLine != want {
+		t.Fatalf("suggestions = %+v, want %s first", suggestions, want)
+	}
+}
+
+func TestSummaryHelpNames(t *testing.T) {
+	help := `Create a summary.
+
+Group-by attributes:
+  broker          Group by broker (broker_id, broker_name)
+  material_type, trucker,
+  date
+
+Metrics:
+  By default: tons_sum, cycle_count
+
+  Available metrics:
+    tons_sum        Sum of tonnage
+    tons_mean
+
+  Use --metrics to customize the metric columns, or --all-metrics
+  to include every available metric.
+
+Filters:
+  broker          Broker ID`
+	dimensions, metrics := summaryHelpNames(help)
+	if !reflect.DeepEqual(dimensions, []string{"broker", "date", "material_type", "trucker"}) {
+		t.Errorf("dimensions = %q", dimensions)
+	}
+	if !reflect.DeepEqual(metrics, []string{"cycle_count", "tons_mean", "tons_sum"}) {
+		t.Errorf("metrics = %q", metrics)
+	}
+}
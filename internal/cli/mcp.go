@@ -0,0 +1,530 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/mcp"
+	"github.com/xbe-inc/xbe-cli/internal/policy"
+	"github.com/xbe-inc/xbe-cli/internal/version"
+)
+
+const (
+	mcpMaxToolName       = 64
+	mcpKnowledgeURI      = "xbe://knowledge/"
+	mcpResourceURIPrefix = mcpKnowledgeURI + "resources/"
+	mcpCommandsURIPrefix = mcpKnowledgeURI + "commands/"
+)
+
+const mcpInstructions = `Tools map one-to-one to xbe CLI commands: view_* read data, summarize_* aggregate it, do_* create, update, or delete records, and knowledge_* query the local knowledge database of resources, commands, fields, and relationships. Start with knowledge_search or the xbe://knowledge/guide resource to find the right command. Tool descriptions list required permissions and side effects; do_* tools accept dry-run to preview the request without sending it.`
+
+// mcpExcludedFlags are handled by the server or meaningless for a tool call.
+var mcpExcludedFlags = map[string]bool{
+	"help":             true,
+	"json":             true,
+	"output":           true,
+	"jq":               true,
+	"template-file":    true,
+	"client-url":       true,
+	"base-url":         true,
+	"token":            true,
+	"no-auth":          true,
+	"omit-null":        true,
+	"permissions":      true,
+	"side-effects":     true,
+	"validation-notes": true,
+	"metadata":         true,
+	"from-file":        true,
+	"concurrency":      true,
+	"results-file":     true,
+	"dry-run-format":   true,
+}
+
+// mcpInheritedFlags are persistent flags worth exposing on every tool that
+// inherits them.
+var mcpInheritedFlags = map[string]bool{
+	"limit":   true,
+	"offset":  true,
+	"fields":  true,
+	"dry-run": true,
+}
+
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "Serve the CLI to AI agents over the Model Context Protocol",
+	Long: `Serve the CLI to AI agents over the Model Context Protocol (MCP).
+
+'xbe mcp serve' speaks JSON-RPC over stdin/stdout. Each view, do, and summarize
+command becomes a typed tool whose input schema comes from the command's flags
+and the knowledge database. The knowledge queries are exposed as tools and as
+resources.`,
+	Annotations: map[string]string{"group": GroupUtility},
+}
+
+var mcpServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run an MCP server on stdin/stdout",
+	Long: `Run a Model Context Protocol server on stdin/stdout.
+
+Tools:
+  view_<resource>_list, view_<resource>_show, summarize_*, do_<resource>_<verb>,
+  and knowledge_<command>. Tool names use the command path joined by
+  underscores. Descriptions include the permissions, side effects, and
+  validation notes recorded in the knowledge database; view, summarize, and
+  knowledge tools are marked read-only and delete tools destructive.
+
+Resources:
+  xbe://knowledge/guide              First-run exploration guide
+  xbe://knowledge/resources          Resource index
+  xbe://knowledge/resources/{name}   Fields, relationships, summaries, commands
+  xbe://knowledge/commands/{name}    Commands that operate on a resource
+
+Each tool call runs the command in a new process with --json, so the audit log,
+retries, profiles, and policy all apply. Commands the policy denies or that need
+interactive approval are not listed, and do tools are hidden in read-only mode.
+Credentials come from the usual sources (XBE_TOKEN, profile, or 'xbe auth login').`,
+	Example: `  # Register with an MCP client
+  xbe mcp serve
+
+  # Read-only tools for one profile
+  xbe mcp serve --profile staging --read-only
+
+  # Only knowledge and view tools
+  xbe mcp serve --groups knowledge,view`,
+	Args: cobra.NoArgs,
+	RunE: runMCPServe,
+}
+
+func init() {
+	mcpServeCmd.Flags().String("groups", "view,do,summarize,knowledge", "Comma-separated command groups to expose as tools")
+	mcpServeCmd.Flags().Int("page-size", 0, "Maximum tools or resources per list response (0 for no paging)")
+	mcpCmd.AddCommand(mcpServeCmd)
+	rootCmd.AddCommand(mcpCmd)
+}
+
+type mcpCommandTool struct {
+	path       []string
+	positional []commandArg
+	flags      map[string]*pflag.Flag
+	jsonOutput bool
+}
+
+type mcpHandler struct {
+	executable string
+	globalArgs []string
+	env        []string
+	tools      []mcp.Tool
+	byName     map[string]*mcpCommandTool
+	resources  []string
+}
+
+func runMCPServe(cmd *cobra.Command, _ []string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locate xbe executable: %w", err)
+	}
+	groups := parseCSVFilter(getStringFlag(cmd, "groups"))
+	for _, group := range groups {
+		switch group {
+		case "view", "do", "summarize", "knowledge":
+		default:
+			return fmt.Errorf("%w: unknown --groups value %q (use view, do, summarize, knowledge)", errInvalidInput, group)
+		}
+	}
+
+	p, err := activePolicy()
+	if err != nil {
+		return err
+	}
+	readOnly := p.ReadOnly || getBoolFlag(cmd, "read-only")
+
+	knowledge, err := loadCommandKnowledge(cmd)
+	if err != nil {
+		// Tools still work without the knowledge database, just with thinner
+		// descriptions and schemas.
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+	}
+
+	handler := &mcpHandler{
+		executable: executable,
+		env:        os.Environ(),
+		byName:     map[string]*mcpCommandTool{},
+		resources:  knowledge.resources,
+	}
+	if profile := strings.TrimSpace(getStringFlag(cmd, "profile")); profile != "" {
+		handler.globalArgs = append(handler.globalArgs, "--profile", profile)
+	}
+	if readOnly {
+		handler.env = append(handler.env, policy.ReadOnlyEnv+"=1")
+	}
+	for _, group := range groups {
+		groupCmd, _, err := rootCmd.Find([]string{group})
+		if err != nil || groupCmd == rootCmd {
+			continue
+		}
+		handler.addCommandTools(groupCmd, knowledge, p, readOnly)
+	}
+	sort.Slice(handler.tools, func(i, j int) bool { return handler.tools[i].Name < handler.tools[j].Name })
+
+	server := &mcp.Server{
+		Name:         "xbe",
+		Version:      version.String(),
+		Instructions: mcpInstructions,
+		Handler:      handler,
+		PageSize:     getIntFlag(cmd, "page-size"),
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	err = server.Serve(ctx, cmd.InOrStdin(), cmd.OutOrStdout())
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func (h *mcpHandler) addCommandTools(cmd *cobra.Command, knowledge commandKnowledge, p policy.Policy, readOnly bool) {
+	if cmd.Hidden || cmd.Deprecated != "" {
+		return
+	}
+	if cmd.HasSubCommands() {
+		for _, child := range cmd.Commands() {
+			h.addCommandTools(child, knowledge, p, readOnly)
+		}
+		return
+	}
+	if !cmd.Runnable() {
+		return
+	}
+
+	commandPath := knowledgeCommandPath(cmd)
+	path := strings.Fields(commandPath)
+	group := path[0]
+	if group == "do" && readOnly {
+		return
+	}
+	if group != "knowledge" {
+		// Mirror enforcePolicy: hide what would be refused. Approval prompts
+		// cannot be answered over stdio, so those commands are hidden too.
+		decision := p.Check(commandPath)
+		if !decision.Allowed || decision.RequireApproval {
+			return
+		}
+	}
+
+	tool, commandTool := buildMCPTool(cmd, path, knowledge)
+	if _, exists := h.byName[tool.Name]; exists {
+		return
+	}
+	h.byName[tool.Name] = commandTool
+	h.tools = append(h.tools, tool)
+}
+
+func buildMCPTool(cmd *cobra.Command, path []string, knowledge commandKnowledge) (mcp.Tool, *mcpCommandTool) {
+	info := knowledge.command(strings.Join(path, " "))
+	inputSchema, positional, flags := buildCommandInputSchema(cmd, knowledge, func(flag *pflag.Flag, inherited bool) bool {
+		if mcpExcludedFlags[flag.Name] {
+			return false
+		}
+		if !inherited {
+			return true
+		}
+		return mcpInheritedFlags[flag.Name] && (flag.Name != "fields" || path[0] == "view")
+	})
+
+	title := strings.Join(path, " ")
+	tool := mcp.Tool{
+		Name:        mcpToolName(path),
+		Title:       title,
+		Description: mcpToolDescription(cmd, info),
+		InputSchema: inputSchema,
+		Annotations: mcpToolAnnotations(path, info, title),
+	}
+	return tool, &mcpCommandTool{
+		path:       path,
+		positional: positional,
+		flags:      flags,
+		jsonOutput: cmd.Flag("json") != nil,
+	}
+}
+
+// mcpToolName joins the command path with underscores. Names over the MCP
+// limit are shortened with a hash suffix to stay unique.
+func mcpToolName(path []string) string {
+	name := strings.Join(path, "_")
+	if len(name) <= mcpMaxToolName {
+		return name
+	}
+	sum := fmt.Sprintf("%x", sha256.Sum256([]byte(name)))[:8]
+	return name[:mcpMaxToolName-9] + "_" + sum
+}
+
+func mcpToolDescription(cmd *cobra.Command, info knownCommand) string {
+	description := strings.TrimSpace(info.description)
+	if description == "" {
+		description = strings.TrimSpace(cmd.Short)
+	}
+	var b strings.Builder
+	b.WriteString(description)
+	if info.Permissions != "" {
+		b.WriteString("\n\nPermissions: " + info.Permissions)
+	}
+	if info.SideEffects != "" {
+		b.WriteString("\n\nSide effects: " + info.SideEffects)
+	}
+	if info.ValidationNotes != "" {
+		b.WriteString("\n\nValidation: " + info.ValidationNotes)
+	}
+	return b.String()
+}
+
+func mcpToolAnnotations(path []string, info knownCommand, title string) *mcp.ToolAnnotations {
+	yes, no := true, false
+	annotations := &mcp.ToolAnnotations{Title: title}
+	switch path[0] {
+	case "do":
+		annotations.ReadOnlyHint = &no
+		annotations.OpenWorldHint = &yes
+		verb := path[len(path)-1]
+		sideEffects := strings.ToLower(info.SideEffects)
+		if verb == "delete" || strings.Contains(sideEffects, "delete") || strings.Contains(sideEffects, "destroy") {
+			annotations.DestructiveHint = &yes
+		} else {
+			annotations.DestructiveHint = &no
+		}
+		if verb == "delete" || verb == "update" {
+			annotations.IdempotentHint = &yes
+		}
+	case "knowledge":
+		annotations.ReadOnlyHint = &yes
+		annotations.OpenWorldHint = &no
+	default:
+		annotations.ReadOnlyHint = &yes
+		annotations.OpenWorldHint = &yes
+	}
+	return annotations
+}
+
+func (h *mcpHandler) Tools(context.Context) ([]mcp.Tool, error) {
+	return h.tools, nil
+}
+
+func (h *mcpHandler) CallTool(ctx context.Context, name string, arguments map[string]any) (mcp.ToolResult, error) {
+	tool, ok := h.byName[name]
+	if !ok {
+		return mcp.ToolResult{}, mcp.Errorf(mcp.CodeInvalidParams, "unknown tool: %s", name)
+	}
+	args, err := tool.commandArgs(arguments)
+	if err != nil {
+		return mcp.TextResult(err.Error(), true), nil
+	}
+	stdout, stderr, failed, err := h.run(ctx, args)
+	if err != nil {
+		return mcp.ToolResult{}, err
+	}
+	text := strings.TrimSpace(stdout)
+	if failed {
+		text = strings.TrimSpace(strings.Join([]string{text, strings.TrimSpace(stderr)}, "\n"))
+	}
+	if text == "" {
+		text = "OK"
+	}
+	return mcp.TextResult(text, failed), nil
+}
+
+// commandArgs converts tool arguments into a command line. Positional values
+// follow "--" so a value such as "--base-url=..." can never be read as a flag.
+func (t *mcpCommandTool) commandArgs(arguments map[string]any) ([]string, error) {
+	args := append([]string{}, t.path...)
+	positionals := []string{}
+	used := map[string]bool{}
+	for _, arg := range t.positional {
+		value, ok := arguments[arg.property]
+		if !ok {
+			continue
+		}
+		used[arg.property] = true
+		values, err := mcpArgumentStrings(arg.property, value)
+		if err != nil {
+			return nil, err
+		}
+		if !arg.variadic && len(values) != 1 {
+			return nil, fmt.Errorf("%s must be a single value", arg.property)
+		}
+		positionals = append(positionals, values...)
+	}
+
+	names := make([]string, 0, len(arguments))
+	for name := range arguments {
+		if !used[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		flag, ok := t.flags[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown argument %q", name)
+		}
+		value := arguments[name]
+		if value == nil {
+			continue
+		}
+		if flag.Value.Type() == "bool" {
+			enabled, ok := value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a boolean", name)
+			}
+			args = append(args, "--"+name+"="+strconv.FormatBool(enabled))
+			continue
+		}
+		values, err := mcpArgumentStrings(name, value)
+		if err != nil {
+			return nil, err
+		}
+		if _, isList := value.([]any); isList && !strings.HasSuffix(flag.Value.Type(), "Array") {
+			values = []string{strings.Join(values, ",")}
+		}
+		for _, item := range values {
+			args = append(args, "--"+name+"="+item)
+		}
+	}
+	if t.jsonOutput {
+		args = append(args, "--json")
+	}
+	if len(positionals) > 0 {
+		args = append(append(args, "--"), positionals...)
+	}
+	return args, nil
+}
+
+func mcpArgumentStrings(name string, value any) ([]string, error) {
+	switch typed := value.(type) {
+	case string:
+		return []string{typed}, nil
+	case bool:
+		return []string{strconv.FormatBool(typed)}, nil
+	case float64:
+		return []string{strconv.FormatFloat(typed, 'f', -1, 64)}, nil
+	case []any:
+		values := make([]string, 0, len(typed))
+		for _, item := range typed {
+			itemValues, err := mcpArgumentStrings(name, item)
+			if err != nil || len(itemValues) != 1 {
+				return nil, fmt.Errorf("%s must be a list of scalar values", name)
+			}
+			values = append(values, itemValues[0])
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("%s has an unsupported value type", name)
+	}
+}
+
+// run executes the CLI in a child process. failed reports a non-zero exit;
+// err is only set when the process could not be run at all.
+func (h *mcpHandler) run(ctx context.Context, args []string) (stdout, stderr string, failed bool, err error) {
+	// Global flags go before the "--" that ends a tool's flags.
+	argv := append([]string{}, args...)
+	if i := slices.Index(argv, "--"); i >= 0 {
+		argv = slices.Concat(argv[:i], h.globalArgs, argv[i:])
+	} else {
+		argv = append(argv, h.globalArgs...)
+	}
+	child := exec.CommandContext(ctx, h.executable, argv...)
+	child.Env = h.env
+	var outBuf, errBuf bytes.Buffer
+	child.Stdout = &outBuf
+	child.Stderr = &errBuf
+	runErr := child.Run()
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		return "", "", false, fmt.Errorf("run xbe: %w", runErr)
+	}
+	return outBuf.String(), errBuf.String(), runErr != nil, nil
+}
+
+func (h *mcpHandler) Resources(context.Context) ([]mcp.Resource, error) {
+	resources := []mcp.Resource{
+		{
+			URI:         mcpKnowledgeURI + "guide",
+			Name:        "guide",
+			Title:       "Knowledge guide",
+			Description: "First-run playbook for exploring XBE resources and commands",
+			MimeType:    "application/json",
+		},
+		{
+			URI:         mcpKnowledgeURI + "resources",
+			Name:        "resources",
+			Title:       "Resource index",
+			Description: "Every resource with its label fields",
+			MimeType:    "application/json",
+		},
+	}
+	for _, name := range h.resources {
+		resources = append(resources, mcp.Resource{
+			URI:      mcpResourceURIPrefix + name,
+			Name:     name,
+			Title:    name,
+			MimeType: "application/json",
+		})
+	}
+	return resources, nil
+}
+
+func (h *mcpHandler) ResourceTemplates(context.Context) ([]mcp.ResourceTemplate, error) {
+	return []mcp.ResourceTemplate{
+		{
+			URITemplate: mcpResourceURIPrefix + "{name}",
+			Name:        "resource",
+			Title:       "Resource details",
+			Description: "Fields, relationships, summaries, and commands for a resource",
+			MimeType:    "application/json",
+		},
+		{
+			URITemplate: mcpCommandsURIPrefix + "{name}",
+			Name:        "resource-commands",
+			Title:       "Resource commands",
+			Description: "view, do, and summarize commands that operate on a resource",
+			MimeType:    "application/json",
+		},
+	}, nil
+}
+
+func (h *mcpHandler) ReadResource(ctx context.Context, uri string) ([]mcp.ResourceContents, error) {
+	var args []string
+	switch {
+	case uri == mcpKnowledgeURI+"guide":
+		args = []string{"knowledge", "guide", "--json"}
+	case uri == mcpKnowledgeURI+"resources":
+		args = []string{"knowledge", "resources", "--limit", "100000", "--json"}
+	// Only names from the resource index reach the command line, and never
+	// where they could be read as a flag.
+	case strings.HasPrefix(uri, mcpResourceURIPrefix) && slices.Contains(h.resources, strings.TrimPrefix(uri, mcpResourceURIPrefix)):
+		args = []string{"knowledge", "resource", "--json", "--", strings.TrimPrefix(uri, mcpResourceURIPrefix)}
+	case strings.HasPrefix(uri, mcpCommandsURIPrefix) && slices.Contains(h.resources, strings.TrimPrefix(uri, mcpCommandsURIPrefix)):
+		args = []string{"knowledge", "commands", "--limit", "100000", "--json", "--resource=" + strings.TrimPrefix(uri, mcpCommandsURIPrefix)}
+	default:
+		return nil, mcp.Errorf(mcp.CodeResourceNotFound, "resource not found: %s", uri)
+	}
+	stdout, stderr, failed, err := h.run(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		return nil, mcp.Errorf(mcp.CodeResourceNotFound, "%s: %s", uri, strings.TrimSpace(stderr))
+	}
+	return []mcp.ResourceContents{{URI: uri, MimeType: "application/json", Text: stdout}}, nil
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/mcp"
+)
+
+func TestBuildMCPToolArguments(t *testing.T) {
+	parent := &cobra.Command{Use: "do"}
+	parent.PersistentFlags().Bool("dry-run", false, "")
+	cmd := &cobra.Command{Use: "update <id>", RunE: func(*cobra.Command, []string) error { return nil }}
+	cmd.Flags().String("name", "", "Name")
+	cmd.Flags().Bool("active", true, "")
+	cmd.Flags().StringSlice("tags", nil, "")
+	cmd.Flags().Int("quantity", 0, "")
+	cmd.Flags().Bool("json", false, "")
+	cmd.Flags().String("token", "", "")
+	parent.AddCommand(cmd)
+
+	knowledge := commandKnowledge{flags: map[string]map[string]*knownFlag{
+		"do update": {"name": {required: true, description: "Material name", fieldLinks: []string{"materials.name"}}},
+	}}
+	tool, commandTool := buildMCPTool(cmd, []string{"do", "update"}, knowledge)
+
+	properties := tool.InputSchema["properties"].(map[string]any)
+	for _, name := range []string{"id", "name", "active", "tags", "quantity", "dry-run"} {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("missing property %q", name)
+		}
+	}
+	for _, name := range []string{"json", "token", "help"} {
+		if _, ok := properties[name]; ok {
+			t.Errorf("unexpected property %q", name)
+		}
+	}
+	if !reflect.DeepEqual(tool.InputSchema["required"], []string{"id", "name"}) {
+		t.Errorf("required = %v", tool.InputSchema["required"])
+	}
+	if description := properties["name"].(map[string]any)["description"].(string); !strings.Contains(description, "materials.name") {
+		t.Errorf("name description = %q", description)
+	}
+
+	args, err := commandTool.commandArgs(map[string]any{
+		"id":       "42",
+		"name":     "Sand",
+		"active":   false,
+		"tags":     []any{"a", "b"},
+		"quantity": float64(3),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"do", "update", "--active=false", "--name=Sand", "--quantity=3", "--tags=a,b", "--json", "--", "42"}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+
+	// A positional value that looks like a flag must stay a positional.
+	args, err = commandTool.commandArgs(map[string]any{"id": "--token=stolen"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.ParseFlags(args[2:]); err != nil {
+		t.Fatal(err)
+	}
+	if token, _ := cmd.Flags().GetString("token"); token != "" {
+		t.Errorf("positional value was parsed as --token=%s", token)
+	}
+	if positional := cmd.Flags().Args(); !reflect.DeepEqual(positional, []string{"--token=stolen"}) {
+		t.Errorf("positional args = %v", positional)
+	}
+
+	if _, err := commandTool.commandArgs(map[string]any{"bogus": "x"}); err == nil {
+		t.Error("expected unknown argument error")
+	}
+}
+
+func TestMCPToolNameLimit(t *testing.T) {
+	long := []string{"view", strings.Repeat("very-long-resource-name-", 4), "list"}
+	name := mcpToolName(long)
+	if len(name) != mcpMaxToolName {
+		t.Fatalf("name %q has length %d", name, len(name))
+	}
+	if mcpToolName([]string{"view", "brokers", "list"}) != "view_brokers_list" {
+		t.Fatal("short names should be joined unchanged")
+	}
+}
+
+func TestMCPReadResourceOnlyPassesKnownNames(t *testing.T) {
+	echo, err := exec.LookPath("echo")
+	if err != nil {
+		t.Skip("echo not available")
+	}
+	h := &mcpHandler{executable: echo, globalArgs: []string{"--profile", "dev"}, resources: []string{"brokers"}}
+
+	read := func(uri string) (string, error) {
+		contents, err := h.ReadResource(context.Background(), uri)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(contents[0].Text), nil
+	}
+	if got, err := read(mcpResourceURIPrefix + "brokers"); err != nil || got != "knowledge resource --json --profile dev -- brokers" {
+		t.Fatalf("resource argv = %q, %v", got, err)
+	}
+	if got, err := read(mcpCommandsURIPrefix + "brokers"); err != nil || got != "knowledge commands --limit 100000 --json --resource=brokers --profile dev" {
+		t.Fatalf("commands argv = %q, %v", got, err)
+	}
+	for _, uri := range []string{mcpResourceURIPrefix + "--db=/tmp/x", mcpCommandsURIPrefix + "brokers --db /tmp/x", mcpResourceURIPrefix + "unknown"} {
+		var mcpErr *mcp.Error
+		if _, err := read(uri); !errors.As(err, &mcpErr) || mcpErr.Code != mcp.CodeResourceNotFound {
+			t.Errorf("ReadResource(%q) = %v, want resource not found", uri, err)
+		}
+	}
+}
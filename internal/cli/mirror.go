@@ -0,0 +1,597 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+	"golang.org/x/term"
+)
+
+const (
+	mirrorDBEnv        = "XBE_MIRROR_DB"
+	mirrorSyncsTable   = "_mirror_syncs"
+	mirrorSyncedColumn = "_synced_at"
+)
+
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror",
+	Short: "Sync API resources into a local SQLite database and query it",
+	Long: `Keep a local SQLite copy of API resources for heavy analytical queries.
+
+'xbe mirror sync' pages through list endpoints and upserts each record into a
+table named after the resource (material-transactions -> material_transactions).
+Tables have one column per attribute in the resource map (hyphens become
+underscores), <relationship>_id and <relationship>_type columns for to-one
+relationships, a <relationship>_ids JSON array for to-many relationships, and
+_synced_at. Attributes the map does not know are added as columns when first
+seen. Each sync is recorded in the _mirror_syncs table.
+
+'xbe mirror query' runs read-only SQL over the mirror, like
+'xbe knowledge sql'.
+
+The mirror lives at <user cache dir>/xbe/mirror.sqlite unless --db or
+XBE_MIRROR_DB points elsewhere.`,
+	Example: `  # Initial sync
+  xbe mirror sync material-transactions tender-job-schedule-shifts time-cards
+
+  # Only records updated since the last sync
+  xbe mirror sync broker-tenders --since last
+
+  # Join across resources
+  xbe mirror query "SELECT t.id, t.status, s.start_at FROM time_cards t JOIN tender_job_schedule_shifts s ON s.id = t.tender_job_schedule_shift_id LIMIT 20"`,
+	Annotations: map[string]string{"group": GroupUtility},
+}
+
+var mirrorSyncCmd = &cobra.Command{
+	Use:   "sync <resource...>",
+	Short: "Fetch resources from the API into the mirror",
+	Long: `Fetch resources from the API into the mirror database.
+
+Every page of each resource's list endpoint is fetched and upserted by ID, so
+re-running a sync updates existing rows and adds new ones. Records deleted on
+the server are not removed.
+
+--since limits the sync to records updated on or after a time, using the
+list endpoint's updated-at-min filter. It accepts a date (2026-03-01), an
+RFC 3339 timestamp, a duration back from now (36h, 7d), or "last" for the
+start of the previous complete sync of that resource (a run stopped by
+--max does not count). Resources whose list endpoint
+has no updated-at filter are synced in full, with a note on stderr.`,
+	Example: `  xbe mirror sync material-transactions
+  xbe mirror sync time-cards tender-job-schedule-shifts --since 7d
+  xbe mirror sync broker-tenders --since last --json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runMirrorSync,
+}
+
+var mirrorQueryCmd = &cobra.Command{
+	Use:   "query [sql]",
+	Short: "Run read-only SQL against the mirror",
+	Long: `Run SQL against the mirror database on a read-only connection.
+
+Works like 'xbe knowledge sql': with a query argument, runs that statement and
+prints the rows through the standard output options; without one, opens an
+interactive console when stdin is a terminal or runs statements from stdin.
+Dot commands .tables, .schema [name], .help and .quit are available.`,
+	Example: `  xbe mirror query ".tables"
+  xbe mirror query "SELECT resource, synced_at, rows FROM _mirror_syncs"
+  xbe mirror query "SELECT status, COUNT(*) FROM time_cards GROUP BY status" --output csv`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMirrorQuery,
+}
+
+func init() {
+	mirrorCmd.PersistentFlags().String("db", "", "Mirror database path (env "+mirrorDBEnv+")")
+
+	mirrorSyncCmd.Flags().String("since", "", "Only records updated since a date, timestamp, duration (7d), or \"last\"")
+	mirrorSyncCmd.Flags().Int("page-size", api.DefaultPageSize, "Records per request")
+	mirrorSyncCmd.Flags().Int("max", 0, "Stop after this many records per resource (0 for all)")
+	mirrorSyncCmd.Flags().Bool("json", false, "Output JSON")
+	mirrorSyncCmd.Flags().String("base-url", defaultBaseURL(), "API base URL")
+	mirrorSyncCmd.Flags().String("token", "", "API token (optional)")
+
+	mirrorQueryCmd.Flags().Duration("timeout", 30*time.Second, "Cancel a statement that runs longer than this")
+	mirrorQueryCmd.Flags().Int("limit", 100, "Maximum rows to print (0 for all)")
+	mirrorQueryCmd.Flags().Int("offset", 0, "Rows to skip")
+	mirrorQueryCmd.Flags().Bool("json", false, "Output JSON")
+
+	mirrorCmd.AddCommand(mirrorSyncCmd)
+	mirrorCmd.AddCommand(mirrorQueryCmd)
+	rootCmd.AddCommand(mirrorCmd)
+}
+
+func mirrorDBPath(cmd *cobra.Command) string {
+	if value := strings.TrimSpace(getStringFlag(cmd, "db")); value != "" {
+		return value
+	}
+	if value := strings.TrimSpace(os.Getenv(mirrorDBEnv)); value != "" {
+		return value
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil || cacheDir == "" {
+		cacheDir = os.TempDir()
+	}
+	return filepath.Join(cacheDir, "xbe", "mirror.sqlite")
+}
+
+type mirrorSyncResult struct {
+	Resource    string `json:"resource"`
+	Table       string `json:"table"`
+	Rows        int    `json:"rows"`
+	Pages       int    `json:"pages"`
+	Since       string `json:"since,omitempty"`
+	Incremental bool   `json:"incremental"`
+}
+
+func runMirrorSync(cmd *cobra.Command, args []string) error {
+	resources, err := loadResourceMap()
+	if err != nil {
+		return fmt.Errorf("load resource map: %w", err)
+	}
+	for _, resource := range args {
+		if _, ok := resources.Resources[resource]; !ok {
+			return fmt.Errorf("%w: unknown resource %q (see 'xbe knowledge resources')", errInvalidInput, resource)
+		}
+	}
+	pageSize := getIntFlag(cmd, "page-size")
+	if pageSize <= 0 {
+		return fmt.Errorf("--page-size must be greater than 0")
+	}
+	maxRows := getIntFlag(cmd, "max")
+	rawSince := strings.TrimSpace(getStringFlag(cmd, "since"))
+
+	baseURL := getStringFlag(cmd, "base-url")
+	token := strings.TrimSpace(getStringFlag(cmd, "token"))
+	if token == "" {
+		if resolved, _, err := auth.ResolveToken(baseURL, ""); err == nil {
+			token = resolved
+		}
+	}
+	client := api.NewClient(baseURL, token)
+
+	mirror, err := openMirrorDB(mirrorDBPath(cmd))
+	if err != nil {
+		return err
+	}
+	defer mirror.db.Close()
+
+	results := []mirrorSyncResult{}
+	for _, resource := range args {
+		since, err := mirror.resolveSince(resource, rawSince, time.Now())
+		if err != nil {
+			return err
+		}
+		result, err := mirror.sync(cmd, client, resources, resource, since, pageSize, maxRows)
+		if err != nil {
+			return err
+		}
+		results = append(results, result)
+	}
+
+	if getBoolFlag(cmd, "json") {
+		return writeJSON(cmd.OutOrStdout(), results)
+	}
+	writer := newTabWriter(cmd)
+	fmt.Fprintln(writer, "RESOURCE\tTABLE\tROWS\tSINCE")
+	for _, result := range results {
+		since := "(all)"
+		if result.Incremental {
+			since = result.Since
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\n", result.Resource, result.Table, result.Rows, since)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Mirror: %s\n", mirror.path)
+	return nil
+}
+
+func runMirrorQuery(cmd *cobra.Command, args []string) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than 0")
+	}
+	path := mirrorDBPath(cmd)
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("no mirror at %s; run 'xbe mirror sync <resource>' first", path)
+	}
+	db, err := openReadOnlySQLite(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	console := &sqlConsole{
+		name:    "mirror",
+		db:      db,
+		timeout: timeout,
+		limit:   getIntFlag(cmd, "limit"),
+		offset:  getIntFlag(cmd, "offset"),
+		json:    getBoolFlag(cmd, "json"),
+		out:     cmd.OutOrStdout(),
+		errOut:  cmd.ErrOrStderr(),
+	}
+	if len(args) == 1 {
+		statements := splitSQLStatements(args[0])
+		if len(statements) != 1 {
+			return fmt.Errorf("run exactly one statement at a time (found %d)", len(statements))
+		}
+		console.single = true
+		return console.run(cmd.Context(), statements[0])
+	}
+	if _, buffered := cmd.Context().Value(outputSettingsKey).(outputSettings); buffered {
+		return fmt.Errorf("--output, --jq and --template-file need a query argument: xbe mirror query \"<query>\"")
+	}
+	return console.runInput(cmd.Context(), cmd.InOrStdin())
+}
+
+type mirrorDB struct {
+	db      *sql.DB
+	path    string
+	columns map[string]map[string]bool
+}
+
+func openMirrorDB(path string) (*mirrorDB, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("create mirror directory: %w", err)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	dsn := (&url.URL{Scheme: "file", Path: filepath.ToSlash(absPath), RawQuery: "_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"}).String()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open mirror: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+	mirror := &mirrorDB{db: db, path: path, columns: map[string]map[string]bool{}}
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS ` + mirrorSyncsTable + ` (
+		resource TEXT PRIMARY KEY,
+		table_name TEXT NOT NULL,
+		synced_at TEXT NOT NULL,
+		since TEXT,
+		rows INTEGER NOT NULL
+	)`); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open mirror %s: %w", path, err)
+	}
+	return mirror, nil
+}
+
+// resolveSince turns --since into the updated-at-min value for resource.
+func (m *mirrorDB) resolveSince(resource, raw string, now time.Time) (string, error) {
+	if raw != "last" {
+		return parseMirrorSince(raw, now)
+	}
+	var syncedAt string
+	err := m.db.QueryRow(`SELECT synced_at FROM `+mirrorSyncsTable+` WHERE resource = ?`, resource).Scan(&syncedAt)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return syncedAt, err
+}
+
+func parseMirrorSince(raw string, now time.Time) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+		return parsed.UTC().Format(time.RFC3339), nil
+	}
+	if _, err := time.Parse("2006-01-02", raw); err == nil {
+		return raw, nil
+	}
+	if strings.HasSuffix(raw, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(raw, "d")); err == nil && days > 0 {
+			return now.UTC().AddDate(0, 0, -days).Format(time.RFC3339), nil
+		}
+	}
+	if duration, err := time.ParseDuration(raw); err == nil && duration > 0 {
+		return now.UTC().Add(-duration).Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("%w: --since must be a date, RFC 3339 timestamp, duration (36h, 7d), or \"last\"", errInvalidInput)
+}
+
+// mirrorUpdatedAtFilter returns the updated-at-min query parameter the
+// resource's list command sends, or "" when it has none.
+func mirrorUpdatedAtFilter(resource string) string {
+	source := strings.ReplaceAll(resource, "-", "_") + "_list"
+	for _, route := range apiRoutes {
+		if route.Source != source || route.Method != "GET" {
+			continue
+		}
+		for _, filter := range route.Filters {
+			if filter == "updated-at-min" || filter == "updated_at_min" {
+				return "filter[" + filter + "]"
+			}
+		}
+	}
+	return ""
+}
+
+func mirrorTableName(resource string) string {
+	return strings.ReplaceAll(resource, "-", "_")
+}
+
+func mirrorColumnName(name string) string {
+	column := strings.ReplaceAll(name, "-", "_")
+	if column == "id" || column == "type" || strings.HasPrefix(column, "_") {
+		column = "attr_" + strings.TrimLeft(column, "_")
+	}
+	return column
+}
+
+func quoteSQLIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (m *mirrorDB) sync(cmd *cobra.Command, client *api.Client, resources resourceMap, resource, since string, pageSize, maxRows int) (mirrorSyncResult, error) {
+	table := mirrorTableName(resource)
+	result := mirrorSyncResult{Resource: resource, Table: table}
+	if err := m.ensureTable(table, mirrorColumns(resource, resources)); err != nil {
+		return result, err
+	}
+
+	// A stable order keeps offsets from skipping or repeating records.
+	query := url.Values{"sort": {"id"}}
+	if since != "" {
+		if filter := mirrorUpdatedAtFilter(resource); filter != "" {
+			query.Set(filter, since)
+			result.Since = since
+			result.Incremental = true
+		} else {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s: list endpoint has no updated-at filter; syncing all records\n", resource)
+		}
+	}
+
+	startedAt := time.Now().UTC().Format(time.RFC3339)
+	file, isFile := cmd.ErrOrStderr().(*os.File)
+	progress := isFile && term.IsTerminal(int(file.Fd()))
+	offset := 0
+	complete := false
+	for {
+		limit := pageSize
+		if maxRows > 0 && maxRows-result.Rows < limit {
+			limit = maxRows - result.Rows
+		}
+		query.Set("page[limit]", strconv.Itoa(limit))
+		query.Set("page[offset]", strconv.Itoa(offset))
+		body, _, err := client.Get(cmd.Context(), "/v1/"+resource, query)
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", resource, err)
+		}
+		var page struct {
+			Data []mirrorRecord `json:"data"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return result, fmt.Errorf("%s: decode page: %w", resource, err)
+		}
+		if err := m.upsertRecords(cmd.Context(), table, page.Data, startedAt); err != nil {
+			return result, fmt.Errorf("%s: %w", resource, err)
+		}
+		result.Pages++
+		result.Rows += len(page.Data)
+		if progress {
+			fmt.Fprintf(cmd.ErrOrStderr(), "\r%s: %d records", resource, result.Rows)
+		}
+		offset += len(page.Data)
+		if api.IsLastPage(body, offset) {
+			complete = true
+			break
+		}
+		if maxRows > 0 && result.Rows >= maxRows {
+			break
+		}
+	}
+	if progress {
+		fmt.Fprintln(cmd.ErrOrStderr())
+	}
+
+	// Records past --max were never fetched, so a later --since last must
+	// not start after them.
+	if !complete {
+		fmt.Fprintf(cmd.ErrOrStderr(), "%s: stopped at --max %d; sync time not recorded\n", resource, maxRows)
+		return result, nil
+	}
+	_, err := m.db.ExecContext(cmd.Context(), `INSERT INTO `+mirrorSyncsTable+` (resource, table_name, synced_at, since, rows)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(resource) DO UPDATE SET table_name = excluded.table_name, synced_at = excluded.synced_at, since = excluded.since, rows = excluded.rows`,
+		resource, table, startedAt, nullString(result.Since), result.Rows)
+	return result, err
+}
+
+// mirrorColumns lists the columns resource_map.json implies for a resource.
+func mirrorColumns(resource string, resources resourceMap) []string {
+	columns := []string{}
+	for _, attribute := range appendUniversalFields(append([]string{}, resources.Resources[resource].Attributes...)) {
+		columns = append(columns, mirrorColumnName(attribute))
+	}
+	for _, relation := range sortedRelationNames(resources.Relationships[resource]) {
+		base := strings.ReplaceAll(relation, "-", "_")
+		columns = append(columns, base+"_id", base+"_type")
+	}
+	return columns
+}
+
+func (m *mirrorDB) ensureTable(table string, columns []string) error {
+	definitions := []string{"id TEXT PRIMARY KEY", "type TEXT"}
+	seen := map[string]bool{"id": true, "type": true, mirrorSyncedColumn: true}
+	for _, column := range columns {
+		if !seen[column] {
+			seen[column] = true
+			definitions = append(definitions, quoteSQLIdent(column))
+		}
+	}
+	definitions = append(definitions, mirrorSyncedColumn+" TEXT")
+	if _, err := m.db.Exec(`CREATE TABLE IF NOT EXISTS ` + quoteSQLIdent(table) + ` (` + strings.Join(definitions, ", ") + `)`); err != nil {
+		return fmt.Errorf("create table %s: %w", table, err)
+	}
+	return m.loadColumns(table)
+}
+
+func (m *mirrorDB) loadColumns(table string) error {
+	rows, err := m.db.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columns := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		columns[name] = true
+	}
+	m.columns[table] = columns
+	return rows.Err()
+}
+
+type mirrorRecord struct {
+	ID            string                        `json:"id"`
+	Type          string                        `json:"type"`
+	Attributes    map[string]any                `json:"attributes"`
+	Relationships map[string]mirrorRelationship `json:"relationships"`
+}
+
+type mirrorRelationship struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// mirrorRow flattens a record into column values.
+func mirrorRow(record mirrorRecord, syncedAt string) map[string]any {
+	row := map[string]any{"id": record.ID, "type": record.Type, mirrorSyncedColumn: syncedAt}
+	for name, value := range record.Attributes {
+		row[mirrorColumnName(name)] = mirrorValue(value)
+	}
+	for name, relation := range record.Relationships {
+		base := strings.ReplaceAll(name, "-", "_")
+		data := strings.TrimSpace(string(relation.Data))
+		switch {
+		case data == "":
+			// Relationship links without data: leave existing values alone.
+		case data == "null":
+			row[base+"_id"] = nil
+			row[base+"_type"] = nil
+		case strings.HasPrefix(data, "{"):
+			var identifier jsonAPIResourceIdentifier
+			if json.Unmarshal(relation.Data, &identifier) == nil {
+				row[base+"_id"] = identifier.ID
+				row[base+"_type"] = identifier.Type
+			}
+		case strings.HasPrefix(data, "["):
+			var identifiers []jsonAPIResourceIdentifier
+			if json.Unmarshal(relation.Data, &identifiers) == nil {
+				ids := make([]string, 0, len(identifiers))
+				for _, identifier := range identifiers {
+					ids = append(ids, identifier.ID)
+				}
+				encoded, _ := json.Marshal(ids)
+				row[base+"_ids"] = string(encoded)
+			}
+		}
+	}
+	return row
+}
+
+// mirrorValue converts a JSON value to what SQLite stores natively: integers
+// stay integers, booleans become 0/1, objects and arrays become JSON text.
+func mirrorValue(value any) any {
+	switch typed := value.(type) {
+	case nil, string:
+		return typed
+	case bool:
+		if typed {
+			return 1
+		}
+		return 0
+	case float64:
+		if typed == float64(int64(typed)) {
+			return int64(typed)
+		}
+		return typed
+	default:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(encoded)
+	}
+}
+
+func (m *mirrorDB) upsertRecords(ctx context.Context, table string, records []mirrorRecord, syncedAt string) error {
+	if len(records) == 0 {
+		return nil
+	}
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	// Columns added in this transaction join the cache only once it commits;
+	// a rollback takes them back out of the table.
+	added := map[string]bool{}
+	for _, record := range records {
+		row := mirrorRow(record, syncedAt)
+		columns := make([]string, 0, len(row))
+		for column := range row {
+			if !m.columns[table][column] && !added[column] {
+				if _, err := tx.ExecContext(ctx, `ALTER TABLE `+quoteSQLIdent(table)+` ADD COLUMN `+quoteSQLIdent(column)); err != nil {
+					return fmt.Errorf("add column %s.%s: %w", table, column, err)
+				}
+				added[column] = true
+			}
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+		quoted := make([]string, len(columns))
+		placeholders := make([]string, len(columns))
+		updates := []string{}
+		values := make([]any, len(columns))
+		for i, column := range columns {
+			quoted[i] = quoteSQLIdent(column)
+			placeholders[i] = "?"
+			values[i] = row[column]
+			if column != "id" {
+				updates = append(updates, quoted[i]+" = excluded."+quoted[i])
+			}
+		}
+		statement := `INSERT INTO ` + quoteSQLIdent(table) + ` (` + strings.Join(quoted, ", ") + `) VALUES (` + strings.Join(placeholders, ", ") + `)` +
+			` ON CONFLICT(id) DO UPDATE SET ` + strings.Join(updates, ", ")
+		if _, err := tx.ExecContext(ctx, statement, values...); err != nil {
+			return fmt.Errorf("upsert %s %s: %w", table, record.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for column := range added {
+		m.columns[table][column] = true
+	}
+	return nil
+}
+
+func nullString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
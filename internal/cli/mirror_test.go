@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+func TestParseMirrorSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	cases := map[string]string{
+		"":                          "",
+		"2026-03-01":                "2026-03-01",
+		"2026-03-01T08:00:00-05:00": "2026-03-01T13:00:00Z",
+		"36h":                       "2026-03-09T00:00:00Z",
+		"7d":                        "2026-03-03T12:00:00Z",
+	}
+	for raw, want := range cases {
+		got, err := parseMirrorSince(raw, now)
+		if err != nil || got != want {
+			t.Errorf("parseMirrorSince(%q) = %q, %v; want %q", raw, got, err, want)
+		}
+	}
+	if _, err := parseMirrorSince("yesterday-ish", now); err == nil {
+		t.Error("expected an error for an unparseable --since")
+	}
+}
+
+func TestMirrorUpsert(t *testing.T) {
+	mirror, err := openMirrorDB(filepath.Join(t.TempDir(), "mirror.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mirror.db.Close()
+	if err := mirror.ensureTable("time_cards", []string{"status", "broker_id", "broker_type"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var records []mirrorRecord
+	page := `[
+		{"id":"1","type":"time-cards","attributes":{"status":"submitted","total-hours":7.5,"is-billable":true},
+		 "relationships":{"broker":{"data":{"type":"brokers","id":"9"}},"approvers":{"data":[{"type":"users","id":"3"}]}}}
+	]`
+	if err := json.Unmarshal([]byte(page), &records); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := mirror.upsertRecords(ctx, "time_cards", records, "2026-03-10T00:00:00Z"); err != nil {
+		t.Fatal(err)
+	}
+	records[0].Attributes["status"] = "approved"
+	if err := mirror.upsertRecords(ctx, "time_cards", records, "2026-03-11T00:00:00Z"); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		count             int
+		status, brokerID  string
+		hours             float64
+		billable          int
+		approvers, synced string
+	)
+	row := mirror.db.QueryRow(`SELECT COUNT(*), status, broker_id, total_hours, is_billable, approvers_ids, _synced_at FROM time_cards`)
+	if err := row.Scan(&count, &status, &brokerID, &hours, &billable, &approvers, &synced); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 || status != "approved" || brokerID != "9" || hours != 7.5 || billable != 1 || approvers != `["3"]` || synced != "2026-03-11T00:00:00Z" {
+		t.Fatalf("row = %d %q %q %v %d %q %q", count, status, brokerID, hours, billable, approvers, synced)
+	}
+
+	// A failed page rolls back the columns it added; the next page adds them
+	// again instead of trusting the cache.
+	if _, err := mirror.db.Exec(`CREATE TRIGGER reject_void BEFORE INSERT ON time_cards WHEN NEW.status = 'void' BEGIN SELECT RAISE(ABORT, 'void'); END`); err != nil {
+		t.Fatal(err)
+	}
+	records = []mirrorRecord{{ID: "2", Type: "time-cards", Attributes: map[string]any{"status": "void", "color": "red"}}}
+	if err := mirror.upsertRecords(ctx, "time_cards", records, "2026-03-12T00:00:00Z"); err == nil {
+		t.Fatal("upsert of a rejected row succeeded")
+	}
+	records[0].Attributes["status"] = "submitted"
+	if err := mirror.upsertRecords(ctx, "time_cards", records, "2026-03-12T00:00:00Z"); err != nil {
+		t.Fatalf("upsert after rollback: %v", err)
+	}
+}
+
+func TestMirrorSyncPagesInIDOrder(t *testing.T) {
+	var sorts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sorts = append(sorts, r.URL.Query().Get("sort"))
+		// At most 2 records per page, whatever page[limit] asks for.
+		offset, _ := strconv.Atoi(r.URL.Query().Get("page[offset]"))
+		data := []map[string]any{}
+		for i := offset; i < offset+2 && i < 3; i++ {
+			data = append(data, map[string]any{"id": strconv.Itoa(i + 1), "type": "brokers"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	mirror, err := openMirrorDB(filepath.Join(t.TempDir(), "mirror.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mirror.db.Close()
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	cmd.SetErr(io.Discard)
+
+	client := api.NewClient(server.URL, "test")
+
+	// A run cut short by --max leaves records unfetched, so it records no
+	// sync time for --since last to resume from.
+	result, err := mirror.sync(cmd, client, resourceMap{}, "brokers", "", 100, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if since, err := mirror.resolveSince("brokers", "last", time.Now()); result.Rows != 2 || err != nil || since != "" {
+		t.Fatalf("--max run: rows = %d, since last = %q, %v", result.Rows, since, err)
+	}
+
+	sorts = nil
+	result, err = mirror.sync(cmd, client, resourceMap{}, "brokers", "", 100, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Rows != 3 || !reflect.DeepEqual(sorts, []string{"id", "id", "id"}) {
+		t.Fatalf("rows = %d, sorts = %q; want 3 rows over 3 sorted pages", result.Rows, sorts)
+	}
+	if since, err := mirror.resolveSince("brokers", "last", time.Now()); err != nil || since == "" {
+		t.Fatalf("full run: since last = %q, %v", since, err)
+	}
+}
@@ -0,0 +1,415 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+)
+
+const (
+	nameRefPrefix = "name:"
+	// nameRefCacheTTL keeps resolutions for a working session: long enough
+	// to cover a run of commands, short enough to notice renamed records.
+	nameRefCacheTTL    = 15 * time.Minute
+	nameRefLookupLimit = 25
+	nameRefTimeout     = 15 * time.Second
+	nameRefListMax     = 10
+)
+
+// nameRefSession holds the resolutions made by this process so repeated
+// references (bulk rows, several flags) cost one lookup.
+var nameRefSession = struct {
+	sync.Mutex
+	ids map[string]string
+}{ids: map[string]string{}}
+
+type nameRefCandidate struct {
+	ID    string
+	Label string
+	Exact bool
+}
+
+// parseNameRef reports whether value refers to a record by name rather than
+// ID: name:Acme Paving or @"Acme Paving".
+func parseNameRef(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	var name string
+	switch {
+	case strings.HasPrefix(value, nameRefPrefix):
+		name = value[len(nameRefPrefix):]
+	case len(value) >= 3 && strings.HasPrefix(value, `@"`) && strings.HasSuffix(value, `"`):
+		name = value[2 : len(value)-1]
+	default:
+		return "", false
+	}
+	return strings.TrimSpace(name), true
+}
+
+// splitNameRefList splits a comma-separated flag value, keeping commas
+// inside @"..." references.
+func splitNameRefList(value string) []string {
+	parts := []string{}
+	quoted := false
+	start := 0
+	for i, r := range value {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// resolveNameRefFlags replaces name references in the ID flags of cmd with
+// the IDs they name. resource is the resource the command acts on, used to
+// map flags through its relationships.
+func resolveNameRefFlags(cmd *cobra.Command, resource string) error {
+	var refs []*pflag.Flag
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		for _, value := range nameRefFlagValues(flag) {
+			if _, ok := parseNameRef(value); ok {
+				refs = append(refs, flag)
+				return
+			}
+		}
+	})
+	if len(refs) == 0 {
+		return nil
+	}
+	resources, err := loadResourceMap()
+	if err != nil {
+		return err
+	}
+
+	resolver := &nameResolver{cmd: cmd}
+	for _, flag := range refs {
+		// Only flags documented as taking IDs resolve names; elsewhere a
+		// leading name: or @"..." is an ordinary value.
+		target := idFlagResource(flag.Name, resource, resources)
+		if target == "" || !strings.Contains(flag.Usage, "ID") {
+			continue
+		}
+		values := nameRefFlagValues(flag)
+		for i, value := range values {
+			name, ok := parseNameRef(value)
+			if !ok {
+				continue
+			}
+			id, err := resolver.resolve(flag.Name, target, name, resources.Resources[target].LabelFields)
+			if err != nil {
+				return err
+			}
+			values[i] = id
+		}
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			err = slice.Replace(values)
+		} else {
+			err = flag.Value.Set(strings.Join(values, ","))
+		}
+		if err != nil {
+			return fmt.Errorf("--%s: %w", flag.Name, err)
+		}
+	}
+	return nil
+}
+
+// nameRefFlagValues returns the values of a string or string-slice flag;
+// comma-separated string flags are split into their elements.
+func nameRefFlagValues(flag *pflag.Flag) []string {
+	switch flag.Value.Type() {
+	case "stringSlice", "stringArray":
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			return slice.GetSlice()
+		}
+	case "string":
+		if strings.Contains(strings.ToLower(flag.Usage), "comma") {
+			return splitNameRefList(flag.Value.String())
+		}
+		return []string{flag.Value.String()}
+	}
+	return nil
+}
+
+type nameResolver struct {
+	cmd     *cobra.Command
+	baseURL string
+	client  *api.Client
+}
+
+// resolve returns the ID of the single resource record named name, looking
+// in the session cache, then the list endpoint's label-field filters, then
+// the search catalog.
+func (r *nameResolver) resolve(flagName, resource, name string, labels []string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("%w: --%s has an empty name reference", errInvalidInput, flagName)
+	}
+	if r.client == nil {
+		r.connect()
+	}
+	identity := cacheIdentity(r.baseURL, r.client.Token)
+	key := identity + "|" + resource + "|" + strings.ToLower(name)
+	cachePath := nameRefCachePath(identity)
+
+	nameRefSession.Lock()
+	id, ok := nameRefSession.ids[key]
+	if !ok {
+		id, ok = readNameRefCache(cachePath, time.Now())[key]
+	}
+	nameRefSession.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	// Lookups run outside the command's context so its pagination, field,
+	// and dry-run settings do not apply to them; they still stop on Ctrl-C.
+	ctx, cancel := context.WithTimeout(context.Background(), nameRefTimeout)
+	defer cancel()
+	if parent := r.cmd.Context(); parent != nil {
+		stop := context.AfterFunc(parent, cancel)
+		defer stop()
+	}
+
+	candidates, err := r.listCandidates(ctx, resource, name, labels)
+	if err == nil && len(candidates) == 0 {
+		candidates, err = r.catalogCandidates(ctx, resource, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("--%s: %w", flagName, err)
+	}
+	id, err = pickNameRefCandidate(flagName, resource, name, candidates)
+	if err != nil {
+		return "", err
+	}
+
+	nameRefSession.Lock()
+	nameRefSession.ids[key] = id
+	writeNameRefCache(cachePath, key, id, time.Now())
+	nameRefSession.Unlock()
+	return id, nil
+}
+
+func (r *nameResolver) connect() {
+	r.baseURL = strings.TrimSpace(getStringFlag(r.cmd, "base-url"))
+	if r.baseURL == "" {
+		r.baseURL = defaultBaseURL()
+	}
+	token := strings.TrimSpace(getStringFlag(r.cmd, "token"))
+	if token == "" && !getBoolFlag(r.cmd, "no-auth") {
+		if resolved, _, err := auth.ResolveToken(r.baseURL, ""); err == nil {
+			token = resolved
+		}
+	}
+	r.client = api.NewClient(r.baseURL, token)
+}
+
+// listCandidates queries the resource's list with each label field it can
+// filter by (or its q search) and marks records whose label matches exactly.
+func (r *nameResolver) listCandidates(ctx context.Context, resource, name string, labels []string) ([]nameRefCandidate, error) {
+	route := listCommandRoute(resource)
+	if route == nil {
+		return nil, nil
+	}
+	filters := []string{}
+	for _, label := range labels {
+		if filter := routeFilterName(label, route.Filters); filter != "" {
+			filters = append(filters, filter)
+		}
+	}
+	if len(filters) == 0 {
+		if filter := routeFilterName("q", route.Filters); filter != "" {
+			filters = append(filters, filter)
+		}
+	}
+
+	candidates := []nameRefCandidate{}
+	seen := map[string]bool{}
+	for _, filter := range filters {
+		query := url.Values{}
+		query.Set("filter["+filter+"]", name)
+		query.Set("page[limit]", strconv.Itoa(nameRefLookupLimit))
+		if len(labels) > 0 {
+			query.Set("fields["+resource+"]", strings.Join(labels, ","))
+		}
+		body, _, err := r.client.Get(ctx, "/v1/"+resource, query)
+		if err != nil {
+			return nil, fmt.Errorf("look up %s named %q: %w", resource, name, err)
+		}
+		var resp jsonAPIResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("look up %s named %q: %w", resource, name, err)
+		}
+		for _, record := range resp.Data {
+			if seen[record.ID] {
+				continue
+			}
+			seen[record.ID] = true
+			candidate := nameRefCandidate{ID: record.ID, Label: completionLabel(record.Attributes, labels)}
+			candidate.Exact = strings.EqualFold(candidate.Label, name)
+			for _, label := range labels {
+				if strings.EqualFold(strings.TrimSpace(stringAttr(record.Attributes, label)), name) {
+					candidate.Exact = true
+				}
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates, nil
+}
+
+// catalogCandidates searches the search catalog for entries of the
+// resource's entity type (brokers -> Broker, material-sites -> MaterialSite).
+// Resources the catalog does not index yield no candidates.
+func (r *nameResolver) catalogCandidates(ctx context.Context, resource, name string) ([]nameRefCandidate, error) {
+	query := url.Values{}
+	query.Set("filter[search]", name)
+	query.Set("filter[entity-type]", nameRefEntityType(resource))
+	query.Set("page[limit]", strconv.Itoa(nameRefLookupLimit))
+	query.Set("fields[search-catalog-entries]", "entity-id,entity-type,display-text")
+	body, _, err := r.client.Get(ctx, "/v1/search-catalog-entries", query)
+	if err != nil {
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == 400 || apiErr.StatusCode == 404 || apiErr.StatusCode == 422) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("search %s named %q: %w", resource, name, err)
+	}
+	var resp jsonAPIResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("search %s named %q: %w", resource, name, err)
+	}
+	candidates := []nameRefCandidate{}
+	seen := map[string]bool{}
+	for _, entry := range resp.Data {
+		id := nameRefEntityID(entry.Attributes["entity-id"])
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		label := strings.TrimSpace(stringAttr(entry.Attributes, "display-text"))
+		candidates = append(candidates, nameRefCandidate{ID: id, Label: label, Exact: strings.EqualFold(label, name)})
+	}
+	return candidates, nil
+}
+
+func nameRefEntityType(resource string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(singularize(resource), "-") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+func nameRefEntityID(value any) string {
+	switch typed := value.(type) {
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case string:
+		return strings.TrimSpace(typed)
+	}
+	return ""
+}
+
+// pickNameRefCandidate chooses the record a name refers to: the only exact
+// label match, or the only match of any kind. Anything else is ambiguous and
+// the error lists the matches to choose from.
+func pickNameRefCandidate(flagName, resource, name string, candidates []nameRefCandidate) (string, error) {
+	exact := []nameRefCandidate{}
+	for _, candidate := range candidates {
+		if candidate.Exact {
+			exact = append(exact, candidate)
+		}
+	}
+	matches := candidates
+	if len(exact) > 0 {
+		matches = exact
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w: --%s: no %s named %q", errInvalidInput, flagName, resource, name)
+	case 1:
+		return matches[0].ID, nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--%s %q matches %d %s; use one of these IDs:", flagName, name, len(matches), resource)
+	for i, match := range matches {
+		if i == nameRefListMax {
+			fmt.Fprintf(&b, "\n  ... and %d more", len(matches)-i)
+			break
+		}
+		fmt.Fprintf(&b, "\n  %s  %s", match.ID, match.Label)
+	}
+	return "", fmt.Errorf("%w: %s", errInvalidInput, b.String())
+}
+
+type nameRefCacheEntry struct {
+	ID         string    `json:"id"`
+	ResolvedAt time.Time `json:"resolved_at"`
+}
+
+func nameRefCachePath(identity string) string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil || cacheDir == "" {
+		cacheDir = os.TempDir()
+	}
+	return filepath.Join(cacheDir, "xbe", "names", identity+".json")
+}
+
+func readNameRefCacheEntries(path string) map[string]nameRefCacheEntry {
+	entries := map[string]nameRefCacheEntry{}
+	if data, err := os.ReadFile(path); err == nil {
+		_ = json.Unmarshal(data, &entries)
+	}
+	return entries
+}
+
+// readNameRefCache returns the resolutions younger than nameRefCacheTTL.
+func readNameRefCache(path string, now time.Time) map[string]string {
+	ids := map[string]string{}
+	for key, entry := range readNameRefCacheEntries(path) {
+		if now.Sub(entry.ResolvedAt) < nameRefCacheTTL {
+			ids[key] = entry.ID
+		}
+	}
+	return ids
+}
+
+func writeNameRefCache(path, key, id string, now time.Time) {
+	entries := readNameRefCacheEntries(path)
+	for existing, entry := range entries {
+		if now.Sub(entry.ResolvedAt) >= nameRefCacheTTL {
+			delete(entries, existing)
+		}
+	}
+	entries[key] = nameRefCacheEntry{ID: id, ResolvedAt: now}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return
+	}
+	_ = os.Rename(tmp, path)
+}
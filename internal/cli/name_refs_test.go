@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestParseNameRef(t *testing.T) {
+	cases := map[string]string{
+		"name:Acme Paving":  "Acme Paving",
+		`@"Acme Paving"`:    "Acme Paving",
+		" name: Acme ":      "Acme",
+		`@"Beta, Inc"`:      "Beta, Inc",
+		"123":               "",
+		"@Acme":             "",
+		"named:Acme Paving": "",
+	}
+	for input, want := range cases {
+		name, ok := parseNameRef(input)
+		if ok != (want != "") || name != want {
+			t.Errorf("parseNameRef(%q) = %q, %v", input, name, ok)
+		}
+	}
+	if got := splitNameRefList(`@"Beta, Inc",name:Acme,7`); !reflect.DeepEqual(got, []string{`@"Beta, Inc"`, "name:Acme", "7"}) {
+		t.Errorf("splitNameRefList = %q", got)
+	}
+	if got := nameRefEntityType("material-sites"); got != "MaterialSite" {
+		t.Errorf("nameRefEntityType = %q", got)
+	}
+}
+
+func TestPickNameRefCandidate(t *testing.T) {
+	candidates := []nameRefCandidate{
+		{ID: "1", Label: "Acme", Exact: true},
+		{ID: "2", Label: "Acme Paving"},
+	}
+	if id, err := pickNameRefCandidate("broker", "brokers", "Acme", candidates); err != nil || id != "1" {
+		t.Errorf("exact match = %q, %v", id, err)
+	}
+	if id, err := pickNameRefCandidate("broker", "brokers", "Paving", candidates[1:]); err != nil || id != "2" {
+		t.Errorf("single match = %q, %v", id, err)
+	}
+	candidates[1].Exact = true
+	_, err := pickNameRefCandidate("broker", "brokers", "Acme", candidates)
+	if !errors.Is(err, errInvalidInput) || !strings.Contains(err.Error(), "2  Acme Paving") {
+		t.Errorf("ambiguous = %v", err)
+	}
+	if _, err := pickNameRefCandidate("broker", "brokers", "Nobody", nil); !errors.Is(err, errInvalidInput) {
+		t.Errorf("no match = %v", err)
+	}
+}
+
+func TestResolveNameRefFlags(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+	lookups := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/brokers" || r.URL.Query().Get("filter[company-name]") != "Acme Paving" {
+			http.NotFound(w, r)
+			return
+		}
+		lookups++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"12","type":"brokers","attributes":{"company-name":"Acme Paving"}},
+			{"id":"13","type":"brokers","attributes":{"company-name":"Acme Paving East"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	newCmd := func(value, token string) *cobra.Command {
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().String("broker", "", "Filter by broker ID")
+		cmd.Flags().String("notes", "", "Notes")
+		cmd.Flags().String("base-url", server.URL, "API base URL")
+		cmd.Flags().String("token", token, "API token")
+		_ = cmd.Flags().Set("broker", value)
+		_ = cmd.Flags().Set("notes", "name:not a reference")
+		return cmd
+	}
+
+	// Resolutions are cached per token: another profile looks the name up again.
+	for _, token := range []string{"test", "test", "other"} {
+		cmd := newCmd(`@"Acme Paving"`, token)
+		if err := resolveNameRefFlags(cmd, "tender-job-schedule-shifts"); err != nil {
+			t.Fatal(err)
+		}
+		if got := getStringFlag(cmd, "broker"); got != "12" {
+			t.Errorf("broker = %q, want 12", got)
+		}
+		if got := getStringFlag(cmd, "notes"); got != "name:not a reference" {
+			t.Errorf("notes = %q, want it untouched", got)
+		}
+	}
+	if lookups != 2 {
+		t.Errorf("lookups = %d, want the repeated resolution cached", lookups)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/config"
 )
 
 type jsonAPIResponse struct {
@@ -264,6 +265,10 @@ func writeJSON(out io.Writer, value any) error {
 	return err
 }
 
+// builtinBaseURL is the API server used when neither the environment nor the
+// active profile names one.
+const builtinBaseURL = "https://server.x-b-e.com"
+
 func defaultBaseURL() string {
 	if value := strings.TrimSpace(os.Getenv("XBE_BASE_URL")); value != "" {
 		return value
@@ -271,7 +276,10 @@ func defaultBaseURL() string {
 	if value := strings.TrimSpace(os.Getenv("XBE_API_BASE_URL")); value != "" {
 		return value
 	}
-	return "https://server.x-b-e.com"
+	if _, profile, ok, err := config.ActiveProfile(); err == nil && ok && profile.BaseURL != "" {
+		return profile.BaseURL
+	}
+	return builtinBaseURL
 }
 
 // decodeHTMLEntities replaces common HTML entities with their character equivalents
@@ -11,23 +11,31 @@ import (
 
 	"github.com/itchyny/gojq"
 	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
 	"sigs.k8s.io/yaml"
 )
 
 type outputFormat string
 
 const (
-	outputTable outputFormat = "table"
-	outputJSON  outputFormat = "json"
-	outputYAML  outputFormat = "yaml"
+	outputTable    outputFormat = "table"
+	outputJSON     outputFormat = "json"
+	outputYAML     outputFormat = "yaml"
+	outputCSV      outputFormat = "csv"
+	outputTSV      outputFormat = "tsv"
+	outputNDJSON   outputFormat = "ndjson"
+	outputTemplate outputFormat = "template"
 )
 
 type outputSettings struct {
 	Format      outputFormat
 	JQ          string
 	Buffer      *bytes.Buffer
+	Stream      *ndjsonStream
 	OriginalOut io.Writer
 	OutputSet   bool
+	Template    string
+	Recorder    *api.ResponseRecorder
 }
 
 type outputContextKey string
@@ -42,7 +50,10 @@ func initOutputFlags(cmd *cobra.Command) {
 	}
 	flags := cmd.PersistentFlags()
 	if flags.Lookup("output") == nil {
-		flags.String("output", string(outputTable), "Output format: table, json, yaml")
+		flags.String("output", string(outputTable), "Output format: table, json, yaml, csv, tsv, ndjson, template=<go-template>")
+	}
+	if flags.Lookup("template-file") == nil {
+		flags.String("template-file", "", "Render output with a Go text/template file (implies --output template)")
 	}
 	if flags.Lookup("jq") == nil {
 		flags.String("jq", "", "Apply jq-style filter to JSON output")
@@ -63,10 +74,17 @@ func prepareOutput(cmd *cobra.Command) error {
 			return err
 		}
 	}
-	if settings.Buffer != nil {
+	preferredOutputColumns = nil
+	if settings.Format == outputNDJSON && settings.JQ == "" {
+		settings.Stream = newNDJSONStream(settings.OriginalOut)
 		lastOutputCmd = cmd
-		cmd.SetOut(settings.Buffer)
+		cmd.SetOut(settings.Stream)
 		cmd.SetContext(context.WithValue(cmd.Context(), outputSettingsKey, settings))
+	} else if settings.Buffer != nil {
+		lastOutputCmd = cmd
+		cmd.SetOut(settings.Buffer)
+		ctx := context.WithValue(cmd.Context(), outputSettingsKey, settings)
+		cmd.SetContext(api.WithResponseRecorder(ctx, settings.Recorder))
 	}
 	return nil
 }
@@ -76,6 +94,13 @@ func finalizeOutput(cmdErr error) error {
 		return cmdErr
 	}
 	settings, ok := lastOutputCmd.Context().Value(outputSettingsKey).(outputSettings)
+	if ok && settings.Stream != nil {
+		lastOutputCmd = nil
+		if err := settings.Stream.Close(); err != nil && cmdErr == nil {
+			return err
+		}
+		return cmdErr
+	}
 	if !ok || settings.Buffer == nil {
 		lastOutputCmd = nil
 		return cmdErr
@@ -106,6 +131,15 @@ func finalizeOutput(cmdErr error) error {
 	switch settings.Format {
 	case outputYAML:
 		return writeYAMLOutput(settings.OriginalOut, value)
+	case outputCSV:
+		return writeDelimitedOutput(settings.OriginalOut, value, payload, ',')
+	case outputTSV:
+		return writeDelimitedOutput(settings.OriginalOut, value, payload, '\t')
+	case outputNDJSON:
+		// Only reached with --jq; plain NDJSON streams.
+		return writeNDJSONOutput(settings.OriginalOut, value)
+	case outputTemplate:
+		return writeTemplateOutput(settings.OriginalOut, value, settings)
 	case outputJSON:
 		return writeJSONOutput(settings.OriginalOut, value)
 	default:
@@ -114,11 +148,25 @@ func finalizeOutput(cmdErr error) error {
 }
 
 func resolveOutputSettings(cmd *cobra.Command) (outputSettings, error) {
-	outputRaw := strings.ToLower(strings.TrimSpace(getStringFlag(cmd, "output")))
+	outputRaw := strings.TrimSpace(getStringFlag(cmd, "output"))
+	templateText := ""
+	if name, text, ok := strings.Cut(outputRaw, "="); ok && strings.EqualFold(strings.TrimSpace(name), string(outputTemplate)) {
+		outputRaw = string(outputTemplate)
+		templateText = text
+	}
+	outputRaw = strings.ToLower(outputRaw)
 	if outputRaw == "" {
 		outputRaw = string(outputTable)
 	}
 	outputChanged := flagChanged(cmd, "output")
+	templateFile := strings.TrimSpace(getStringFlag(cmd, "template-file"))
+	if templateFile != "" {
+		if outputChanged && outputRaw != string(outputTemplate) {
+			return outputSettings{}, fmt.Errorf("--template-file cannot be combined with --output %s", outputRaw)
+		}
+		outputRaw = string(outputTemplate)
+		outputChanged = true
+	}
 	jqExpr := strings.TrimSpace(getStringFlag(cmd, "jq"))
 	jsonFlag := getBoolFlag(cmd, "json")
 
@@ -130,12 +178,12 @@ func resolveOutputSettings(cmd *cobra.Command) (outputSettings, error) {
 	}
 
 	format := outputFormat(outputRaw)
-	if format != outputTable && format != outputJSON && format != outputYAML {
-		return outputSettings{}, fmt.Errorf("invalid --output value %q (use table, json, yaml)", outputRaw)
+	if !validOutputFormat(format) {
+		return outputSettings{}, fmt.Errorf("invalid --output value %q (use table, json, yaml, csv, tsv, ndjson, template=...)", outputRaw)
 	}
 
 	if jqExpr != "" && outputChanged && format == outputTable {
-		return outputSettings{}, fmt.Errorf("--jq requires JSON or YAML output")
+		return outputSettings{}, fmt.Errorf("--jq requires JSON, YAML, CSV, TSV, NDJSON or template output")
 	}
 
 	if (format != outputTable || jqExpr != "") && !commandSupportsJSON(cmd) {
@@ -148,14 +196,38 @@ func resolveOutputSettings(cmd *cobra.Command) (outputSettings, error) {
 		OutputSet: outputChanged,
 	}
 
-	if jqExpr != "" || format == outputYAML {
-		settings.Buffer = &bytes.Buffer{}
+	if format == outputTemplate {
+		text, err := loadTemplateText(templateText, templateFile)
+		if err != nil {
+			return outputSettings{}, err
+		}
+		// Parse up front so template mistakes fail before any API call.
+		if _, err := parseOutputTemplate(text, &templateData{}); err != nil {
+			return outputSettings{}, err
+		}
+		settings.Template = text
+		settings.Recorder = &api.ResponseRecorder{}
+	}
+
+	if jqExpr != "" || (format != outputTable && format != outputJSON) {
 		settings.OriginalOut = cmd.OutOrStdout()
+		if format != outputNDJSON || jqExpr != "" {
+			settings.Buffer = &bytes.Buffer{}
+		}
 	}
 
 	return settings, nil
 }
 
+func validOutputFormat(format outputFormat) bool {
+	switch format {
+	case outputTable, outputJSON, outputYAML, outputCSV, outputTSV, outputNDJSON, outputTemplate:
+		return true
+	default:
+		return false
+	}
+}
+
 func commandSupportsJSON(cmd *cobra.Command) bool {
 	if cmd == nil {
 		return false
@@ -0,0 +1,322 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// preferredOutputColumns holds the column order chosen by the command (for
+// example the sparse field selection). Tabular formats use it before falling
+// back to the key order in the JSON payload.
+var preferredOutputColumns []string
+
+// setOutputColumns records the preferred column order for csv/tsv output.
+func setOutputColumns(columns []string) {
+	preferredOutputColumns = append([]string(nil), columns...)
+}
+
+// writeDelimitedOutput flattens value into rows and writes them as CSV or TSV
+// with a header line.
+func writeDelimitedOutput(out io.Writer, value any, payload []byte, comma rune) error {
+	items := outputRows(value)
+	rows := make([]map[string]string, 0, len(items))
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, item := range items {
+		row := map[string]string{}
+		flattenOutputValue("", item, row)
+		for key := range row {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+		rows = append(rows, row)
+	}
+	columns := orderOutputColumns(keys, append(append([]string{}, preferredOutputColumns...), payloadKeyOrder(payload)...))
+
+	writer := csv.NewWriter(out)
+	writer.Comma = comma
+	if len(columns) > 0 {
+		if err := writer.Write(columns); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for idx, column := range columns {
+			record[idx] = row[column]
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeNDJSONOutput writes one compact JSON document per line, one per row.
+func writeNDJSONOutput(out io.Writer, value any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	for _, item := range outputRows(value) {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ndjsonStream turns the JSON a command writes into NDJSON as it arrives, so
+// each element of a top-level array is printed as soon as it is complete
+// instead of after the command finishes.
+type ndjsonStream struct {
+	pipe *io.PipeWriter
+	done chan error
+}
+
+func newNDJSONStream(out io.Writer) *ndjsonStream {
+	reader, writer := io.Pipe()
+	stream := &ndjsonStream{pipe: writer, done: make(chan error, 1)}
+	go func() {
+		err := streamNDJSON(reader, out)
+		// Unblock the command's writes if the output stops being JSON.
+		reader.CloseWithError(err)
+		stream.done <- err
+	}()
+	return stream
+}
+
+func (s *ndjsonStream) Write(p []byte) (int, error) {
+	return s.pipe.Write(p)
+}
+
+// Close waits for everything written so far to be printed.
+func (s *ndjsonStream) Close() error {
+	s.pipe.Close()
+	return <-s.done
+}
+
+func streamNDJSON(in io.Reader, out io.Writer) error {
+	decoder := json.NewDecoder(in)
+	decoder.UseNumber()
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if token != json.Delim('[') {
+			value, err := decodeJSONValue(decoder, token)
+			if err != nil {
+				return err
+			}
+			for _, item := range outputRows(value) {
+				if err := encoder.Encode(item); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		for decoder.More() {
+			var item any
+			if err := decoder.Decode(&item); err != nil {
+				return err
+			}
+			if err := encoder.Encode(item); err != nil {
+				return err
+			}
+		}
+		if _, err := decoder.Token(); err != nil {
+			return err
+		}
+	}
+}
+
+// decodeJSONValue finishes decoding the value that starts with token.
+func decodeJSONValue(decoder *json.Decoder, token json.Token) (any, error) {
+	if token != json.Delim('{') {
+		return token, nil
+	}
+	object := map[string]any{}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		object[key.(string)] = value
+	}
+	_, err := decoder.Token()
+	return object, err
+}
+
+// outputRows treats a top-level array as a list of rows and anything else as a
+// single row.
+func outputRows(value any) []any {
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return typed
+	default:
+		return []any{typed}
+	}
+}
+
+// flattenOutputValue turns nested objects into dotted column names
+// (e.g. "attributes.name"). Arrays are kept as JSON text in one column.
+func flattenOutputValue(prefix string, value any, row map[string]string) {
+	switch typed := value.(type) {
+	case map[string]any:
+		if len(typed) == 0 && prefix != "" {
+			row[prefix] = ""
+			return
+		}
+		for key, nested := range typed {
+			flattenOutputValue(joinColumn(prefix, key), nested, row)
+		}
+	default:
+		if prefix == "" {
+			prefix = "value"
+		}
+		row[prefix] = formatOutputCell(typed)
+	}
+}
+
+func joinColumn(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func formatOutputCell(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		if typed {
+			return "true"
+		}
+		return "false"
+	case []any:
+		if len(typed) == 0 {
+			return ""
+		}
+		payload, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprintf("%v", typed)
+		}
+		return string(payload)
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}
+
+// orderOutputColumns orders keys by the preferred list (first occurrence wins;
+// a preferred "relation" also places "relation.*" columns), then appends any
+// remaining keys alphabetically.
+func orderOutputColumns(keys []string, preferred []string) []string {
+	remaining := makeSet(keys)
+	columns := make([]string, 0, len(keys))
+	sortedKeys := append([]string{}, keys...)
+	sort.Strings(sortedKeys)
+	for _, name := range preferred {
+		if remaining[name] {
+			columns = append(columns, name)
+			delete(remaining, name)
+			continue
+		}
+		for _, key := range sortedKeys {
+			if remaining[key] && strings.HasPrefix(key, name+".") {
+				columns = append(columns, key)
+				delete(remaining, key)
+			}
+		}
+	}
+	for _, key := range sortedKeys {
+		if remaining[key] {
+			columns = append(columns, key)
+		}
+	}
+	return columns
+}
+
+// payloadKeyOrder returns flattened object keys in the order they first appear
+// in the JSON payload. Row structs marshal fields in declaration order, so this
+// keeps their column order even though decoding into maps loses it.
+func payloadKeyOrder(payload []byte) []string {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	seen := map[string]bool{}
+	keys := []string{}
+	var walk func(prefix string) error
+	walk = func(prefix string) error {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		delim, ok := token.(json.Delim)
+		if !ok {
+			if prefix != "" && !seen[prefix] {
+				seen[prefix] = true
+				keys = append(keys, prefix)
+			}
+			return nil
+		}
+		switch delim {
+		case '{':
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				key, _ := keyToken.(string)
+				if err := walk(joinColumn(prefix, key)); err != nil {
+					return err
+				}
+			}
+		case '[':
+			// Array elements at the top level are rows; nested arrays are
+			// single cells.
+			for decoder.More() {
+				if prefix == "" {
+					if err := walk(""); err != nil {
+						return err
+					}
+					continue
+				}
+				var skip json.RawMessage
+				if err := decoder.Decode(&skip); err != nil {
+					return err
+				}
+			}
+			if prefix != "" && !seen[prefix] {
+				seen[prefix] = true
+				keys = append(keys, prefix)
+			}
+		}
+		_, err = decoder.Token()
+		return err
+	}
+	// A truncated walk still yields the keys seen so far.
+	_ = walk("")
+	return keys
+}
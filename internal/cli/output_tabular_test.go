@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestWriteDelimitedOutputFlattensAndOrdersColumns(t *testing.T) {
+	payload := []byte(`[{"id":"1","name":"Acme","address":{"city":"Austin","zip":"78701"},"tags":["a","b"]},{"id":"2","name":"Beta","extra":true}]`)
+	value, err := decodeJSON(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := writeDelimitedOutput(&out, value, payload, ','); err != nil {
+		t.Fatal(err)
+	}
+	expected := "id,name,address.city,address.zip,tags,extra\n" +
+		"1,Acme,Austin,78701,\"[\"\"a\"\",\"\"b\"\"]\",\n" +
+		"2,Beta,,,,true\n"
+	if out.String() != expected {
+		t.Fatalf("unexpected csv:\n%s", out.String())
+	}
+}
+
+func TestWriteDelimitedOutputUsesPreferredColumns(t *testing.T) {
+	setOutputColumns([]string{"id", "status", "broker"})
+	defer setOutputColumns(nil)
+
+	payload := []byte(`[{"broker":"Acme","id":"7","status":"open"}]`)
+	value, err := decodeJSON(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := writeDelimitedOutput(&out, value, payload, '\t'); err != nil {
+		t.Fatal(err)
+	}
+	expected := "id\tstatus\tbroker\n7\topen\tAcme\n"
+	if out.String() != expected {
+		t.Fatalf("unexpected tsv:\n%q", out.String())
+	}
+}
+
+func TestWriteNDJSONOutput(t *testing.T) {
+	value, err := decodeJSON([]byte(`[{"id":"1"},{"id":"2","n":3}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := writeNDJSONOutput(&out, value); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "{\"id\":\"1\"}\n{\"id\":\"2\",\"n\":3}\n" {
+		t.Fatalf("unexpected ndjson:\n%s", out.String())
+	}
+}
+
+func TestNDJSONStreamPrintsRecordsAsWritten(t *testing.T) {
+	reader, writer := io.Pipe()
+	stream := newNDJSONStream(writer)
+	lines := bufio.NewReader(reader)
+	readLine := func() string {
+		t.Helper()
+		line, err := lines.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		return line
+	}
+
+	// The first record is printed before the command writes the rest.
+	if _, err := io.WriteString(stream, "[\n  {\"id\": \"1\"},\n"); err != nil {
+		t.Fatal(err)
+	}
+	if line := readLine(); line != "{\"id\":\"1\"}\n" {
+		t.Fatalf("first line = %q", line)
+	}
+
+	closed := make(chan error, 1)
+	go func() {
+		_, _ = io.WriteString(stream, "  {\"id\": \"2\", \"n\": 3}\n]\n{\"id\": \"3\"}\n")
+		closed <- stream.Close()
+	}()
+	for _, want := range []string{"{\"id\":\"2\",\"n\":3}\n", "{\"id\":\"3\"}\n"} {
+		if line := readLine(); line != want {
+			t.Fatalf("line = %q, want %q", line, want)
+		}
+	}
+	if err := <-closed; err != nil {
+		t.Fatal(err)
+	}
+}
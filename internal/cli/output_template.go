@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+// templateData exposes the raw JSON:API document behind the command output so
+// template helpers can resolve relationships against its included records.
+type templateData struct {
+	document map[string]any
+	included map[string]map[string]any
+}
+
+// loadTemplateText returns the template from --output template=... or
+// --template-file.
+func loadTemplateText(inline, file string) (string, error) {
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read template file: %w", err)
+		}
+		return string(content), nil
+	}
+	if strings.TrimSpace(inline) == "" {
+		return "", fmt.Errorf("--output template requires a template (template=...) or --template-file")
+	}
+	return inline, nil
+}
+
+func parseOutputTemplate(text string, data *templateData) (*template.Template, error) {
+	tmpl, err := template.New("output").Option("missingkey=zero").Funcs(templateFuncs(data)).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func writeTemplateOutput(out io.Writer, value any, settings outputSettings) error {
+	data := newTemplateData(settings.Recorder)
+	tmpl, err := parseOutputTemplate(settings.Template, data)
+	if err != nil {
+		return err
+	}
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, value); err != nil {
+		return fmt.Errorf("render template: %w", err)
+	}
+	text := rendered.String()
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	_, err = io.WriteString(out, text)
+	return err
+}
+
+func newTemplateData(recorder *api.ResponseRecorder) *templateData {
+	data := &templateData{included: map[string]map[string]any{}}
+	body := recorder.Last()
+	if len(body) == 0 {
+		return data
+	}
+	value, err := decodeJSON(body)
+	if err != nil {
+		return data
+	}
+	document, ok := value.(map[string]any)
+	if !ok {
+		return data
+	}
+	data.document = document
+	if items, ok := document["included"].([]any); ok {
+		for _, item := range items {
+			record, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			typ, _ := record["type"].(string)
+			id, _ := record["id"].(string)
+			data.included[resourceKey(typ, id)] = record
+		}
+	}
+	return data
+}
+
+func templateFuncs(data *templateData) template.FuncMap {
+	return template.FuncMap{
+		// Formatting
+		"date":     templateDate,
+		"datetime": templateDateTime,
+		"truncate": func(max int, value any) string { return truncateString(templateString(value), max) },
+		"default": func(fallback, value any) any {
+			if templateString(value) == "" {
+				return fallback
+			}
+			return value
+		},
+		"upper": func(value any) string { return strings.ToUpper(templateString(value)) },
+		"lower": func(value any) string { return strings.ToLower(templateString(value)) },
+		"join": func(sep string, value any) string {
+			items, ok := value.([]any)
+			if !ok {
+				return templateString(value)
+			}
+			parts := make([]string, 0, len(items))
+			for _, item := range items {
+				parts = append(parts, templateString(item))
+			}
+			return strings.Join(parts, sep)
+		},
+		"json": func(value any) (string, error) {
+			payload, err := json.Marshal(value)
+			return string(payload), err
+		},
+
+		// JSON:API access
+		"document": func() map[string]any { return data.document },
+		"attr":     templateAttr,
+		"related":  data.related,
+		"label":    data.label,
+		"included": func(typ, id string) map[string]any { return data.included[resourceKey(typ, id)] },
+	}
+}
+
+// related returns the included record a relationship points at, or nil.
+func (d *templateData) related(resource any, relationship string) map[string]any {
+	typ, id := templateRelationshipRef(resource, relationship)
+	if typ == "" || id == "" {
+		return nil
+	}
+	return d.included[resourceKey(typ, id)]
+}
+
+// label returns a display name for a relationship, resolving included records
+// the same way resolveOrganization does and falling back to "type:id".
+func (d *templateData) label(resource any, relationship string) string {
+	typ, id := templateRelationshipRef(resource, relationship)
+	if typ == "" || id == "" {
+		return ""
+	}
+	if record, ok := d.included[resourceKey(typ, id)]; ok {
+		attrs, _ := record["attributes"].(map[string]any)
+		name := firstNonEmpty(
+			stringAttr(attrs, "company-name"),
+			stringAttr(attrs, "name"),
+			stringAttr(attrs, "title"),
+		)
+		if name != "" {
+			return name
+		}
+	}
+	return fmt.Sprintf("%s:%s", typ, id)
+}
+
+func templateRelationshipRef(resource any, relationship string) (string, string) {
+	record, ok := resource.(map[string]any)
+	if !ok {
+		return "", ""
+	}
+	relationships, _ := record["relationships"].(map[string]any)
+	rel, _ := relationships[relationship].(map[string]any)
+	ref, _ := rel["data"].(map[string]any)
+	if ref == nil {
+		return "", ""
+	}
+	typ, _ := ref["type"].(string)
+	id, _ := ref["id"].(string)
+	return typ, id
+}
+
+// templateAttr reads a key from a JSON:API resource's attributes, or from the
+// value itself when it is a plain row object.
+func templateAttr(resource any, key string) any {
+	record, ok := resource.(map[string]any)
+	if !ok {
+		return nil
+	}
+	if attrs, ok := record["attributes"].(map[string]any); ok {
+		if value, ok := attrs[key]; ok {
+			return value
+		}
+	}
+	return record[key]
+}
+
+func templateDate(value any) string {
+	return formatDate(templateString(value))
+}
+
+// templateDateTime formats an RFC3339 timestamp with a Go layout, e.g.
+// {{ datetime "Jan 2 3:04PM" .start_at }}.
+func templateDateTime(layout string, value any) string {
+	text := strings.TrimSpace(templateString(value))
+	if text == "" {
+		return ""
+	}
+	parsed, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return text
+	}
+	return parsed.Format(layout)
+}
+
+func templateString(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+func TestWriteTemplateOutputResolvesIncluded(t *testing.T) {
+	document := []byte(`{
+		"data": [{"id": "1", "type": "job-production-plans", "attributes": {"job-name": "Paving Main Street", "start-on": "2026-03-04T07:00:00Z"}, "relationships": {"customer": {"data": {"type": "customers", "id": "9"}}}}],
+		"included": [{"id": "9", "type": "customers", "attributes": {"company-name": "Acme Paving"}}]
+	}`)
+	recorder := &api.ResponseRecorder{}
+	server := newTemplateTestServer(t, document)
+	defer server.Close()
+	client := api.NewClient(server.URL, "")
+	if _, _, err := client.Get(api.WithResponseRecorder(context.Background(), recorder), "/v1/job-production-plans", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	settings := outputSettings{
+		Template: `{{range document.data}}{{attr . "job-name" | truncate 10}} for {{label . "customer"}} on {{attr . "start-on" | date}}{{end}}`,
+		Recorder: recorder,
+	}
+	var out bytes.Buffer
+	if err := writeTemplateOutput(&out, []any{}, settings); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "Paving ... for Acme Paving on 2026-03-04\n" {
+		t.Fatalf("unexpected output: %q", out.String())
+	}
+}
+
+func TestParseOutputTemplateRejectsInvalidTemplate(t *testing.T) {
+	if _, err := parseOutputTemplate("{{ .name ", &templateData{}); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
+
+func newTemplateTestServer(t *testing.T, body []byte) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write(body)
+	}))
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+func initPaginationFlags(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	if flags.Lookup("all") == nil {
+		flags.Bool("all", false, "Fetch every page of results (list only)")
+	}
+	if flags.Lookup("max-items") == nil {
+		flags.Int("max-items", 0, "Fetch pages until this many results are collected (list only)")
+	}
+}
+
+// applyPaginationContext stores automatic pagination settings on the command
+// context so api.Client.Get walks and merges every page for list endpoints.
+func applyPaginationContext(cmd *cobra.Command) error {
+	all := getBoolFlag(cmd, "all")
+	maxItems := getIntFlag(cmd, "max-items")
+	if !all && maxItems == 0 {
+		return nil
+	}
+	if maxItems < 0 {
+		return fmt.Errorf("--max-items must be greater than zero")
+	}
+	if !isListCommand(cmd) {
+		return fmt.Errorf("--all and --max-items are only supported on view <resource> list commands")
+	}
+
+	overrides := api.PaginationOverrides{
+		All:      all,
+		MaxItems: maxItems,
+		PageSize: getIntFlag(cmd, "limit"),
+	}
+	cmd.SetContext(api.WithPaginationOverrides(cmd.Context(), overrides))
+	return nil
+}
+
+func isListCommand(cmd *cobra.Command) bool {
+	parts := strings.Fields(cmd.CommandPath())
+	if len(parts) < 4 {
+		return false
+	}
+	return parts[1] == "view" && parts[len(parts)-1] == "list"
+}
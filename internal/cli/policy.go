@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/policy"
+)
+
+var (
+	policyOnce   sync.Once
+	loadedPolicy policy.Policy
+	policyErr    error
+)
+
+func initPolicyFlags(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	if flags.Lookup("read-only") == nil {
+		flags.Bool("read-only", false, "Refuse every API request other than GET (env "+policy.ReadOnlyEnv+")")
+	}
+}
+
+func activePolicy() (policy.Policy, error) {
+	policyOnce.Do(func() {
+		loadedPolicy, policyErr = policy.Load()
+	})
+	return loadedPolicy, policyErr
+}
+
+// applyReadOnly turns on read-only mode in the API client when the policy or
+// --read-only asks for it. The flag can only tighten the policy.
+func applyReadOnly(cmd *cobra.Command) error {
+	p, err := activePolicy()
+	if err != nil {
+		return err
+	}
+	if p.ReadOnly || getBoolFlag(cmd, "read-only") {
+		api.SetReadOnly(true)
+	}
+	return nil
+}
+
+// enforcePolicy checks a command against the allow/deny lists and asks for
+// interactive approval when a require_approval rule matches. Dry runs skip
+// approval because nothing is sent.
+func enforcePolicy(cmd *cobra.Command, commandPath string, args []string) error {
+	p, err := activePolicy()
+	if err != nil {
+		return err
+	}
+	if p.Empty() {
+		return nil
+	}
+	commandPath = strings.TrimPrefix(commandPath, "xbe ")
+	decision := p.Check(commandPath)
+	if !decision.Allowed {
+		return fmt.Errorf("%w: xbe %s (%s; policy %s)", policy.ErrDenied, commandPath, decision.Rule, p.Source)
+	}
+	if !decision.RequireApproval || cliDryRunRequested(cmd) {
+		return nil
+	}
+	return requestApproval(commandPath, args, decision.Rule)
+}
+
+func requestApproval(commandPath string, args []string, rule string) error {
+	commandLine := strings.TrimSpace("xbe " + commandPath + " " + strings.Join(args, " "))
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return fmt.Errorf("%w: %s requires interactive approval (%s)", policy.ErrDenied, commandLine, rule)
+	}
+	// Prompt on the real stderr: command stderr may be buffered until exit.
+	fmt.Fprintf(os.Stderr, "Policy requires approval to run:\n  %s\nProceed? [y/N] ", commandLine)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return fmt.Errorf("%w: %s was not approved", policy.ErrDenied, commandLine)
+	}
+}
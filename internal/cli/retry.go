@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+const (
+	retriesEnv       = "XBE_RETRIES"
+	retryMaxDelayEnv = "XBE_RETRY_MAX_DELAY"
+	retryPOSTEnv     = "XBE_RETRY_POST"
+	retryPATCHEnv    = "XBE_RETRY_PATCH"
+)
+
+func initRetryFlags(cmd *cobra.Command) {
+	defaults := defaultRetryPolicy()
+	flags := cmd.PersistentFlags()
+	if flags.Lookup("retries") == nil {
+		flags.Int("retries", defaults.MaxRetries, "Retries for transient API failures (429, 502, 503, 504, connection resets); env "+retriesEnv)
+	}
+	if flags.Lookup("retry-max-delay") == nil {
+		flags.Duration("retry-max-delay", defaults.MaxDelay, "Maximum backoff between retries; env "+retryMaxDelayEnv)
+	}
+	if flags.Lookup("retry-post") == nil {
+		flags.Bool("retry-post", defaults.RetryPOST, "Also retry POST requests (not idempotent); env "+retryPOSTEnv)
+	}
+	if flags.Lookup("retry-patch") == nil {
+		flags.Bool("retry-patch", defaults.RetryPATCH, "Also retry PATCH requests; env "+retryPATCHEnv)
+	}
+}
+
+// defaultRetryPolicy returns the API retry policy with environment overrides applied.
+func defaultRetryPolicy() api.RetryPolicy {
+	policy := api.DefaultRetryPolicy()
+	if value := strings.TrimSpace(os.Getenv(retriesEnv)); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed >= 0 {
+			policy.MaxRetries = parsed
+		}
+	}
+	if value := strings.TrimSpace(os.Getenv(retryMaxDelayEnv)); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			policy.MaxDelay = parsed
+		}
+	}
+	if value := strings.TrimSpace(os.Getenv(retryPOSTEnv)); value != "" {
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			policy.RetryPOST = parsed
+		}
+	}
+	if value := strings.TrimSpace(os.Getenv(retryPATCHEnv)); value != "" {
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			policy.RetryPATCH = parsed
+		}
+	}
+	return policy
+}
+
+func applyRetryPolicy(cmd *cobra.Command) error {
+	policy := defaultRetryPolicy()
+	if flag := cmd.Flags().Lookup("retries"); flag != nil {
+		retries, err := cmd.Flags().GetInt("retries")
+		if err != nil {
+			return err
+		}
+		if retries < 0 {
+			return fmt.Errorf("--retries must be zero or greater")
+		}
+		policy.MaxRetries = retries
+	}
+	if flag := cmd.Flags().Lookup("retry-max-delay"); flag != nil {
+		maxDelay, err := cmd.Flags().GetDuration("retry-max-delay")
+		if err != nil {
+			return err
+		}
+		if maxDelay <= 0 {
+			return fmt.Errorf("--retry-max-delay must be greater than zero")
+		}
+		policy.MaxDelay = maxDelay
+	}
+	if flag := cmd.Flags().Lookup("retry-post"); flag != nil {
+		retryPOST, err := cmd.Flags().GetBool("retry-post")
+		if err != nil {
+			return err
+		}
+		policy.RetryPOST = retryPOST
+	}
+	if flag := cmd.Flags().Lookup("retry-patch"); flag != nil {
+		retryPATCH, err := cmd.Flags().GetBool("retry-patch")
+		if err != nil {
+			return err
+		}
+		policy.RetryPATCH = retryPATCH
+	}
+	api.SetRetryPolicy(policy)
+	return nil
+}
@@ -51,6 +51,10 @@ func init() {
 	initHelp(rootCmd)
 	initSparseFieldFlags(rootCmd)
 	initOutputFlags(rootCmd)
+	initRetryFlags(rootCmd)
+	initCacheFlags(rootCmd)
+	initProfileFlag(rootCmd)
+	initPolicyFlags(rootCmd)
 	rootCmd.AddCommand(versionCmd)
 
 	// Set up telemetry hook for span creation
@@ -60,19 +64,28 @@ func init() {
 // Execute runs the root command (for backward compatibility).
 func Execute() error {
 	applyCommandMetadataSupport(rootCmd)
-	err := rootCmd.Execute()
-	return finalizeOutput(err)
+	if isCompletionRequest() {
+		registerIDCompletions(rootCmd)
+	}
+	err := finalizeErrors(finalizeOutput(rootCmd.Execute()))
+	finalizeAudit(err)
+	return err
 }
 
 // ExecuteContext runs the root command with context and telemetry support.
 func ExecuteContext(ctx context.Context, tp *telemetry.Provider) error {
 	applyCommandMetadataSupport(rootCmd)
+	if isCompletionRequest() {
+		registerIDCompletions(rootCmd)
+	}
 	telemetryProvider = tp
 	api.SetTelemetryProvider(tp)
 
 	// Execute the command and capture the error
 	err := rootCmd.ExecuteContext(ctx)
 	err = finalizeOutput(err)
+	err = finalizeErrors(err)
+	finalizeAudit(err)
 
 	// Finalize telemetry regardless of success/failure
 	// This ensures spans are always closed and metrics recorded
@@ -82,8 +95,26 @@ func ExecuteContext(ctx context.Context, tp *telemetry.Provider) error {
 }
 
 func telemetryPreRun(cmd *cobra.Command, args []string) error {
+	captureCommandErrors(cmd)
+	prepareBulkInput(cmd)
+	if err := applyProfile(cmd); err != nil {
+		return err
+	}
+	if err := applyDryRun(cmd); err != nil {
+		return err
+	}
+	startAudit(cmd, args)
 	if err := prepareOutput(cmd); err != nil {
 		fmt.Fprintln(cmd.ErrOrStderr(), err)
+		return &reportedError{err: err}
+	}
+	if err := applyRetryPolicy(cmd); err != nil {
+		return err
+	}
+	if err := applyCachePolicy(cmd); err != nil {
+		return err
+	}
+	if err := applyReadOnly(cmd); err != nil {
 		return err
 	}
 	if telemetryProvider == nil || !telemetryProvider.Enabled() {
@@ -0,0 +1,674 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/version"
+)
+
+//go:generate go run ../../build_tools/rowschemas .
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Export machine-readable contracts for the CLI",
+	Long: `Export machine-readable contracts for the CLI.
+
+Use these documents to generate function-calling definitions or typed clients,
+and to validate agent-produced invocations before running them.`,
+	Annotations: map[string]string{"group": GroupUtility},
+}
+
+var schemaCommandsCmd = &cobra.Command{
+	Use:   "commands [path...]",
+	Short: "Emit JSON Schema for command inputs and outputs",
+	Long: `Emit JSON Schema (draft 2020-12) for every command's inputs and --json output.
+
+Each command entry has:
+  path              Command path, e.g. "view brokers list"
+  name              Function-safe name, e.g. "view_brokers_list"
+  arguments         Positional argument properties, in order
+  input             Object schema of positional arguments and flags, with
+                    types, defaults, required flags, and enums taken from the
+                    help text and the knowledge database
+  output            Schema of the --json output
+  permissions, side_effects, validation_notes
+                    Metadata recorded in the knowledge database
+
+Flags accepted by every command (--profile, --output, --fields, ...) are listed
+once under global_flags. Output schemas for view commands list every field
+selectable with --fields; the fields shown by default are required.
+
+Pass a path prefix to limit the output to matching commands.`,
+	Example: `  # Every command
+  xbe schema commands > xbe-commands.schema.json
+
+  # One resource
+  xbe schema commands view brokers
+
+  # One command's input schema
+  xbe schema commands do cost-codes create --jq '.commands[0].input'`,
+	RunE: runSchemaCommands,
+}
+
+func init() {
+	// The document is always JSON; the flag lets --jq and --output apply.
+	schemaCommandsCmd.Flags().Bool("json", true, "Output JSON")
+	_ = schemaCommandsCmd.Flags().MarkHidden("json")
+	schemaCmd.AddCommand(schemaCommandsCmd)
+	rootCmd.AddCommand(schemaCmd)
+}
+
+type commandSchemaDocument struct {
+	Schema      string          `json:"$schema"`
+	CLI         string          `json:"cli"`
+	Version     string          `json:"version"`
+	GlobalFlags map[string]any  `json:"global_flags"`
+	Commands    []commandSchema `json:"commands"`
+}
+
+type commandSchema struct {
+	Path            string         `json:"path"`
+	Name            string         `json:"name"`
+	Description     string         `json:"description"`
+	Permissions     string         `json:"permissions,omitempty"`
+	SideEffects     string         `json:"side_effects,omitempty"`
+	ValidationNotes string         `json:"validation_notes,omitempty"`
+	Arguments       []string       `json:"arguments"`
+	Input           map[string]any `json:"input"`
+	Output          map[string]any `json:"output,omitempty"`
+}
+
+// commandKnowledge is what the knowledge database records about commands and
+// their flags, keyed by command path without the leading "xbe".
+type commandKnowledge struct {
+	commands  map[string]knownCommand
+	flags     map[string]map[string]*knownFlag
+	resources []string
+}
+
+type knownCommand struct {
+	description string
+	commandMetadata
+}
+
+type knownFlag struct {
+	required    bool
+	description string
+	validation  string
+	fieldLinks  []string
+}
+
+type commandArg struct {
+	property string
+	label    string
+	required bool
+	variadic bool
+}
+
+func runSchemaCommands(cmd *cobra.Command, args []string) error {
+	knowledge, err := loadCommandKnowledge(cmd)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+	}
+	prefix := strings.Join(args, " ")
+
+	document := commandSchemaDocument{
+		Schema:      jsonSchemaDialect,
+		CLI:         rootCmd.Name(),
+		Version:     version.String(),
+		GlobalFlags: flagSetSchema(rootCmd.PersistentFlags()),
+		Commands:    []commandSchema{},
+	}
+	var visit func(*cobra.Command)
+	visit = func(current *cobra.Command) {
+		if current.Hidden || current.Deprecated != "" || current.Name() == "help" {
+			return
+		}
+		if current.HasSubCommands() {
+			for _, child := range current.Commands() {
+				visit(child)
+			}
+			return
+		}
+		if !current.Runnable() || current == rootCmd {
+			return
+		}
+		path := knowledgeCommandPath(current)
+		if prefix != "" && path != prefix && !strings.HasPrefix(path, prefix+" ") {
+			return
+		}
+		document.Commands = append(document.Commands, buildCommandSchema(current, knowledge))
+	}
+	visit(rootCmd)
+	if len(document.Commands) == 0 && prefix != "" {
+		return fmt.Errorf("%w: no commands match %q", errInvalidInput, prefix)
+	}
+	sort.Slice(document.Commands, func(i, j int) bool { return document.Commands[i].Path < document.Commands[j].Path })
+
+	return writeJSON(cmd.OutOrStdout(), document)
+}
+
+func buildCommandSchema(cmd *cobra.Command, knowledge commandKnowledge) commandSchema {
+	path := knowledgeCommandPath(cmd)
+	info := knowledge.command(path)
+	description := strings.TrimSpace(info.description)
+	if description == "" {
+		description = strings.TrimSpace(cmd.Short)
+	}
+	input, positional, _ := buildCommandInputSchema(cmd, knowledge, func(flag *pflag.Flag, inherited bool) bool {
+		// Root persistent flags are described once in global_flags.
+		return !inherited || rootCmd.PersistentFlags().Lookup(flag.Name) == nil
+	})
+	arguments := make([]string, 0, len(positional))
+	for _, arg := range positional {
+		arguments = append(arguments, arg.property)
+	}
+	return commandSchema{
+		Path:            path,
+		Name:            mcpToolName(strings.Fields(path)),
+		Description:     description,
+		Permissions:     info.Permissions,
+		SideEffects:     info.SideEffects,
+		ValidationNotes: info.ValidationNotes,
+		Arguments:       arguments,
+		Input:           input,
+		Output:          commandOutputSchema(cmd, strings.Fields(path)),
+	}
+}
+
+// command returns what is known about a command. show commands share
+// permissions with list; the list's filter notes do not apply to them.
+func (k commandKnowledge) command(path string) knownCommand {
+	if info, ok := k.commands[path]; ok {
+		return info
+	}
+	if fallback, ok := listFallbackCommandPath(path); ok {
+		return knownCommand{commandMetadata: commandMetadata{Permissions: k.commands[fallback].Permissions}}
+	}
+	return knownCommand{}
+}
+
+// buildCommandInputSchema describes a command's positional arguments and the
+// flags accepted by include as a JSON Schema object.
+func buildCommandInputSchema(cmd *cobra.Command, knowledge commandKnowledge, include func(flag *pflag.Flag, inherited bool) bool) (map[string]any, []commandArg, map[string]*pflag.Flag) {
+	flagInfo := knowledge.flags[knowledgeCommandPath(cmd)]
+	properties := map[string]any{}
+	required := []string{}
+	flags := map[string]*pflag.Flag{}
+
+	positional := parseUseArgs(cmd.Use)
+	for _, arg := range positional {
+		schema := map[string]any{"type": "string", "description": "Positional argument <" + arg.label + ">"}
+		if arg.variadic {
+			schema = map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Positional arguments <" + arg.label + ">..."}
+		}
+		properties[arg.property] = schema
+		if arg.required {
+			required = append(required, arg.property)
+		}
+	}
+
+	addFlag := func(flag *pflag.Flag, inherited bool) {
+		if flag.Hidden || flag.Name == "help" || !include(flag, inherited) {
+			return
+		}
+		if _, exists := properties[flag.Name]; exists {
+			return
+		}
+		known := flagInfo[flag.Name]
+		schema := flagSchema(flag, known)
+		properties[flag.Name] = schema
+		flags[flag.Name] = flag
+		if (known != nil && known.required) || flagRequired(flag) {
+			required = append(required, flag.Name)
+		}
+	}
+	cmd.NonInheritedFlags().VisitAll(func(flag *pflag.Flag) { addFlag(flag, false) })
+	cmd.InheritedFlags().VisitAll(func(flag *pflag.Flag) { addFlag(flag, true) })
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+	return schema, positional, flags
+}
+
+func flagSetSchema(flags *pflag.FlagSet) map[string]any {
+	properties := map[string]any{}
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden || flag.Name == "help" {
+			return
+		}
+		properties[flag.Name] = flagSchema(flag, nil)
+	})
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// parseUseArgs reads positional arguments from a Use line such as
+// "show <id>" or "commands [path...]".
+func parseUseArgs(use string) []commandArg {
+	fields := strings.Fields(use)
+	if len(fields) < 2 {
+		return nil
+	}
+	var args []commandArg
+	for _, field := range fields[1:] {
+		required := strings.HasPrefix(field, "<")
+		if !required && !strings.HasPrefix(field, "[") {
+			continue
+		}
+		variadic := strings.HasSuffix(field, "...") || strings.HasSuffix(field, "...]")
+		label := strings.Trim(strings.ReplaceAll(field, "...", ""), "<>[]")
+		if label == "" || label == "flags" {
+			continue
+		}
+		property := strings.Map(func(r rune) rune {
+			switch {
+			case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+				return r
+			case r >= 'A' && r <= 'Z':
+				return r + ('a' - 'A')
+			default:
+				return '_'
+			}
+		}, label)
+		args = append(args, commandArg{property: property, label: label, required: required, variadic: variadic})
+	}
+	return args
+}
+
+func flagSchema(flag *pflag.Flag, known *knownFlag) map[string]any {
+	schema := map[string]any{}
+	switch flag.Value.Type() {
+	case "bool":
+		schema["type"] = "boolean"
+		if flag.DefValue == "true" {
+			schema["default"] = true
+		}
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "count":
+		schema["type"] = "integer"
+		if value, err := strconv.ParseInt(flag.DefValue, 10, 64); err == nil && value != 0 {
+			schema["default"] = value
+		}
+	case "float32", "float64":
+		schema["type"] = "number"
+		if value, err := strconv.ParseFloat(flag.DefValue, 64); err == nil && value != 0 {
+			schema["default"] = value
+		}
+	case "stringSlice", "stringArray", "intSlice", "int64Slice", "uintSlice", "float64Slice", "boolSlice":
+		schema["type"] = "array"
+		schema["items"] = map[string]any{"type": "string"}
+	case "duration":
+		// JSON Schema's "duration" format is ISO 8601 (PT1H); flags take Go
+		// durations (1h30m).
+		schema["type"] = "string"
+		schema["pattern"] = goDurationPattern
+		if flag.DefValue != "" && flag.DefValue != "0s" {
+			schema["default"] = flag.DefValue
+		}
+	default:
+		schema["type"] = "string"
+		if flag.DefValue != "" {
+			schema["default"] = flag.DefValue
+		}
+	}
+
+	description := flag.Usage
+	validation := ""
+	if known != nil {
+		if known.description != "" {
+			description = known.description
+		}
+		validation = known.validation
+		if validation != "" {
+			description += " Validation: " + validation
+		}
+		if len(known.fieldLinks) > 0 {
+			description += " Maps to " + strings.Join(known.fieldLinks, ", ") + "."
+		}
+	}
+	schema["description"] = description
+	if schema["type"] == "string" && schema["format"] == nil && schema["pattern"] == nil {
+		if values := flagEnum(flag.Usage, validation); len(values) > 0 {
+			schema["enum"] = values
+		}
+	}
+	return schema
+}
+
+// goDurationPattern matches the values time.ParseDuration accepts.
+const goDurationPattern = `^[-+]?(0|(([0-9]+(\.[0-9]*)?|\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+)$`
+
+var (
+	enumListPattern  = regexp.MustCompile(`(?i)(?:one of|allowed values)[:\s]+([\w.-]+(?:\s*,\s*(?:or\s+)?[\w.-]+)+)`)
+	enumParenPattern = regexp.MustCompile(`\(([\w.-]+(?:\s*[,/|]\s*[\w.-]+)+)\)`)
+	enumSplitPattern = regexp.MustCompile(`\s*[,/|]\s*(?:or\s+)?`)
+	enumTokenPattern = regexp.MustCompile(`^[\w.-]+$`)
+)
+
+// flagEnum extracts allowed values from help text such as "(true/false)",
+// "(brokers|truckers)", or "Must be one of: driver, equipment, admin."
+// Comma-separated list flags accept several values and "Type|ID" style
+// formats describe structure rather than choices, so neither gets an enum.
+func flagEnum(usage, validation string) []string {
+	if strings.Contains(strings.ToLower(usage), "comma-separated") || strings.Contains(strings.ToLower(usage+" "+validation), "format") {
+		return nil
+	}
+	candidates := []string{}
+	for _, text := range []string{validation, usage} {
+		if match := enumListPattern.FindStringSubmatch(text); match != nil {
+			candidates = append(candidates, match[1])
+		}
+	}
+	for _, match := range enumParenPattern.FindAllStringSubmatch(usage, -1) {
+		candidates = append(candidates, match[1])
+	}
+	for _, candidate := range candidates {
+		values := enumSplitPattern.Split(strings.TrimSuffix(strings.TrimSpace(candidate), "."), -1)
+		valid := len(values) >= 2
+		for _, value := range values {
+			lower := strings.ToLower(value)
+			if !enumTokenPattern.MatchString(value) || strings.HasPrefix(value, "-") || strings.HasPrefix(lower, "e.g") || lower == "required" || lower == "optional" || lower == "default" {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			return values
+		}
+	}
+	return nil
+}
+
+func flagRequired(flag *pflag.Flag) bool {
+	values, ok := flag.Annotations[cobra.BashCompOneRequiredFlag]
+	return ok && len(values) > 0 && values[0] == "true"
+}
+
+// commandOutputSchema describes the --json output of a command. view list and
+// show commands print sparse rows built from resource_map.json; other commands
+// print the Go types recorded in commandOutputTypes.
+func commandOutputSchema(cmd *cobra.Command, path []string) map[string]any {
+	if len(path) < 2 {
+		return nil
+	}
+	group := path[0]
+	verb := path[len(path)-1]
+	var outputType reflect.Type
+	switch group {
+	case "view":
+		outputType = commandOutputTypes[strings.ReplaceAll(path[1], "-", "_")+"_"+verb]
+		if len(path) == 3 && (verb == "list" || verb == "show") {
+			if schema := sparseOutputSchema(path[1], verb == "list", outputType); schema != nil {
+				return schema
+			}
+		}
+	case "do":
+		outputType = commandOutputTypes["do_"+strings.ReplaceAll(strings.Join(path[1:], "_"), "-", "_")]
+	case "summarize":
+		return map[string]any{"type": "array", "items": map[string]any{"type": "object"}}
+	}
+	if outputType == nil {
+		return nil
+	}
+	return reflectSchema(outputType, map[reflect.Type]bool{})
+}
+
+func sparseOutputSchema(resource string, list bool, rowType reflect.Type) map[string]any {
+	all, ok, err := defaultShowSelection(resource)
+	if err != nil || !ok {
+		return nil
+	}
+	defaults := all
+	if list {
+		defaults, ok, err = defaultListSelection(resource)
+		if err != nil || !ok {
+			return nil
+		}
+	}
+
+	rowFields := rowFieldSchemas(rowType)
+	properties := map[string]any{"id": map[string]any{"type": "string"}}
+	for _, field := range all.Fields {
+		schema := map[string]any{}
+		if _, isRelation := all.RelationIDFields[field]; isRelation {
+			schema["type"] = []string{"string", "null"}
+		} else if _, isRelation := all.RelationLabels[field]; isRelation {
+			schema["type"] = []string{"string", "null"}
+		} else if rowSchema, ok := rowFields[field]; ok {
+			if typ, ok := rowSchema["type"].(string); ok && typ != "object" && typ != "array" {
+				schema["type"] = []string{typ, "null"}
+			}
+		}
+		properties[field] = schema
+	}
+	required := append([]string{"id"}, defaults.Fields...)
+	sort.Strings(required)
+	object := map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+	if list {
+		return map[string]any{"type": "array", "items": object}
+	}
+	return object
+}
+
+// rowFieldSchemas maps hyphenated field names to the schemas of a list row
+// struct's fields. Row structs use snake_case tags; matching them to
+// hyphenated field names borrows their value types.
+func rowFieldSchemas(rowType reflect.Type) map[string]map[string]any {
+	rowFields := map[string]map[string]any{}
+	if rowType == nil {
+		return rowFields
+	}
+	if row, ok := reflectSchema(rowType, map[reflect.Type]bool{})["items"].(map[string]any); ok {
+		if properties, ok := row["properties"].(map[string]any); ok {
+			for name, schema := range properties {
+				rowFields[strings.ReplaceAll(name, "_", "-")] = schema.(map[string]any)
+			}
+		}
+	}
+	return rowFields
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// reflectSchema converts a Go type to JSON Schema following encoding/json
+// rules for field names, omitempty, and embedded structs.
+func reflectSchema(t reflect.Type, seen map[reflect.Type]bool) map[string]any {
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]any{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := reflectSchema(t.Elem(), seen)
+		if typ, ok := schema["type"].(string); ok {
+			schema["type"] = []string{typ, "null"}
+		}
+		return schema
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string"}
+		}
+		return map[string]any{"type": "array", "items": reflectSchema(t.Elem(), seen)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": reflectSchema(t.Elem(), seen)}
+	case reflect.Struct:
+		if seen[t] {
+			return map[string]any{"type": "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		properties := map[string]any{}
+		required := []string{}
+		collectStructFields(t, seen, properties, &required)
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			sort.Strings(required)
+			schema["required"] = required
+		}
+		return schema
+	default:
+		return map[string]any{}
+	}
+}
+
+func collectStructFields(t reflect.Type, seen map[reflect.Type]bool, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				collectStructFields(embedded, seen, properties, required)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = reflectSchema(field.Type, seen)
+		if !strings.Contains(","+options+",", ",omitempty,") {
+			*required = append(*required, name)
+		}
+	}
+}
+
+func loadCommandKnowledge(cmd *cobra.Command) (commandKnowledge, error) {
+	knowledge := commandKnowledge{
+		commands: map[string]knownCommand{},
+		flags:    map[string]map[string]*knownFlag{},
+	}
+	db, dbPath, err := openKnowledgeDB(cmd)
+	if err != nil {
+		return knowledge, err
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	rows, err := db.QueryContext(ctx, `
+SELECT full_path, description, COALESCE(permissions, ''), COALESCE(side_effects, ''), COALESCE(validation_notes, '')
+FROM commands`)
+	if err != nil {
+		return knowledge, checkDBError(err, dbPath)
+	}
+	for rows.Next() {
+		var path string
+		var info knownCommand
+		if err := rows.Scan(&path, &info.description, &info.Permissions, &info.SideEffects, &info.ValidationNotes); err != nil {
+			rows.Close()
+			return knowledge, checkDBError(err, dbPath)
+		}
+		knowledge.commands[path] = info
+	}
+	rows.Close()
+
+	flagFor := func(path, name string) *knownFlag {
+		name = strings.TrimPrefix(name, "--")
+		if knowledge.flags[path] == nil {
+			knowledge.flags[path] = map[string]*knownFlag{}
+		}
+		if knowledge.flags[path][name] == nil {
+			knowledge.flags[path][name] = &knownFlag{}
+		}
+		return knowledge.flags[path][name]
+	}
+
+	rows, err = db.QueryContext(ctx, `
+SELECT c.full_path, f.name, f.required, f.description, COALESCE(f.validation, '')
+FROM flags f
+JOIN commands c ON c.id = f.command_id`)
+	if err != nil {
+		return knowledge, checkDBError(err, dbPath)
+	}
+	for rows.Next() {
+		var path, name, description, validation string
+		var required int
+		if err := rows.Scan(&path, &name, &required, &description, &validation); err != nil {
+			rows.Close()
+			return knowledge, checkDBError(err, dbPath)
+		}
+		flag := flagFor(path, name)
+		flag.required = required != 0
+		flag.description = description
+		flag.validation = validation
+	}
+	rows.Close()
+
+	rows, err = db.QueryContext(ctx, `
+SELECT c.full_path, l.flag_name, l.resource, l.field
+FROM command_field_links l
+JOIN commands c ON c.id = l.command_id
+ORDER BY c.full_path, l.flag_name, l.resource, l.field`)
+	if err != nil {
+		return knowledge, checkDBError(err, dbPath)
+	}
+	for rows.Next() {
+		var path, name, resource, field string
+		if err := rows.Scan(&path, &name, &resource, &field); err != nil {
+			rows.Close()
+			return knowledge, checkDBError(err, dbPath)
+		}
+		flag := flagFor(path, name)
+		flag.fieldLinks = append(flag.fieldLinks, resource+"."+field)
+	}
+	rows.Close()
+
+	resources, err := db.QueryContext(ctx, `SELECT name FROM resources ORDER BY name`)
+	if err != nil {
+		return knowledge, checkDBError(err, dbPath)
+	}
+	defer resources.Close()
+	for resources.Next() {
+		var name string
+		if err := resources.Scan(&name); err != nil {
+			return knowledge, checkDBError(err, dbPath)
+		}
+		knowledge.resources = append(knowledge.resources, name)
+	}
+	return knowledge, checkDBError(resources.Err(), dbPath)
+}
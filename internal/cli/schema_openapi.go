@@ -0,0 +1,598 @@
+package cli
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xbe-inc/xbe-cli/internal/version"
+)
+
+//go:generate go run ../../build_tools/apiroutes .
+
+const jsonAPIMediaType = "application/vnd.api+json"
+
+// apiRoute is one API request made by a command source file. The list is
+// generated into schema_routes.go by build_tools/apiroutes.
+type apiRoute struct {
+	Source        string
+	Method        string
+	Path          string
+	Filters       []string
+	Attributes    []string
+	Relationships []string
+}
+
+// listCommandRoute returns the generated route of a resource's list command.
+func listCommandRoute(resource string) *apiRoute {
+	source := strings.ReplaceAll(resource, "-", "_") + "_list"
+	for i := range apiRoutes {
+		if apiRoutes[i].Source == source && apiRoutes[i].Method == "GET" {
+			return &apiRoutes[i]
+		}
+	}
+	return nil
+}
+
+// routeFilterName returns the list filter matching name as the route spells
+// it (dashes or underscores), or "" when the list has no such filter.
+func routeFilterName(name string, filters []string) string {
+	for _, filter := range filters {
+		if filter == name || filter == strings.ReplaceAll(name, "-", "_") {
+			return filter
+		}
+	}
+	return ""
+}
+
+//go:embed summary_map.json
+var summaryMapJSON []byte
+
+type summaryMapSpec struct {
+	Summaries map[string]struct {
+		PrimaryResources []string `json:"primary_resources"`
+	} `json:"summaries"`
+}
+
+var schemaOpenAPICmd = &cobra.Command{
+	Use:   "openapi [resource...]",
+	Short: "Emit an OpenAPI 3.1 document for the API endpoints the CLI uses",
+	Long: `Emit an OpenAPI 3.1 document for the JSON:API endpoints the CLI calls.
+
+Paths and methods come from the CLI's own requests, so the document covers
+exactly what the commands use:
+  GET collections   filter[...] parameters set by the list command, page[limit],
+                    page[offset], sort, include, and fields[<type>]
+  GET members       include and fields[<type>]
+  POST, PATCH       request bodies with the attributes and relationships the
+                    create/update commands send
+  DELETE            no body
+Errors on every operation use the JSON:API errors shape.
+
+Resource schemas (components.schemas) come from resource_map.json: attributes,
+relationships and their target types, and server types; attribute value types
+are borrowed from list output where known. Summary endpoints note the
+resources they aggregate, from summary_map.json. Each operation records the
+CLI command that calls it under x-xbe-command.
+
+Pass resource names to limit the document to their paths.`,
+	Example: `  # Whole document
+  xbe schema openapi > xbe-openapi.json
+
+  # Only brokers and customers
+  xbe schema openapi brokers customers
+
+  # List the paths
+  xbe schema openapi --jq '.paths | keys'`,
+	RunE: runSchemaOpenAPI,
+}
+
+func init() {
+	// The document is always JSON; the flag lets --jq and --output apply.
+	schemaOpenAPICmd.Flags().Bool("json", true, "Output JSON")
+	_ = schemaOpenAPICmd.Flags().MarkHidden("json")
+	schemaCmd.AddCommand(schemaOpenAPICmd)
+}
+
+func runSchemaOpenAPI(cmd *cobra.Command, args []string) error {
+	resourceMap, err := loadResourceMap()
+	if err != nil {
+		return fmt.Errorf("load resource map: %w", err)
+	}
+	var summaries summaryMapSpec
+	if err := json.Unmarshal(summaryMapJSON, &summaries); err != nil {
+		return fmt.Errorf("load summary map: %w", err)
+	}
+	only := map[string]bool{}
+	for _, arg := range args {
+		only[arg] = true
+	}
+	document := buildOpenAPIDocument(resourceMap, summaries, apiRoutes, commandsBySource(), only)
+	if len(only) > 0 && len(document["paths"].(map[string]any)) == 0 {
+		return fmt.Errorf("%w: no API paths for %s", errInvalidInput, strings.Join(args, ", "))
+	}
+	return writeJSON(cmd.OutOrStdout(), document)
+}
+
+// commandsBySource maps command source file stems (brokers_list,
+// do_brokers_create) to the commands they define.
+func commandsBySource() map[string]*cobra.Command {
+	commands := map[string]*cobra.Command{}
+	var visit func(*cobra.Command)
+	visit = func(current *cobra.Command) {
+		for _, child := range current.Commands() {
+			visit(child)
+		}
+		path := strings.Fields(knowledgeCommandPath(current))
+		if len(path) < 2 || !current.Runnable() {
+			return
+		}
+		var stem string
+		switch path[0] {
+		case "view":
+			stem = strings.Join(path[1:], "_")
+		case "do", "summarize":
+			stem = "do_" + strings.Join(path[1:], "_")
+		default:
+			return
+		}
+		commands[strings.ReplaceAll(stem, "-", "_")] = current
+	}
+	visit(rootCmd)
+	return commands
+}
+
+func buildOpenAPIDocument(resources resourceMap, summaries summaryMapSpec, routes []apiRoute, commands map[string]*cobra.Command, only map[string]bool) map[string]any {
+	paths := map[string]any{}
+	schemas := openAPIBaseSchemas()
+	operationIDs := map[string]bool{}
+
+	for _, route := range routes {
+		resource := routeResource(route.Path, resources)
+		if len(only) > 0 && !only[resource] {
+			continue
+		}
+		item, _ := paths[route.Path].(map[string]any)
+		if item == nil {
+			item = map[string]any{}
+			paths[route.Path] = item
+		}
+		method := strings.ToLower(route.Method)
+		cmd := commands[route.Source]
+		if existing, ok := item[method].(map[string]any); ok {
+			mergeOpenAPIOperation(existing, route, cmd)
+			continue
+		}
+
+		schemaRef := "#/components/schemas/Resource"
+		if _, ok := resources.Resources[resource]; ok {
+			schemaRef = "#/components/schemas/" + resource
+			if _, done := schemas[resource]; !done {
+				schemas[resource] = openAPIResourceSchema(resource, resources)
+			}
+		}
+		operation := openAPIOperation(route, resource, schemaRef, cmd, resources, operationIDs)
+		if summary, ok := summaries.Summaries[resource]; ok && len(summary.PrimaryResources) > 0 {
+			operation["x-xbe-summarizes"] = summary.PrimaryResources
+		}
+		item[method] = operation
+	}
+
+	return map[string]any{
+		"openapi":           "3.1.0",
+		"jsonSchemaDialect": jsonSchemaDialect,
+		"info": map[string]any{
+			"title":       "XBE API",
+			"version":     version.String(),
+			"description": "JSON:API endpoints used by the xbe CLI, generated by 'xbe schema openapi'.",
+		},
+		"servers":  []any{map[string]any{"url": strings.TrimRight(defaultBaseURL(), "/")}},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+		"paths":    paths,
+		"components": map[string]any{
+			"schemas":   schemas,
+			"responses": openAPIErrorResponses(),
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "description": "API token (xbe auth login)"},
+			},
+		},
+	}
+}
+
+// routeResource names the resource a path operates on: the last segment
+// that resource_map.json knows, else the last literal segment.
+func routeResource(path string, resources resourceMap) string {
+	var segments []string
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "/v1/"), "/") {
+		if segment != "" && !strings.HasPrefix(segment, "{") {
+			segments = append(segments, segment)
+		}
+	}
+	for i := len(segments) - 1; i >= 0; i-- {
+		if _, ok := resources.Resources[segments[i]]; ok {
+			return segments[i]
+		}
+	}
+	if len(segments) == 0 {
+		return ""
+	}
+	return segments[len(segments)-1]
+}
+
+func openAPIOperation(route apiRoute, resource, schemaRef string, cmd *cobra.Command, resources resourceMap, operationIDs map[string]bool) map[string]any {
+	member := strings.Contains(route.Path, "{")
+	operation := map[string]any{
+		"tags":        []string{resource},
+		"operationId": uniqueOperationID(route, cmd, operationIDs),
+	}
+	if cmd != nil {
+		operation["summary"] = strings.TrimSpace(cmd.Short)
+		operation["x-xbe-command"] = "xbe " + knowledgeCommandPath(cmd)
+	}
+
+	var parameters []any
+	for _, name := range pathParameters(route.Path) {
+		parameters = append(parameters, map[string]any{
+			"name": name, "in": "path", "required": true, "schema": map[string]any{"type": "string"},
+		})
+	}
+	responses := map[string]any{
+		"400": map[string]any{"$ref": "#/components/responses/BadRequest"},
+		"401": map[string]any{"$ref": "#/components/responses/Unauthorized"},
+		"403": map[string]any{"$ref": "#/components/responses/Forbidden"},
+	}
+	if member {
+		responses["404"] = map[string]any{"$ref": "#/components/responses/NotFound"}
+	}
+
+	switch route.Method {
+	case "GET":
+		parameters = append(parameters, readParameters(resource, resources)...)
+		data := map[string]any{"$ref": schemaRef}
+		if !member {
+			parameters = append(parameters, filterParameters(route.Filters, cmd)...)
+			parameters = append(parameters,
+				queryParameter("page[limit]", "Maximum number of records to return", map[string]any{"type": "integer", "minimum": 1}),
+				queryParameter("page[offset]", "Number of records to skip", map[string]any{"type": "integer", "minimum": 0}),
+				queryParameter("sort", "Comma-separated fields to sort by; prefix with - for descending", map[string]any{"type": "string"}),
+			)
+			data = map[string]any{"type": "array", "items": data}
+		}
+		responses["200"] = documentResponse("OK", data)
+	case "POST", "PATCH":
+		operation["requestBody"] = map[string]any{
+			"required": true,
+			"content": map[string]any{
+				jsonAPIMediaType: map[string]any{"schema": requestBodySchema(route, resource, cmd, resources)},
+			},
+		}
+		responses["422"] = map[string]any{"$ref": "#/components/responses/UnprocessableEntity"}
+		if route.Method == "POST" {
+			responses["201"] = documentResponse("Created", map[string]any{"$ref": schemaRef})
+			responses["200"] = documentResponse("OK", map[string]any{})
+		} else {
+			responses["200"] = documentResponse("OK", map[string]any{"$ref": schemaRef})
+		}
+	case "DELETE":
+		responses["204"] = map[string]any{"description": "Deleted"}
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+	operation["responses"] = responses
+	return operation
+}
+
+// mergeOpenAPIOperation folds a second command calling the same endpoint
+// (for example a create command and a bulk helper) into the operation.
+func mergeOpenAPIOperation(operation map[string]any, route apiRoute, cmd *cobra.Command) {
+	if len(route.Filters) == 0 {
+		return
+	}
+	parameters, _ := operation["parameters"].([]any)
+	present := map[string]bool{}
+	for _, parameter := range parameters {
+		present[parameter.(map[string]any)["name"].(string)] = true
+	}
+	for _, parameter := range filterParameters(route.Filters, cmd) {
+		if !present[parameter.(map[string]any)["name"].(string)] {
+			parameters = append(parameters, parameter)
+		}
+	}
+	operation["parameters"] = parameters
+}
+
+func uniqueOperationID(route apiRoute, cmd *cobra.Command, used map[string]bool) string {
+	base := strings.ToLower(route.Method) + "_" + strings.Trim(strings.NewReplacer("/v1/", "", "/", "_", "{", "", "}", "", "-", "_").Replace(route.Path), "_")
+	if cmd != nil {
+		base = mcpToolName(strings.Fields(knowledgeCommandPath(cmd)))
+	}
+	id := base
+	for n := 2; used[id]; n++ {
+		id = fmt.Sprintf("%s_%d", base, n)
+	}
+	used[id] = true
+	return id
+}
+
+func pathParameters(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, strings.Trim(segment, "{}"))
+		}
+	}
+	return names
+}
+
+func queryParameter(name, description string, schema map[string]any) map[string]any {
+	return map[string]any{"name": name, "in": "query", "description": description, "schema": schema}
+}
+
+func readParameters(resource string, resources resourceMap) []any {
+	fieldsDescription := "Comma-separated attributes and relationships to return (sparse fieldset)"
+	if _, ok := resources.Resources[resource]; ok {
+		fieldsDescription += "; see #/components/schemas/" + resource
+	}
+	includeDescription := "Comma-separated relationship paths to include"
+	if relations := sortedRelationNames(resources.Relationships[resource]); len(relations) > 0 {
+		includeDescription += ": " + strings.Join(relations, ", ")
+	}
+	return []any{
+		queryParameter("fields["+resource+"]", fieldsDescription, map[string]any{"type": "string"}),
+		queryParameter("include", includeDescription, map[string]any{"type": "string"}),
+	}
+}
+
+// filterParameters describes filter[...] query parameters, borrowing the
+// description of the list command's flag with the same name.
+func filterParameters(filters []string, cmd *cobra.Command) []any {
+	parameters := make([]any, 0, len(filters))
+	for _, filter := range filters {
+		description := "Filter by " + filter
+		schema := map[string]any{"type": "string"}
+		if flag := commandFlag(cmd, filter); flag != nil {
+			description = flag.Usage
+			if values := flagEnum(flag.Usage, ""); len(values) > 0 {
+				schema["enum"] = values
+			}
+		}
+		parameters = append(parameters, queryParameter("filter["+filter+"]", description, schema))
+	}
+	return parameters
+}
+
+func commandFlag(cmd *cobra.Command, name string) *pflag.Flag {
+	if cmd == nil {
+		return nil
+	}
+	if flag := cmd.Flags().Lookup(name); flag != nil {
+		return flag
+	}
+	return cmd.Flags().Lookup(strings.ReplaceAll(name, "_", "-"))
+}
+
+func requestBodySchema(route apiRoute, resource string, cmd *cobra.Command, resources resourceMap) map[string]any {
+	attributeTypes := resourceAttributeSchemas(resource, resources)
+	attributes := map[string]any{}
+	for _, name := range route.Attributes {
+		schema := map[string]any{}
+		if known, ok := attributeTypes[name]; ok {
+			for key, value := range known {
+				schema[key] = value
+			}
+		}
+		if flag := commandFlag(cmd, name); flag != nil {
+			schema["description"] = flag.Usage
+		}
+		attributes[name] = schema
+	}
+	relationships := map[string]any{}
+	targets := resources.Relationships[resource]
+	for _, name := range route.Relationships {
+		relationships[name] = relationshipSchema(targets[name].Resources, commandFlag(cmd, name))
+	}
+
+	types := []string{resource}
+	if spec, ok := resources.Resources[resource]; ok && len(spec.ServerTypes) > 0 {
+		types = spec.ServerTypes
+	}
+	data := map[string]any{
+		"type":     "object",
+		"required": []string{"type"},
+		"properties": map[string]any{
+			"type":          map[string]any{"type": "string", "enum": types},
+			"attributes":    map[string]any{"type": "object", "properties": attributes},
+			"relationships": map[string]any{"type": "object", "properties": relationships},
+		},
+	}
+	if route.Method == "PATCH" {
+		data["required"] = []string{"id", "type"}
+		data["properties"].(map[string]any)["id"] = map[string]any{"type": "string"}
+	}
+	return map[string]any{
+		"type":       "object",
+		"required":   []string{"data"},
+		"properties": map[string]any{"data": data},
+	}
+}
+
+func relationshipSchema(targets []string, flag *pflag.Flag) map[string]any {
+	schema := map[string]any{"$ref": "#/components/schemas/Relationship"}
+	var parts []string
+	if flag != nil {
+		parts = append(parts, flag.Usage)
+	}
+	if len(targets) > 0 {
+		parts = append(parts, "Targets: "+strings.Join(targets, ", "))
+	}
+	if len(parts) > 0 {
+		schema["description"] = strings.Join(parts, ". ")
+	}
+	return schema
+}
+
+// resourceAttributeSchemas types a resource's attributes from its list row
+// struct where the struct has a matching field.
+func resourceAttributeSchemas(resource string, resources resourceMap) map[string]map[string]any {
+	rowFields := rowFieldSchemas(commandOutputTypes[strings.ReplaceAll(resource, "-", "_")+"_list"])
+	schemas := map[string]map[string]any{}
+	spec := resources.Resources[resource]
+	for _, name := range appendUniversalFields(append([]string{}, spec.Attributes...)) {
+		schema := map[string]any{}
+		if row, ok := rowFields[name]; ok {
+			if typ, ok := row["type"].(string); ok && typ != "object" && typ != "array" {
+				schema["type"] = []string{typ, "null"}
+			}
+		}
+		if name == "created-at" || name == "updated-at" {
+			schema = map[string]any{"type": "string", "format": "date-time"}
+		}
+		schemas[name] = schema
+	}
+	return schemas
+}
+
+func openAPIResourceSchema(resource string, resources resourceMap) map[string]any {
+	spec := resources.Resources[resource]
+	attributes := map[string]any{}
+	for name, schema := range resourceAttributeSchemas(resource, resources) {
+		attributes[name] = schema
+	}
+	relationships := map[string]any{}
+	for name, target := range resources.Relationships[resource] {
+		relationships[name] = relationshipSchema(target.Resources, nil)
+	}
+	types := spec.ServerTypes
+	if len(types) == 0 {
+		types = []string{resource}
+	}
+	schema := map[string]any{
+		"type":     "object",
+		"required": []string{"id", "type"},
+		"properties": map[string]any{
+			"id":            map[string]any{"type": "string"},
+			"type":          map[string]any{"type": "string", "enum": types},
+			"attributes":    map[string]any{"type": "object", "properties": attributes},
+			"relationships": map[string]any{"type": "object", "properties": relationships},
+			"links":         map[string]any{"type": "object"},
+			"meta":          map[string]any{"type": "object"},
+		},
+	}
+	if len(spec.LabelFields) > 0 {
+		schema["x-xbe-label-fields"] = spec.LabelFields
+	}
+	return schema
+}
+
+func sortedRelationNames(relations map[string]relationshipSpec) []string {
+	names := make([]string, 0, len(relations))
+	for name := range relations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func documentResponse(description string, data map[string]any) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content": map[string]any{
+			jsonAPIMediaType: map[string]any{
+				"schema": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"data":     data,
+						"included": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/components/schemas/Resource"}},
+						"meta":     map[string]any{"type": "object"},
+						"links":    map[string]any{"type": "object"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func openAPIBaseSchemas() map[string]any {
+	identifier := map[string]any{
+		"type":       "object",
+		"required":   []string{"id", "type"},
+		"properties": map[string]any{"id": map[string]any{"type": "string"}, "type": map[string]any{"type": "string"}},
+	}
+	return map[string]any{
+		"ResourceIdentifier": identifier,
+		"Relationship": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"data": map[string]any{
+					"oneOf": []any{
+						map[string]any{"type": "null"},
+						map[string]any{"$ref": "#/components/schemas/ResourceIdentifier"},
+						map[string]any{"type": "array", "items": map[string]any{"$ref": "#/components/schemas/ResourceIdentifier"}},
+					},
+				},
+				"links": map[string]any{"type": "object"},
+				"meta":  map[string]any{"type": "object"},
+			},
+		},
+		"Resource": map[string]any{
+			"type":     "object",
+			"required": []string{"id", "type"},
+			"properties": map[string]any{
+				"id":            map[string]any{"type": "string"},
+				"type":          map[string]any{"type": "string"},
+				"attributes":    map[string]any{"type": "object"},
+				"relationships": map[string]any{"type": "object", "additionalProperties": map[string]any{"$ref": "#/components/schemas/Relationship"}},
+			},
+		},
+		"Error": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"id":     map[string]any{"type": "string"},
+				"status": map[string]any{"type": "string"},
+				"code":   map[string]any{"type": "string"},
+				"title":  map[string]any{"type": "string"},
+				"detail": map[string]any{"type": "string"},
+				"source": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"pointer":   map[string]any{"type": "string", "description": "JSON Pointer to the offending request body member, e.g. /data/attributes/name"},
+						"parameter": map[string]any{"type": "string", "description": "Offending query parameter"},
+						"header":    map[string]any{"type": "string"},
+					},
+				},
+				"meta": map[string]any{"type": "object"},
+			},
+		},
+		"Errors": map[string]any{
+			"type":     "object",
+			"required": []string{"errors"},
+			"properties": map[string]any{
+				"errors": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/components/schemas/Error"}},
+			},
+		},
+	}
+}
+
+func openAPIErrorResponses() map[string]any {
+	responses := map[string]any{}
+	for name, description := range map[string]string{
+		"BadRequest":          "Invalid parameters",
+		"Unauthorized":        "Missing or invalid API token",
+		"Forbidden":           "Not permitted for the current user",
+		"NotFound":            "Record not found or not visible to the current user",
+		"UnprocessableEntity": "Validation failed; source.pointer names the attribute",
+	} {
+		responses[name] = map[string]any{
+			"description": description,
+			"content": map[string]any{
+				jsonAPIMediaType: map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Errors"}},
+			},
+		}
+	}
+	return responses
+}
@@ -0,0 +1,2297 @@
+// Code generated by build_tools/apiroutes; DO NOT EDIT.
+
+package cli
+
+// apiRoutes lists the API requests made by each command source file.
+var apiRoutes = []apiRoute{
+	{Source: "action_item_key_results_list", Method: "GET", Path: "/v1/action-item-key-results", Filters: []string{"action-item", "key-result"}},
+	{Source: "action_item_key_results_show", Method: "GET", Path: "/v1/action-item-key-results/{id}"},
+	{Source: "action_item_line_items_list", Method: "GET", Path: "/v1/action-item-line-items", Filters: []string{"action-item", "due-on", "due-on-max", "due-on-min", "has-due-on", "responsible-person", "status"}},
+	{Source: "action_item_line_items_show", Method: "GET", Path: "/v1/action-item-line-items/{id}"},
+	{Source: "action_item_team_members_list", Method: "GET", Path: "/v1/action-item-team-members", Filters: []string{"action-item", "is-responsible-person", "user"}},
+	{Source: "action_item_team_members_show", Method: "GET", Path: "/v1/action-item-team-members/{id}"},
+	{Source: "action_item_tracker_update_requests_list", Method: "GET", Path: "/v1/action-item-tracker-update-requests", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "action_item_tracker_update_requests_show", Method: "GET", Path: "/v1/action-item-tracker-update-requests/{id}"},
+	{Source: "action_item_trackers_list", Method: "GET", Path: "/v1/action-item-trackers"},
+	{Source: "action_item_trackers_show", Method: "GET", Path: "/v1/action-item-trackers/{id}"},
+	{Source: "action_items_list", Method: "GET", Path: "/v1/action-items", Filters: []string{"broker", "completed-on", "completed-on-max", "completed-on-min", "created-by", "due-on", "due-on-max", "due-on-min", "expected-benefit-amount-max", "expected-benefit-amount-min", "expected-cost-amount-max", "expected-cost-amount-min", "expected-net-benefit-amount-max", "expected-net-benefit-amount-min", "incident", "is-completed", "is-deleted", "is-unplanned", "kind", "meeting", "parent-action-item", "priority", "project", "q", "requires-xbe-feature", "responsible-organization", "responsible-person", "root-cause", "source", "status", "team-member", "tracker"}},
+	{Source: "action_items_show", Method: "GET", Path: "/v1/action-items/{id}"},
+	{Source: "administrative_incidents_list", Method: "GET", Path: "/v1/administrative-incidents", Filters: []string{"action_items", "assignee", "broker", "contractor", "created_by", "customer", "developer", "did_stop_work", "end_at_max", "end_at_min", "equipment", "has_equipment", "has_live_action_items", "has_parent", "incident_tag", "incident_tag_slug", "job_number", "job_production_plan", "job_production_plan_project", "kind", "material_site", "material_supplier", "natures", "net_impact_dollars_max", "net_impact_dollars_min", "not_subject_type", "notifiable_to", "parent", "q", "responsible_person", "root_causes", "severity", "start_at_max", "start_at_min", "start_on", "start_on_max", "start_on_min", "status", "subject", "subject_type", "tender_job_schedule_shift", "tender_job_schedule_shift_driver", "tender_job_schedule_shift_trucker", "trucker", "user_has_stake", "zero_incident_tags"}},
+	{Source: "administrative_incidents_show", Method: "GET", Path: "/v1/administrative-incidents/{id}"},
+	{Source: "answer_feedbacks_list", Method: "GET", Path: "/v1/answer-feedbacks", Filters: []string{"answer"}},
+	{Source: "answer_feedbacks_show", Method: "GET", Path: "/v1/answer-feedbacks/{id}"},
+	{Source: "answer_related_contents_list", Method: "GET", Path: "/v1/answer-related-contents", Filters: []string{"answer", "created-at-max", "created-at-min", "is-created-at", "is-updated-at", "not_related_content_type", "related_content", "related_content_type", "updated-at-max", "updated-at-min"}},
+	{Source: "answer_related_contents_show", Method: "GET", Path: "/v1/answer-related-contents/{id}"},
+	{Source: "answers_list", Method: "GET", Path: "/v1/answers", Filters: []string{"question", "with-feedback", "without-feedback"}},
+	{Source: "answers_show", Method: "GET", Path: "/v1/answers/{id}"},
+	{Source: "api_tokens_list", Method: "GET", Path: "/v1/api-tokens", Filters: []string{"user"}},
+	{Source: "api_tokens_show", Method: "GET", Path: "/v1/api-tokens/{id}"},
+	{Source: "application_settings_list", Method: "GET", Path: "/v1/application-settings"},
+	{Source: "application_settings_show", Method: "GET", Path: "/v1/application-settings/{id}"},
+	{Source: "auth", Method: "GET", Path: "/v1/users/me"},
+	{Source: "auth_whoami", Method: "GET", Path: "/v1/users/me"},
+	{Source: "base_summary_templates_list", Method: "GET", Path: "/v1/base-summary-templates", Filters: []string{"broker", "created-by", "label"}},
+	{Source: "base_summary_templates_show", Method: "GET", Path: "/v1/base-summary-templates/{id}"},
+	{Source: "bidders_list", Method: "GET", Path: "/v1/bidders", Filters: []string{"broker", "is-self-for-broker", "name", "name-like"}},
+	{Source: "bidders_show", Method: "GET", Path: "/v1/bidders/{id}"},
+	{Source: "broker_certification_types_list", Method: "GET", Path: "/v1/broker-certification-types", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "broker_certification_types_show", Method: "GET", Path: "/v1/broker-certification-types/{id}"},
+	{Source: "broker_commitments_list", Method: "GET", Path: "/v1/broker-commitments", Filters: []string{"broker", "broker_id", "created-at-max", "created-at-min", "status", "trucker", "trucker_id", "updated-at-max", "updated-at-min"}},
+	{Source: "broker_commitments_show", Method: "GET", Path: "/v1/broker-commitments/{id}"},
+	{Source: "broker_customers_list", Method: "GET", Path: "/v1/broker-customers", Filters: []string{"broker", "created-at-max", "created-at-min", "external-accounting-broker-customer-id", "external-identification-value", "not-organization-type", "not-partner-type", "organization", "organization-id", "organization-type", "partner", "partner-id", "partner-type", "trading-partner-type", "updated-at-max", "updated-at-min"}},
+	{Source: "broker_customers_show", Method: "GET", Path: "/v1/broker-customers/{id}"},
+	{Source: "broker_equipment_classifications_list", Method: "GET", Path: "/v1/broker-equipment-classifications", Filters: []string{"broker", "created_at_max", "created_at_min", "equipment_classification", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "broker_equipment_classifications_show", Method: "GET", Path: "/v1/broker-equipment-classifications/{id}"},
+	{Source: "broker_invoices_list", Method: "GET", Path: "/v1/broker-invoices", Filters: []string{"allocated-cost-codes", "batch-status", "broker", "business-unit", "buyer", "customer", "due-on", "due-on-max", "due-on-min", "has-due-on", "has-invoice-date", "has-ticket-report", "having-plans-with-job-number-like", "invoice-date", "invoice-date-max", "invoice-date-min", "is-management-service-type", "material-transaction-cost-codes", "material-transaction-ticket-numbers", "not-business-unit", "seller", "status", "tender", "ticket-number"}},
+	{Source: "broker_invoices_show", Method: "GET", Path: "/v1/broker-invoices/{id}"},
+	{Source: "broker_memberships_list", Method: "GET", Path: "/v1/broker-memberships", Filters: []string{"broker", "drives_shift_type", "external_employee_id", "is_default_job_production_plan_subscriber", "is_equipment_rental_team_member", "is_geofence_violation_team_member", "is_rate_editor", "is_time_card_auditor", "is_unapproved_time_card_subscriber", "kind", "organization", "project_office", "q", "user"}},
+	{Source: "broker_memberships_show", Method: "GET", Path: "/v1/broker-memberships/{id}"},
+	{Source: "broker_project_transport_event_types_list", Method: "GET", Path: "/v1/broker-project-transport-event-types", Filters: []string{"broker", "project-transport-event-type"}},
+	{Source: "broker_project_transport_event_types_show", Method: "GET", Path: "/v1/broker-project-transport-event-types/{id}"},
+	{Source: "broker_retainers_list", Method: "GET", Path: "/v1/broker-retainers", Filters: []string{"buyer", "seller", "status"}},
+	{Source: "broker_retainers_show", Method: "GET", Path: "/v1/broker-retainers/{id}"},
+	{Source: "broker_settings_list", Method: "GET", Path: "/v1/broker-settings"},
+	{Source: "broker_tender_cancelled_seller_notifications_list", Method: "GET", Path: "/v1/broker-tender-cancelled-seller-notifications", Filters: []string{"deliver-at", "delivery-decision-approach", "is-ready-for-delivery", "notification-type", "read", "user"}},
+	{Source: "broker_tender_cancelled_seller_notifications_show", Method: "GET", Path: "/v1/broker-tender-cancelled-seller-notifications/{id}"},
+	{Source: "broker_tender_offered_seller_notification_subscriptions_list", Method: "GET", Path: "/v1/broker-tender-offered-seller-notification-subscriptions", Filters: []string{"user"}},
+	{Source: "broker_tender_offered_seller_notification_subscriptions_show", Method: "GET", Path: "/v1/broker-tender-offered-seller-notification-subscriptions/{id}"},
+	{Source: "broker_tender_offered_seller_notifications_list", Method: "GET", Path: "/v1/broker-tender-offered-seller-notifications", Filters: []string{"created-at-max", "created-at-min", "deliver-at", "deliver-at-max", "deliver-at-min", "delivery-decision-approach", "is-created-at", "is-ready-for-delivery", "is-updated-at", "not-id", "notification-type", "read", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "broker_tender_offered_seller_notifications_show", Method: "GET", Path: "/v1/broker-tender-offered-seller-notifications/{id}"},
+	{Source: "broker_tender_returned_buyer_notifications_list", Method: "GET", Path: "/v1/broker-tender-returned-buyer-notifications", Filters: []string{"deliver-at", "delivery-decision-approach", "is-ready-for-delivery", "notification-type", "read", "user"}},
+	{Source: "broker_tender_returned_buyer_notifications_show", Method: "GET", Path: "/v1/broker-tender-returned-buyer-notifications/{id}"},
+	{Source: "broker_tenders_list", Method: "GET", Path: "/v1/broker-tenders", Filters: []string{"broker", "business-unit", "buyer", "created-at-max", "created-at-min", "end-at-max", "has-flexible-shifts", "job", "job-number", "job-production-plan-material-sites", "job-production-plan-material-types", "job-production-plan-name-or-number-like", "job-production-plan-trailer-classification-or-equivalent", "job-site", "seller", "start-at-max", "start-at-min", "status", "updated-at-max", "updated-at-min", "with-alive-shifts"}},
+	{Source: "broker_tenders_show", Method: "GET", Path: "/v1/broker-tenders/{id}"},
+	{Source: "broker_trucker_ratings_list", Method: "GET", Path: "/v1/broker-trucker-ratings", Filters: []string{"broker", "created_at_max", "created_at_min", "is_created_at", "is_updated_at", "rating", "trucker", "updated_at_max", "updated_at_min"}},
+	{Source: "broker_trucker_ratings_show", Method: "GET", Path: "/v1/broker-trucker-ratings/{id}"},
+	{Source: "broker_vendors_list", Method: "GET", Path: "/v1/broker-vendors", Filters: []string{"broker", "created-at-max", "created-at-min", "external-accounting-broker-vendor-id", "external-identification-value", "not-organization-type", "not-partner-type", "organization", "organization-id", "organization-type", "partner", "partner-id", "partner-type", "trading-partner-type", "updated-at-max", "updated-at-min"}},
+	{Source: "broker_vendors_show", Method: "GET", Path: "/v1/broker-vendors/{id}"},
+	{Source: "brokers_list", Method: "GET", Path: "/v1/brokers", Filters: []string{"can-customer-operations-see-driver-contact-information", "can-customers-see-driver-contact-information", "company-name", "has-help-text", "is-active", "is-default", "quickbooks-enabled", "skip-tender-job-schedule-shift-starting-seller-notifications", "sub-domain", "trailer-classification"}},
+	{Source: "business_unit_customers_list", Method: "GET", Path: "/v1/business-unit-customers", Filters: []string{"business-unit", "customer"}},
+	{Source: "business_unit_customers_show", Method: "GET", Path: "/v1/business-unit-customers/{id}"},
+	{Source: "business_unit_equipments_list", Method: "GET", Path: "/v1/business-unit-equipments", Filters: []string{"business-unit", "equipment"}},
+	{Source: "business_unit_equipments_show", Method: "GET", Path: "/v1/business-unit-equipments/{id}"},
+	{Source: "business_unit_laborers_list", Method: "GET", Path: "/v1/business-unit-laborers", Filters: []string{"business-unit", "laborer"}},
+	{Source: "business_unit_laborers_show", Method: "GET", Path: "/v1/business-unit-laborers/{id}"},
+	{Source: "business_unit_memberships_list", Method: "GET", Path: "/v1/business-unit-memberships", Filters: []string{"business_unit", "kind", "membership"}},
+	{Source: "business_unit_memberships_show", Method: "GET", Path: "/v1/business-unit-memberships/{id}"},
+	{Source: "business_units_list", Method: "GET", Path: "/v1/business-units", Filters: []string{"broker", "company-name", "parent", "with-children", "without-children"}},
+	{Source: "certification_requirements_list", Method: "GET", Path: "/v1/certification-requirements", Filters: []string{"certification_type", "required_by"}},
+	{Source: "certification_types_list", Method: "GET", Path: "/v1/certification-types", Filters: []string{"broker", "can-apply-to", "can-be-requirement-of", "name"}},
+	{Source: "certifications_list", Method: "GET", Path: "/v1/certifications", Filters: []string{"broker", "by_certifies", "certification_type", "expires_before", "expires_within_days", "status"}},
+	{Source: "change_requests_list", Method: "GET", Path: "/v1/change-requests", Filters: []string{"broker", "created_by", "organization", "organization_id", "organization_type"}},
+	{Source: "change_requests_show", Method: "GET", Path: "/v1/change-requests/{id}"},
+	{Source: "comment_reactions_list", Method: "GET", Path: "/v1/comment-reactions", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "not-id", "updated-at-max", "updated-at-min"}},
+	{Source: "comment_reactions_show", Method: "GET", Path: "/v1/comment-reactions/{id}"},
+	{Source: "comments_list", Method: "GET", Path: "/v1/comments", Filters: []string{"commentable", "commentable_type", "created_by", "driver_day_driver", "job_production_plan_project"}},
+	{Source: "commitment_items_list", Method: "GET", Path: "/v1/commitment-items", Filters: []string{"commitment", "status"}},
+	{Source: "commitment_items_show", Method: "GET", Path: "/v1/commitment-items/{id}"},
+	{Source: "commitment_simulation_periods_list", Method: "GET", Path: "/v1/commitment-simulation-periods", Filters: []string{"commitment_simulation"}},
+	{Source: "commitment_simulation_periods_show", Method: "GET", Path: "/v1/commitment-simulation-periods/{id}"},
+	{Source: "commitment_simulation_sets_list", Method: "GET", Path: "/v1/commitment-simulation-sets", Filters: []string{"organization", "organization_id", "organization_type", "status"}},
+	{Source: "commitment_simulation_sets_show", Method: "GET", Path: "/v1/commitment-simulation-sets/{id}"},
+	{Source: "commitment_simulations_list", Method: "GET", Path: "/v1/commitment-simulations", Filters: []string{"commitment", "created-at-max", "created-at-min", "status", "updated-at-max", "updated-at-min"}},
+	{Source: "commitment_simulations_show", Method: "GET", Path: "/v1/commitment-simulations/{id}"},
+	{Source: "commitments_list", Method: "GET", Path: "/v1/commitments", Filters: []string{"broker", "status"}},
+	{Source: "commitments_show", Method: "GET", Path: "/v1/commitments/{id}"},
+	{Source: "communications_list", Method: "GET", Path: "/v1/communications", Filters: []string{"created-at-max", "created-at-min", "delivery-status", "is-addressed", "is-created-at", "is-message-sent-at", "is-retried", "is-updated-at", "message-id", "message-sent-at-max", "message-sent-at-min", "message-type", "subject", "subject-type", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "communications_show", Method: "GET", Path: "/v1/communications/{id}"},
+	{Source: "contractors_list", Method: "GET", Path: "/v1/contractors", Filters: []string{"broker", "incidents", "name"}},
+	{Source: "contractors_show", Method: "GET", Path: "/v1/contractors/{id}"},
+	{Source: "cost_code_trucking_cost_summaries_list", Method: "GET", Path: "/v1/cost-code-trucking-cost-summaries", Filters: []string{"broker", "created-at-max", "created-at-min", "created-by", "is-created-at", "is-updated-at", "updated-at-max", "updated-at-min"}},
+	{Source: "cost_code_trucking_cost_summaries_show", Method: "GET", Path: "/v1/cost-code-trucking-cost-summaries/{id}"},
+	{Source: "cost_codes_list", Method: "GET", Path: "/v1/cost-codes", Filters: []string{"broker", "code", "customer", "description", "job-number", "q", "trucker"}},
+	{Source: "cost_index_entries_list", Method: "GET", Path: "/v1/cost-index-entries", Filters: []string{"cost-index", "end-on", "start-on", "value"}},
+	{Source: "cost_indexes_list", Method: "GET", Path: "/v1/cost-indexes", Filters: []string{"broker", "expired-at-max", "expired-at-min", "is-broker", "is-expired", "is-valid-for-broker"}},
+	{Source: "craft_classes_list", Method: "GET", Path: "/v1/craft-classes", Filters: []string{"broker", "craft", "is-valid-for-drivers"}},
+	{Source: "crafts_list", Method: "GET", Path: "/v1/crafts", Filters: []string{"broker"}},
+	{Source: "crew_assignment_confirmations_list", Method: "GET", Path: "/v1/crew-assignment-confirmations", Filters: []string{"confirmed-at-max", "confirmed-at-min", "confirmed-by", "created-at-max", "created-at-min", "crew-requirement", "resource", "resource-type", "updated-at-max", "updated-at-min"}},
+	{Source: "crew_assignment_confirmations_show", Method: "GET", Path: "/v1/crew-assignment-confirmations/{id}"},
+	{Source: "crew_rates_list", Method: "GET", Path: "/v1/crew-rates", Filters: []string{"broker", "craft_class", "end_on", "end_on_max", "end_on_min", "is_active", "q", "resource", "resource_classification", "start_on", "start_on_max", "start_on_min"}},
+	{Source: "crew_rates_show", Method: "GET", Path: "/v1/crew-rates/{id}"},
+	{Source: "crew_requirement_credential_classifications_list", Method: "GET", Path: "/v1/crew-requirement-credential-classifications", Filters: []string{"crew_requirement"}},
+	{Source: "crew_requirement_credential_classifications_show", Method: "GET", Path: "/v1/crew-requirement-credential-classifications/{id}"},
+	{Source: "crew_requirements_list", Method: "GET", Path: "/v1/crew-requirements", Filters: []string{"broker", "calculated-mobilization-method", "created-at-max", "created-at-min", "customer", "end-at-effective-max", "end-at-effective-min", "end-at-max", "end-at-min", "has-resource", "is-created-at", "is-end-at", "is-expecting-time-sheet", "is-only-for-equipment-movement", "is-start-at", "is-updated-at", "job-production-plan", "job-production-plan-status", "labor-requirement", "labor-requirement-laborer", "labor-requirement-laborer-id", "labor-requirement-user", "labor-requirement-user-id", "project", "project-manager", "requires-inbound-movement", "requires-outbound-movement", "resource", "resource_classification", "resource_classification_type", "resource_type", "start-at-effective-max", "start-at-effective-min", "start-at-max", "start-at-min", "start-on-effective-max", "start-on-effective-min", "updated-at-max", "updated-at-min", "without-approved-time-sheet", "without-submitted-time-sheet"}},
+	{Source: "crew_requirements_show", Method: "GET", Path: "/v1/crew-requirements/{id}"},
+	{Source: "culture_values_list", Method: "GET", Path: "/v1/culture-values", Filters: []string{"organization"}},
+	{Source: "custom_work_order_statuses_list", Method: "GET", Path: "/v1/custom-work-order-statuses", Filters: []string{"broker", "primary-status"}},
+	{Source: "customer_applications_list", Method: "GET", Path: "/v1/customer-applications", Filters: []string{"broker", "created-at-max", "created-at-min", "status", "updated-at-max", "updated-at-min"}},
+	{Source: "customer_applications_show", Method: "GET", Path: "/v1/customer-applications/{id}"},
+	{Source: "customer_certification_types_list", Method: "GET", Path: "/v1/customer-certification-types", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "customer_certification_types_show", Method: "GET", Path: "/v1/customer-certification-types/{id}"},
+	{Source: "customer_commitments_list", Method: "GET", Path: "/v1/customer-commitments", Filters: []string{"broker", "broker-id", "created-at-max", "created-at-min", "customer", "customer-id", "external-identification-value", "external-job-number", "not-id", "status", "updated-at-max", "updated-at-min"}},
+	{Source: "customer_commitments_show", Method: "GET", Path: "/v1/customer-commitments/{id}"},
+	{Source: "customer_incident_default_assignees_list", Method: "GET", Path: "/v1/customer-incident-default-assignees", Filters: []string{"customer", "default-assignee", "kind"}},
+	{Source: "customer_incident_default_assignees_show", Method: "GET", Path: "/v1/customer-incident-default-assignees/{id}"},
+	{Source: "customer_memberships_list", Method: "GET", Path: "/v1/customer-memberships", Filters: []string{"broker", "drives_shift_type", "external_employee_id", "is_default_job_production_plan_subscriber", "is_equipment_rental_team_member", "is_geofence_violation_team_member", "is_rate_editor", "is_time_card_auditor", "is_unapproved_time_card_subscriber", "kind", "organization", "project_office", "q", "user"}},
+	{Source: "customer_memberships_show", Method: "GET", Path: "/v1/customer-memberships/{id}"},
+	{Source: "customer_retainers_list", Method: "GET", Path: "/v1/customer-retainers", Filters: []string{"buyer", "created-at-max", "created-at-min", "not-id", "seller", "status", "updated-at-max", "updated-at-min"}},
+	{Source: "customer_retainers_show", Method: "GET", Path: "/v1/customer-retainers/{id}"},
+	{Source: "customer_settings_list", Method: "GET", Path: "/v1/customer-settings"},
+	{Source: "customer_tender_offered_buyer_notification_subscriptions_list", Method: "GET", Path: "/v1/customer-tender-offered-buyer-notification-subscriptions", Filters: []string{"user"}},
+	{Source: "customer_tender_offered_buyer_notification_subscriptions_show", Method: "GET", Path: "/v1/customer-tender-offered-buyer-notification-subscriptions/{id}"},
+	{Source: "customer_tender_offered_buyer_notifications_list", Method: "GET", Path: "/v1/customer-tender-offered-buyer-notifications", Filters: []string{"deliver-at", "delivery-decision-approach", "is-ready-for-delivery", "notification-type", "read", "user"}},
+	{Source: "customer_tender_offered_buyer_notifications_show", Method: "GET", Path: "/v1/customer-tender-offered-buyer-notifications/{id}"},
+	{Source: "customer_tenders_list", Method: "GET", Path: "/v1/customer-tenders", Filters: []string{"broker", "business-unit", "buyer", "created-at-max", "created-at-min", "end-at-max", "external-identification-value", "has-flexible-shifts", "job", "job-number", "job-production-plan-material-sites", "job-production-plan-material-types", "job-production-plan-name-or-number-like", "job-production-plan-trailer-classification-or-equivalent", "job-site", "seller", "start-at-max", "start-at-min", "status", "updated-at-max", "updated-at-min", "with-alive-shifts"}},
+	{Source: "customer_tenders_show", Method: "GET", Path: "/v1/customer-tenders/{id}"},
+	{Source: "customer_truckers_list", Method: "GET", Path: "/v1/customer-truckers", Filters: []string{"broker", "customer", "trucker"}},
+	{Source: "customer_truckers_show", Method: "GET", Path: "/v1/customer-truckers/{id}"},
+	{Source: "customer_vendors_list", Method: "GET", Path: "/v1/customer-vendors", Filters: []string{"created-at-max", "created-at-min", "external-identification-value", "not-organization-type", "not-partner-type", "organization", "organization-id", "organization-type", "partner", "partner-id", "partner-type", "trading-partner-type", "updated-at-max", "updated-at-min"}},
+	{Source: "customer_vendors_show", Method: "GET", Path: "/v1/customer-vendors/{id}"},
+	{Source: "customers_list", Method: "GET", Path: "/v1/customers", Filters: []string{"broker", "broker-customer-id", "company-name", "external-identification-value", "favorite", "is-controlled-by-broker", "is-only-for-equipment-movement", "is_active", "trailer-classification"}},
+	{Source: "deere_equipments_list", Method: "GET", Path: "/v1/deere-equipments", Filters: []string{"broker", "created_at_max", "created_at_min", "equipment", "equipment_assigned_at_min", "equipment_set_at_max", "equipment_set_at_min", "has_equipment", "integration_identifier", "is_created_at", "is_equipment_set_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "deere_equipments_show", Method: "GET", Path: "/v1/deere-equipments/{id}"},
+	{Source: "deere_integrations_list", Method: "GET", Path: "/v1/deere-integrations", Filters: []string{"broker"}},
+	{Source: "deere_integrations_show", Method: "GET", Path: "/v1/deere-integrations/{id}"},
+	{Source: "developer_certified_weighers_list", Method: "GET", Path: "/v1/developer-certified-weighers", Filters: []string{"developer", "is_active", "user"}},
+	{Source: "developer_certified_weighers_show", Method: "GET", Path: "/v1/developer-certified-weighers/{id}"},
+	{Source: "developer_memberships_list", Method: "GET", Path: "/v1/developer-memberships", Filters: []string{"broker", "drives_shift_type", "external_employee_id", "is_default_job_production_plan_subscriber", "is_equipment_rental_team_member", "is_geofence_violation_team_member", "is_rate_editor", "is_time_card_auditor", "is_unapproved_time_card_subscriber", "kind", "organization", "project_office", "q", "user"}},
+	{Source: "developer_memberships_show", Method: "GET", Path: "/v1/developer-memberships/{id}"},
+	{Source: "developer_reference_types_list", Method: "GET", Path: "/v1/developer-reference-types", Filters: []string{"broker", "developer", "subject-type"}},
+	{Source: "developer_references_list", Method: "GET", Path: "/v1/developer-references", Filters: []string{"developer", "developer_reference_type", "subject"}},
+	{Source: "developer_trucker_certification_classifications_list", Method: "GET", Path: "/v1/developer-trucker-certification-classifications", Filters: []string{"broker", "developer"}},
+	{Source: "developer_trucker_certification_multipliers_list", Method: "GET", Path: "/v1/developer-trucker-certification-multipliers", Filters: []string{"developer_trucker_certification", "trailer"}},
+	{Source: "developer_trucker_certification_multipliers_show", Method: "GET", Path: "/v1/developer-trucker-certification-multipliers/{id}"},
+	{Source: "developer_trucker_certifications_list", Method: "GET", Path: "/v1/developer-trucker-certifications", Filters: []string{"classification", "developer", "tender-job-schedule-shift", "trucker"}},
+	{Source: "developer_trucker_certifications_show", Method: "GET", Path: "/v1/developer-trucker-certifications/{id}"},
+	{Source: "developers_list", Method: "GET", Path: "/v1/developers", Filters: []string{"broker", "exact_name", "name"}},
+	{Source: "device_diagnostics_list", Method: "GET", Path: "/v1/device-diagnostics", Filters: []string{"are-location-services-enabled", "device", "device-identifier", "has-location-accuracy-authorization-status", "has-motion-permission-status", "has-permission-status", "is-gps-location-provider-enabled", "is-network-location-provider-enabled", "is-not-tracking-because-of-stationary-mode", "location-accuracy-authorization-status", "motion-permission-status", "not-motion-permission-status", "not-permission-status", "permission-status", "user"}},
+	{Source: "device_diagnostics_show", Method: "GET", Path: "/v1/device-diagnostics/{id}"},
+	{Source: "devices_list", Method: "GET", Path: "/v1/devices", Filters: []string{"first-device-location-event-at-max", "first-device-location-event-at-min", "has-push-token", "identifier", "is-pushable", "last-device-location-event-at-max", "last-device-location-event-at-min", "pushable-status", "user"}},
+	{Source: "digital_fleet_ticket_events_list", Method: "GET", Path: "/v1/digital-fleet-ticket-events", Filters: []string{"broker", "event-at-max", "event-at-min", "is-event-at"}},
+	{Source: "digital_fleet_ticket_events_show", Method: "GET", Path: "/v1/digital-fleet-ticket-events/{id}"},
+	{Source: "digital_fleet_trucks_list", Method: "GET", Path: "/v1/digital-fleet-trucks", Filters: []string{"assigned_at_min", "broker", "created_at_max", "created_at_min", "has_tractor", "has_trailer", "integration_identifier", "is_active", "is_created_at", "is_tractor_set_at", "is_trailer_set_at", "is_updated_at", "tractor", "tractor_set_at_max", "tractor_set_at_min", "trailer", "trailer_set_at_max", "trailer_set_at_min", "trucker", "updated_at_max", "updated_at_min"}},
+	{Source: "digital_fleet_trucks_show", Method: "GET", Path: "/v1/digital-fleet-trucks/{id}"},
+	{Source: "do_action_item_key_results_create", Method: "POST", Path: "/v1/action-item-key-results", Relationships: []string{"action-item", "key-result"}},
+	{Source: "do_action_item_key_results_delete", Method: "DELETE", Path: "/v1/action-item-key-results/{id}"},
+	{Source: "do_action_item_line_items_create", Method: "POST", Path: "/v1/action-item-line-items", Attributes: []string{"due-on", "status", "title"}, Relationships: []string{"action-item", "responsible-person"}},
+	{Source: "do_action_item_line_items_delete", Method: "DELETE", Path: "/v1/action-item-line-items/{id}"},
+	{Source: "do_action_item_line_items_update", Method: "PATCH", Path: "/v1/action-item-line-items/{id}", Attributes: []string{"due-on", "status", "title"}, Relationships: []string{"responsible-person"}},
+	{Source: "do_action_item_team_members_create", Method: "POST", Path: "/v1/action-item-team-members", Attributes: []string{"is-responsible-person"}, Relationships: []string{"action-item", "user"}},
+	{Source: "do_action_item_team_members_delete", Method: "DELETE", Path: "/v1/action-item-team-members/{id}"},
+	{Source: "do_action_item_team_members_update", Method: "PATCH", Path: "/v1/action-item-team-members/{id}", Attributes: []string{"is-responsible-person"}},
+	{Source: "do_action_item_tracker_update_requests_create", Method: "POST", Path: "/v1/action-item-tracker-update-requests", Attributes: []string{"due-on", "request-note", "update-note"}, Relationships: []string{"action-item-tracker", "requested-by", "requested-from"}},
+	{Source: "do_action_item_tracker_update_requests_delete", Method: "DELETE", Path: "/v1/action-item-tracker-update-requests/{id}"},
+	{Source: "do_action_item_tracker_update_requests_update", Method: "PATCH", Path: "/v1/action-item-tracker-update-requests/{id}", Attributes: []string{"due-on", "request-note", "update-note"}},
+	{Source: "do_action_item_trackers_create", Method: "POST", Path: "/v1/action-item-trackers", Attributes: []string{"dev-effort-minutes", "dev-effort-size", "has-due-date-agreement", "is-unplanned", "priority-position", "status"}, Relationships: []string{"action-item", "customer-success-assignee", "dev-assignee"}},
+	{Source: "do_action_item_trackers_delete", Method: "DELETE", Path: "/v1/action-item-trackers/{id}"},
+	{Source: "do_action_item_trackers_update", Method: "PATCH", Path: "/v1/action-item-trackers/{id}", Attributes: []string{"dev-effort-minutes", "dev-effort-size", "has-due-date-agreement", "is-unplanned", "priority-position", "status"}, Relationships: []string{"customer-success-assignee", "dev-assignee"}},
+	{Source: "do_action_items_create", Method: "POST", Path: "/v1/action-items", Attributes: []string{"description", "due-on", "expected-benefit-amount", "expected-cost-amount", "kind", "requires-xbe-feature", "status", "title"}},
+	{Source: "do_action_items_delete", Method: "GET", Path: "/v1/action-items/{id}"},
+	{Source: "do_action_items_delete", Method: "PATCH", Path: "/v1/action-items/{id}", Attributes: []string{"deleted-at"}},
+	{Source: "do_action_items_update", Method: "PATCH", Path: "/v1/action-items/{id}", Attributes: []string{"completed-on", "description", "due-on", "expected-benefit-amount", "expected-cost-amount", "kind", "requires-xbe-feature", "status", "title"}},
+	{Source: "do_administrative_incidents_create", Method: "POST", Path: "/v1/administrative-incidents", Attributes: []string{"natures", "start-at", "status"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_administrative_incidents_update", Method: "PATCH", Path: "/v1/administrative-incidents/{id}", Attributes: []string{"natures"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_answer_feedbacks_create", Method: "POST", Path: "/v1/answer-feedbacks", Attributes: []string{"better-content", "notes", "score"}, Relationships: []string{"answer"}},
+	{Source: "do_answer_feedbacks_delete", Method: "DELETE", Path: "/v1/answer-feedbacks/{id}"},
+	{Source: "do_answer_feedbacks_update", Method: "PATCH", Path: "/v1/answer-feedbacks/{id}", Attributes: []string{"better-content", "notes", "score"}},
+	{Source: "do_answer_related_contents_create", Method: "POST", Path: "/v1/answer-related-contents", Relationships: []string{"answer", "related-content"}},
+	{Source: "do_answer_related_contents_update", Method: "PATCH", Path: "/v1/answer-related-contents/{id}", Relationships: []string{"answer", "related-content"}},
+	{Source: "do_api_tokens_create", Method: "POST", Path: "/v1/api-tokens", Attributes: []string{"expires-at", "name"}, Relationships: []string{"user"}},
+	{Source: "do_api_tokens_update", Method: "PATCH", Path: "/v1/api-tokens/{id}", Attributes: []string{"revoked-at"}},
+	{Source: "do_application_settings_create", Method: "POST", Path: "/v1/application-settings", Attributes: []string{"description", "key", "value"}},
+	{Source: "do_application_settings_delete", Method: "GET", Path: "/v1/application-settings/{id}"},
+	{Source: "do_application_settings_delete", Method: "DELETE", Path: "/v1/application-settings/{id}"},
+	{Source: "do_application_settings_update", Method: "PATCH", Path: "/v1/application-settings/{id}", Attributes: []string{"description", "value"}},
+	{Source: "do_base_summary_templates_create", Method: "POST", Path: "/v1/base-summary-templates", Attributes: []string{"end-date", "explicit-metrics", "filters", "group-bys", "label", "start-date"}, Relationships: []string{"broker", "created-by"}},
+	{Source: "do_base_summary_templates_delete", Method: "GET", Path: "/v1/base-summary-templates/{id}"},
+	{Source: "do_base_summary_templates_delete", Method: "DELETE", Path: "/v1/base-summary-templates/{id}"},
+	{Source: "do_bidders_create", Method: "POST", Path: "/v1/bidders", Attributes: []string{"is-self-for-broker", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_bidders_delete", Method: "DELETE", Path: "/v1/bidders/{id}"},
+	{Source: "do_bidders_update", Method: "PATCH", Path: "/v1/bidders/{id}", Attributes: []string{"is-self-for-broker", "name"}},
+	{Source: "do_broker_certification_types_create", Method: "POST", Path: "/v1/broker-certification-types", Relationships: []string{"broker", "certification-type"}},
+	{Source: "do_broker_certification_types_delete", Method: "GET", Path: "/v1/broker-certification-types/{id}"},
+	{Source: "do_broker_certification_types_delete", Method: "DELETE", Path: "/v1/broker-certification-types/{id}"},
+	{Source: "do_broker_certification_types_update", Method: "PATCH", Path: "/v1/broker-certification-types/{id}", Relationships: []string{"broker", "certification-type"}},
+	{Source: "do_broker_commitments_create", Method: "POST", Path: "/v1/broker-commitments", Attributes: []string{"label", "notes", "status"}, Relationships: []string{"buyer", "seller", "truck-scope"}},
+	{Source: "do_broker_commitments_delete", Method: "DELETE", Path: "/v1/broker-commitments/{id}"},
+	{Source: "do_broker_commitments_update", Method: "PATCH", Path: "/v1/broker-commitments/{id}", Attributes: []string{"label", "notes", "status"}, Relationships: []string{"buyer", "seller", "truck-scope"}},
+	{Source: "do_broker_customers_create", Method: "POST", Path: "/v1/broker-customers", Attributes: []string{"external-accounting-broker-customer-id"}, Relationships: []string{"organization", "partner"}},
+	{Source: "do_broker_customers_delete", Method: "DELETE", Path: "/v1/broker-customers/{id}"},
+	{Source: "do_broker_customers_update", Method: "PATCH", Path: "/v1/broker-customers/{id}", Attributes: []string{"external-accounting-broker-customer-id"}},
+	{Source: "do_broker_equipment_classifications_create", Method: "POST", Path: "/v1/broker-equipment-classifications", Relationships: []string{"broker", "equipment-classification"}},
+	{Source: "do_broker_equipment_classifications_delete", Method: "GET", Path: "/v1/broker-equipment-classifications/{id}"},
+	{Source: "do_broker_equipment_classifications_delete", Method: "DELETE", Path: "/v1/broker-equipment-classifications/{id}"},
+	{Source: "do_broker_equipment_classifications_update", Method: "PATCH", Path: "/v1/broker-equipment-classifications/{id}", Relationships: []string{"broker", "equipment-classification"}},
+	{Source: "do_broker_invoices_create", Method: "POST", Path: "/v1/broker-invoices", Relationships: []string{"buyer", "seller", "time-cards"}},
+	{Source: "do_broker_invoices_delete", Method: "DELETE", Path: "/v1/broker-invoices/{id}"},
+	{Source: "do_broker_invoices_update", Method: "PATCH", Path: "/v1/broker-invoices/{id}", Attributes: []string{"adjustment-amount", "currency-code", "due-on", "explicit-buyer-address", "explicit-buyer-name", "invoice-date", "notes"}, Relationships: []string{"buyer", "seller", "time-cards"}},
+	{Source: "do_broker_memberships_create", Method: "POST", Path: "/v1/broker-memberships", Relationships: []string{"organization", "project-office", "user"}},
+	{Source: "do_broker_memberships_delete", Method: "GET", Path: "/v1/broker-memberships/{id}"},
+	{Source: "do_broker_memberships_delete", Method: "DELETE", Path: "/v1/broker-memberships/{id}"},
+	{Source: "do_broker_memberships_update", Method: "PATCH", Path: "/v1/broker-memberships/{id}", Attributes: []string{"can-see-rates-as-driver", "can-see-rates-as-manager", "can-validate-profit-improvements", "color-hex", "drives-shift-type", "enable-inventory-capacity-notifications", "enable-recap-notifications", "end-at", "explicit-sort-order", "external-employee-id", "is-admin", "is-default-job-production-plan-subscriber", "is-equipment-rental-team-member", "is-geofence-violation-team-member", "is-rate-editor", "is-time-card-auditor", "is-unapproved-time-card-subscriber", "kind", "start-at", "title"}},
+	{Source: "do_broker_project_transport_event_types_create", Method: "POST", Path: "/v1/broker-project-transport-event-types", Attributes: []string{"code"}, Relationships: []string{"broker", "project-transport-event-type"}},
+	{Source: "do_broker_project_transport_event_types_delete", Method: "DELETE", Path: "/v1/broker-project-transport-event-types/{id}"},
+	{Source: "do_broker_project_transport_event_types_update", Method: "PATCH", Path: "/v1/broker-project-transport-event-types/{id}", Attributes: []string{"code"}},
+	{Source: "do_broker_retainer_payment_forecasts_create", Method: "POST", Path: "/v1/broker-retainer-payment-forecasts", Attributes: []string{"date"}, Relationships: []string{"broker"}},
+	{Source: "do_broker_retainers_create", Method: "POST", Path: "/v1/broker-retainers", Attributes: []string{"billable-travel-minutes-per-travel-mile", "maximum-expected-daily-hours", "maximum-travel-minutes", "status", "terminated-on"}, Relationships: []string{"buyer", "seller"}},
+	{Source: "do_broker_retainers_delete", Method: "DELETE", Path: "/v1/broker-retainers/{id}"},
+	{Source: "do_broker_retainers_update", Method: "PATCH", Path: "/v1/broker-retainers/{id}", Attributes: []string{"billable-travel-minutes-per-travel-mile", "maximum-expected-daily-hours", "maximum-travel-minutes", "status", "terminated-on"}, Relationships: []string{"buyer", "seller"}},
+	{Source: "do_broker_settings_update", Method: "PATCH", Path: "/v1/broker-settings/{id}", Attributes: []string{"auto-cancel-shifts-without-activity", "enable-recap-notifications", "is-auditing-time-card-approvals", "plan-requires-business-unit", "plan-requires-project", "require-explicit-rate-editing-permission", "restrict-contact-info-visibility"}},
+	{Source: "do_broker_tender_cancelled_seller_notifications_update", Method: "PATCH", Path: "/v1/broker-tender-cancelled-seller-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_broker_tender_offered_seller_notification_subscriptions_create", Method: "POST", Path: "/v1/broker-tender-offered-seller-notification-subscriptions", Attributes: []string{"notify-by-email", "notify-by-txt"}, Relationships: []string{"trucker", "user"}},
+	{Source: "do_broker_tender_offered_seller_notification_subscriptions_delete", Method: "DELETE", Path: "/v1/broker-tender-offered-seller-notification-subscriptions/{id}"},
+	{Source: "do_broker_tender_offered_seller_notification_subscriptions_update", Method: "PATCH", Path: "/v1/broker-tender-offered-seller-notification-subscriptions/{id}", Attributes: []string{"notify-by-email", "notify-by-txt"}, Relationships: []string{"trucker", "user"}},
+	{Source: "do_broker_tender_offered_seller_notifications_update", Method: "PATCH", Path: "/v1/broker-tender-offered-seller-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_broker_tender_returned_buyer_notifications_update", Method: "PATCH", Path: "/v1/broker-tender-returned-buyer-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_broker_tenders_create", Method: "POST", Path: "/v1/broker-tenders", Attributes: []string{"billable-travel-minutes-per-travel-mile", "displays-trips", "expires-at", "is-trucker-shift-rejection-permitted", "maximum-travel-minutes", "note", "payment-terms", "payment-terms-and-conditions", "restrict-to-customer-truckers"}, Relationships: []string{"buyer", "buyer-financial-contact", "buyer-operations-contact", "job", "seller", "seller-financial-contact", "seller-operations-contact"}},
+	{Source: "do_broker_tenders_delete", Method: "DELETE", Path: "/v1/broker-tenders/{id}"},
+	{Source: "do_broker_tenders_update", Method: "PATCH", Path: "/v1/broker-tenders/{id}", Attributes: []string{"billable-travel-minutes-per-travel-mile", "displays-trips", "expires-at", "is-trucker-shift-rejection-permitted", "maximum-travel-minutes", "note", "payment-terms", "payment-terms-and-conditions", "restrict-to-customer-truckers"}, Relationships: []string{"buyer", "buyer-financial-contact", "buyer-operations-contact", "job", "seller", "seller-financial-contact", "seller-operations-contact"}},
+	{Source: "do_broker_trucker_ratings_create", Method: "POST", Path: "/v1/broker-trucker-ratings", Attributes: []string{"rating"}, Relationships: []string{"broker", "trucker"}},
+	{Source: "do_broker_trucker_ratings_delete", Method: "GET", Path: "/v1/broker-trucker-ratings/{id}"},
+	{Source: "do_broker_trucker_ratings_delete", Method: "DELETE", Path: "/v1/broker-trucker-ratings/{id}"},
+	{Source: "do_broker_trucker_ratings_update", Method: "PATCH", Path: "/v1/broker-trucker-ratings/{id}", Attributes: []string{"rating"}},
+	{Source: "do_broker_vendors_create", Method: "POST", Path: "/v1/broker-vendors", Attributes: []string{"external-accounting-broker-vendor-id"}, Relationships: []string{"organization", "partner"}},
+	{Source: "do_broker_vendors_delete", Method: "DELETE", Path: "/v1/broker-vendors/{id}"},
+	{Source: "do_broker_vendors_update", Method: "PATCH", Path: "/v1/broker-vendors/{id}", Attributes: []string{"external-accounting-broker-vendor-id"}},
+	{Source: "do_brokers_create", Method: "POST", Path: "/v1/brokers", Attributes: []string{"abbreviation", "active-equipment-rental-notification-days", "can-customer-operations-see-driver-contact-information", "can-customers-see-driver-contact-information", "company-name", "default-customer-payment-terms", "default-prediction-subject-kind", "default-prediction-subject-lead-time-hours", "default-reply-to-email-address", "default-trucker-payment-terms", "disabled-feedback-types", "enable-equipment-movement", "enable-implicit-time-card-approval", "help-text", "is-accepting-open-door-issues", "is-active", "is-generating-automated-shift-feedback", "is-managing-driver-visibility", "is-managing-quality-control-requirements", "is-non-driver-permitted-to-check-in", "is-transport-only", "job-production-plan-recap-template", "make-trucker-report-card-visible-to-truckers", "min-duration-of-auto-trucking-incident-with-down-time", "modeled-to-actual-confidence-threshold", "modeled-to-projected-confidence-threshold", "prefer-public-dispatch-phone-number", "public-dispatch-phone-number-explicit", "quickbooks-enabled", "quickbooks-enabled-customer-ids", "remit-to-address", "requires-cost-code-allocations", "send-lineup-summaries-to", "shift-feedback-reason-notification-exclusions", "skip-material-transaction-image-extraction", "skip-tender-job-schedule-shift-starting-seller-notifications", "slack-horizon-channel", "slack-ntfy-channel", "slack-ntfy-icon"}, Relationships: []string{"default-customer-rate-agreement-template", "default-dispatch-contact", "default-financial-contact", "default-operations-contact"}},
+	{Source: "do_brokers_delete", Method: "DELETE", Path: "/v1/brokers/{id}"},
+	{Source: "do_brokers_update", Method: "PATCH", Path: "/v1/brokers/{id}", Attributes: []string{"abbreviation", "active-equipment-rental-notification-days", "can-customer-operations-see-driver-contact-information", "can-customers-see-driver-contact-information", "company-name", "default-customer-payment-terms", "default-prediction-subject-kind", "default-prediction-subject-lead-time-hours", "default-reply-to-email-address", "default-trucker-payment-terms", "disabled-feedback-types", "enable-equipment-movement", "enable-implicit-time-card-approval", "help-text", "is-accepting-open-door-issues", "is-active", "is-generating-automated-shift-feedback", "is-managing-driver-visibility", "is-managing-quality-control-requirements", "is-non-driver-permitted-to-check-in", "is-transport-only", "job-production-plan-recap-template", "make-trucker-report-card-visible-to-truckers", "min-duration-of-auto-trucking-incident-with-down-time", "modeled-to-actual-confidence-threshold", "modeled-to-projected-confidence-threshold", "prefer-public-dispatch-phone-number", "public-dispatch-phone-number-explicit", "quickbooks-enabled", "quickbooks-enabled-customer-ids", "remit-to-address", "requires-cost-code-allocations", "send-lineup-summaries-to", "shift-feedback-reason-notification-exclusions", "skip-material-transaction-image-extraction", "skip-tender-job-schedule-shift-starting-seller-notifications", "slack-horizon-channel", "slack-ntfy-channel", "slack-ntfy-icon"}, Relationships: []string{"default-customer-rate-agreement-template", "default-dispatch-contact", "default-financial-contact", "default-operations-contact", "developer"}},
+	{Source: "do_built_time_cards_create", Method: "POST", Path: "/v1/built-time-cards", Relationships: []string{"broker-tender-job-schedule-shift", "customer-tender-job-schedule-shift"}},
+	{Source: "do_business_unit_customers_create", Method: "POST", Path: "/v1/business-unit-customers", Relationships: []string{"business-unit", "customer"}},
+	{Source: "do_business_unit_customers_delete", Method: "DELETE", Path: "/v1/business-unit-customers/{id}"},
+	{Source: "do_business_unit_equipments_create", Method: "POST", Path: "/v1/business-unit-equipments", Relationships: []string{"business-unit", "equipment"}},
+	{Source: "do_business_unit_equipments_delete", Method: "DELETE", Path: "/v1/business-unit-equipments/{id}"},
+	{Source: "do_business_unit_laborers_create", Method: "POST", Path: "/v1/business-unit-laborers", Relationships: []string{"business-unit", "laborer"}},
+	{Source: "do_business_unit_laborers_delete", Method: "DELETE", Path: "/v1/business-unit-laborers/{id}"},
+	{Source: "do_business_unit_memberships_create", Method: "POST", Path: "/v1/business-unit-memberships", Attributes: []string{"kind"}, Relationships: []string{"business-unit", "membership"}},
+	{Source: "do_business_unit_memberships_delete", Method: "DELETE", Path: "/v1/business-unit-memberships/{id}"},
+	{Source: "do_business_unit_memberships_update", Method: "PATCH", Path: "/v1/business-unit-memberships/{id}", Attributes: []string{"kind"}},
+	{Source: "do_business_units_create", Method: "POST", Path: "/v1/business-units", Attributes: []string{"company-name", "external-id"}, Relationships: []string{"broker", "parent"}},
+	{Source: "do_business_units_delete", Method: "DELETE", Path: "/v1/business-units/{id}"},
+	{Source: "do_business_units_update", Method: "PATCH", Path: "/v1/business-units/{id}", Attributes: []string{"company-name", "external-id"}},
+	{Source: "do_certification_requirements_create", Method: "POST", Path: "/v1/certification-requirements", Attributes: []string{"period-end", "period-start"}, Relationships: []string{"certification-type", "required-by"}},
+	{Source: "do_certification_requirements_delete", Method: "DELETE", Path: "/v1/certification-requirements/{id}"},
+	{Source: "do_certification_requirements_update", Method: "PATCH", Path: "/v1/certification-requirements/{id}", Attributes: []string{"period-end", "period-start"}},
+	{Source: "do_certification_types_create", Method: "POST", Path: "/v1/certification-types", Attributes: []string{"can-apply-to", "can-be-requirement-of", "name", "requires-expiration"}, Relationships: []string{"broker"}},
+	{Source: "do_certification_types_delete", Method: "GET", Path: "/v1/certification-types/{id}"},
+	{Source: "do_certification_types_delete", Method: "DELETE", Path: "/v1/certification-types/{id}"},
+	{Source: "do_certification_types_update", Method: "PATCH", Path: "/v1/certification-types/{id}", Attributes: []string{"can-apply-to", "can-be-requirement-of", "name", "requires-expiration"}},
+	{Source: "do_certifications_create", Method: "POST", Path: "/v1/certifications", Attributes: []string{"effective-at", "expires-at", "status"}, Relationships: []string{"certification-type", "certifies"}},
+	{Source: "do_certifications_delete", Method: "DELETE", Path: "/v1/certifications/{id}"},
+	{Source: "do_certifications_update", Method: "PATCH", Path: "/v1/certifications/{id}", Attributes: []string{"effective-at", "expires-at", "status"}},
+	{Source: "do_change_requests_create", Method: "POST", Path: "/v1/change-requests", Attributes: []string{"requests"}, Relationships: []string{"organization"}},
+	{Source: "do_change_requests_delete", Method: "DELETE", Path: "/v1/change-requests/{id}"},
+	{Source: "do_change_requests_update", Method: "PATCH", Path: "/v1/change-requests/{id}", Attributes: []string{"requests"}},
+	{Source: "do_comment_reactions_create", Method: "POST", Path: "/v1/comment-reactions", Relationships: []string{"comment", "created-by", "reaction-classification"}},
+	{Source: "do_comment_reactions_delete", Method: "DELETE", Path: "/v1/comment-reactions/{id}"},
+	{Source: "do_comments_create", Method: "POST", Path: "/v1/comments", Attributes: []string{"body", "do-not-notify", "include-in-recap", "is-admin-only"}, Relationships: []string{"commentable"}},
+	{Source: "do_comments_delete", Method: "DELETE", Path: "/v1/comments/{id}"},
+	{Source: "do_comments_update", Method: "PATCH", Path: "/v1/comments/{id}", Attributes: []string{"body", "do-not-notify", "include-in-recap", "is-admin-only"}},
+	{Source: "do_commitment_items_create", Method: "POST", Path: "/v1/commitment-items", Attributes: []string{"adjustment-coefficient", "adjustment-constant", "adjustment-input", "adjustment-sequence-position", "days-of-week", "end-on", "label", "months", "start-on", "status", "times-of-day", "weeks", "years"}, Relationships: []string{"commitment"}},
+	{Source: "do_commitment_items_delete", Method: "DELETE", Path: "/v1/commitment-items/{id}"},
+	{Source: "do_commitment_items_update", Method: "PATCH", Path: "/v1/commitment-items/{id}", Attributes: []string{"adjustment-coefficient", "adjustment-constant", "adjustment-input", "adjustment-sequence-position", "days-of-week", "end-on", "label", "months", "start-on", "status", "times-of-day", "weeks", "years"}},
+	{Source: "do_commitment_simulation_sets_create", Method: "POST", Path: "/v1/commitment-simulation-sets", Attributes: []string{"end-on", "iteration-count", "start-on"}, Relationships: []string{"organization"}},
+	{Source: "do_commitment_simulation_sets_delete", Method: "DELETE", Path: "/v1/commitment-simulation-sets/{id}"},
+	{Source: "do_commitment_simulations_create", Method: "POST", Path: "/v1/commitment-simulations", Attributes: []string{"end-on", "iteration-count", "start-on"}, Relationships: []string{"commitment"}},
+	{Source: "do_contractors_create", Method: "POST", Path: "/v1/contractors", Attributes: []string{"name"}, Relationships: []string{"broker"}},
+	{Source: "do_contractors_delete", Method: "DELETE", Path: "/v1/contractors/{id}"},
+	{Source: "do_contractors_update", Method: "PATCH", Path: "/v1/contractors/{id}", Attributes: []string{"name"}},
+	{Source: "do_cost_code_trucking_cost_summaries_create", Method: "POST", Path: "/v1/cost-code-trucking-cost-summaries", Attributes: []string{"end-on", "start-on"}, Relationships: []string{"broker"}},
+	{Source: "do_cost_code_trucking_cost_summaries_delete", Method: "DELETE", Path: "/v1/cost-code-trucking-cost-summaries/{id}"},
+	{Source: "do_cost_code_trucking_cost_summaries_update", Method: "PATCH", Path: "/v1/cost-code-trucking-cost-summaries/{id}", Attributes: []string{"end-on", "start-on"}, Relationships: []string{"broker"}},
+	{Source: "do_cost_codes_create", Method: "POST", Path: "/v1/cost-codes", Attributes: []string{"code", "description", "is-active"}, Relationships: []string{"customer", "trucker"}},
+	{Source: "do_cost_codes_delete", Method: "DELETE", Path: "/v1/cost-codes/{id}"},
+	{Source: "do_cost_codes_update", Method: "PATCH", Path: "/v1/cost-codes/{id}", Attributes: []string{"description", "is-active"}},
+	{Source: "do_cost_index_entries_create", Method: "POST", Path: "/v1/cost-index-entries", Attributes: []string{"end-on", "start-on", "value"}, Relationships: []string{"cost-index"}},
+	{Source: "do_cost_index_entries_delete", Method: "DELETE", Path: "/v1/cost-index-entries/{id}"},
+	{Source: "do_cost_index_entries_update", Method: "PATCH", Path: "/v1/cost-index-entries/{id}", Attributes: []string{"end-on", "start-on", "value"}},
+	{Source: "do_cost_indexes_create", Method: "POST", Path: "/v1/cost-indexes", Attributes: []string{"description", "expired-at", "name", "url"}},
+	{Source: "do_cost_indexes_delete", Method: "DELETE", Path: "/v1/cost-indexes/{id}"},
+	{Source: "do_cost_indexes_update", Method: "PATCH", Path: "/v1/cost-indexes/{id}", Attributes: []string{"description", "expired-at", "name", "url"}},
+	{Source: "do_craft_classes_create", Method: "POST", Path: "/v1/craft-classes", Attributes: []string{"code", "is-valid-for-drivers", "name"}, Relationships: []string{"craft"}},
+	{Source: "do_craft_classes_delete", Method: "DELETE", Path: "/v1/craft-classes/{id}"},
+	{Source: "do_craft_classes_update", Method: "PATCH", Path: "/v1/craft-classes/{id}", Attributes: []string{"code", "is-valid-for-drivers", "name"}},
+	{Source: "do_crafts_create", Method: "POST", Path: "/v1/crafts", Attributes: []string{"code", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_crafts_delete", Method: "DELETE", Path: "/v1/crafts/{id}"},
+	{Source: "do_crafts_update", Method: "PATCH", Path: "/v1/crafts/{id}", Attributes: []string{"code", "name"}},
+	{Source: "do_crew_assignment_confirmations_create", Method: "POST", Path: "/v1/crew-assignment-confirmations", Attributes: []string{"assignment-confirmation-uuid", "is-explicit", "note", "start-at"}, Relationships: []string{"confirmed-by", "crew-requirement", "resource"}},
+	{Source: "do_crew_assignment_confirmations_update", Method: "PATCH", Path: "/v1/crew-assignment-confirmations/{id}", Attributes: []string{"is-explicit", "note"}},
+	{Source: "do_crew_rates_create", Method: "POST", Path: "/v1/crew-rates", Attributes: []string{"description", "end-on", "is-active", "price-per-unit", "start-on"}, Relationships: []string{"broker", "craft-class", "resource", "resource-classification"}},
+	{Source: "do_crew_rates_delete", Method: "DELETE", Path: "/v1/crew-rates/{id}"},
+	{Source: "do_crew_rates_update", Method: "PATCH", Path: "/v1/crew-rates/{id}", Attributes: []string{"description", "end-on", "is-active", "price-per-unit", "start-on"}, Relationships: []string{"broker", "craft-class", "resource", "resource-classification"}},
+	{Source: "do_crew_requirement_credential_classifications_create", Method: "POST", Path: "/v1/crew-requirement-credential-classifications", Relationships: []string{"credential-classification", "crew-requirement"}},
+	{Source: "do_crew_requirement_credential_classifications_delete", Method: "DELETE", Path: "/v1/crew-requirement-credential-classifications/{id}"},
+	{Source: "do_crew_requirements_delete", Method: "DELETE", Path: "/v1/crew-requirements/{id}"},
+	{Source: "do_crew_requirements_update", Method: "PATCH", Path: "/v1/crew-requirements/{id}", Attributes: []string{"end-at", "explicit-inbound-latitude", "explicit-inbound-longitude", "explicit-outbound-latitude", "explicit-outbound-longitude", "is-validating-overlapping", "mobilization-method", "note", "requires-inbound-movement", "requires-outbound-movement", "start-at"}, Relationships: []string{"craft-class", "crew-requirement-credential-classifications", "job-production-plan", "labor-requirement", "origin-material-site", "project-cost-classification", "resource", "resource-classification"}},
+	{Source: "do_culture_values_create", Method: "POST", Path: "/v1/culture-values", Attributes: []string{"description", "name"}, Relationships: []string{"organization"}},
+	{Source: "do_culture_values_delete", Method: "GET", Path: "/v1/culture-values/{id}"},
+	{Source: "do_culture_values_delete", Method: "DELETE", Path: "/v1/culture-values/{id}"},
+	{Source: "do_culture_values_update", Method: "PATCH", Path: "/v1/culture-values/{id}", Attributes: []string{"description", "name", "sequence-position"}},
+	{Source: "do_custom_work_order_statuses_create", Method: "POST", Path: "/v1/custom-work-order-statuses", Attributes: []string{"color-hex", "description", "label", "primary-status"}, Relationships: []string{"broker"}},
+	{Source: "do_custom_work_order_statuses_delete", Method: "DELETE", Path: "/v1/custom-work-order-statuses/{id}"},
+	{Source: "do_custom_work_order_statuses_update", Method: "PATCH", Path: "/v1/custom-work-order-statuses/{id}", Attributes: []string{"color-hex", "description", "label", "primary-status"}},
+	{Source: "do_customer_application_approvals_create", Method: "POST", Path: "/v1/customer-application-approvals", Attributes: []string{"credit-limit", "customer-application-id"}},
+	{Source: "do_customer_applications_create", Method: "POST", Path: "/v1/customer-applications", Attributes: []string{"company-address", "company-address-latitude", "company-address-longitude", "company-address-place-id", "company-address-plus-code", "company-name", "company-url", "estimated-annual-material-transport-spend", "is-trucking-company", "notes", "requires-union-drivers", "skip-company-address-geocoding", "status"}, Relationships: []string{"broker", "job-types", "user"}},
+	{Source: "do_customer_applications_delete", Method: "DELETE", Path: "/v1/customer-applications/{id}"},
+	{Source: "do_customer_applications_update", Method: "PATCH", Path: "/v1/customer-applications/{id}", Attributes: []string{"company-address", "company-address-latitude", "company-address-longitude", "company-address-place-id", "company-address-plus-code", "company-name", "company-url", "estimated-annual-material-transport-spend", "is-trucking-company", "notes", "requires-union-drivers", "skip-company-address-geocoding", "status"}, Relationships: []string{"broker", "job-types", "user"}},
+	{Source: "do_customer_certification_types_create", Method: "POST", Path: "/v1/customer-certification-types", Relationships: []string{"certification-type", "customer"}},
+	{Source: "do_customer_certification_types_delete", Method: "GET", Path: "/v1/customer-certification-types/{id}"},
+	{Source: "do_customer_certification_types_delete", Method: "DELETE", Path: "/v1/customer-certification-types/{id}"},
+	{Source: "do_customer_certification_types_update", Method: "PATCH", Path: "/v1/customer-certification-types/{id}", Relationships: []string{"certification-type", "customer"}},
+	{Source: "do_customer_commitments_create", Method: "POST", Path: "/v1/customer-commitments", Attributes: []string{"label", "notes", "status", "tons", "tons-per-shift"}, Relationships: []string{"buyer", "preceding-commitment", "seller", "truck-scope"}},
+	{Source: "do_customer_commitments_delete", Method: "DELETE", Path: "/v1/customer-commitments/{id}"},
+	{Source: "do_customer_commitments_update", Method: "PATCH", Path: "/v1/customer-commitments/{id}", Attributes: []string{"external-job-number", "label", "notes", "status", "tons", "tons-per-shift"}, Relationships: []string{"buyer", "preceding-commitment", "seller", "truck-scope"}},
+	{Source: "do_customer_incident_default_assignees_create", Method: "POST", Path: "/v1/customer-incident-default-assignees", Attributes: []string{"kind"}, Relationships: []string{"customer", "default-assignee"}},
+	{Source: "do_customer_incident_default_assignees_delete", Method: "DELETE", Path: "/v1/customer-incident-default-assignees/{id}"},
+	{Source: "do_customer_incident_default_assignees_update", Method: "PATCH", Path: "/v1/customer-incident-default-assignees/{id}", Attributes: []string{"kind"}, Relationships: []string{"default-assignee"}},
+	{Source: "do_customer_memberships_create", Method: "POST", Path: "/v1/customer-memberships", Relationships: []string{"organization", "project-office", "user"}},
+	{Source: "do_customer_memberships_delete", Method: "GET", Path: "/v1/customer-memberships/{id}"},
+	{Source: "do_customer_memberships_delete", Method: "DELETE", Path: "/v1/customer-memberships/{id}"},
+	{Source: "do_customer_memberships_update", Method: "PATCH", Path: "/v1/customer-memberships/{id}", Attributes: []string{"can-see-rates-as-driver", "can-see-rates-as-manager", "can-validate-profit-improvements", "color-hex", "drives-shift-type", "enable-inventory-capacity-notifications", "enable-recap-notifications", "end-at", "explicit-sort-order", "external-employee-id", "is-admin", "is-default-job-production-plan-subscriber", "is-equipment-rental-team-member", "is-geofence-violation-team-member", "is-rate-editor", "is-time-card-auditor", "is-unapproved-time-card-subscriber", "kind", "start-at", "title"}},
+	{Source: "do_customer_retainers_create", Method: "POST", Path: "/v1/customer-retainers", Relationships: []string{"buyer", "file-attachments", "seller"}},
+	{Source: "do_customer_retainers_delete", Method: "DELETE", Path: "/v1/customer-retainers/{id}"},
+	{Source: "do_customer_retainers_update", Method: "PATCH", Path: "/v1/customer-retainers/{id}", Attributes: []string{"billable-travel-minutes-per-travel-mile", "maximum-expected-daily-hours", "maximum-travel-minutes", "status", "terminated-on"}, Relationships: []string{"buyer", "file-attachments", "seller"}},
+	{Source: "do_customer_settings_update", Method: "PATCH", Path: "/v1/customer-settings/{id}", Attributes: []string{"auto-cancel-shifts-without-activity", "enable-recap-notifications", "is-auditing-time-card-approvals", "plan-requires-business-unit", "plan-requires-project"}},
+	{Source: "do_customer_tender_offered_buyer_notification_subscriptions_create", Method: "POST", Path: "/v1/customer-tender-offered-buyer-notification-subscriptions", Attributes: []string{"notify-by-email", "notify-by-txt"}, Relationships: []string{"broker", "user"}},
+	{Source: "do_customer_tender_offered_buyer_notification_subscriptions_delete", Method: "DELETE", Path: "/v1/customer-tender-offered-buyer-notification-subscriptions/{id}"},
+	{Source: "do_customer_tender_offered_buyer_notification_subscriptions_update", Method: "PATCH", Path: "/v1/customer-tender-offered-buyer-notification-subscriptions/{id}", Attributes: []string{"notify-by-email", "notify-by-txt"}, Relationships: []string{"broker", "user"}},
+	{Source: "do_customer_tender_offered_buyer_notifications_update", Method: "PATCH", Path: "/v1/customer-tender-offered-buyer-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_customer_tenders_create", Method: "POST", Path: "/v1/customer-tenders", Attributes: []string{"billable-travel-minutes-per-travel-mile", "displays-trips", "expires-at", "is-trucker-shift-rejection-permitted", "maximum-travel-minutes", "note", "payment-terms", "payment-terms-and-conditions", "restrict-to-customer-truckers"}, Relationships: []string{"buyer", "buyer-financial-contact", "buyer-operations-contact", "certification-requirements", "job", "seller", "seller-financial-contact", "seller-operations-contact"}},
+	{Source: "do_customer_tenders_delete", Method: "DELETE", Path: "/v1/customer-tenders/{id}"},
+	{Source: "do_customer_tenders_update", Method: "PATCH", Path: "/v1/customer-tenders/{id}", Attributes: []string{"billable-travel-minutes-per-travel-mile", "displays-trips", "expires-at", "is-trucker-shift-rejection-permitted", "maximum-travel-minutes", "note", "payment-terms", "payment-terms-and-conditions", "restrict-to-customer-truckers"}, Relationships: []string{"buyer", "buyer-financial-contact", "buyer-operations-contact", "job", "seller", "seller-financial-contact", "seller-operations-contact"}},
+	{Source: "do_customer_truckers_create", Method: "POST", Path: "/v1/customer-truckers", Relationships: []string{"customer", "trucker"}},
+	{Source: "do_customer_truckers_delete", Method: "DELETE", Path: "/v1/customer-truckers/{id}"},
+	{Source: "do_customer_vendors_create", Method: "POST", Path: "/v1/customer-vendors", Attributes: []string{"external-accounting-customer-vendor-id"}, Relationships: []string{"organization", "partner"}},
+	{Source: "do_customer_vendors_delete", Method: "DELETE", Path: "/v1/customer-vendors/{id}"},
+	{Source: "do_customer_vendors_update", Method: "PATCH", Path: "/v1/customer-vendors/{id}", Attributes: []string{"external-accounting-customer-vendor-id"}},
+	{Source: "do_customers_create", Method: "POST", Path: "/v1/customers", Attributes: []string{"automatically-approve-daily-invoice", "bill-to-address", "billing-period-day-count", "billing-period-end-invoice-offset-day-count", "billing-period-start-on", "can-manage-crew-requirements", "company-address", "company-address-latitude", "company-address-longitude", "company-address-place-id", "company-address-plus-code", "company-name", "company-url", "credit-limit", "credit-type", "credit-type-changed-at", "default-automatic-submission-delay-minutes", "default-delay-automatic-submission-after-hours", "default-is-expecting-safety-meeting", "default-is-managing-crew-requirements", "default-payment-terms", "default-time-card-approval-process", "enable-non-default-contractors", "estimated-annual-material-transport-spend", "exclude-from-lineup-scenarios", "expecting-crew-requirement-time-sheets-on", "favorite", "fax-number", "generate-daily-invoice", "group-daily-invoice-by-job-site", "hold-job-production-plan-approval", "is-accepting-open-door-issues", "is-active", "is-company-address-formatted-address", "is-controlled-by-broker", "is-developer", "is-eticketing-cycle-time-enabled", "is-eticketing-enabled", "is-eticketing-raw-enabled", "is-expecting-crew-requirement-time-sheets", "is-material-transaction-inspection-enabled", "is-time-card-start-at-evidence-required", "is-trucking-company", "job-production-plan-recap-summary-template", "job-production-plan-recap-template", "notes", "phone-number", "requires-cost-code-allocations", "requires-job-production-plans", "requires-union-drivers", "restrict-tenders-to-customer-truckers", "send-lineup-summaries-to", "skip-company-address-geocoding", "split-billing-periods-spanning-months"}, Relationships: []string{"broker", "default-contractor", "default-dispatch-contact", "default-financial-contact", "default-operations-contact", "developer", "rate-agreement-template"}},
+	{Source: "do_customers_delete", Method: "DELETE", Path: "/v1/customers/{id}"},
+	{Source: "do_customers_update", Method: "PATCH", Path: "/v1/customers/{id}", Attributes: []string{"automatically-approve-daily-invoice", "bill-to-address", "billing-period-day-count", "billing-period-end-invoice-offset-day-count", "billing-period-start-on", "can-manage-crew-requirements", "company-address", "company-address-latitude", "company-address-longitude", "company-address-place-id", "company-address-plus-code", "company-name", "company-url", "credit-limit", "credit-type", "credit-type-changed-at", "default-automatic-submission-delay-minutes", "default-delay-automatic-submission-after-hours", "default-is-expecting-safety-meeting", "default-is-managing-crew-requirements", "default-payment-terms", "default-time-card-approval-process", "enable-non-default-contractors", "estimated-annual-material-transport-spend", "exclude-from-lineup-scenarios", "expecting-crew-requirement-time-sheets-on", "favorite", "fax-number", "generate-daily-invoice", "group-daily-invoice-by-job-site", "hold-job-production-plan-approval", "is-accepting-open-door-issues", "is-active", "is-company-address-formatted-address", "is-controlled-by-broker", "is-developer", "is-eticketing-cycle-time-enabled", "is-eticketing-enabled", "is-eticketing-raw-enabled", "is-expecting-crew-requirement-time-sheets", "is-material-transaction-inspection-enabled", "is-time-card-start-at-evidence-required", "is-trucking-company", "job-production-plan-recap-summary-template", "job-production-plan-recap-template", "notes", "phone-number", "requires-cost-code-allocations", "requires-job-production-plans", "requires-union-drivers", "restrict-tenders-to-customer-truckers", "send-lineup-summaries-to", "skip-company-address-geocoding", "split-billing-periods-spanning-months"}, Relationships: []string{"default-contractor", "default-dispatch-contact", "default-financial-contact", "default-operations-contact", "developer"}},
+	{Source: "do_cycle_time_comparisons_create", Method: "POST", Path: "/v1/cycle-time-comparisons", Attributes: []string{"coordinates-one", "coordinates-two", "cycle-count", "proximity-meters", "transaction-at-max", "transaction-at-min"}},
+	{Source: "do_deere_equipments_update", Method: "PATCH", Path: "/v1/deere-equipments/{id}", Relationships: []string{"equipment"}},
+	{Source: "do_deere_integrations_create", Method: "POST", Path: "/v1/deere-integrations", Attributes: []string{"friendly-name", "integration-identifier"}, Relationships: []string{"broker", "integration-config"}},
+	{Source: "do_deere_integrations_delete", Method: "GET", Path: "/v1/deere-integrations/{id}"},
+	{Source: "do_deere_integrations_delete", Method: "DELETE", Path: "/v1/deere-integrations/{id}"},
+	{Source: "do_deere_integrations_update", Method: "PATCH", Path: "/v1/deere-integrations/{id}", Attributes: []string{"friendly-name", "integration-identifier"}},
+	{Source: "do_developer_certified_weighers_create", Method: "POST", Path: "/v1/developer-certified-weighers", Attributes: []string{"is-active", "number"}, Relationships: []string{"developer", "user"}},
+	{Source: "do_developer_certified_weighers_delete", Method: "DELETE", Path: "/v1/developer-certified-weighers/{id}"},
+	{Source: "do_developer_certified_weighers_update", Method: "PATCH", Path: "/v1/developer-certified-weighers/{id}", Attributes: []string{"is-active", "number"}},
+	{Source: "do_developer_memberships_create", Method: "POST", Path: "/v1/developer-memberships", Relationships: []string{"organization", "project-office", "user"}},
+	{Source: "do_developer_memberships_delete", Method: "GET", Path: "/v1/developer-memberships/{id}"},
+	{Source: "do_developer_memberships_delete", Method: "DELETE", Path: "/v1/developer-memberships/{id}"},
+	{Source: "do_developer_memberships_update", Method: "PATCH", Path: "/v1/developer-memberships/{id}", Attributes: []string{"can-see-rates-as-manager", "can-validate-profit-improvements", "color-hex", "drives-shift-type", "enable-inventory-capacity-notifications", "end-at", "explicit-sort-order", "external-employee-id", "is-admin", "is-default-job-production-plan-subscriber", "is-geofence-violation-team-member", "is-unapproved-time-card-subscriber", "kind", "start-at", "title"}},
+	{Source: "do_developer_reference_types_create", Method: "POST", Path: "/v1/developer-reference-types", Attributes: []string{"name", "subject-types"}, Relationships: []string{"developer"}},
+	{Source: "do_developer_reference_types_delete", Method: "DELETE", Path: "/v1/developer-reference-types/{id}"},
+	{Source: "do_developer_reference_types_update", Method: "PATCH", Path: "/v1/developer-reference-types/{id}", Attributes: []string{"name", "subject-types"}},
+	{Source: "do_developer_references_create", Method: "POST", Path: "/v1/developer-references", Attributes: []string{"value"}, Relationships: []string{"developer-reference-type", "subject"}},
+	{Source: "do_developer_references_delete", Method: "DELETE", Path: "/v1/developer-references/{id}"},
+	{Source: "do_developer_references_update", Method: "PATCH", Path: "/v1/developer-references/{id}", Attributes: []string{"value"}, Relationships: []string{"subject"}},
+	{Source: "do_developer_trucker_certification_classifications_create", Method: "POST", Path: "/v1/developer-trucker-certification-classifications", Attributes: []string{"name"}, Relationships: []string{"developer"}},
+	{Source: "do_developer_trucker_certification_classifications_delete", Method: "DELETE", Path: "/v1/developer-trucker-certification-classifications/{id}"},
+	{Source: "do_developer_trucker_certification_classifications_update", Method: "PATCH", Path: "/v1/developer-trucker-certification-classifications/{id}", Attributes: []string{"name"}},
+	{Source: "do_developer_trucker_certification_multipliers_create", Method: "POST", Path: "/v1/developer-trucker-certification-multipliers", Attributes: []string{"multiplier"}, Relationships: []string{"developer-trucker-certification", "trailer"}},
+	{Source: "do_developer_trucker_certification_multipliers_delete", Method: "DELETE", Path: "/v1/developer-trucker-certification-multipliers/{id}"},
+	{Source: "do_developer_trucker_certification_multipliers_update", Method: "PATCH", Path: "/v1/developer-trucker-certification-multipliers/{id}", Attributes: []string{"multiplier"}, Relationships: []string{"trailer"}},
+	{Source: "do_developer_trucker_certifications_create", Method: "POST", Path: "/v1/developer-trucker-certifications", Attributes: []string{"default-multiplier", "end-on", "start-on"}, Relationships: []string{"classification", "developer", "trucker"}},
+	{Source: "do_developer_trucker_certifications_delete", Method: "DELETE", Path: "/v1/developer-trucker-certifications/{id}"},
+	{Source: "do_developer_trucker_certifications_update", Method: "PATCH", Path: "/v1/developer-trucker-certifications/{id}", Attributes: []string{"default-multiplier", "end-on", "start-on"}, Relationships: []string{"classification"}},
+	{Source: "do_developers_create", Method: "POST", Path: "/v1/developers", Attributes: []string{"is-certification-required-explicit", "is-prevailing-wage-explicit", "name", "weigher-seal-label"}, Relationships: []string{"broker"}},
+	{Source: "do_developers_delete", Method: "DELETE", Path: "/v1/developers/{id}"},
+	{Source: "do_developers_update", Method: "PATCH", Path: "/v1/developers/{id}", Attributes: []string{"is-certification-required-explicit", "is-prevailing-wage-explicit", "name", "weigher-seal-label"}},
+	{Source: "do_device_diagnostics_create", Method: "POST", Path: "/v1/device-diagnostics", Attributes: []string{"are-location-services-enabled", "change-trigger-context", "change-trigger-source", "changed-at", "changeset", "device-identifier", "is-gps-location-provider-enabled", "is-ignoring-battery-optimizations", "is-in-power-saver-mode", "is-network-location-provider-enabled", "is-not-tracking-because-of-stationary-mode", "is-trackable", "is-tracking", "location-accuracy-authorization-status", "motion-permission-status", "permission-status", "stop-on-terminate"}, Relationships: []string{"device", "user"}},
+	{Source: "do_device_location_event_summary_create", Method: "POST", Path: "/v1/device-location-event-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_device_location_events_create", Method: "POST", Path: "/v1/device-location-events", Attributes: []string{"device-identifier", "event-at", "event-description", "event-id", "event-latitude", "event-longitude", "payload"}},
+	{Source: "do_devices_update", Method: "PATCH", Path: "/v1/devices/{id}", Attributes: []string{"is-preferred", "nickname", "pushable-status"}},
+	{Source: "do_digital_fleet_trucks_update", Method: "PATCH", Path: "/v1/digital-fleet-trucks/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_dispatch_user_matchers_create", Method: "POST", Path: "/v1/dispatch-user-matchers", Attributes: []string{"phone-number"}},
+	{Source: "do_down_minutes_estimates_create", Method: "POST", Path: "/v1/down-minutes-estimates", Attributes: []string{"time-card-end-at", "time-card-start-at"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_driver_assignment_acknowledgements_create", Method: "POST", Path: "/v1/driver-assignment-acknowledgements", Relationships: []string{"driver", "tender-job-schedule-shift"}},
+	{Source: "do_driver_assignment_refusals_create", Method: "POST", Path: "/v1/driver-assignment-refusals", Attributes: []string{"comment"}, Relationships: []string{"driver", "tender-job-schedule-shift"}},
+	{Source: "do_driver_assignment_rules_create", Method: "POST", Path: "/v1/driver-assignment-rules", Attributes: []string{"is-active", "rule"}, Relationships: []string{"level"}},
+	{Source: "do_driver_assignment_rules_delete", Method: "DELETE", Path: "/v1/driver-assignment-rules/{id}"},
+	{Source: "do_driver_assignment_rules_update", Method: "PATCH", Path: "/v1/driver-assignment-rules/{id}", Attributes: []string{"is-active", "rule"}},
+	{Source: "do_driver_day_adjustment_plans_create", Method: "POST", Path: "/v1/driver-day-adjustment-plans", Attributes: []string{"content", "start-at"}, Relationships: []string{"trucker"}},
+	{Source: "do_driver_day_adjustment_plans_delete", Method: "DELETE", Path: "/v1/driver-day-adjustment-plans/{id}"},
+	{Source: "do_driver_day_adjustment_plans_update", Method: "PATCH", Path: "/v1/driver-day-adjustment-plans/{id}", Attributes: []string{"content", "start-at"}},
+	{Source: "do_driver_day_adjustments_create", Method: "POST", Path: "/v1/driver-day-adjustments", Attributes: []string{"amount-explicit"}, Relationships: []string{"driver-day"}},
+	{Source: "do_driver_day_adjustments_delete", Method: "DELETE", Path: "/v1/driver-day-adjustments/{id}"},
+	{Source: "do_driver_day_constraints_create", Method: "POST", Path: "/v1/driver-day-constraints", Relationships: []string{"constraint", "driver-day"}},
+	{Source: "do_driver_day_constraints_delete", Method: "DELETE", Path: "/v1/driver-day-constraints/{id}"},
+	{Source: "do_driver_day_constraints_update", Method: "PATCH", Path: "/v1/driver-day-constraints/{id}", Relationships: []string{"constraint"}},
+	{Source: "do_driver_day_shortfall_allocations_create", Method: "POST", Path: "/v1/driver-day-shortfall-allocations", Attributes: []string{"quantity", "time-card-shortfall-allocation-quantities-attributes"}, Relationships: []string{"service-type-unit-of-measure", "shift-set-time-card-constraints", "time-cards"}},
+	{Source: "do_driver_day_shortfall_calculations_create", Method: "POST", Path: "/v1/driver-day-shortfall-calculations", Attributes: []string{"driver-day-time-card-constraint-ids", "time-card-ids", "unallocatable-time-card-ids"}},
+	{Source: "do_driver_day_summary_create", Method: "POST", Path: "/v1/driver-day-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_driver_day_trips_adjustments_create", Method: "POST", Path: "/v1/driver-day-trips-adjustments", Attributes: []string{"description", "old-trips-attributes", "status"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_driver_day_trips_adjustments_update", Method: "PATCH", Path: "/v1/driver-day-trips-adjustments/{id}", Attributes: []string{"description", "new-trips-attributes", "status"}},
+	{Source: "do_driver_managers_create", Method: "POST", Path: "/v1/driver-managers", Relationships: []string{"managed-membership", "manager-membership", "trucker"}},
+	{Source: "do_driver_managers_delete", Method: "DELETE", Path: "/v1/driver-managers/{id}"},
+	{Source: "do_driver_managers_update", Method: "PATCH", Path: "/v1/driver-managers/{id}", Relationships: []string{"managed-membership", "manager-membership", "trucker"}},
+	{Source: "do_efficiency_incidents_create", Method: "POST", Path: "/v1/efficiency-incidents", Attributes: []string{"natures", "start-at", "status"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_efficiency_incidents_update", Method: "PATCH", Path: "/v1/efficiency-incidents/{id}", Attributes: []string{"natures"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_email_address_statuses_create", Method: "POST", Path: "/v1/email-address-statuses", Attributes: []string{"email-address"}},
+	{Source: "do_equipment_classifications_create", Method: "POST", Path: "/v1/equipment-classifications", Attributes: []string{"abbreviation", "mobilization-method", "name"}},
+	{Source: "do_equipment_classifications_delete", Method: "GET", Path: "/v1/equipment-classifications/{id}"},
+	{Source: "do_equipment_classifications_delete", Method: "DELETE", Path: "/v1/equipment-classifications/{id}"},
+	{Source: "do_equipment_classifications_update", Method: "PATCH", Path: "/v1/equipment-classifications/{id}", Attributes: []string{"abbreviation", "mobilization-method", "name"}},
+	{Source: "do_equipment_create", Method: "POST", Path: "/v1/equipment", Attributes: []string{"color-hex", "description", "group-name", "is-active", "is-available", "is-off-road", "manufacturer-name", "mobilization-method", "model-description", "nickname", "serial-number", "weight-lbs", "year"}, Relationships: []string{"equipment-classification", "organization", "tractor", "trailer"}},
+	{Source: "do_equipment_delete", Method: "DELETE", Path: "/v1/equipment/{id}"},
+	{Source: "do_equipment_location_events_create", Method: "POST", Path: "/v1/equipment-location-events", Attributes: []string{"event-at", "event-latitude", "event-longitude", "provenance"}, Relationships: []string{"equipment"}},
+	{Source: "do_equipment_location_events_delete", Method: "DELETE", Path: "/v1/equipment-location-events/{id}"},
+	{Source: "do_equipment_location_events_update", Method: "PATCH", Path: "/v1/equipment-location-events/{id}", Attributes: []string{"event-at", "event-latitude", "event-longitude", "provenance"}, Relationships: []string{"equipment"}},
+	{Source: "do_equipment_movement_requirement_locations_create", Method: "POST", Path: "/v1/equipment-movement-requirement-locations", Attributes: []string{"latitude", "longitude", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_equipment_movement_requirement_locations_delete", Method: "DELETE", Path: "/v1/equipment-movement-requirement-locations/{id}"},
+	{Source: "do_equipment_movement_requirement_locations_update", Method: "PATCH", Path: "/v1/equipment-movement-requirement-locations/{id}", Attributes: []string{"latitude", "longitude", "name"}},
+	{Source: "do_equipment_movement_requirements_create", Method: "POST", Path: "/v1/equipment-movement-requirements", Attributes: []string{"destination-at-max", "note", "origin-at-min"}, Relationships: []string{"broker", "customer-explicit", "destination", "equipment", "inbound-requirement", "origin", "outbound-requirement"}},
+	{Source: "do_equipment_movement_requirements_delete", Method: "DELETE", Path: "/v1/equipment-movement-requirements/{id}"},
+	{Source: "do_equipment_movement_requirements_update", Method: "PATCH", Path: "/v1/equipment-movement-requirements/{id}", Attributes: []string{"destination-at-max", "note", "origin-at-min"}, Relationships: []string{"customer-explicit", "destination", "equipment", "inbound-requirement", "origin", "outbound-requirement"}},
+	{Source: "do_equipment_movement_stop_completions_create", Method: "POST", Path: "/v1/equipment-movement-stop-completions", Attributes: []string{"completed-at", "latitude", "longitude", "note"}, Relationships: []string{"stop"}},
+	{Source: "do_equipment_movement_stop_completions_delete", Method: "DELETE", Path: "/v1/equipment-movement-stop-completions/{id}"},
+	{Source: "do_equipment_movement_stop_completions_update", Method: "PATCH", Path: "/v1/equipment-movement-stop-completions/{id}", Attributes: []string{"completed-at", "latitude", "longitude", "note"}},
+	{Source: "do_equipment_movement_stop_requirements_create", Method: "POST", Path: "/v1/equipment-movement-stop-requirements", Attributes: []string{"kind"}, Relationships: []string{"requirement", "stop"}},
+	{Source: "do_equipment_movement_stop_requirements_delete", Method: "DELETE", Path: "/v1/equipment-movement-stop-requirements/{id}"},
+	{Source: "do_equipment_movement_stops_create", Method: "POST", Path: "/v1/equipment-movement-stops", Attributes: []string{"scheduled-arrival-at", "sequence-position"}, Relationships: []string{"location", "trip"}},
+	{Source: "do_equipment_movement_stops_delete", Method: "DELETE", Path: "/v1/equipment-movement-stops/{id}"},
+	{Source: "do_equipment_movement_stops_update", Method: "PATCH", Path: "/v1/equipment-movement-stops/{id}", Attributes: []string{"scheduled-arrival-at", "sequence-position"}, Relationships: []string{"location"}},
+	{Source: "do_equipment_movement_trip_customer_cost_allocations_create", Method: "POST", Path: "/v1/equipment-movement-trip-customer-cost-allocations", Attributes: []string{"allocation", "is-explicit"}, Relationships: []string{"trip"}},
+	{Source: "do_equipment_movement_trip_customer_cost_allocations_delete", Method: "DELETE", Path: "/v1/equipment-movement-trip-customer-cost-allocations/{id}"},
+	{Source: "do_equipment_movement_trip_customer_cost_allocations_update", Method: "PATCH", Path: "/v1/equipment-movement-trip-customer-cost-allocations/{id}", Attributes: []string{"allocation", "is-explicit"}},
+	{Source: "do_equipment_movement_trip_dispatch_fulfillment_clerks_create", Method: "POST", Path: "/v1/equipment-movement-trip-dispatch-fulfillment-clerks", Relationships: []string{"equipment-movement-trip-dispatch"}},
+	{Source: "do_equipment_movement_trip_dispatches_create", Method: "POST", Path: "/v1/equipment-movement-trip-dispatches", Attributes: []string{"tell-clerk-synchronously"}, Relationships: []string{"destination-location", "driver", "equipment-movement-requirement", "equipment-movement-trip", "inbound-equipment-requirement", "origin-location", "outbound-equipment-requirement", "trailer", "trucker"}},
+	{Source: "do_equipment_movement_trip_dispatches_delete", Method: "DELETE", Path: "/v1/equipment-movement-trip-dispatches/{id}"},
+	{Source: "do_equipment_movement_trip_dispatches_update", Method: "PATCH", Path: "/v1/equipment-movement-trip-dispatches/{id}", Attributes: []string{"tell-clerk-synchronously"}, Relationships: []string{"driver", "trailer", "trucker"}},
+	{Source: "do_equipment_movement_trip_job_production_plans_create", Method: "POST", Path: "/v1/equipment-movement-trip-job-production-plans", Relationships: []string{"equipment-movement-trip"}},
+	{Source: "do_equipment_movement_trip_job_production_plans_delete", Method: "DELETE", Path: "/v1/equipment-movement-trip-job-production-plans/{id}"},
+	{Source: "do_equipment_movement_trips_create", Method: "POST", Path: "/v1/equipment-movement-trips", Attributes: []string{"explicit-driver-day-mobilization-before-minutes", "job-number", "service-type-unit-of-measure-ids", "trailer-classification-equivalent-ids"}, Relationships: []string{"broker", "trailer-classification"}},
+	{Source: "do_equipment_movement_trips_delete", Method: "DELETE", Path: "/v1/equipment-movement-trips/{id}"},
+	{Source: "do_equipment_movement_trips_update", Method: "PATCH", Path: "/v1/equipment-movement-trips/{id}", Attributes: []string{"explicit-driver-day-mobilization-before-minutes", "job-number", "service-type-unit-of-measure-ids", "trailer-classification-equivalent-ids"}, Relationships: []string{"trailer-classification"}},
+	{Source: "do_equipment_rentals_create", Method: "POST", Path: "/v1/equipment-rentals", Attributes: []string{"actual-rental-usage-hours", "approximate-cost-per-day", "cost-per-hour", "description", "end-on", "end-on-planned", "skip-weekend", "start-on", "start-on-planned", "status", "target-utilization-hours"}, Relationships: []string{"broker", "equipment", "equipment-classification", "equipment-supplier"}},
+	{Source: "do_equipment_rentals_delete", Method: "DELETE", Path: "/v1/equipment-rentals/{id}"},
+	{Source: "do_equipment_rentals_update", Method: "PATCH", Path: "/v1/equipment-rentals/{id}", Attributes: []string{"actual-rental-usage-hours", "approximate-cost-per-day", "cost-per-hour", "description", "end-on", "end-on-planned", "skip-weekend", "start-on", "start-on-planned", "status", "target-utilization-hours"}, Relationships: []string{"equipment", "equipment-classification", "equipment-supplier"}},
+	{Source: "do_equipment_requirements_create", Method: "POST", Path: "/v1/equipment-requirements", Attributes: []string{"end-at", "explicit-inbound-latitude", "explicit-inbound-longitude", "explicit-outbound-latitude", "explicit-outbound-longitude", "is-validating-overlapping", "mobilization-method", "note", "requires-inbound-movement", "requires-outbound-movement", "start-at"}, Relationships: []string{"craft-class", "crew-requirement-credential-classifications", "job-production-plan", "labor-requirement", "origin-material-site", "project-cost-classification", "resource", "resource-classification"}},
+	{Source: "do_equipment_requirements_delete", Method: "DELETE", Path: "/v1/equipment-requirements/{id}"},
+	{Source: "do_equipment_requirements_update", Method: "PATCH", Path: "/v1/equipment-requirements/{id}", Attributes: []string{"end-at", "explicit-inbound-latitude", "explicit-inbound-longitude", "explicit-outbound-latitude", "explicit-outbound-longitude", "is-validating-overlapping", "mobilization-method", "note", "requires-inbound-movement", "requires-outbound-movement", "start-at"}, Relationships: []string{"craft-class", "crew-requirement-credential-classifications", "job-production-plan", "labor-requirement", "origin-material-site", "project-cost-classification", "resource", "resource-classification"}},
+	{Source: "do_equipment_suppliers_create", Method: "POST", Path: "/v1/equipment-suppliers", Attributes: []string{"contract-number", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_equipment_suppliers_delete", Method: "DELETE", Path: "/v1/equipment-suppliers/{id}"},
+	{Source: "do_equipment_suppliers_update", Method: "PATCH", Path: "/v1/equipment-suppliers/{id}", Attributes: []string{"contract-number", "name"}},
+	{Source: "do_equipment_update", Method: "PATCH", Path: "/v1/equipment/{id}", Attributes: []string{"color-hex", "description", "group-name", "is-active", "is-available", "is-off-road", "manufacturer-name", "mobilization-method", "model-description", "nickname", "serial-number", "weight-lbs", "year"}, Relationships: []string{"equipment-classification", "tractor", "trailer"}},
+	{Source: "do_equipment_utilization_readings_create", Method: "POST", Path: "/v1/equipment-utilization-readings", Attributes: []string{"hourmeter", "odometer", "other-readings", "reported-at"}, Relationships: []string{"business-unit", "equipment", "user"}},
+	{Source: "do_equipment_utilization_readings_delete", Method: "DELETE", Path: "/v1/equipment-utilization-readings/{id}"},
+	{Source: "do_equipment_utilization_readings_update", Method: "PATCH", Path: "/v1/equipment-utilization-readings/{id}", Attributes: []string{"hourmeter", "odometer", "other-readings", "reported-at"}, Relationships: []string{"business-unit", "equipment", "user"}},
+	{Source: "do_expected_time_of_arrivals_create", Method: "POST", Path: "/v1/expected-time-of-arrivals", Attributes: []string{"expected-at", "note", "unsure"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_expected_time_of_arrivals_delete", Method: "DELETE", Path: "/v1/expected-time-of-arrivals/{id}"},
+	{Source: "do_expected_time_of_arrivals_update", Method: "PATCH", Path: "/v1/expected-time-of-arrivals/{id}", Attributes: []string{"expected-at", "note", "unsure"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_exporter_configurations_create", Method: "POST", Path: "/v1/free-ticketing/exporter-configurations", Attributes: []string{"additional-configurations", "api-url", "exporter-headers", "name", "template", "ticket-identifier-field"}, Relationships: []string{"integration-config"}},
+	{Source: "do_exporter_configurations_delete", Method: "GET", Path: "/v1/free-ticketing/exporter-configurations/{id}"},
+	{Source: "do_exporter_configurations_delete", Method: "DELETE", Path: "/v1/free-ticketing/exporter-configurations/{id}"},
+	{Source: "do_exporter_configurations_update", Method: "PATCH", Path: "/v1/free-ticketing/exporter-configurations/{id}", Attributes: []string{"additional-configurations", "api-url", "exporter-headers", "name", "template", "ticket-identifier-field"}},
+	{Source: "do_external_identification_types_create", Method: "POST", Path: "/v1/external-identification-types", Attributes: []string{"can-apply-to", "format-validation-regex", "name", "value-should-be-globally-unique"}},
+	{Source: "do_external_identification_types_delete", Method: "GET", Path: "/v1/external-identification-types/{id}"},
+	{Source: "do_external_identification_types_delete", Method: "DELETE", Path: "/v1/external-identification-types/{id}"},
+	{Source: "do_external_identification_types_update", Method: "PATCH", Path: "/v1/external-identification-types/{id}", Attributes: []string{"can-apply-to", "format-validation-regex", "name", "value-should-be-globally-unique"}},
+	{Source: "do_external_identifications_create", Method: "POST", Path: "/v1/external-identifications", Attributes: []string{"skip-value-validation", "value"}, Relationships: []string{"external-identification-type", "identifies"}},
+	{Source: "do_external_identifications_delete", Method: "DELETE", Path: "/v1/external-identifications/{id}"},
+	{Source: "do_external_identifications_update", Method: "PATCH", Path: "/v1/external-identifications/{id}", Attributes: []string{"skip-value-validation", "value"}},
+	{Source: "do_features_create", Method: "POST", Path: "/v1/features", Attributes: []string{"description", "differentiation-degree", "name-branded", "name-generic", "pdca-stage", "released-on", "scale"}},
+	{Source: "do_file_attachment_signed_urls_create", Method: "POST", Path: "/v1/file-attachment-signed-urls", Attributes: []string{"file-attachment-id"}},
+	{Source: "do_file_attachments_create", Method: "POST", Path: "/v1/file-attachments", Attributes: []string{"file-name", "object-key"}},
+	{Source: "do_file_imports_create", Method: "POST", Path: "/v1/file-imports", Attributes: []string{"note", "processed-at"}, Relationships: []string{"broker", "file-attachment"}},
+	{Source: "do_file_imports_delete", Method: "DELETE", Path: "/v1/file-imports/{id}"},
+	{Source: "do_file_imports_update", Method: "PATCH", Path: "/v1/file-imports/{id}", Attributes: []string{"note", "processed-at"}},
+	{Source: "do_follows_create", Method: "POST", Path: "/v1/follows", Relationships: []string{"creator", "follower"}},
+	{Source: "do_follows_delete", Method: "DELETE", Path: "/v1/follows/{id}"},
+	{Source: "do_follows_update", Method: "PATCH", Path: "/v1/follows/{id}", Relationships: []string{"creator", "follower"}},
+	{Source: "do_gauge_vehicles_update", Method: "PATCH", Path: "/v1/gauge-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_geofence_restrictions_create", Method: "POST", Path: "/v1/geofence-restrictions", Attributes: []string{"max-seconds-between-notification", "status"}, Relationships: []string{"geofence", "trucker"}},
+	{Source: "do_geofence_restrictions_delete", Method: "DELETE", Path: "/v1/geofence-restrictions/{id}"},
+	{Source: "do_geofence_restrictions_update", Method: "PATCH", Path: "/v1/geofence-restrictions/{id}", Attributes: []string{"max-seconds-between-notification", "status"}, Relationships: []string{"geofence"}},
+	{Source: "do_geofences_create", Method: "POST", Path: "/v1/geofences", Attributes: []string{"description", "explicit-geofence-restriction-violation-notification-message", "name", "polygon-coordinates", "restriction-mode", "status"}, Relationships: []string{"broker"}},
+	{Source: "do_geofences_delete", Method: "DELETE", Path: "/v1/geofences/{id}"},
+	{Source: "do_geofences_update", Method: "PATCH", Path: "/v1/geofences/{id}", Attributes: []string{"description", "explicit-geofence-restriction-violation-notification-message", "name", "polygon-coordinates", "restriction-mode", "status"}},
+	{Source: "do_geotab_vehicles_update", Method: "PATCH", Path: "/v1/geotab-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_glossary_terms_create", Method: "POST", Path: "/v1/glossary-terms", Attributes: []string{"definition", "source", "term"}},
+	{Source: "do_glossary_terms_delete", Method: "GET", Path: "/v1/glossary-terms/{id}"},
+	{Source: "do_glossary_terms_delete", Method: "DELETE", Path: "/v1/glossary-terms/{id}"},
+	{Source: "do_glossary_terms_update", Method: "PATCH", Path: "/v1/glossary-terms/{id}", Attributes: []string{"definition", "source", "term"}},
+	{Source: "do_go_motive_integrations_create", Method: "POST", Path: "/v1/go-motive-integrations", Attributes: []string{"friendly-name", "integration-identifier"}, Relationships: []string{"broker", "integration-config"}},
+	{Source: "do_go_motive_integrations_delete", Method: "GET", Path: "/v1/go-motive-integrations/{id}"},
+	{Source: "do_go_motive_integrations_delete", Method: "DELETE", Path: "/v1/go-motive-integrations/{id}"},
+	{Source: "do_go_motive_integrations_update", Method: "PATCH", Path: "/v1/go-motive-integrations/{id}", Attributes: []string{"friendly-name", "integration-identifier"}},
+	{Source: "do_gps_insight_vehicles_update", Method: "PATCH", Path: "/v1/gps-insight-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_haskell_lemon_inbound_material_transaction_exports_create", Method: "POST", Path: "/v1/haskell-lemon-inbound-material-transaction-exports", Attributes: []string{"cc-addresses", "is-test", "to-addresses", "transaction-date"}},
+	{Source: "do_haskell_lemon_outbound_material_transaction_exports_create", Method: "POST", Path: "/v1/haskell-lemon-outbound-material-transaction-exports", Attributes: []string{"cc-addresses", "is-test", "to-addresses", "transaction-date"}},
+	{Source: "do_hos_annotations_delete", Method: "DELETE", Path: "/v1/hos-annotations/{id}"},
+	{Source: "do_importer_configurations_create", Method: "POST", Path: "/v1/free-ticketing/importer-configurations", Attributes: []string{"additional-configurations", "importer-data-source-type", "latest-tickets-queries", "ticket-identifier-field"}, Relationships: []string{"integration-config"}},
+	{Source: "do_importer_configurations_delete", Method: "GET", Path: "/v1/free-ticketing/importer-configurations/{id}"},
+	{Source: "do_importer_configurations_delete", Method: "DELETE", Path: "/v1/free-ticketing/importer-configurations/{id}"},
+	{Source: "do_importer_configurations_update", Method: "PATCH", Path: "/v1/free-ticketing/importer-configurations/{id}", Attributes: []string{"additional-configurations", "importer-data-source-type", "latest-tickets-queries", "ticket-identifier-field"}},
+	{Source: "do_incident_headline_suggestions_create", Method: "POST", Path: "/v1/incident-headline-suggestions", Attributes: []string{"is-async", "options"}, Relationships: []string{"incident"}},
+	{Source: "do_incident_headline_suggestions_delete", Method: "DELETE", Path: "/v1/incident-headline-suggestions/{id}"},
+	{Source: "do_incident_participants_create", Method: "POST", Path: "/v1/incident-participants", Attributes: []string{"email-address", "involvement", "mobile-number", "name"}, Relationships: []string{"incident"}},
+	{Source: "do_incident_participants_delete", Method: "DELETE", Path: "/v1/incident-participants/{id}"},
+	{Source: "do_incident_participants_update", Method: "PATCH", Path: "/v1/incident-participants/{id}", Attributes: []string{"email-address", "involvement", "mobile-number", "name"}},
+	{Source: "do_incident_request_approvals_create", Method: "POST", Path: "/v1/incident-request-approvals", Attributes: []string{"comment"}, Relationships: []string{"incident-request"}},
+	{Source: "do_incident_request_cancellations_create", Method: "POST", Path: "/v1/incident-request-cancellations", Attributes: []string{"comment"}, Relationships: []string{"incident-request"}},
+	{Source: "do_incident_request_rejections_create", Method: "POST", Path: "/v1/incident-request-rejections", Attributes: []string{"comment"}, Relationships: []string{"incident-request"}},
+	{Source: "do_incident_requests_create", Method: "POST", Path: "/v1/incident-requests", Attributes: []string{"is-down-time", "start-at"}, Relationships: []string{"assignee", "created-by", "tender-job-schedule-shift"}},
+	{Source: "do_incident_requests_delete", Method: "DELETE", Path: "/v1/incident-requests/{id}"},
+	{Source: "do_incident_requests_update", Method: "PATCH", Path: "/v1/incident-requests/{id}", Attributes: []string{"description", "end-at", "is-down-time", "start-at", "time-value-type"}, Relationships: []string{"assignee", "created-by"}},
+	{Source: "do_incident_subscriptions_create", Method: "POST", Path: "/v1/incident-subscriptions", Attributes: []string{"contact-method", "kind"}, Relationships: []string{"broker", "customer", "incident", "material-supplier", "user"}},
+	{Source: "do_incident_subscriptions_delete", Method: "DELETE", Path: "/v1/incident-subscriptions/{id}"},
+	{Source: "do_incident_subscriptions_update", Method: "PATCH", Path: "/v1/incident-subscriptions/{id}", Attributes: []string{"contact-method", "kind"}, Relationships: []string{"broker", "customer", "incident", "material-supplier"}},
+	{Source: "do_incident_tag_incidents_create", Method: "POST", Path: "/v1/incident-tag-incidents", Relationships: []string{"incident", "incident-tag"}},
+	{Source: "do_incident_tag_incidents_delete", Method: "DELETE", Path: "/v1/incident-tag-incidents/{id}"},
+	{Source: "do_incident_tags_create", Method: "POST", Path: "/v1/incident-tags", Attributes: []string{"description", "kinds", "name", "slug"}},
+	{Source: "do_incident_unit_of_measure_quantities_create", Method: "POST", Path: "/v1/incident-unit-of-measure-quantities", Attributes: []string{"quantity"}, Relationships: []string{"incident", "unit-of-measure"}},
+	{Source: "do_incident_unit_of_measure_quantities_delete", Method: "DELETE", Path: "/v1/incident-unit-of-measure-quantities/{id}"},
+	{Source: "do_incident_unit_of_measure_quantities_update", Method: "PATCH", Path: "/v1/incident-unit-of-measure-quantities/{id}", Attributes: []string{"quantity"}, Relationships: []string{"incident", "unit-of-measure"}},
+	{Source: "do_inventory_capacities_create", Method: "POST", Path: "/v1/inventory-capacities", Attributes: []string{"max-capacity-tons", "min-capacity-tons", "threshold-tons"}, Relationships: []string{"material-site", "material-type"}},
+	{Source: "do_inventory_capacities_delete", Method: "DELETE", Path: "/v1/inventory-capacities/{id}"},
+	{Source: "do_inventory_capacities_update", Method: "PATCH", Path: "/v1/inventory-capacities/{id}", Attributes: []string{"max-capacity-tons", "min-capacity-tons", "threshold-tons"}},
+	{Source: "do_inventory_changes_create", Method: "POST", Path: "/v1/inventory-changes", Attributes: []string{"estimate-at", "forecast-start-at"}, Relationships: []string{"material-site", "material-type"}},
+	{Source: "do_inventory_estimates_create", Method: "POST", Path: "/v1/inventory-estimates", Attributes: []string{"amount-tons", "description", "estimated-at"}, Relationships: []string{"material-site", "material-type"}},
+	{Source: "do_inventory_estimates_delete", Method: "DELETE", Path: "/v1/inventory-estimates/{id}"},
+	{Source: "do_inventory_estimates_update", Method: "PATCH", Path: "/v1/inventory-estimates/{id}", Attributes: []string{"amount-tons", "description", "estimated-at"}, Relationships: []string{"material-site", "material-type"}},
+	{Source: "do_invoice_addresses_create", Method: "POST", Path: "/v1/invoice-addresses", Attributes: []string{"comment"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_approvals_create", Method: "POST", Path: "/v1/invoice-approvals", Attributes: []string{"comment"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_exports_create", Method: "POST", Path: "/v1/invoice-exports", Attributes: []string{"comment"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_generations_create", Method: "POST", Path: "/v1/invoice-generations", Attributes: []string{"note", "time-card-ids"}, Relationships: []string{"organization"}},
+	{Source: "do_invoice_pdf_emails_create", Method: "POST", Path: "/v1/invoice-pdf-emails", Attributes: []string{"email-address"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_rejections_create", Method: "POST", Path: "/v1/invoice-rejections", Attributes: []string{"comment"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_revisionables_create", Method: "POST", Path: "/v1/invoice-revisionables", Attributes: []string{"comment"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_revisionizings_create", Method: "POST", Path: "/v1/invoice-revisionizings", Attributes: []string{"comment", "in-bulk"}, Relationships: []string{"invoice"}},
+	{Source: "do_invoice_sends_create", Method: "POST", Path: "/v1/invoice-sends", Attributes: []string{"comment"}, Relationships: []string{"invoice"}},
+	{Source: "do_job_production_plan_abandonments_create", Method: "POST", Path: "/v1/job-production-plan-abandonments", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_alarm_subscribers_create", Method: "POST", Path: "/v1/job-production-plan-alarm-subscribers", Relationships: []string{"job-production-plan-alarm", "subscriber"}},
+	{Source: "do_job_production_plan_alarm_subscribers_delete", Method: "DELETE", Path: "/v1/job-production-plan-alarm-subscribers/{id}"},
+	{Source: "do_job_production_plan_alarms_create", Method: "POST", Path: "/v1/job-production-plan-alarms", Attributes: []string{"base-material-type-fully-qualified-name", "max-latency-minutes", "note", "tons"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_alarms_delete", Method: "DELETE", Path: "/v1/job-production-plan-alarms/{id}"},
+	{Source: "do_job_production_plan_alarms_update", Method: "PATCH", Path: "/v1/job-production-plan-alarms/{id}", Attributes: []string{"base-material-type-fully-qualified-name", "max-latency-minutes", "note", "tons"}},
+	{Source: "do_job_production_plan_approvals_create", Method: "POST", Path: "/v1/job-production-plan-approvals", Attributes: []string{"comment", "skip-validate-required-mix-designs", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_broadcast_messages_create", Method: "POST", Path: "/v1/job-production-plan-broadcast-messages", Attributes: []string{"message", "summary", "user-ids"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_broadcast_messages_update", Method: "PATCH", Path: "/v1/job-production-plan-broadcast-messages/{id}", Attributes: []string{"is-hidden"}},
+	{Source: "do_job_production_plan_cancellation_reason_types_create", Method: "POST", Path: "/v1/job-production-plan-cancellation-reason-types", Attributes: []string{"description", "name", "slug"}},
+	{Source: "do_job_production_plan_cancellation_reason_types_delete", Method: "DELETE", Path: "/v1/job-production-plan-cancellation-reason-types/{id}"},
+	{Source: "do_job_production_plan_cancellation_reason_types_update", Method: "PATCH", Path: "/v1/job-production-plan-cancellation-reason-types/{id}", Attributes: []string{"description", "name"}},
+	{Source: "do_job_production_plan_cancellations_create", Method: "POST", Path: "/v1/job-production-plan-cancellations", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan", "job-production-plan-cancellation-reason-type"}},
+	{Source: "do_job_production_plan_change_sets_create", Method: "POST", Path: "/v1/job-production-plan-change-sets", Attributes: []string{"change-job-number-new", "change-new-offset-skip-saturdays", "change-new-offset-skip-sundays", "change-new-planner-nullify", "change-new-project-manager-nullify", "change-new-status", "change-raw-job-number-new", "scope-foreman-ids", "scope-job-production-plan-ids", "scope-material-site-ids", "scope-material-type-ids", "scope-planner-ids", "scope-project-ids", "scope-start-on-max", "scope-start-on-min", "scope-statuses", "scope-ultimate-material-types", "should-persist", "skip-invalid-plans"}},
+	{Source: "do_job_production_plan_change_sets_delete", Method: "DELETE", Path: "/v1/job-production-plan-change-sets/{id}"},
+	{Source: "do_job_production_plan_change_sets_update", Method: "PATCH", Path: "/v1/job-production-plan-change-sets/{id}", Attributes: []string{"change-job-number-new", "change-new-offset-skip-saturdays", "change-new-offset-skip-sundays", "change-new-planner-nullify", "change-new-project-manager-nullify", "change-new-status", "change-raw-job-number-new", "scope-foreman-ids", "scope-job-production-plan-ids", "scope-material-site-ids", "scope-material-type-ids", "scope-planner-ids", "scope-project-ids", "scope-start-on-max", "scope-start-on-min", "scope-statuses", "scope-ultimate-material-types", "should-persist", "skip-invalid-plans"}},
+	{Source: "do_job_production_plan_completions_create", Method: "POST", Path: "/v1/job-production-plan-completions", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_cost_codes_create", Method: "POST", Path: "/v1/job-production-plan-cost-codes", Relationships: []string{"cost-code", "job-production-plan", "project-resource-classification"}},
+	{Source: "do_job_production_plan_cost_codes_delete", Method: "DELETE", Path: "/v1/job-production-plan-cost-codes/{id}"},
+	{Source: "do_job_production_plan_cost_codes_update", Method: "PATCH", Path: "/v1/job-production-plan-cost-codes/{id}", Relationships: []string{"project-resource-classification"}},
+	{Source: "do_job_production_plan_display_unit_of_measures_create", Method: "POST", Path: "/v1/job-production-plan-display-unit-of-measures", Attributes: []string{"importance-position"}, Relationships: []string{"job-production-plan", "unit-of-measure"}},
+	{Source: "do_job_production_plan_display_unit_of_measures_delete", Method: "DELETE", Path: "/v1/job-production-plan-display-unit-of-measures/{id}"},
+	{Source: "do_job_production_plan_display_unit_of_measures_update", Method: "PATCH", Path: "/v1/job-production-plan-display-unit-of-measures/{id}", Attributes: []string{"importance-position"}},
+	{Source: "do_job_production_plan_driver_movements_create", Method: "POST", Path: "/v1/job-production-plan-driver-movements", Attributes: []string{"bust-cache", "explicit-location-event-sources"}, Relationships: []string{"driver", "job-production-plan", "tender-job-schedule-shift"}},
+	{Source: "do_job_production_plan_duplications_create", Method: "POST", Path: "/v1/job-production-plan-duplications", Attributes: []string{"derived-job-production-plan-template-name", "skipped-labor-requirements-not-valid-to-assign-ids", "skipped-labor-requirements-overlapping-resource-ids", "start-on"}, Relationships: []string{"derived-job-production-plan", "job-production-plan-template", "new-customer"}},
+	{Source: "do_job_production_plan_inspectors_create", Method: "POST", Path: "/v1/job-production-plan-inspectors", Attributes: []string{"job-production-plan-id"}, Relationships: []string{"user"}},
+	{Source: "do_job_production_plan_inspectors_delete", Method: "DELETE", Path: "/v1/job-production-plan-inspectors/{id}"},
+	{Source: "do_job_production_plan_job_site_changes_create", Method: "POST", Path: "/v1/job-production-plan-job-site-changes", Relationships: []string{"job-production-plan", "new-job-site", "old-job-site"}},
+	{Source: "do_job_production_plan_locations_create", Method: "POST", Path: "/v1/job-production-plan-locations", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "is-start-site-candidate", "name", "site-kind", "skip-address-geocoding"}, Relationships: []string{"job-production-plan", "segment"}},
+	{Source: "do_job_production_plan_locations_delete", Method: "DELETE", Path: "/v1/job-production-plan-locations/{id}"},
+	{Source: "do_job_production_plan_locations_update", Method: "PATCH", Path: "/v1/job-production-plan-locations/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "is-start-site-candidate", "name", "site-kind", "skip-address-geocoding"}, Relationships: []string{"segment"}},
+	{Source: "do_job_production_plan_material_site_changes_create", Method: "POST", Path: "/v1/job-production-plan-material-site-changes", Relationships: []string{"job-production-plan", "new-material-mix-design", "new-material-site", "new-material-type", "old-material-site", "old-material-type"}},
+	{Source: "do_job_production_plan_material_sites_create", Method: "POST", Path: "/v1/job-production-plan-material-sites", Attributes: []string{"default-ticket-maker", "has-user-scale-tickets", "is-default", "miles", "plan-requires-site-specific-material-types", "plan-requires-supplier-specific-material-types", "user-ticket-maker-material-type-ids"}, Relationships: []string{"job-production-plan", "material-site"}},
+	{Source: "do_job_production_plan_material_sites_delete", Method: "DELETE", Path: "/v1/job-production-plan-material-sites/{id}"},
+	{Source: "do_job_production_plan_material_sites_update", Method: "PATCH", Path: "/v1/job-production-plan-material-sites/{id}", Attributes: []string{"default-ticket-maker", "has-user-scale-tickets", "is-default", "miles", "plan-requires-site-specific-material-types", "plan-requires-supplier-specific-material-types", "user-ticket-maker-material-type-ids"}},
+	{Source: "do_job_production_plan_material_transaction_summaries_create", Method: "POST", Path: "/v1/job-production-plan-material-transaction-summaries", Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_material_type_quality_control_requirements_create", Method: "POST", Path: "/v1/job-production-plan-material-type-quality-control-requirements", Attributes: []string{"note"}, Relationships: []string{"job-production-plan-material-type", "quality-control-classification"}},
+	{Source: "do_job_production_plan_material_type_quality_control_requirements_delete", Method: "DELETE", Path: "/v1/job-production-plan-material-type-quality-control-requirements/{id}"},
+	{Source: "do_job_production_plan_material_type_quality_control_requirements_update", Method: "PATCH", Path: "/v1/job-production-plan-material-type-quality-control-requirements/{id}", Attributes: []string{"note"}, Relationships: []string{"quality-control-classification"}},
+	{Source: "do_job_production_plan_material_types_create", Method: "POST", Path: "/v1/job-production-plan-material-types", Attributes: []string{"explicit-display-name", "is-quantity-unknown", "plan-requires-site-specific-material-types", "plan-requires-supplier-specific-material-types", "quantity"}, Relationships: []string{"default-cost-code", "explicit-material-mix-design", "explicit-material-type-material-site-inventory-location", "job-production-plan", "material-site", "material-type", "unit-of-measure"}},
+	{Source: "do_job_production_plan_material_types_delete", Method: "DELETE", Path: "/v1/job-production-plan-material-types/{id}"},
+	{Source: "do_job_production_plan_material_types_update", Method: "PATCH", Path: "/v1/job-production-plan-material-types/{id}", Attributes: []string{"explicit-display-name", "is-quantity-unknown", "plan-requires-site-specific-material-types", "plan-requires-supplier-specific-material-types", "quantity"}, Relationships: []string{"default-cost-code", "explicit-material-mix-design", "explicit-material-type-material-site-inventory-location", "job-production-plan", "material-site", "material-type", "unit-of-measure"}},
+	{Source: "do_job_production_plan_project_phase_revenue_items_create", Method: "POST", Path: "/v1/job-production-plan-project-phase-revenue-items", Attributes: []string{"quantity"}, Relationships: []string{"job-production-plan", "project-phase-revenue-item"}},
+	{Source: "do_job_production_plan_project_phase_revenue_items_delete", Method: "DELETE", Path: "/v1/job-production-plan-project-phase-revenue-items/{id}"},
+	{Source: "do_job_production_plan_project_phase_revenue_items_update", Method: "PATCH", Path: "/v1/job-production-plan-project-phase-revenue-items/{id}", Attributes: []string{"quantity", "should-update"}},
+	{Source: "do_job_production_plan_recap_generations_create", Method: "POST", Path: "/v1/job-production-plan-recap-generations", Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_rejections_create", Method: "POST", Path: "/v1/job-production-plan-rejections", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_safety_risk_communication_suggestions_create", Method: "POST", Path: "/v1/job-production-plan-safety-risk-communication-suggestions", Attributes: []string{"is-async", "options"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_safety_risk_communication_suggestions_delete", Method: "DELETE", Path: "/v1/job-production-plan-safety-risk-communication-suggestions/{id}"},
+	{Source: "do_job_production_plan_safety_risks_create", Method: "POST", Path: "/v1/job-production-plan-safety-risks", Attributes: []string{"description"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_safety_risks_delete", Method: "DELETE", Path: "/v1/job-production-plan-safety-risks/{id}"},
+	{Source: "do_job_production_plan_safety_risks_suggestions_create", Method: "POST", Path: "/v1/job-production-plan-safety-risks-suggestions", Attributes: []string{"is-async", "options"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_safety_risks_update", Method: "PATCH", Path: "/v1/job-production-plan-safety-risks/{id}", Attributes: []string{"description"}},
+	{Source: "do_job_production_plan_schedule_changes_create", Method: "POST", Path: "/v1/job-production-plan-schedule-changes", Attributes: []string{"offset-seconds", "skip-persistence", "skip-update-crew-requirements", "skip-update-safety-meeting", "skip-update-shifts", "time-kind"}, Relationships: []string{"job-production-plan", "work"}},
+	{Source: "do_job_production_plan_scrappages_create", Method: "POST", Path: "/v1/job-production-plan-scrappages", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan", "job-production-plan-cancellation-reason-type"}},
+	{Source: "do_job_production_plan_segment_sets_create", Method: "POST", Path: "/v1/job-production-plan-segment-sets", Attributes: []string{"is-default", "name", "start-offset-minutes"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_segment_sets_delete", Method: "DELETE", Path: "/v1/job-production-plan-segment-sets/{id}"},
+	{Source: "do_job_production_plan_segment_sets_update", Method: "PATCH", Path: "/v1/job-production-plan-segment-sets/{id}", Attributes: []string{"is-default", "name", "start-offset-minutes"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_segments_create", Method: "POST", Path: "/v1/job-production-plan-segments", Attributes: []string{"description", "driving-minutes-per-cycle", "explicit-start-site-kind", "is-expecting-weighed-transactions", "lock-observed-possible-cycle-minutes", "material-site-minutes-per-cycle", "non-production-minutes", "observed-possible-cycle-minutes", "planned-unproductive-minutes-per-hour", "quantity", "quantity-per-hour", "selected-google-route", "sequence-position", "tons-per-cycle"}, Relationships: []string{"cost-code", "explicit-material-type-material-site-inventory-location", "job-production-plan", "job-production-plan-segment-set", "material-site", "material-type"}},
+	{Source: "do_job_production_plan_segments_delete", Method: "DELETE", Path: "/v1/job-production-plan-segments/{id}"},
+	{Source: "do_job_production_plan_segments_update", Method: "PATCH", Path: "/v1/job-production-plan-segments/{id}", Attributes: []string{"description", "driving-minutes-per-cycle", "explicit-start-site-kind", "is-expecting-weighed-transactions", "lock-observed-possible-cycle-minutes", "material-site-minutes-per-cycle", "non-production-minutes", "observed-possible-cycle-minutes", "planned-unproductive-minutes-per-hour", "quantity", "quantity-per-hour", "selected-google-route", "sequence-position", "tons-per-cycle"}, Relationships: []string{"cost-code", "explicit-material-type-material-site-inventory-location", "job-production-plan", "job-production-plan-segment-set", "material-site", "material-type"}},
+	{Source: "do_job_production_plan_service_type_unit_of_measure_cohorts_create", Method: "POST", Path: "/v1/job-production-plan-service-type-unit-of-measure-cohorts", Relationships: []string{"job-production-plan", "service-type-unit-of-measure-cohort"}},
+	{Source: "do_job_production_plan_service_type_unit_of_measure_cohorts_delete", Method: "DELETE", Path: "/v1/job-production-plan-service-type-unit-of-measure-cohorts/{id}"},
+	{Source: "do_job_production_plan_service_type_unit_of_measures_create", Method: "POST", Path: "/v1/job-production-plan-service-type-unit-of-measures", Attributes: []string{"exclude-from-time-card-invoices", "explicit-step-size-target", "step-size"}, Relationships: []string{"job-production-plan", "service-type-unit-of-measure"}},
+	{Source: "do_job_production_plan_service_type_unit_of_measures_delete", Method: "DELETE", Path: "/v1/job-production-plan-service-type-unit-of-measures/{id}"},
+	{Source: "do_job_production_plan_service_type_unit_of_measures_update", Method: "PATCH", Path: "/v1/job-production-plan-service-type-unit-of-measures/{id}", Attributes: []string{"exclude-from-time-card-invoices", "explicit-step-size-target", "step-size"}, Relationships: []string{"job-production-plan", "service-type-unit-of-measure"}},
+	{Source: "do_job_production_plan_status_changes_update", Method: "PATCH", Path: "/v1/job-production-plan-status-changes/{id}", Relationships: []string{"job-production-plan-cancellation-reason-type"}},
+	{Source: "do_job_production_plan_submissions_create", Method: "POST", Path: "/v1/job-production-plan-submissions", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_subscriptions_create", Method: "POST", Path: "/v1/job-production-plan-subscriptions", Relationships: []string{"job-production-plan", "user"}},
+	{Source: "do_job_production_plan_subscriptions_delete", Method: "DELETE", Path: "/v1/job-production-plan-subscriptions/{id}"},
+	{Source: "do_job_production_plan_subscriptions_update", Method: "PATCH", Path: "/v1/job-production-plan-subscriptions/{id}", Attributes: []string{"contact-method"}},
+	{Source: "do_job_production_plan_summary_create", Method: "POST", Path: "/v1/job-production-plan-summaries", Attributes: []string{"end-on", "filters", "group-by", "included-metrics", "limit", "sort", "start-on"}},
+	{Source: "do_job_production_plan_time_card_approvers_create", Method: "POST", Path: "/v1/job-production-plan-time-card-approvers", Relationships: []string{"job-production-plan", "user"}},
+	{Source: "do_job_production_plan_time_card_approvers_delete", Method: "DELETE", Path: "/v1/job-production-plan-time-card-approvers/{id}"},
+	{Source: "do_job_production_plan_trailer_classifications_create", Method: "POST", Path: "/v1/job-production-plan-trailer-classifications", Attributes: []string{"explicit-material-transaction-tons-max", "gross-weight-legal-limit-lbs-explicit", "trailer-classification-equivalent-ids"}, Relationships: []string{"job-production-plan", "trailer-classification"}},
+	{Source: "do_job_production_plan_trailer_classifications_delete", Method: "DELETE", Path: "/v1/job-production-plan-trailer-classifications/{id}"},
+	{Source: "do_job_production_plan_trailer_classifications_update", Method: "PATCH", Path: "/v1/job-production-plan-trailer-classifications/{id}", Attributes: []string{"explicit-material-transaction-tons-max", "gross-weight-legal-limit-lbs-explicit", "trailer-classification-equivalent-ids"}, Relationships: []string{"job-production-plan", "trailer-classification"}},
+	{Source: "do_job_production_plan_trucking_incident_detectors_create", Method: "POST", Path: "/v1/job-production-plan-trucking-incident-detectors", Attributes: []string{"as-of", "persist-changes"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_unabandonments_create", Method: "POST", Path: "/v1/job-production-plan-unabandonments", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_unapprovals_create", Method: "POST", Path: "/v1/job-production-plan-unapprovals", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_uncancellations_create", Method: "POST", Path: "/v1/job-production-plan-uncancellations", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_uncompletions_create", Method: "POST", Path: "/v1/job-production-plan-uncompletions", Attributes: []string{"comment", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plan_unscrappages_create", Method: "POST", Path: "/v1/job-production-plan-unscrappages", Attributes: []string{"comment", "skip-validate-required-mix-designs", "suppress-status-change-notifications"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_job_production_plans_create", Method: "POST", Path: "/v1/job-production-plans", Attributes: []string{"allows-unmanaged-shift", "approval-requires-job-number", "are-goals-synced-from-segments", "are-shifts-expecting-time-cards", "auto-check-in-driver-on-arrival-at-start-site", "benchmark-tons-per-truck-hour", "cost-per-crew-hour", "cost-per-truck-hour", "create-detected-production-incidents", "default-crew-requirement-start-at-offset-minutes", "default-material-transaction-tons-max", "default-time-card-approval-process", "default-transactions-end-after-seconds", "default-transactions-start-before-seconds", "dispatch-instructions", "enable-implicit-time-card-approval", "enable-recap-notifications", "end-time", "explicit-auto-approve-auto-time-cards-with-non-material-quantities", "explicit-automatically-create-project-phase-cost-items", "explicit-color-hex", "explicit-crew-requirement-inbound-latitude", "explicit-crew-requirement-inbound-longitude", "explicit-crew-requirement-outbound-latitude", "explicit-crew-requirement-outbound-longitude", "explicit-driver-day-mobilization-before-minutes", "explicit-estimates-cost-codes-via", "explicit-excessive-job-site-wait-time-threshold-minutes", "explicit-excessive-material-site-wait-time-threshold-minutes", "explicit-is-auditing-time-card-approvals", "explicit-is-driver-expecting-material-transaction-inspection", "explicit-is-material-type-default-cost-code-required", "explicit-is-time-card-expecting-mtxns-accepted-before-approval", "explicit-is-updating-checksum-range-from-segments", "explicit-is-validating-project-cost-codes", "explicit-job-site-phone-number", "explicit-job-site-proximity-meters", "explicit-job-site-start-on", "explicit-job-site-start-time", "explicit-loaded-miles", "explicit-material-site-proximity-meters", "explicit-notify-driver-when-gps-not-available", "explicit-notify-job-production-plan-time-card-approver", "explicit-notify-on-excessive-site-wait-time", "explicit-notify-when-all-plan-time-sheets-approved", "explicit-notify-when-all-plan-time-sheets-submitted", "explicit-notify-when-plan-schedule-changes", "explicit-plan-disallows-mtxns-implicit-mix-design-match", "explicit-plan-valid-material-transaction-unit-of-measures", "explicit-require-admin-approval-time-card-attachments", "explicit-requires-business-unit", "explicit-requires-certified-weigher", "explicit-requires-inspector", "explicit-requires-project", "explicit-submission-requires-different-job-site", "explicit-time-zone-id", "goal-hours", "goal-quantity", "is-certification-required-explicit", "is-cost-code-allocation-required-per-time-card", "is-cost-code-allocation-required-per-time-sheet", "is-cost-code-required-per-segment", "is-expecting-driver-field-approval-time-card", "is-expecting-safety-meeting", "is-job-site-material-site-material-transaction-source", "is-job-site-times-creation-automated-explicit", "is-lord", "is-maintaining-service-type-unit-of-measure-cohort", "is-managing-crew-requirements", "is-managing-job-site-times-explicit", "is-managing-material-site-start-timing-explicit", "is-material-transaction-inspection-enabled", "is-notifying-crew", "is-on-hold", "is-one-way-job-explicit", "is-prevailing-wage-explicit", "is-raw-job-number-locked", "is-schedule-locked", "is-template", "is-time-card-payroll-certification-required-explicit", "is-trucker-incident-creation-automated-explicit", "is-using-volumetric-measurements", "is-validating-project-material-types", "job-name", "job-number", "lock-observed-possible-cycle-minutes", "material-site-start-on", "material-site-start-time", "notes", "observed-possible-cycle-minutes", "on-hold-comment", "parallel-production-count", "phase-name", "planned-non-production-truck-count", "raw-job-number", "reference-data", "remaining-quantity", "requires-driving-minutes", "requires-material-site-minutes", "requires-materials", "requires-trucking", "show-loadout-position-to-drivers", "start-on", "start-time", "template-name", "template-start-on-max", "template-start-on-min"}, Relationships: []string{"business-unit", "certified-weigher", "contractor", "cost-codes", "customer", "default-trucker", "developer", "equipment-movement-trip", "explicit-dispatch-user", "job-site", "planner", "project", "project-manager", "safety-meeting", "template", "trailer-classifications", "unit-of-measure"}},
+	{Source: "do_job_production_plans_update", Method: "PATCH", Path: "/v1/job-production-plans/{id}", Attributes: []string{"allows-unmanaged-shift", "approval-requires-job-number", "are-goals-synced-from-segments", "are-shifts-expecting-time-cards", "auto-check-in-driver-on-arrival-at-start-site", "benchmark-tons-per-truck-hour", "cost-per-crew-hour", "cost-per-truck-hour", "create-detected-production-incidents", "default-crew-requirement-start-at-offset-minutes", "default-material-transaction-tons-max", "default-time-card-approval-process", "default-transactions-end-after-seconds", "default-transactions-start-before-seconds", "dispatch-instructions", "enable-implicit-time-card-approval", "enable-recap-notifications", "end-time", "explicit-auto-approve-auto-time-cards-with-non-material-quantities", "explicit-automatically-create-project-phase-cost-items", "explicit-color-hex", "explicit-crew-requirement-inbound-latitude", "explicit-crew-requirement-inbound-longitude", "explicit-crew-requirement-outbound-latitude", "explicit-crew-requirement-outbound-longitude", "explicit-driver-day-mobilization-before-minutes", "explicit-estimates-cost-codes-via", "explicit-excessive-job-site-wait-time-threshold-minutes", "explicit-excessive-material-site-wait-time-threshold-minutes", "explicit-is-auditing-time-card-approvals", "explicit-is-driver-expecting-material-transaction-inspection", "explicit-is-material-type-default-cost-code-required", "explicit-is-time-card-expecting-mtxns-accepted-before-approval", "explicit-is-updating-checksum-range-from-segments", "explicit-is-validating-project-cost-codes", "explicit-job-site-phone-number", "explicit-job-site-proximity-meters", "explicit-job-site-start-on", "explicit-job-site-start-time", "explicit-loaded-miles", "explicit-material-site-proximity-meters", "explicit-notify-driver-when-gps-not-available", "explicit-notify-job-production-plan-time-card-approver", "explicit-notify-on-excessive-site-wait-time", "explicit-notify-when-all-plan-time-sheets-approved", "explicit-notify-when-all-plan-time-sheets-submitted", "explicit-notify-when-plan-schedule-changes", "explicit-plan-disallows-mtxns-implicit-mix-design-match", "explicit-plan-valid-material-transaction-unit-of-measures", "explicit-require-admin-approval-time-card-attachments", "explicit-requires-business-unit", "explicit-requires-certified-weigher", "explicit-requires-inspector", "explicit-requires-project", "explicit-submission-requires-different-job-site", "explicit-time-zone-id", "goal-hours", "goal-quantity", "is-certification-required-explicit", "is-cost-code-allocation-required-per-time-card", "is-cost-code-allocation-required-per-time-sheet", "is-cost-code-required-per-segment", "is-expecting-driver-field-approval-time-card", "is-expecting-safety-meeting", "is-job-site-material-site-material-transaction-source", "is-job-site-times-creation-automated-explicit", "is-maintaining-service-type-unit-of-measure-cohort", "is-managing-crew-requirements", "is-managing-job-site-times-explicit", "is-managing-material-site-start-timing-explicit", "is-material-transaction-inspection-enabled", "is-notifying-crew", "is-on-hold", "is-one-way-job-explicit", "is-prevailing-wage-explicit", "is-raw-job-number-locked", "is-schedule-locked", "is-template", "is-time-card-payroll-certification-required-explicit", "is-trucker-incident-creation-automated-explicit", "is-using-volumetric-measurements", "is-validating-project-material-types", "job-name", "job-number", "lock-observed-possible-cycle-minutes", "material-site-start-on", "material-site-start-time", "not-on-hold-comment", "notes", "observed-possible-cycle-minutes", "on-hold-comment", "parallel-production-count", "phase-name", "planned-non-production-truck-count", "raw-job-number", "reference-data", "remaining-quantity", "requires-driving-minutes", "requires-material-site-minutes", "requires-materials", "requires-trucking", "show-loadout-position-to-drivers", "start-on", "start-time", "template-name", "template-start-on-max", "template-start-on-min"}, Relationships: []string{"business-unit", "certified-weigher", "contractor", "cost-codes", "customer", "default-trucker", "developer", "equipment-movement-trip", "explicit-dispatch-user", "job-site", "planner", "project", "project-manager", "safety-meeting", "template", "trailer-classifications", "unit-of-measure"}},
+	{Source: "do_job_schedule_shift_is_managed_toggles_create", Method: "POST", Path: "/v1/job-schedule-shift-is-managed-toggles", Relationships: []string{"job-schedule-shift"}},
+	{Source: "do_job_schedule_shift_splits_create", Method: "POST", Path: "/v1/job-schedule-shift-splits", Attributes: []string{"expected-material-transaction-count", "expected-material-transaction-tons", "new-start-at"}, Relationships: []string{"job-schedule-shift"}},
+	{Source: "do_job_schedule_shift_start_at_changes_create", Method: "POST", Path: "/v1/job-schedule-shift-start-at-changes", Attributes: []string{"new-start-at"}, Relationships: []string{"job-schedule-shift"}},
+	{Source: "do_job_schedule_shift_start_site_changes_create", Method: "POST", Path: "/v1/job-schedule-shift-start-site-changes", Relationships: []string{"job-schedule-shift", "new-start-site"}},
+	{Source: "do_job_schedule_shifts_create", Method: "POST", Path: "/v1/job-schedule-shifts", Attributes: []string{"cancelled-at", "dispatch-instructions", "end-at", "expected-material-transaction-count", "expected-material-transaction-tons", "is-flexible", "is-planned-productive", "is-subsequent-shift-in-driver-day", "is-trucker-incident-creation-automated-explicit", "start-at", "start-at-max", "start-at-min", "suppress-automated-shift-feedback"}, Relationships: []string{"job", "project-labor-classification", "start-location", "start-site", "trailer-classification"}},
+	{Source: "do_job_schedule_shifts_delete", Method: "DELETE", Path: "/v1/job-schedule-shifts/{id}"},
+	{Source: "do_job_schedule_shifts_update", Method: "PATCH", Path: "/v1/job-schedule-shifts/{id}", Attributes: []string{"cancelled-at", "dispatch-instructions", "end-at", "expected-material-transaction-count", "expected-material-transaction-tons", "is-flexible", "is-planned-productive", "is-subsequent-shift-in-driver-day", "is-trucker-incident-creation-automated-explicit", "start-at", "start-at-max", "start-at-min", "suppress-automated-shift-feedback"}, Relationships: []string{"job", "project-labor-classification", "start-location", "start-site", "trailer-classification"}},
+	{Source: "do_job_site_times_create", Method: "POST", Path: "/v1/job-site-times", Attributes: []string{"description", "end-at", "start-at"}, Relationships: []string{"job-production-plan", "user"}},
+	{Source: "do_job_site_times_delete", Method: "DELETE", Path: "/v1/job-site-times/{id}"},
+	{Source: "do_job_site_times_update", Method: "PATCH", Path: "/v1/job-site-times/{id}", Attributes: []string{"description", "end-at", "start-at"}},
+	{Source: "do_job_sites_create", Method: "POST", Path: "/v1/job-sites", Attributes: []string{"address", "address-latitude", "address-longitude", "contact-name", "default-time-card-approval-process", "is-active", "name", "notes", "phone-number", "skip-geocoding"}, Relationships: []string{"customer"}},
+	{Source: "do_job_sites_delete", Method: "DELETE", Path: "/v1/job-sites/{id}"},
+	{Source: "do_job_sites_update", Method: "PATCH", Path: "/v1/job-sites/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "contact-name", "default-time-card-approval-process", "is-active", "name", "notes", "phone-number", "skip-geocoding"}},
+	{Source: "do_jobs_create", Method: "POST", Path: "/v1/jobs", Attributes: []string{"dispatch-instructions", "is-prevailing-wage", "loaded-miles", "notes", "prevailing-wage-hourly-rate", "requires-certified-payroll", "skip-material-type-start-site-type-validation", "validate-job-schedule-shifts"}, Relationships: []string{"customer", "foreman", "job-production-plan", "job-site", "material-sites", "material-types", "service-type-unit-of-measures", "start-site", "trailer-classifications"}},
+	{Source: "do_jobs_delete", Method: "DELETE", Path: "/v1/jobs/{id}"},
+	{Source: "do_jobs_update", Method: "PATCH", Path: "/v1/jobs/{id}", Attributes: []string{"dispatch-instructions", "external-job-number", "is-prevailing-wage", "loaded-miles", "notes", "prevailing-wage-hourly-rate", "requires-certified-payroll", "skip-material-type-start-site-type-validation", "validate-job-schedule-shifts"}, Relationships: []string{"customer", "foreman", "job-production-plan", "job-site", "material-sites", "material-types", "service-type-unit-of-measures", "start-site", "trailer-classifications"}},
+	{Source: "do_keep_truckin_users_update", Method: "PATCH", Path: "/v1/keep-truckin-users/{id}", Relationships: []string{"user"}},
+	{Source: "do_keep_truckin_vehicles_update", Method: "PATCH", Path: "/v1/keep-truckin-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_key_result_scrappages_create", Method: "POST", Path: "/v1/key-result-scrappages", Attributes: []string{"comment"}, Relationships: []string{"key-result"}},
+	{Source: "do_key_result_unscrappages_create", Method: "POST", Path: "/v1/key-result-unscrappages", Attributes: []string{"comment"}, Relationships: []string{"key-result"}},
+	{Source: "do_key_results_create", Method: "POST", Path: "/v1/key-results", Attributes: []string{"completion-percentage", "end-on", "start-on", "title", "title-summary-explicit"}, Relationships: []string{"customer-success-responsible-person", "objective", "owner"}},
+	{Source: "do_key_results_delete", Method: "DELETE", Path: "/v1/key-results/{id}"},
+	{Source: "do_key_results_update", Method: "PATCH", Path: "/v1/key-results/{id}", Attributes: []string{"completion-percentage", "end-on", "start-on", "title", "title-summary-explicit"}, Relationships: []string{"customer-success-responsible-person", "owner"}},
+	{Source: "do_labor_classifications_create", Method: "POST", Path: "/v1/labor-classifications", Attributes: []string{"abbreviation", "allow-colors", "can-manage-projects", "is-manager", "is-time-card-approver", "mobilization-method", "name"}},
+	{Source: "do_labor_classifications_delete", Method: "GET", Path: "/v1/labor-classifications/{id}"},
+	{Source: "do_labor_classifications_delete", Method: "DELETE", Path: "/v1/labor-classifications/{id}"},
+	{Source: "do_labor_classifications_update", Method: "PATCH", Path: "/v1/labor-classifications/{id}", Attributes: []string{"abbreviation", "allow-colors", "can-manage-projects", "is-manager", "is-time-card-approver", "mobilization-method", "name"}},
+	{Source: "do_labor_requirements_create", Method: "POST", Path: "/v1/labor-requirements", Attributes: []string{"end-at", "explicit-inbound-latitude", "explicit-inbound-longitude", "explicit-outbound-latitude", "explicit-outbound-longitude", "is-validating-overlapping", "mobilization-method", "note", "requires-inbound-movement", "requires-outbound-movement", "start-at"}, Relationships: []string{"craft-class", "job-production-plan", "project-cost-classification", "resource", "resource-classification"}},
+	{Source: "do_labor_requirements_delete", Method: "DELETE", Path: "/v1/labor-requirements/{id}"},
+	{Source: "do_labor_requirements_update", Method: "PATCH", Path: "/v1/labor-requirements/{id}", Attributes: []string{"end-at", "explicit-inbound-latitude", "explicit-inbound-longitude", "explicit-outbound-latitude", "explicit-outbound-longitude", "is-validating-overlapping", "mobilization-method", "note", "requires-inbound-movement", "requires-outbound-movement", "start-at"}, Relationships: []string{"craft-class", "job-production-plan", "project-cost-classification", "resource", "resource-classification"}},
+	{Source: "do_laborers_create", Method: "POST", Path: "/v1/laborers", Attributes: []string{"color-hex", "group-name", "is-active", "mobilization-method"}, Relationships: []string{"craft-class", "labor-classification", "organization", "user"}},
+	{Source: "do_laborers_delete", Method: "DELETE", Path: "/v1/laborers/{id}"},
+	{Source: "do_laborers_update", Method: "PATCH", Path: "/v1/laborers/{id}", Attributes: []string{"color-hex", "group-name", "is-active", "mobilization-method"}, Relationships: []string{"craft-class"}},
+	{Source: "do_lane_summary_create", Method: "POST", Path: "/v1/cycle-summaries", Attributes: []string{"filters", "group-by", "limit", "sort"}},
+	{Source: "do_lehman_roberts_apex_viewpoint_ticket_exports_create", Method: "POST", Path: "/v1/lehman-roberts-apex-viewpoint-ticket-exports", Attributes: []string{"location-ids", "omit-header-row", "sale-date-max", "sale-date-min", "template-name"}},
+	{Source: "do_liability_incidents_create", Method: "POST", Path: "/v1/liability-incidents", Attributes: []string{"description", "did-stop-work", "end-at", "headline", "is-down-time", "kind", "natures", "net-impact-dollars", "net-impact-minutes", "severity", "start-at", "status"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_liability_incidents_delete", Method: "DELETE", Path: "/v1/liability-incidents/{id}"},
+	{Source: "do_liability_incidents_update", Method: "PATCH", Path: "/v1/liability-incidents/{id}", Attributes: []string{"description", "did-stop-work", "end-at", "headline", "is-down-time", "kind", "natures", "net-impact-dollars", "net-impact-minutes", "new-type", "severity", "start-at", "status"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_lineup_dispatch_fulfillment_clerks_create", Method: "POST", Path: "/v1/lineup-dispatch-fulfillment-clerks", Relationships: []string{"lineup-dispatch"}},
+	{Source: "do_lineup_dispatch_statuses_create", Method: "POST", Path: "/v1/lineup-dispatch-statuses", Attributes: []string{"date", "window"}, Relationships: []string{"broker"}},
+	{Source: "do_lineup_dispatches_create", Method: "POST", Path: "/v1/lineup-dispatches", Attributes: []string{"auto-accept-trucker-tenders", "auto-offer-customer-tenders", "auto-offer-trucker-tenders", "comment"}, Relationships: []string{"lineup"}},
+	{Source: "do_lineup_dispatches_update", Method: "PATCH", Path: "/v1/lineup-dispatches/{id}", Attributes: []string{"auto-accept-trucker-tenders", "auto-offer-customer-tenders", "auto-offer-trucker-tenders", "comment"}},
+	{Source: "do_lineup_job_production_plans_create", Method: "POST", Path: "/v1/lineup-job-production-plans", Relationships: []string{"job-production-plan", "lineup"}},
+	{Source: "do_lineup_job_production_plans_delete", Method: "DELETE", Path: "/v1/lineup-job-production-plans/{id}"},
+	{Source: "do_lineup_job_schedule_shift_trucker_assignment_recommendations_create", Method: "POST", Path: "/v1/lineup-job-schedule-shift-trucker-assignment-recommendations", Relationships: []string{"lineup-job-schedule-shift"}},
+	{Source: "do_lineup_job_schedule_shifts_create", Method: "POST", Path: "/v1/lineup-job-schedule-shifts", Attributes: []string{"exclude-from-lineup-scenarios", "explicit-material-transaction-tons-max", "is-brokered", "is-expecting-time-card", "is-ready-to-dispatch", "loaded-tons-max", "notify-driver-on-late-shift-assignment", "trailer-classification-equivalent-type", "travel-minutes"}, Relationships: []string{"driver", "job-schedule-shift", "lineup", "trailer-classification", "trucker"}},
+	{Source: "do_lineup_job_schedule_shifts_delete", Method: "DELETE", Path: "/v1/lineup-job-schedule-shifts/{id}"},
+	{Source: "do_lineup_job_schedule_shifts_update", Method: "PATCH", Path: "/v1/lineup-job-schedule-shifts/{id}", Attributes: []string{"exclude-from-lineup-scenarios", "explicit-material-transaction-tons-max", "is-brokered", "is-expecting-time-card", "is-ready-to-dispatch", "loaded-tons-max", "notify-driver-on-late-shift-assignment", "trailer-classification-equivalent-type", "travel-minutes"}, Relationships: []string{"driver", "trailer-classification", "trucker"}},
+	{Source: "do_lineup_scenario_generators_create", Method: "POST", Path: "/v1/lineup-scenario-generators", Attributes: []string{"date", "include-trucker-assignments-as-constraints", "skip-create-lineup-scenario-solution", "skip-minimum-assignment-count", "trucker-assignment-limits-lookback-window-days", "use-most-recent-lineup-scenario-constraints", "window"}, Relationships: []string{"broker", "customer"}},
+	{Source: "do_lineup_scenario_lineup_job_schedule_shifts_create", Method: "POST", Path: "/v1/lineup-scenario-lineup-job-schedule-shifts", Relationships: []string{"lineup-job-schedule-shift", "lineup-scenario"}},
+	{Source: "do_lineup_scenario_lineup_job_schedule_shifts_delete", Method: "DELETE", Path: "/v1/lineup-scenario-lineup-job-schedule-shifts/{id}"},
+	{Source: "do_lineup_scenario_lineups_create", Method: "POST", Path: "/v1/lineup-scenario-lineups", Relationships: []string{"lineup", "lineup-scenario"}},
+	{Source: "do_lineup_scenario_lineups_delete", Method: "DELETE", Path: "/v1/lineup-scenario-lineups/{id}"},
+	{Source: "do_lineup_scenario_solutions_create", Method: "POST", Path: "/v1/lineup-scenario-solutions", Relationships: []string{"lineup-scenario"}},
+	{Source: "do_lineup_scenario_trailer_lineup_job_schedule_shifts_create", Method: "POST", Path: "/v1/lineup-scenario-trailer-lineup-job-schedule-shifts", Attributes: []string{"end-site-distance-minutes", "start-site-distance-minutes"}, Relationships: []string{"lineup-scenario-lineup-job-schedule-shift", "lineup-scenario-trailer"}},
+	{Source: "do_lineup_scenario_trailer_lineup_job_schedule_shifts_delete", Method: "DELETE", Path: "/v1/lineup-scenario-trailer-lineup-job-schedule-shifts/{id}"},
+	{Source: "do_lineup_scenario_trailer_lineup_job_schedule_shifts_update", Method: "PATCH", Path: "/v1/lineup-scenario-trailer-lineup-job-schedule-shifts/{id}", Attributes: []string{"end-site-distance-minutes", "start-site-distance-minutes"}},
+	{Source: "do_lineup_scenario_trailers_create", Method: "POST", Path: "/v1/lineup-scenario-trailers", Attributes: []string{"last-assigned-on"}, Relationships: []string{"lineup-scenario-trucker", "trailer"}},
+	{Source: "do_lineup_scenario_trailers_delete", Method: "DELETE", Path: "/v1/lineup-scenario-trailers/{id}"},
+	{Source: "do_lineup_scenario_trailers_update", Method: "PATCH", Path: "/v1/lineup-scenario-trailers/{id}", Attributes: []string{"last-assigned-on"}},
+	{Source: "do_lineup_scenario_truckers_create", Method: "POST", Path: "/v1/lineup-scenario-truckers", Attributes: []string{"material-type-constraints", "maximum-assignment-count", "maximum-minutes-to-start-site", "minimum-assignment-count", "trailer-classification-constraints"}, Relationships: []string{"lineup-scenario", "trucker"}},
+	{Source: "do_lineup_scenario_truckers_delete", Method: "DELETE", Path: "/v1/lineup-scenario-truckers/{id}"},
+	{Source: "do_lineup_scenario_truckers_update", Method: "PATCH", Path: "/v1/lineup-scenario-truckers/{id}", Attributes: []string{"material-type-constraints", "maximum-assignment-count", "maximum-minutes-to-start-site", "minimum-assignment-count", "trailer-classification-constraints"}},
+	{Source: "do_lineup_scenarios_create", Method: "POST", Path: "/v1/lineup-scenarios", Attributes: []string{"add-lineups-automatically", "date", "include-trucker-assignments-as-constraints", "name", "window"}, Relationships: []string{"broker", "customer"}},
+	{Source: "do_lineup_scenarios_delete", Method: "DELETE", Path: "/v1/lineup-scenarios/{id}"},
+	{Source: "do_lineup_scenarios_update", Method: "PATCH", Path: "/v1/lineup-scenarios/{id}", Attributes: []string{"include-trucker-assignments-as-constraints", "name"}},
+	{Source: "do_lineup_summary_requests_create", Method: "POST", Path: "/v1/lineup-summary-requests", Attributes: []string{"email-to", "send-if-no-shifts", "start-at-max", "start-at-min"}, Relationships: []string{"level"}},
+	{Source: "do_lineups_create", Method: "POST", Path: "/v1/lineups", Attributes: []string{"start-at-max", "start-at-min"}, Relationships: []string{"customer"}},
+	{Source: "do_lineups_delete", Method: "DELETE", Path: "/v1/lineups/{id}"},
+	{Source: "do_lineups_update", Method: "PATCH", Path: "/v1/lineups/{id}", Attributes: []string{"name", "start-at-max", "start-at-min"}},
+	{Source: "do_login_code_redemptions_create", Method: "POST", Path: "/v1/login-code-redemptions", Attributes: []string{"code", "device-id"}},
+	{Source: "do_login_code_requests_create", Method: "POST", Path: "/v1/login-code-requests", Attributes: []string{"contact-method", "device-id"}},
+	{Source: "do_lowest_losing_bid_prediction_subject_details_create", Method: "POST", Path: "/v1/lowest-losing-bid-prediction-subject-details", Attributes: []string{"bid-amount", "bid-details", "engineer-estimate-amount", "internal-engineer-estimate-amount", "lowest-bid-amount", "walk-away-bid-amount"}, Relationships: []string{"prediction-subject"}},
+	{Source: "do_lowest_losing_bid_prediction_subject_details_update", Method: "PATCH", Path: "/v1/lowest-losing-bid-prediction-subject-details/{id}", Attributes: []string{"bid-amount", "bid-details", "engineer-estimate-amount", "internal-engineer-estimate-amount", "lowest-bid-amount", "walk-away-bid-amount"}},
+	{Source: "do_maintenance_requirement_maintenance_requirement_parts_create", Method: "POST", Path: "/v1/maintenance-requirement-maintenance-requirement-parts", Attributes: []string{"quantity", "source", "unit-cost"}, Relationships: []string{"maintenance-requirement", "maintenance-requirement-part"}},
+	{Source: "do_maintenance_requirement_maintenance_requirement_parts_delete", Method: "DELETE", Path: "/v1/maintenance-requirement-maintenance-requirement-parts/{id}"},
+	{Source: "do_maintenance_requirement_maintenance_requirement_parts_update", Method: "PATCH", Path: "/v1/maintenance-requirement-maintenance-requirement-parts/{id}", Attributes: []string{"quantity", "source", "unit-cost"}, Relationships: []string{"maintenance-requirement", "maintenance-requirement-part"}},
+	{Source: "do_maintenance_requirement_parts_create", Method: "POST", Path: "/v1/maintenance-requirement-parts", Attributes: []string{"description", "is-template", "make", "model", "name", "notes", "part-number", "year"}, Relationships: []string{"broker", "equipment-classification"}},
+	{Source: "do_maintenance_requirement_parts_delete", Method: "DELETE", Path: "/v1/maintenance-requirement-parts/{id}"},
+	{Source: "do_maintenance_requirement_parts_update", Method: "PATCH", Path: "/v1/maintenance-requirement-parts/{id}", Attributes: []string{"description", "is-template", "make", "model", "name", "notes", "part-number", "year"}, Relationships: []string{"broker", "equipment-classification"}},
+	{Source: "do_maintenance_requirement_rule_evaluation_clerks_create", Method: "POST", Path: "/v1/maintenance-requirement-rule-evaluation-clerks", Attributes: []string{"equipment-id"}},
+	{Source: "do_maintenance_requirement_rule_maintenance_requirement_sets_create", Method: "POST", Path: "/v1/maintenance-requirement-rule-maintenance-requirement-sets", Relationships: []string{"maintenance-requirement-rule", "maintenance-requirement-set"}},
+	{Source: "do_maintenance_requirement_rule_maintenance_requirement_sets_delete", Method: "DELETE", Path: "/v1/maintenance-requirement-rule-maintenance-requirement-sets/{id}"},
+	{Source: "do_maintenance_requirement_rules_create", Method: "POST", Path: "/v1/maintenance-requirement-rules", Attributes: []string{"is-active", "rule"}, Relationships: []string{"broker", "business-unit", "equipment", "equipment-classification"}},
+	{Source: "do_maintenance_requirement_rules_delete", Method: "DELETE", Path: "/v1/maintenance-requirement-rules/{id}"},
+	{Source: "do_maintenance_requirement_rules_update", Method: "PATCH", Path: "/v1/maintenance-requirement-rules/{id}", Attributes: []string{"is-active", "rule"}, Relationships: []string{"broker", "business-unit", "equipment", "equipment-classification"}},
+	{Source: "do_maintenance_requirement_set_maintenance_requirements_create", Method: "POST", Path: "/v1/maintenance-requirement-set-maintenance-requirements", Relationships: []string{"maintenance-requirement", "maintenance-requirement-set"}},
+	{Source: "do_maintenance_requirement_set_maintenance_requirements_delete", Method: "DELETE", Path: "/v1/maintenance-requirement-set-maintenance-requirements/{id}"},
+	{Source: "do_maintenance_requirement_set_maintenance_requirements_update", Method: "PATCH", Path: "/v1/maintenance-requirement-set-maintenance-requirements/{id}", Relationships: []string{"maintenance-requirement", "maintenance-requirement-set"}},
+	{Source: "do_maintenance_requirement_sets_create", Method: "POST", Path: "/v1/maintenance-requirement-sets", Attributes: []string{"is-archived", "is-template", "maintenance-type", "status", "template-name"}, Relationships: []string{"broker", "equipment-classification", "work-order"}},
+	{Source: "do_maintenance_requirement_sets_delete", Method: "DELETE", Path: "/v1/maintenance-requirement-sets/{id}"},
+	{Source: "do_maintenance_requirement_sets_update", Method: "PATCH", Path: "/v1/maintenance-requirement-sets/{id}", Attributes: []string{"is-archived", "is-template", "maintenance-type", "status", "template-name"}, Relationships: []string{"broker", "equipment-classification", "work-order"}},
+	{Source: "do_material_mix_design_matches_create", Method: "POST", Path: "/v1/material-mix-design-matches", Attributes: []string{"as-of"}, Relationships: []string{"material-sites", "material-type"}},
+	{Source: "do_material_mix_designs_create", Method: "POST", Path: "/v1/material-mix-designs", Attributes: []string{"description", "end-at", "mix", "notes", "start-at"}, Relationships: []string{"material-type"}},
+	{Source: "do_material_mix_designs_delete", Method: "DELETE", Path: "/v1/material-mix-designs/{id}"},
+	{Source: "do_material_mix_designs_update", Method: "PATCH", Path: "/v1/material-mix-designs/{id}", Attributes: []string{"description", "end-at", "mix", "notes", "start-at"}},
+	{Source: "do_material_purchase_order_release_redemptions_create", Method: "POST", Path: "/v1/material-purchase-order-release-redemptions", Attributes: []string{"ticket-number"}, Relationships: []string{"material-transaction", "release"}},
+	{Source: "do_material_purchase_order_release_redemptions_update", Method: "PATCH", Path: "/v1/material-purchase-order-release-redemptions/{id}", Attributes: []string{"ticket-number"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_purchase_order_releases_create", Method: "POST", Path: "/v1/material-purchase-order-releases", Attributes: []string{"quantity", "status"}, Relationships: []string{"job-schedule-shift", "purchase-order", "tender-job-schedule-shift", "trucker"}},
+	{Source: "do_material_purchase_orders_create", Method: "POST", Path: "/v1/material-purchase-orders", Attributes: []string{"external-purchase-order-id", "external-sales-order-id", "is-managing-redemption", "quantity", "status", "transaction-at-max", "transaction-at-min"}, Relationships: []string{"broker", "customer", "job-site", "material-site", "material-supplier", "material-type", "unit-of-measure"}},
+	{Source: "do_material_purchase_orders_delete", Method: "DELETE", Path: "/v1/material-purchase-orders/{id}"},
+	{Source: "do_material_purchase_orders_update", Method: "PATCH", Path: "/v1/material-purchase-orders/{id}", Attributes: []string{"external-purchase-order-id", "external-sales-order-id", "is-managing-redemption", "quantity", "status", "transaction-at-max", "transaction-at-min"}, Relationships: []string{"customer", "job-site", "material-site", "material-type", "unit-of-measure"}},
+	{Source: "do_material_site_inventory_locations_create", Method: "POST", Path: "/v1/material-site-inventory-locations", Attributes: []string{"display-name-explicit", "latitude", "longitude", "qualified-name"}, Relationships: []string{"material-site", "unit-of-measure"}},
+	{Source: "do_material_site_inventory_locations_delete", Method: "DELETE", Path: "/v1/material-site-inventory-locations/{id}"},
+	{Source: "do_material_site_inventory_locations_update", Method: "PATCH", Path: "/v1/material-site-inventory-locations/{id}", Attributes: []string{"display-name-explicit", "latitude", "longitude"}, Relationships: []string{"unit-of-measure"}},
+	{Source: "do_material_site_mergers_create", Method: "POST", Path: "/v1/material-site-mergers", Relationships: []string{"orphan", "survivor"}},
+	{Source: "do_material_site_reading_material_types_create", Method: "POST", Path: "/v1/material-site-reading-material-types", Attributes: []string{"external-id"}, Relationships: []string{"material-site", "material-type"}},
+	{Source: "do_material_site_reading_material_types_delete", Method: "DELETE", Path: "/v1/material-site-reading-material-types/{id}"},
+	{Source: "do_material_site_reading_material_types_update", Method: "PATCH", Path: "/v1/material-site-reading-material-types/{id}", Attributes: []string{"external-id"}, Relationships: []string{"material-type"}},
+	{Source: "do_material_site_reading_summary_create", Method: "POST", Path: "/v1/material-site-reading-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_material_site_readings_create", Method: "POST", Path: "/v1/material-site-readings", Attributes: []string{"raw-material-description", "raw-material-feeder-number", "raw-material-kind", "reading-at", "value"}, Relationships: []string{"material-site", "material-site-measure", "material-site-reading-material-type", "material-site-reading-raw-material-type"}},
+	{Source: "do_material_site_readings_update", Method: "PATCH", Path: "/v1/material-site-readings/{id}", Relationships: []string{"material-site-reading-material-type", "material-site-reading-raw-material-type"}},
+	{Source: "do_material_site_subscriptions_create", Method: "POST", Path: "/v1/material-site-subscriptions", Attributes: []string{"contact-method"}, Relationships: []string{"material-site", "user"}},
+	{Source: "do_material_site_subscriptions_delete", Method: "DELETE", Path: "/v1/material-site-subscriptions/{id}"},
+	{Source: "do_material_site_subscriptions_update", Method: "PATCH", Path: "/v1/material-site-subscriptions/{id}", Attributes: []string{"contact-method"}},
+	{Source: "do_material_site_unavailabilities_create", Method: "POST", Path: "/v1/material-site-unavailabilities", Attributes: []string{"description", "end-at", "start-at"}, Relationships: []string{"material-site"}},
+	{Source: "do_material_site_unavailabilities_delete", Method: "DELETE", Path: "/v1/material-site-unavailabilities/{id}"},
+	{Source: "do_material_site_unavailabilities_update", Method: "PATCH", Path: "/v1/material-site-unavailabilities/{id}", Attributes: []string{"description", "end-at", "start-at"}},
+	{Source: "do_material_sites_create", Method: "POST", Path: "/v1/material-sites", Attributes: []string{"address", "address-latitude", "address-longitude", "can-be-job-material-site", "can-be-start-site", "cb-channel", "color-hex", "has-scale", "hours-description", "is-portable", "is-productive", "is-ticket-maker", "name", "notes", "operating-status", "phone-number", "skip-geocoding"}, Relationships: []string{"material-supplier", "parent"}},
+	{Source: "do_material_sites_delete", Method: "DELETE", Path: "/v1/material-sites/{id}"},
+	{Source: "do_material_sites_update", Method: "PATCH", Path: "/v1/material-sites/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "can-be-job-material-site", "can-be-start-site", "cb-channel", "color-hex", "has-scale", "hours-description", "is-portable", "is-productive", "is-ticket-maker", "name", "notes", "operating-status", "phone-number", "skip-geocoding"}, Relationships: []string{"material-supplier"}},
+	{Source: "do_material_supplier_memberships_create", Method: "POST", Path: "/v1/material-supplier-memberships", Relationships: []string{"organization", "project-office", "user"}},
+	{Source: "do_material_supplier_memberships_delete", Method: "GET", Path: "/v1/material-supplier-memberships/{id}"},
+	{Source: "do_material_supplier_memberships_delete", Method: "DELETE", Path: "/v1/material-supplier-memberships/{id}"},
+	{Source: "do_material_supplier_memberships_update", Method: "PATCH", Path: "/v1/material-supplier-memberships/{id}", Attributes: []string{"can-see-rates-as-manager", "can-validate-profit-improvements", "color-hex", "drives-shift-type", "enable-inventory-capacity-notifications", "enable-recap-notifications", "end-at", "explicit-sort-order", "external-employee-id", "is-admin", "is-default-job-production-plan-subscriber", "is-geofence-violation-team-member", "is-unapproved-time-card-subscriber", "kind", "start-at", "title"}},
+	{Source: "do_material_suppliers_create", Method: "POST", Path: "/v1/material-suppliers", Attributes: []string{"is-active", "is-controlled-by-broker", "name", "phone-number", "url"}, Relationships: []string{"broker"}},
+	{Source: "do_material_suppliers_delete", Method: "DELETE", Path: "/v1/material-suppliers/{id}"},
+	{Source: "do_material_suppliers_update", Method: "PATCH", Path: "/v1/material-suppliers/{id}", Attributes: []string{"is-active", "is-controlled-by-broker", "name", "phone-number", "url"}},
+	{Source: "do_material_transaction_acceptances_create", Method: "POST", Path: "/v1/material-transaction-acceptances", Attributes: []string{"comment", "skip-not-overlapping-validation"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_cost_code_allocations_create", Method: "POST", Path: "/v1/material-transaction-cost-code-allocations", Attributes: []string{"details"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_cost_code_allocations_delete", Method: "DELETE", Path: "/v1/material-transaction-cost-code-allocations/{id}"},
+	{Source: "do_material_transaction_cost_code_allocations_update", Method: "PATCH", Path: "/v1/material-transaction-cost-code-allocations/{id}", Attributes: []string{"details"}},
+	{Source: "do_material_transaction_denials_create", Method: "POST", Path: "/v1/material-transaction-denials", Attributes: []string{"comment"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_diversions_create", Method: "POST", Path: "/v1/material-transaction-diversions", Attributes: []string{"diverted-tons-explicit", "driver-instructions", "new-delivery-date"}, Relationships: []string{"material-transaction", "new-job-site"}},
+	{Source: "do_material_transaction_diversions_delete", Method: "DELETE", Path: "/v1/material-transaction-diversions/{id}"},
+	{Source: "do_material_transaction_diversions_update", Method: "PATCH", Path: "/v1/material-transaction-diversions/{id}", Attributes: []string{"diverted-tons-explicit", "driver-instructions", "new-delivery-date"}, Relationships: []string{"new-job-site"}},
+	{Source: "do_material_transaction_field_scopes_create", Method: "POST", Path: "/v1/material-transaction-field-scopes", Relationships: []string{"tender-job-schedule-shifts"}},
+	{Source: "do_material_transaction_inspection_rejections_create", Method: "POST", Path: "/v1/material-transaction-inspection-rejections", Attributes: []string{"note", "quantity"}, Relationships: []string{"material-transaction-inspection", "unit-of-measure"}},
+	{Source: "do_material_transaction_inspection_rejections_delete", Method: "DELETE", Path: "/v1/material-transaction-inspection-rejections/{id}"},
+	{Source: "do_material_transaction_inspection_rejections_update", Method: "PATCH", Path: "/v1/material-transaction-inspection-rejections/{id}", Attributes: []string{"note", "quantity"}, Relationships: []string{"unit-of-measure"}},
+	{Source: "do_material_transaction_inspections_create", Method: "POST", Path: "/v1/material-transaction-inspections", Attributes: []string{"note", "status", "strategy"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_inspections_update", Method: "PATCH", Path: "/v1/material-transaction-inspections/{id}", Attributes: []string{"note", "status", "strategy"}},
+	{Source: "do_material_transaction_invalidations_create", Method: "POST", Path: "/v1/material-transaction-invalidations", Attributes: []string{"comment"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_preloads_create", Method: "POST", Path: "/v1/material-transaction-preloads", Relationships: []string{"material-transaction", "trailer"}},
+	{Source: "do_material_transaction_rate_summaries_create", Method: "POST", Path: "/v1/material-transaction-rate-summaries", Attributes: []string{"end-at", "group-by", "material-type-hierarchies", "start-at"}, Relationships: []string{"material-site"}},
+	{Source: "do_material_transaction_rejections_create", Method: "POST", Path: "/v1/material-transaction-rejections", Attributes: []string{"comment"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_shift_assignments_create", Method: "POST", Path: "/v1/material-transaction-shift-assignments", Attributes: []string{"comment", "enable-link-invoiced", "material-transaction-ids", "skip-material-transaction-shift-skew-validation"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_material_transaction_submissions_create", Method: "POST", Path: "/v1/material-transaction-submissions", Attributes: []string{"comment"}, Relationships: []string{"material-transaction"}},
+	{Source: "do_material_transaction_summary_create", Method: "POST", Path: "/v1/material-transaction-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_material_transaction_ticket_generators_create", Method: "POST", Path: "/v1/material-transaction-ticket-generators", Attributes: []string{"format-rule"}, Relationships: []string{"organization"}},
+	{Source: "do_material_transaction_ticket_generators_delete", Method: "DELETE", Path: "/v1/material-transaction-ticket-generators/{id}"},
+	{Source: "do_material_transaction_ticket_generators_update", Method: "PATCH", Path: "/v1/material-transaction-ticket-generators/{id}", Attributes: []string{"format-rule"}},
+	{Source: "do_material_transactions_create", Method: "POST", Path: "/v1/material-transactions", Attributes: []string{"gross-weight-lbs", "max-gvm-weight-lbs", "net-weight-lbs", "tare-weight-lbs", "ticket-bol-number", "ticket-item-number", "ticket-number", "transaction-at"}, Relationships: []string{"cost-code", "material-mix-design", "material-site", "material-type", "sales-customer", "trip"}},
+	{Source: "do_material_transactions_exports_create", Method: "POST", Path: "/v1/material-transactions-exports", Relationships: []string{"material-transactions", "organization-formatter"}},
+	{Source: "do_material_type_conversions_create", Method: "POST", Path: "/v1/material-type-conversions", Relationships: []string{"foreign-material-site", "foreign-material-supplier", "foreign-material-type", "material-site", "material-supplier", "material-type"}},
+	{Source: "do_material_type_conversions_delete", Method: "DELETE", Path: "/v1/material-type-conversions/{id}"},
+	{Source: "do_material_type_conversions_update", Method: "PATCH", Path: "/v1/material-type-conversions/{id}", Relationships: []string{"foreign-material-site", "foreign-material-supplier", "foreign-material-type", "material-site", "material-type"}},
+	{Source: "do_material_type_material_site_inventory_locations_create", Method: "POST", Path: "/v1/material-type-material-site-inventory-locations", Relationships: []string{"material-site-inventory-location", "material-type"}},
+	{Source: "do_material_type_material_site_inventory_locations_delete", Method: "DELETE", Path: "/v1/material-type-material-site-inventory-locations/{id}"},
+	{Source: "do_material_type_material_site_inventory_locations_update", Method: "PATCH", Path: "/v1/material-type-material-site-inventory-locations/{id}", Relationships: []string{"material-site-inventory-location", "material-type"}},
+	{Source: "do_material_type_unavailabilities_create", Method: "POST", Path: "/v1/material-type-unavailabilities", Attributes: []string{"description", "end-at", "start-at"}, Relationships: []string{"material-type"}},
+	{Source: "do_material_type_unavailabilities_delete", Method: "DELETE", Path: "/v1/material-type-unavailabilities/{id}"},
+	{Source: "do_material_type_unavailabilities_update", Method: "PATCH", Path: "/v1/material-type-unavailabilities/{id}", Attributes: []string{"description", "end-at", "start-at"}},
+	{Source: "do_material_types_create", Method: "POST", Path: "/v1/material-types", Attributes: []string{"aggregate-bed", "aggregate-ecce", "aggregate-gradation", "description", "explicit-display-name", "lbs-per-cubic-foot", "name", "start-site-type"}, Relationships: []string{"material-supplier", "parent-material-type"}},
+	{Source: "do_material_types_delete", Method: "DELETE", Path: "/v1/material-types/{id}"},
+	{Source: "do_material_types_update", Method: "PATCH", Path: "/v1/material-types/{id}", Attributes: []string{"aggregate-bed", "aggregate-ecce", "aggregate-gradation", "description", "explicit-display-name", "is-archived", "lbs-per-cubic-foot", "name", "start-site-type"}, Relationships: []string{"material-supplier", "parent-material-type"}},
+	{Source: "do_material_unit_of_measure_quantities_create", Method: "POST", Path: "/v1/material-unit-of-measure-quantities", Attributes: []string{"quantity"}, Relationships: []string{"material-transaction", "unit-of-measure"}},
+	{Source: "do_material_unit_of_measure_quantities_delete", Method: "DELETE", Path: "/v1/material-unit-of-measure-quantities/{id}"},
+	{Source: "do_material_unit_of_measure_quantities_update", Method: "PATCH", Path: "/v1/material-unit-of-measure-quantities/{id}", Attributes: []string{"quantity"}, Relationships: []string{"material-transaction", "unit-of-measure"}},
+	{Source: "do_mechanic_user_associations_create", Method: "POST", Path: "/v1/mechanic-user-associations", Relationships: []string{"maintenance-requirement", "user"}},
+	{Source: "do_mechanic_user_associations_delete", Method: "DELETE", Path: "/v1/mechanic-user-associations/{id}"},
+	{Source: "do_mechanic_user_associations_update", Method: "PATCH", Path: "/v1/mechanic-user-associations/{id}", Relationships: []string{"maintenance-requirement", "user"}},
+	{Source: "do_meeting_attendees_create", Method: "POST", Path: "/v1/meeting-attendees", Attributes: []string{"is-presence-required", "is-present", "location-kind", "location-latitude", "location-longitude"}, Relationships: []string{"meeting", "user"}},
+	{Source: "do_meeting_attendees_delete", Method: "DELETE", Path: "/v1/meeting-attendees/{id}"},
+	{Source: "do_meeting_attendees_update", Method: "PATCH", Path: "/v1/meeting-attendees/{id}", Attributes: []string{"is-presence-required", "is-present", "location-kind", "location-latitude", "location-longitude"}},
+	{Source: "do_meetings_create", Method: "POST", Path: "/v1/meetings", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "description", "end-at", "explicit-time-zone-id", "skip-geocoding", "start-at", "subject", "summary", "transcript"}, Relationships: []string{"organization", "organizer"}},
+	{Source: "do_meetings_delete", Method: "DELETE", Path: "/v1/meetings/{id}"},
+	{Source: "do_meetings_update", Method: "PATCH", Path: "/v1/meetings/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "description", "end-at", "explicit-time-zone-id", "skip-geocoding", "start-at", "subject", "summary", "transcript"}, Relationships: []string{"organizer"}},
+	{Source: "do_memberships_delete", Method: "GET", Path: "/v1/memberships/{id}"},
+	{Source: "do_memberships_update", Method: "GET", Path: "/v1/memberships/{id}"},
+	{Source: "do_missing_rates_create", Method: "POST", Path: "/v1/missing-rates", Attributes: []string{"currency-code", "customer-price-per-unit", "trucker-price-per-unit"}, Relationships: []string{"job", "service-type-unit-of-measure"}},
+	{Source: "do_model_filter_infos_create", Method: "POST", Path: "/v1/model-filter-infos", Attributes: []string{"filter-keys", "resource-type", "scope-filters"}},
+	{Source: "do_native_app_releases_create", Method: "POST", Path: "/v1/native-app-releases", Attributes: []string{"build-number", "git-sha", "git-tag", "notes", "release-channel-details"}},
+	{Source: "do_native_app_releases_update", Method: "PATCH", Path: "/v1/native-app-releases/{id}", Attributes: []string{"notes", "release-channel-details"}},
+	{Source: "do_newsletters_create", Method: "POST", Path: "/v1/newsletters", Attributes: []string{"body", "is-public", "is-published", "published-on", "summary"}, Relationships: []string{"broker"}},
+	{Source: "do_notifications_update", Method: "PATCH", Path: "/v1/notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_objective_stakeholder_classification_quotes_create", Method: "POST", Path: "/v1/objective-stakeholder-classification-quotes", Attributes: []string{"content", "is-generated"}, Relationships: []string{"objective-stakeholder-classification"}},
+	{Source: "do_objective_stakeholder_classification_quotes_delete", Method: "DELETE", Path: "/v1/objective-stakeholder-classification-quotes/{id}"},
+	{Source: "do_objective_stakeholder_classifications_create", Method: "POST", Path: "/v1/objective-stakeholder-classifications", Attributes: []string{"interest-degree"}, Relationships: []string{"objective", "stakeholder-classification"}},
+	{Source: "do_objective_stakeholder_classifications_delete", Method: "DELETE", Path: "/v1/objective-stakeholder-classifications/{id}"},
+	{Source: "do_objective_stakeholder_classifications_update", Method: "PATCH", Path: "/v1/objective-stakeholder-classifications/{id}", Attributes: []string{"interest-degree"}},
+	{Source: "do_objectives_create", Method: "POST", Path: "/v1/objectives", Attributes: []string{"name"}, Relationships: []string{"organization", "owner", "parent", "project", "sales-responsible-person"}},
+	{Source: "do_objectives_delete", Method: "DELETE", Path: "/v1/objectives/{id}"},
+	{Source: "do_objectives_update", Method: "PATCH", Path: "/v1/objectives/{id}", Attributes: []string{"commitment", "description", "end-on", "name", "name-summary-explicit", "start-on", "template-scope"}, Relationships: []string{"owner", "parent", "project", "sales-responsible-person"}},
+	{Source: "do_one_step_gps_vehicles_update", Method: "PATCH", Path: "/v1/one-step-gps-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_open_ai_realtime_sessions_create", Method: "POST", Path: "/v1/open-ai-realtime-sessions", Attributes: []string{"client-feature", "model"}},
+	{Source: "do_open_ai_vector_stores_create", Method: "POST", Path: "/v1/open-ai-vector-stores", Attributes: []string{"purpose"}, Relationships: []string{"scope"}},
+	{Source: "do_open_ai_vector_stores_delete", Method: "DELETE", Path: "/v1/open-ai-vector-stores/{id}"},
+	{Source: "do_open_ai_vector_stores_update", Method: "PATCH", Path: "/v1/open-ai-vector-stores/{id}", Attributes: []string{"purpose"}, Relationships: []string{"scope"}},
+	{Source: "do_open_door_issues_create", Method: "POST", Path: "/v1/open-door-issues", Attributes: []string{"description", "status"}, Relationships: []string{"organization", "reported-by"}},
+	{Source: "do_open_door_issues_delete", Method: "DELETE", Path: "/v1/open-door-issues/{id}"},
+	{Source: "do_open_door_issues_update", Method: "PATCH", Path: "/v1/open-door-issues/{id}", Attributes: []string{"description", "status"}, Relationships: []string{"organization", "reported-by"}},
+	{Source: "do_open_door_team_memberships_create", Method: "POST", Path: "/v1/open-door-team-memberships", Relationships: []string{"membership", "organization"}},
+	{Source: "do_open_door_team_memberships_delete", Method: "DELETE", Path: "/v1/open-door-team-memberships/{id}"},
+	{Source: "do_open_door_team_memberships_update", Method: "PATCH", Path: "/v1/open-door-team-memberships/{id}", Relationships: []string{"membership", "organization"}},
+	{Source: "do_organization_formatters_create", Method: "POST", Path: "/v1/organization-formatters", Attributes: []string{"description", "formatter-function", "formatter-type", "is-library", "mime-types", "status"}, Relationships: []string{"organization"}},
+	{Source: "do_organization_formatters_update", Method: "PATCH", Path: "/v1/organization-formatters/{id}", Attributes: []string{"description", "formatter-function", "is-library", "mime-types", "status"}},
+	{Source: "do_organization_invoices_batch_file_exports_create", Method: "POST", Path: "/v1/organization-invoices-batch-file-exports", Attributes: []string{"dry-run"}, Relationships: []string{"organization-invoices-batch-file"}},
+	{Source: "do_organization_invoices_batch_files_create", Method: "POST", Path: "/v1/organization-invoices-batch-files", Relationships: []string{"organization", "organization-formatter", "organization-invoices-batch"}},
+	{Source: "do_organization_invoices_batch_invoice_batchings_create", Method: "POST", Path: "/v1/organization-invoices-batch-invoice-batchings", Attributes: []string{"comment"}, Relationships: []string{"organization-invoices-batch-invoice"}},
+	{Source: "do_organization_invoices_batch_invoice_failures_create", Method: "POST", Path: "/v1/organization-invoices-batch-invoice-failures", Attributes: []string{"comment"}, Relationships: []string{"organization-invoices-batch-invoice"}},
+	{Source: "do_organization_invoices_batch_invoice_unbatchings_create", Method: "POST", Path: "/v1/organization-invoices-batch-invoice-unbatchings", Relationships: []string{"organization-invoices-batch-invoice"}},
+	{Source: "do_organization_invoices_batch_pdf_generations_create", Method: "POST", Path: "/v1/organization-invoices-batch-pdf-generations", Relationships: []string{"organization-invoices-batch", "organization-pdf-template"}},
+	{Source: "do_organization_invoices_batch_pdf_templates_create", Method: "POST", Path: "/v1/organization-invoices-batch-pdf-templates", Attributes: []string{"description", "is-active", "is-global", "template"}, Relationships: []string{"broker", "created-by", "organization"}},
+	{Source: "do_organization_invoices_batch_pdf_templates_update", Method: "PATCH", Path: "/v1/organization-invoices-batch-pdf-templates/{id}", Attributes: []string{"description", "is-active", "is-global", "template"}},
+	{Source: "do_organization_invoices_batch_processes_create", Method: "POST", Path: "/v1/organization-invoices-batch-processes", Attributes: []string{"comment"}, Relationships: []string{"organization-invoices-batch"}},
+	{Source: "do_organization_invoices_batches_create", Method: "POST", Path: "/v1/organization-invoices-batches", Relationships: []string{"invoices", "organization"}},
+	{Source: "do_organization_project_actuals_exports_create", Method: "POST", Path: "/v1/organization-project-actuals-exports", Attributes: []string{"dry-run"}, Relationships: []string{"project-actuals-export"}},
+	{Source: "do_ozinga_tk_batch_file_exports_create", Method: "POST", Path: "/v1/ozinga-tk-batch-file-exports", Attributes: []string{"dry-run"}, Relationships: []string{"organization-invoices-batch-file"}},
+	{Source: "do_parking_sites_create", Method: "POST", Path: "/v1/parking-sites", Attributes: []string{"active-end-at", "active-start-at", "address", "address-latitude", "address-longitude", "is-active", "skip-address-geocoding"}, Relationships: []string{"parked"}},
+	{Source: "do_parking_sites_delete", Method: "DELETE", Path: "/v1/parking-sites/{id}"},
+	{Source: "do_parking_sites_update", Method: "PATCH", Path: "/v1/parking-sites/{id}", Attributes: []string{"active-end-at", "active-start-at", "address", "address-latitude", "address-longitude", "is-active", "skip-address-geocoding"}},
+	{Source: "do_pave_frame_actual_hours_create", Method: "POST", Path: "/v1/pave-frame-actual-hours", Attributes: []string{"date", "hour", "latitude", "longitude", "precip-1hr-in", "temp-min-f", "window"}},
+	{Source: "do_pave_frame_actual_hours_delete", Method: "DELETE", Path: "/v1/pave-frame-actual-hours/{id}"},
+	{Source: "do_pave_frame_actual_hours_update", Method: "PATCH", Path: "/v1/pave-frame-actual-hours/{id}", Attributes: []string{"date", "hour", "latitude", "longitude", "precip-1hr-in", "temp-min-f", "window"}},
+	{Source: "do_pave_frame_actual_statistics_create", Method: "POST", Path: "/v1/pave-frame-actual-statistics", Attributes: []string{"agg-level", "calculate-results-before-create", "date-max", "date-min", "hour-maximum-precip-in", "hour-minimum-temp-f", "latitude", "longitude", "window", "window-minimum-paving-hour-pct", "work-days"}},
+	{Source: "do_platform_statuses_create", Method: "POST", Path: "/v1/platform-statuses", Attributes: []string{"description", "end-at", "published-at", "start-at", "title"}},
+	{Source: "do_platform_statuses_delete", Method: "DELETE", Path: "/v1/platform-statuses/{id}"},
+	{Source: "do_post_children_create", Method: "POST", Path: "/v1/post-children", Relationships: []string{"child-post", "parent-post"}},
+	{Source: "do_post_children_delete", Method: "DELETE", Path: "/v1/post-children/{id}"},
+	{Source: "do_post_routers_create", Method: "POST", Path: "/v1/post-routers", Relationships: []string{"post"}},
+	{Source: "do_post_views_create", Method: "POST", Path: "/v1/post-views", Attributes: []string{"viewed-at"}, Relationships: []string{"post", "viewer"}},
+	{Source: "do_posts_create", Method: "POST", Path: "/v1/posts", Attributes: []string{"is-private", "post-type", "published-at", "status", "text-content"}, Relationships: []string{"creator", "subject-post"}},
+	{Source: "do_prediction_agents_create", Method: "POST", Path: "/v1/prediction-agents", Attributes: []string{"custom-instructions"}, Relationships: []string{"created-by", "prediction-subject"}},
+	{Source: "do_prediction_agents_delete", Method: "DELETE", Path: "/v1/prediction-agents/{id}"},
+	{Source: "do_prediction_agents_update", Method: "PATCH", Path: "/v1/prediction-agents/{id}", Attributes: []string{"custom-instructions"}},
+	{Source: "do_prediction_knowledge_base_questions_create", Method: "POST", Path: "/v1/prediction-knowledge-base-questions", Attributes: []string{"description", "status", "title"}, Relationships: []string{"created-by", "prediction-knowledge-base", "prediction-subject"}},
+	{Source: "do_prediction_knowledge_base_questions_delete", Method: "DELETE", Path: "/v1/prediction-knowledge-base-questions/{id}"},
+	{Source: "do_prediction_knowledge_base_questions_update", Method: "PATCH", Path: "/v1/prediction-knowledge-base-questions/{id}", Attributes: []string{"description", "status", "title"}, Relationships: []string{"prediction-subject"}},
+	{Source: "do_prediction_knowledge_bases_create", Method: "POST", Path: "/v1/prediction-knowledge-bases", Relationships: []string{"broker"}},
+	{Source: "do_prediction_subject_bids_create", Method: "POST", Path: "/v1/prediction-subject-bids", Attributes: []string{"amount"}, Relationships: []string{"bidder", "lowest-losing-bid-prediction-subject-detail"}},
+	{Source: "do_prediction_subject_bids_delete", Method: "DELETE", Path: "/v1/prediction-subject-bids/{id}"},
+	{Source: "do_prediction_subject_bids_update", Method: "PATCH", Path: "/v1/prediction-subject-bids/{id}", Attributes: []string{"amount"}},
+	{Source: "do_prediction_subject_gap_portions_create", Method: "POST", Path: "/v1/prediction-subject-gap-portions", Attributes: []string{"amount", "description", "name", "status"}, Relationships: []string{"created-by", "prediction-subject-gap"}},
+	{Source: "do_prediction_subject_gap_portions_delete", Method: "DELETE", Path: "/v1/prediction-subject-gap-portions/{id}"},
+	{Source: "do_prediction_subject_gap_portions_update", Method: "PATCH", Path: "/v1/prediction-subject-gap-portions/{id}", Attributes: []string{"amount", "description", "name", "status"}},
+	{Source: "do_prediction_subject_gaps_create", Method: "POST", Path: "/v1/prediction-subject-gaps", Attributes: []string{"gap-type", "status"}, Relationships: []string{"prediction-subject"}},
+	{Source: "do_prediction_subject_gaps_delete", Method: "DELETE", Path: "/v1/prediction-subject-gaps/{id}"},
+	{Source: "do_prediction_subject_gaps_update", Method: "PATCH", Path: "/v1/prediction-subject-gaps/{id}", Attributes: []string{"gap-type", "status"}},
+	{Source: "do_prediction_subject_memberships_create", Method: "POST", Path: "/v1/prediction-subject-memberships", Relationships: []string{"created-by", "prediction-subject", "user"}},
+	{Source: "do_prediction_subject_memberships_delete", Method: "DELETE", Path: "/v1/prediction-subject-memberships/{id}"},
+	{Source: "do_prediction_subject_memberships_update", Method: "PATCH", Path: "/v1/prediction-subject-memberships/{id}", Attributes: []string{"can-manage-gaps", "can-manage-memberships", "can-see-predictions-without-creating-prediction", "can-update-or-destroy-others-predictions", "can-update-prediction-consensus"}},
+	{Source: "do_prediction_subject_recap_generations_create", Method: "POST", Path: "/v1/prediction-subject-recap-generations", Relationships: []string{"prediction-subject"}},
+	{Source: "do_prediction_subjects_create", Method: "POST", Path: "/v1/prediction-subjects", Attributes: []string{"actual", "actual-due-at", "additional-attributes", "description", "domain-max", "domain-min", "kind", "name", "predictions-due-at", "reference-number", "status"}, Relationships: []string{"broker", "business-unit", "parent", "prediction-consensus"}},
+	{Source: "do_prediction_subjects_delete", Method: "GET", Path: "/v1/prediction-subjects/{id}"},
+	{Source: "do_prediction_subjects_delete", Method: "DELETE", Path: "/v1/prediction-subjects/{id}"},
+	{Source: "do_prediction_subjects_update", Method: "PATCH", Path: "/v1/prediction-subjects/{id}", Attributes: []string{"actual", "actual-due-at", "additional-attributes", "description", "domain-max", "domain-min", "kind", "name", "predictions-due-at", "reference-number", "status"}, Relationships: []string{"business-unit", "parent", "prediction-consensus"}},
+	{Source: "do_predictions_create", Method: "POST", Path: "/v1/predictions", Attributes: []string{"probability-distribution", "status"}, Relationships: []string{"predicted-by", "prediction-subject"}},
+	{Source: "do_predictions_delete", Method: "DELETE", Path: "/v1/predictions/{id}"},
+	{Source: "do_predictions_update", Method: "PATCH", Path: "/v1/predictions/{id}", Attributes: []string{"probability-distribution", "status"}},
+	{Source: "do_press_releases_create", Method: "POST", Path: "/v1/press-releases", Attributes: []string{"body", "headline", "location-name", "published", "released-at", "slug", "subheadline"}},
+	{Source: "do_process_non_processed_time_card_time_changes_create", Method: "POST", Path: "/v1/sombreros/process-non-processed-time-card-time-changes", Attributes: []string{"delete-unprocessed", "time-card-time-change-ids"}},
+	{Source: "do_production_incident_detectors_create", Method: "POST", Path: "/v1/production-incident-detectors", Attributes: []string{"lookahead-offset", "minutes-threshold", "quantity-threshold"}, Relationships: []string{"job-production-plan"}},
+	{Source: "do_production_incidents_create", Method: "POST", Path: "/v1/production-incidents", Attributes: []string{"description", "did-stop-work", "end-at", "headline", "is-down-time", "kind", "natures", "net-impact-dollars", "net-impact-minutes", "severity", "start-at", "status", "time-value-type"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_production_incidents_delete", Method: "DELETE", Path: "/v1/production-incidents/{id}"},
+	{Source: "do_production_incidents_update", Method: "PATCH", Path: "/v1/production-incidents/{id}", Attributes: []string{"description", "did-stop-work", "end-at", "headline", "is-down-time", "kind", "natures", "net-impact-dollars", "net-impact-minutes", "new-type", "severity", "start-at", "status", "time-value-type"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_production_measurements_create", Method: "POST", Path: "/v1/production-measurements", Attributes: []string{"density-lbs-per-cubic-foot", "depth-inches", "length-feet", "note", "pass-count", "speed-feet-per-minute", "speed-feet-per-minute-possible", "width-display-unit-of-measure", "width-inches"}, Relationships: []string{"job-production-plan-segment"}},
+	{Source: "do_production_measurements_update", Method: "PATCH", Path: "/v1/production-measurements/{id}", Attributes: []string{"density-lbs-per-cubic-foot", "depth-inches", "length-feet", "note", "pass-count", "speed-feet-per-minute", "speed-feet-per-minute-possible", "width-display-unit-of-measure", "width-inches"}},
+	{Source: "do_proffer_likes_create", Method: "POST", Path: "/v1/proffer-likes", Relationships: []string{"proffer", "user"}},
+	{Source: "do_proffer_likes_delete", Method: "DELETE", Path: "/v1/proffer-likes/{id}"},
+	{Source: "do_proffers_create", Method: "POST", Path: "/v1/proffers", Attributes: []string{"description", "kind", "title"}},
+	{Source: "do_proffers_delete", Method: "DELETE", Path: "/v1/proffers/{id}"},
+	{Source: "do_proffers_update", Method: "PATCH", Path: "/v1/proffers/{id}", Attributes: []string{"description", "kind", "title"}},
+	{Source: "do_profit_improvement_categories_create", Method: "POST", Path: "/v1/profit-improvement-categories", Attributes: []string{"name"}},
+	{Source: "do_profit_improvement_subscriptions_create", Method: "POST", Path: "/v1/profit-improvement-subscriptions", Attributes: []string{"contact-method"}, Relationships: []string{"profit-improvement", "user"}},
+	{Source: "do_profit_improvement_subscriptions_delete", Method: "DELETE", Path: "/v1/profit-improvement-subscriptions/{id}"},
+	{Source: "do_profit_improvement_subscriptions_update", Method: "PATCH", Path: "/v1/profit-improvement-subscriptions/{id}", Attributes: []string{"contact-method"}},
+	{Source: "do_profit_improvements_create", Method: "POST", Path: "/v1/profit-improvements", Attributes: []string{"amount-estimated", "amount-validated", "description", "gain-share-fee-end-on", "gain-share-fee-percentage", "gain-share-fee-start-on", "impact-end-on-estimated", "impact-end-on-validated", "impact-frequency-estimated", "impact-frequency-validated", "impact-interval-estimated", "impact-interval-validated", "impact-start-on-estimated", "impact-start-on-validated", "status", "title"}, Relationships: []string{"created-by", "organization", "original", "owned-by", "profit-improvement-category", "validated-by"}},
+	{Source: "do_project_abandonments_create", Method: "POST", Path: "/v1/project-abandonments", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_project_actuals_exports_create", Method: "POST", Path: "/v1/project-actuals-exports", Relationships: []string{"job-production-plans", "organization-formatter"}},
+	{Source: "do_project_approvals_create", Method: "POST", Path: "/v1/project-approvals", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_project_bid_location_material_types_create", Method: "POST", Path: "/v1/project-bid-location-material-types", Attributes: []string{"notes", "quantity"}, Relationships: []string{"material-type", "project-bid-location", "unit-of-measure"}},
+	{Source: "do_project_bid_location_material_types_delete", Method: "DELETE", Path: "/v1/project-bid-location-material-types/{id}"},
+	{Source: "do_project_bid_location_material_types_update", Method: "PATCH", Path: "/v1/project-bid-location-material-types/{id}", Attributes: []string{"notes", "quantity"}, Relationships: []string{"material-type", "unit-of-measure"}},
+	{Source: "do_project_bid_locations_create", Method: "POST", Path: "/v1/project-bid-locations", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "geometry", "name", "notes", "skip-address-geocoding"}, Relationships: []string{"project"}},
+	{Source: "do_project_bid_locations_delete", Method: "DELETE", Path: "/v1/project-bid-locations/{id}"},
+	{Source: "do_project_bid_locations_update", Method: "PATCH", Path: "/v1/project-bid-locations/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "geometry", "name", "notes", "skip-address-geocoding"}},
+	{Source: "do_project_cancellations_create", Method: "POST", Path: "/v1/project-cancellations", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_project_categories_create", Method: "POST", Path: "/v1/project-categories", Attributes: []string{"abbreviation", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_project_completions_create", Method: "POST", Path: "/v1/project-completions", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_project_cost_classifications_create", Method: "POST", Path: "/v1/project-cost-classifications", Attributes: []string{"name"}, Relationships: []string{"broker", "parent"}},
+	{Source: "do_project_cost_classifications_delete", Method: "DELETE", Path: "/v1/project-cost-classifications/{id}"},
+	{Source: "do_project_cost_classifications_update", Method: "PATCH", Path: "/v1/project-cost-classifications/{id}", Attributes: []string{"name"}, Relationships: []string{"parent"}},
+	{Source: "do_project_cost_codes_create", Method: "POST", Path: "/v1/project-cost-codes", Attributes: []string{"explicit-cost-code-description"}, Relationships: []string{"cost-code", "project-customer"}},
+	{Source: "do_project_cost_codes_delete", Method: "DELETE", Path: "/v1/project-cost-codes/{id}"},
+	{Source: "do_project_cost_codes_update", Method: "PATCH", Path: "/v1/project-cost-codes/{id}", Attributes: []string{"explicit-cost-code-description"}},
+	{Source: "do_project_customers_create", Method: "POST", Path: "/v1/project-customers", Relationships: []string{"customer", "project"}},
+	{Source: "do_project_customers_delete", Method: "DELETE", Path: "/v1/project-customers/{id}"},
+	{Source: "do_project_divisions_create", Method: "POST", Path: "/v1/project-divisions", Attributes: []string{"abbreviation", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_project_duplications_create", Method: "POST", Path: "/v1/project-duplications", Attributes: []string{"derived-due-on", "derived-is-prevailing-wage-applicable", "derived-is-time-card-payroll-certification-required", "derived-project-number", "derived-project-template-name", "skip-certification-requirements", "skip-project-cost-codes", "skip-project-customers", "skip-project-labor-classifications", "skip-project-material-types", "skip-project-phase-revenue-items", "skip-project-revenue-items", "skip-project-trailer-classifications", "skip-project-truckers"}, Relationships: []string{"new-developer", "project-template"}},
+	{Source: "do_project_estimate_file_imports_create", Method: "POST", Path: "/v1/project-estimate-file-imports", Attributes: []string{"file-import-type", "is-dry-run", "should-update-file-extraction"}, Relationships: []string{"file-import", "project"}},
+	{Source: "do_project_estimate_sets_create", Method: "POST", Path: "/v1/project-estimate-sets", Attributes: []string{"name"}, Relationships: []string{"backup-estimate-set", "created-by", "project"}},
+	{Source: "do_project_estimate_sets_delete", Method: "DELETE", Path: "/v1/project-estimate-sets/{id}"},
+	{Source: "do_project_estimate_sets_update", Method: "PATCH", Path: "/v1/project-estimate-sets/{id}", Attributes: []string{"name"}, Relationships: []string{"backup-estimate-set", "created-by"}},
+	{Source: "do_project_import_file_verifications_create", Method: "POST", Path: "/v1/project-import-file-verifications", Attributes: []string{"is-dry-run", "verification-type"}, Relationships: []string{"file-import", "project"}},
+	{Source: "do_project_labor_classifications_create", Method: "POST", Path: "/v1/project-labor-classifications", Attributes: []string{"basic-hourly-rate", "fringe-hourly-rate"}, Relationships: []string{"labor-classification", "project"}},
+	{Source: "do_project_labor_classifications_delete", Method: "DELETE", Path: "/v1/project-labor-classifications/{id}"},
+	{Source: "do_project_labor_classifications_update", Method: "PATCH", Path: "/v1/project-labor-classifications/{id}", Attributes: []string{"basic-hourly-rate", "fringe-hourly-rate"}},
+	{Source: "do_project_margin_matrices_create", Method: "POST", Path: "/v1/project-margin-matrices", Relationships: []string{"project"}},
+	{Source: "do_project_margin_matrices_delete", Method: "DELETE", Path: "/v1/project-margin-matrices/{id}"},
+	{Source: "do_project_material_type_quality_control_requirements_create", Method: "POST", Path: "/v1/project-material-type-quality-control-requirements", Relationships: []string{"project-material-type", "quality-control-classification"}},
+	{Source: "do_project_material_type_quality_control_requirements_delete", Method: "DELETE", Path: "/v1/project-material-type-quality-control-requirements/{id}"},
+	{Source: "do_project_material_type_quality_control_requirements_update", Method: "PATCH", Path: "/v1/project-material-type-quality-control-requirements/{id}", Attributes: []string{"note"}, Relationships: []string{"quality-control-classification"}},
+	{Source: "do_project_material_types_create", Method: "POST", Path: "/v1/project-material-types", Attributes: []string{"deliver-at-max", "deliver-at-min", "explicit-display-name", "pickup-at-max", "pickup-at-min", "quantity"}, Relationships: []string{"delivery-location", "job-site", "material-site", "material-type", "pickup-location", "project", "unit-of-measure"}},
+	{Source: "do_project_material_types_delete", Method: "DELETE", Path: "/v1/project-material-types/{id}"},
+	{Source: "do_project_material_types_update", Method: "PATCH", Path: "/v1/project-material-types/{id}", Attributes: []string{"deliver-at-max", "deliver-at-min", "explicit-display-name", "pickup-at-max", "pickup-at-min", "quantity"}, Relationships: []string{"delivery-location", "job-site", "material-site", "pickup-location", "unit-of-measure"}},
+	{Source: "do_project_offices_create", Method: "POST", Path: "/v1/project-offices", Attributes: []string{"abbreviation", "is-active", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_project_offices_delete", Method: "DELETE", Path: "/v1/project-offices/{id}"},
+	{Source: "do_project_offices_update", Method: "PATCH", Path: "/v1/project-offices/{id}", Attributes: []string{"abbreviation", "is-active", "name"}},
+	{Source: "do_project_phase_cost_item_actuals_create", Method: "POST", Path: "/v1/project-phase-cost-item-actuals", Attributes: []string{"price-per-unit-explicit", "quantity"}, Relationships: []string{"created-by", "project-phase-cost-item", "project-phase-revenue-item-actual"}},
+	{Source: "do_project_phase_cost_item_actuals_delete", Method: "DELETE", Path: "/v1/project-phase-cost-item-actuals/{id}"},
+	{Source: "do_project_phase_cost_item_actuals_update", Method: "PATCH", Path: "/v1/project-phase-cost-item-actuals/{id}", Attributes: []string{"price-per-unit-explicit", "quantity"}, Relationships: []string{"created-by", "project-phase-revenue-item-actual"}},
+	{Source: "do_project_phase_cost_item_price_estimates_create", Method: "POST", Path: "/v1/project-phase-cost-item-price-estimates", Attributes: []string{"estimate"}, Relationships: []string{"created-by", "project-estimate-set", "project-phase-cost-item"}},
+	{Source: "do_project_phase_cost_item_price_estimates_delete", Method: "DELETE", Path: "/v1/project-phase-cost-item-price-estimates/{id}"},
+	{Source: "do_project_phase_cost_item_price_estimates_update", Method: "PATCH", Path: "/v1/project-phase-cost-item-price-estimates/{id}", Attributes: []string{"estimate"}, Relationships: []string{"created-by", "project-estimate-set"}},
+	{Source: "do_project_phase_cost_item_quantity_estimates_create", Method: "POST", Path: "/v1/project-phase-cost-item-quantity-estimates", Attributes: []string{"estimate", "revenue-item-quantity-basis"}, Relationships: []string{"created-by", "project-estimate-set", "project-phase-cost-item"}},
+	{Source: "do_project_phase_cost_item_quantity_estimates_delete", Method: "DELETE", Path: "/v1/project-phase-cost-item-quantity-estimates/{id}"},
+	{Source: "do_project_phase_cost_item_quantity_estimates_update", Method: "PATCH", Path: "/v1/project-phase-cost-item-quantity-estimates/{id}", Attributes: []string{"estimate", "revenue-item-quantity-basis"}, Relationships: []string{"created-by", "project-estimate-set"}},
+	{Source: "do_project_phase_cost_items_create", Method: "POST", Path: "/v1/project-phase-cost-items", Attributes: []string{"is-revenue-quantity-driver"}, Relationships: []string{"cost-code", "project-cost-classification", "project-phase-revenue-item", "project-resource-classification", "unit-of-measure"}},
+	{Source: "do_project_phase_cost_items_delete", Method: "DELETE", Path: "/v1/project-phase-cost-items/{id}"},
+	{Source: "do_project_phase_cost_items_update", Method: "PATCH", Path: "/v1/project-phase-cost-items/{id}", Attributes: []string{"is-revenue-quantity-driver"}, Relationships: []string{"cost-code", "price-estimate", "project-cost-classification", "project-resource-classification", "quantity-estimate", "unit-of-measure"}},
+	{Source: "do_project_phase_dates_estimates_create", Method: "POST", Path: "/v1/project-phase-dates-estimates", Attributes: []string{"end-date", "start-date"}, Relationships: []string{"created-by", "project-estimate-set", "project-phase"}},
+	{Source: "do_project_phase_dates_estimates_delete", Method: "DELETE", Path: "/v1/project-phase-dates-estimates/{id}"},
+	{Source: "do_project_phase_dates_estimates_update", Method: "PATCH", Path: "/v1/project-phase-dates-estimates/{id}", Attributes: []string{"end-date", "start-date"}, Relationships: []string{"created-by"}},
+	{Source: "do_project_phase_revenue_item_actual_exports_create", Method: "POST", Path: "/v1/project-phase-revenue-item-actual-exports", Relationships: []string{"organization-formatter", "project-phase-revenue-items"}},
+	{Source: "do_project_phase_revenue_item_actuals_create", Method: "POST", Path: "/v1/project-phase-revenue-item-actuals", Attributes: []string{"quantity", "quantity-strategy-explicit", "revenue-date"}, Relationships: []string{"created-by", "job-production-plan", "job-production-plan-project-phase-revenue-item", "project-phase-revenue-item"}},
+	{Source: "do_project_phase_revenue_item_actuals_delete", Method: "DELETE", Path: "/v1/project-phase-revenue-item-actuals/{id}"},
+	{Source: "do_project_phase_revenue_item_actuals_update", Method: "PATCH", Path: "/v1/project-phase-revenue-item-actuals/{id}", Attributes: []string{"quantity", "quantity-strategy-explicit", "revenue-date"}, Relationships: []string{"created-by", "job-production-plan", "job-production-plan-project-phase-revenue-item"}},
+	{Source: "do_project_phase_revenue_item_quantity_estimates_create", Method: "POST", Path: "/v1/project-phase-revenue-item-quantity-estimates", Attributes: []string{"description", "estimate"}, Relationships: []string{"created-by", "project-estimate-set", "project-phase-revenue-item"}},
+	{Source: "do_project_phase_revenue_item_quantity_estimates_delete", Method: "DELETE", Path: "/v1/project-phase-revenue-item-quantity-estimates/{id}"},
+	{Source: "do_project_phase_revenue_item_quantity_estimates_update", Method: "PATCH", Path: "/v1/project-phase-revenue-item-quantity-estimates/{id}", Attributes: []string{"description", "estimate"}, Relationships: []string{"created-by", "project-estimate-set"}},
+	{Source: "do_project_phase_revenue_items_create", Method: "POST", Path: "/v1/project-phase-revenue-items", Attributes: []string{"note", "quantity-strategy"}, Relationships: []string{"project-phase", "project-revenue-classification", "project-revenue-item"}},
+	{Source: "do_project_phase_revenue_items_delete", Method: "DELETE", Path: "/v1/project-phase-revenue-items/{id}"},
+	{Source: "do_project_phase_revenue_items_update", Method: "PATCH", Path: "/v1/project-phase-revenue-items/{id}", Attributes: []string{"note", "quantity-strategy"}, Relationships: []string{"project-revenue-classification", "quantity-estimate"}},
+	{Source: "do_project_phases_create", Method: "POST", Path: "/v1/project-phases", Attributes: []string{"description", "name", "sequence-position"}, Relationships: []string{"project"}},
+	{Source: "do_project_phases_delete", Method: "DELETE", Path: "/v1/project-phases/{id}"},
+	{Source: "do_project_phases_update", Method: "PATCH", Path: "/v1/project-phases/{id}", Attributes: []string{"description", "name", "sequence-position"}},
+	{Source: "do_project_project_cost_classifications_create", Method: "POST", Path: "/v1/project-project-cost-classifications", Attributes: []string{"name-override"}, Relationships: []string{"project", "project-cost-classification"}},
+	{Source: "do_project_project_cost_classifications_delete", Method: "DELETE", Path: "/v1/project-project-cost-classifications/{id}"},
+	{Source: "do_project_project_cost_classifications_update", Method: "PATCH", Path: "/v1/project-project-cost-classifications/{id}", Attributes: []string{"name-override"}},
+	{Source: "do_project_rejections_create", Method: "POST", Path: "/v1/project-rejections", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_project_resource_classifications_create", Method: "POST", Path: "/v1/project-resource-classifications", Attributes: []string{"name"}, Relationships: []string{"broker", "parent"}},
+	{Source: "do_project_resource_classifications_delete", Method: "DELETE", Path: "/v1/project-resource-classifications/{id}"},
+	{Source: "do_project_resource_classifications_update", Method: "PATCH", Path: "/v1/project-resource-classifications/{id}", Attributes: []string{"name"}, Relationships: []string{"parent"}},
+	{Source: "do_project_revenue_classifications_create", Method: "POST", Path: "/v1/project-revenue-classifications", Attributes: []string{"name"}, Relationships: []string{"broker", "parent"}},
+	{Source: "do_project_revenue_classifications_delete", Method: "DELETE", Path: "/v1/project-revenue-classifications/{id}"},
+	{Source: "do_project_revenue_classifications_update", Method: "PATCH", Path: "/v1/project-revenue-classifications/{id}", Attributes: []string{"name"}, Relationships: []string{"parent"}},
+	{Source: "do_project_revenue_item_price_estimates_create", Method: "POST", Path: "/v1/project-revenue-item-price-estimates", Attributes: []string{"cost-multiplier", "kind", "price-per-unit-explicit"}, Relationships: []string{"created-by", "project-estimate-set", "project-revenue-item"}},
+	{Source: "do_project_revenue_item_price_estimates_delete", Method: "DELETE", Path: "/v1/project-revenue-item-price-estimates/{id}"},
+	{Source: "do_project_revenue_item_price_estimates_update", Method: "PATCH", Path: "/v1/project-revenue-item-price-estimates/{id}", Attributes: []string{"cost-multiplier", "kind", "price-per-unit-explicit"}, Relationships: []string{"created-by", "project-estimate-set"}},
+	{Source: "do_project_revenue_item_quantity_estimates_create", Method: "POST", Path: "/v1/project-revenue-item-quantity-estimates", Attributes: []string{"description", "estimate"}, Relationships: []string{"created-by", "project-estimate-set", "project-revenue-item"}},
+	{Source: "do_project_revenue_item_quantity_estimates_delete", Method: "DELETE", Path: "/v1/project-revenue-item-quantity-estimates/{id}"},
+	{Source: "do_project_revenue_item_quantity_estimates_update", Method: "PATCH", Path: "/v1/project-revenue-item-quantity-estimates/{id}", Attributes: []string{"description", "estimate"}, Relationships: []string{"created-by", "project-estimate-set"}},
+	{Source: "do_project_revenue_items_create", Method: "POST", Path: "/v1/project-revenue-items", Attributes: []string{"description", "developer-quantity-estimate", "external-developer-revenue-item-id"}, Relationships: []string{"project", "revenue-classification", "unit-of-measure"}},
+	{Source: "do_project_revenue_items_delete", Method: "DELETE", Path: "/v1/project-revenue-items/{id}"},
+	{Source: "do_project_revenue_items_update", Method: "PATCH", Path: "/v1/project-revenue-items/{id}", Attributes: []string{"description", "developer-quantity-estimate", "external-developer-revenue-item-id"}, Relationships: []string{"price-estimate", "quantity-estimate", "revenue-classification", "unit-of-measure"}},
+	{Source: "do_project_submissions_create", Method: "POST", Path: "/v1/project-submissions", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_project_subscriptions_create", Method: "POST", Path: "/v1/project-subscriptions", Relationships: []string{"project", "user"}},
+	{Source: "do_project_subscriptions_delete", Method: "DELETE", Path: "/v1/project-subscriptions/{id}"},
+	{Source: "do_project_subscriptions_update", Method: "PATCH", Path: "/v1/project-subscriptions/{id}", Attributes: []string{"contact-method"}},
+	{Source: "do_project_trailer_classifications_create", Method: "POST", Path: "/v1/project-trailer-classifications", Relationships: []string{"project", "project-labor-classification", "trailer-classification"}},
+	{Source: "do_project_trailer_classifications_delete", Method: "DELETE", Path: "/v1/project-trailer-classifications/{id}"},
+	{Source: "do_project_trailer_classifications_update", Method: "PATCH", Path: "/v1/project-trailer-classifications/{id}", Relationships: []string{"project-labor-classification"}},
+	{Source: "do_project_transport_event_types_create", Method: "POST", Path: "/v1/project-transport-event-types", Attributes: []string{"code", "dwell-minutes-min-default", "name", "transport-order-stop-role"}, Relationships: []string{"broker"}},
+	{Source: "do_project_transport_event_types_delete", Method: "DELETE", Path: "/v1/project-transport-event-types/{id}"},
+	{Source: "do_project_transport_event_types_update", Method: "PATCH", Path: "/v1/project-transport-event-types/{id}", Attributes: []string{"code", "dwell-minutes-min-default", "name", "transport-order-stop-role"}},
+	{Source: "do_project_transport_location_event_types_create", Method: "POST", Path: "/v1/project-transport-location-event-types", Relationships: []string{"project-transport-event-type", "project-transport-location"}},
+	{Source: "do_project_transport_location_event_types_delete", Method: "DELETE", Path: "/v1/project-transport-location-event-types/{id}"},
+	{Source: "do_project_transport_locations_create", Method: "POST", Path: "/v1/project-transport-locations", Attributes: []string{"address-city", "address-country-code", "address-full", "address-latitude", "address-longitude", "address-postal-code", "address-splc", "address-state-code", "address-street-one", "address-street-two", "address-time-zone-id", "external-tms-company-id", "geocoding-method", "is-active", "is-valid-for-stop", "name", "skip-detection"}, Relationships: []string{"broker", "project-transport-organization"}},
+	{Source: "do_project_transport_locations_delete", Method: "DELETE", Path: "/v1/project-transport-locations/{id}"},
+	{Source: "do_project_transport_locations_update", Method: "PATCH", Path: "/v1/project-transport-locations/{id}", Attributes: []string{"address-city", "address-country-code", "address-full", "address-latitude", "address-longitude", "address-postal-code", "address-splc", "address-state-code", "address-street-one", "address-street-two", "address-time-zone-id", "external-tms-company-id", "geocoding-method", "is-active", "is-valid-for-stop", "name", "skip-detection"}, Relationships: []string{"project-transport-organization"}},
+	{Source: "do_project_transport_organizations_create", Method: "POST", Path: "/v1/project-transport-organizations", Attributes: []string{"external-tms-master-company-id", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_project_transport_organizations_delete", Method: "DELETE", Path: "/v1/project-transport-organizations/{id}"},
+	{Source: "do_project_transport_organizations_update", Method: "PATCH", Path: "/v1/project-transport-organizations/{id}", Attributes: []string{"external-tms-master-company-id", "name"}},
+	{Source: "do_project_transport_plan_assignment_rules_create", Method: "POST", Path: "/v1/project-transport-plan-assignment-rules", Attributes: []string{"asset-type", "is-active", "rule"}, Relationships: []string{"level"}},
+	{Source: "do_project_transport_plan_assignment_rules_delete", Method: "DELETE", Path: "/v1/project-transport-plan-assignment-rules/{id}"},
+	{Source: "do_project_transport_plan_driver_assignment_recommendations_create", Method: "POST", Path: "/v1/project-transport-plan-driver-assignment-recommendations", Relationships: []string{"project-transport-plan-driver"}},
+	{Source: "do_project_transport_plan_driver_confirmations_update", Method: "PATCH", Path: "/v1/project-transport-plan-driver-confirmations/{id}", Attributes: []string{"confirm-at-max", "note", "status"}},
+	{Source: "do_project_transport_plan_drivers_create", Method: "POST", Path: "/v1/project-transport-plan-drivers", Attributes: []string{"assignment-rule-override-reason", "confirm-at-max", "confirm-note", "skip-assignment-rules-validation", "status"}, Relationships: []string{"driver", "inbound-project-office-explicit", "project-transport-plan", "segment-end", "segment-start"}},
+	{Source: "do_project_transport_plan_drivers_delete", Method: "DELETE", Path: "/v1/project-transport-plan-drivers/{id}"},
+	{Source: "do_project_transport_plan_drivers_update", Method: "PATCH", Path: "/v1/project-transport-plan-drivers/{id}", Attributes: []string{"assignment-rule-override-reason", "confirm-at-max", "confirm-note", "skip-assignment-rules-validation", "status"}, Relationships: []string{"driver", "inbound-project-office-explicit", "segment-end", "segment-start"}},
+	{Source: "do_project_transport_plan_event_location_predictions_create", Method: "POST", Path: "/v1/project-transport-plan-event-location-predictions", Attributes: []string{"broker-id-explicit", "event-position-explicit", "project-transport-event-type-id-explicit", "strategy-set-id-explicit"}, Relationships: []string{"project-transport-plan-event", "transport-order"}},
+	{Source: "do_project_transport_plan_event_location_predictions_delete", Method: "DELETE", Path: "/v1/project-transport-plan-event-location-predictions/{id}"},
+	{Source: "do_project_transport_plan_event_location_predictions_update", Method: "PATCH", Path: "/v1/project-transport-plan-event-location-predictions/{id}", Attributes: []string{"broker-id-explicit", "event-position-explicit", "project-transport-event-type-id-explicit", "strategy-set-id-explicit"}, Relationships: []string{"project-transport-plan-event", "transport-order"}},
+	{Source: "do_project_transport_plan_event_times_create", Method: "POST", Path: "/v1/project-transport-plan-event-times", Attributes: []string{"at", "end-at", "kind", "start-at"}, Relationships: []string{"project-transport-plan-event"}},
+	{Source: "do_project_transport_plan_event_times_delete", Method: "DELETE", Path: "/v1/project-transport-plan-event-times/{id}"},
+	{Source: "do_project_transport_plan_event_times_update", Method: "PATCH", Path: "/v1/project-transport-plan-event-times/{id}", Attributes: []string{"at", "end-at", "kind", "start-at"}},
+	{Source: "do_project_transport_plan_events_create", Method: "POST", Path: "/v1/project-transport-plan-events", Attributes: []string{"external-tms-event-id", "position"}, Relationships: []string{"project-material-type", "project-transport-event-type", "project-transport-location", "project-transport-plan", "project-transport-plan-stop"}},
+	{Source: "do_project_transport_plan_events_delete", Method: "DELETE", Path: "/v1/project-transport-plan-events/{id}"},
+	{Source: "do_project_transport_plan_events_update", Method: "PATCH", Path: "/v1/project-transport-plan-events/{id}", Attributes: []string{"external-tms-event-id", "position"}, Relationships: []string{"project-material-type", "project-transport-event-type", "project-transport-location", "project-transport-plan-stop"}},
+	{Source: "do_project_transport_plan_planned_event_time_schedules_create", Method: "POST", Path: "/v1/project-transport-plan-planned-event-time-schedules", Attributes: []string{"plan-data", "respect-provided-event-times"}, Relationships: []string{"project-transport-plan", "transport-order"}},
+	{Source: "do_project_transport_plan_segment_drivers_create", Method: "POST", Path: "/v1/project-transport-plan-segment-drivers", Relationships: []string{"driver", "project-transport-plan-segment"}},
+	{Source: "do_project_transport_plan_segment_drivers_delete", Method: "DELETE", Path: "/v1/project-transport-plan-segment-drivers/{id}"},
+	{Source: "do_project_transport_plan_segment_sets_create", Method: "POST", Path: "/v1/project-transport-plan-segment-sets", Attributes: []string{"external-tms-leg-number", "position"}, Relationships: []string{"project-transport-plan", "trucker"}},
+	{Source: "do_project_transport_plan_segment_sets_delete", Method: "DELETE", Path: "/v1/project-transport-plan-segment-sets/{id}"},
+	{Source: "do_project_transport_plan_segment_sets_update", Method: "PATCH", Path: "/v1/project-transport-plan-segment-sets/{id}", Attributes: []string{"external-tms-leg-number", "position"}, Relationships: []string{"trucker"}},
+	{Source: "do_project_transport_plan_segment_tractors_create", Method: "POST", Path: "/v1/project-transport-plan-segment-tractors", Relationships: []string{"project-transport-plan-segment", "tractor"}},
+	{Source: "do_project_transport_plan_segment_tractors_delete", Method: "DELETE", Path: "/v1/project-transport-plan-segment-tractors/{id}"},
+	{Source: "do_project_transport_plan_segment_trailers_create", Method: "POST", Path: "/v1/project-transport-plan-segment-trailers", Relationships: []string{"project-transport-plan-segment", "trailer"}},
+	{Source: "do_project_transport_plan_segment_trailers_delete", Method: "DELETE", Path: "/v1/project-transport-plan-segment-trailers/{id}"},
+	{Source: "do_project_transport_plan_segments_create", Method: "POST", Path: "/v1/project-transport-plan-segments", Attributes: []string{"external-tms-movement-number", "external-tms-order-number", "miles", "miles-source", "position"}, Relationships: []string{"destination", "origin", "project-transport-plan", "project-transport-plan-segment-set", "trucker"}},
+	{Source: "do_project_transport_plan_segments_delete", Method: "DELETE", Path: "/v1/project-transport-plan-segments/{id}"},
+	{Source: "do_project_transport_plan_segments_update", Method: "PATCH", Path: "/v1/project-transport-plan-segments/{id}", Attributes: []string{"external-tms-movement-number", "external-tms-order-number", "miles", "miles-source", "position"}, Relationships: []string{"project-transport-plan-segment-set", "trucker"}},
+	{Source: "do_project_transport_plan_stop_insertions_create", Method: "POST", Path: "/v1/project-transport-plan-stop-insertions", Attributes: []string{"boundary-choice", "mode", "planned-event-time-end-at", "planned-event-time-start-at", "preserve-stop-on-delete", "reuse-half"}, Relationships: []string{"existing-project-transport-plan-stop", "planned-completion-event-type", "project-transport-location", "project-transport-plan", "project-transport-plan-segment-set", "reference-project-transport-plan-stop", "stop-to-move"}},
+	{Source: "do_project_transport_plan_stop_order_stops_create", Method: "POST", Path: "/v1/project-transport-plan-stop-order-stops", Relationships: []string{"project-transport-plan-stop", "transport-order-stop"}},
+	{Source: "do_project_transport_plan_stop_order_stops_delete", Method: "DELETE", Path: "/v1/project-transport-plan-stop-order-stops/{id}"},
+	{Source: "do_project_transport_plan_stops_create", Method: "POST", Path: "/v1/project-transport-plan-stops", Attributes: []string{"position", "status"}, Relationships: []string{"planned-completion-event-type", "project-transport-location", "project-transport-plan"}},
+	{Source: "do_project_transport_plan_stops_delete", Method: "DELETE", Path: "/v1/project-transport-plan-stops/{id}"},
+	{Source: "do_project_transport_plan_stops_update", Method: "PATCH", Path: "/v1/project-transport-plan-stops/{id}", Attributes: []string{"position", "status"}, Relationships: []string{"planned-completion-event-type", "project-transport-location"}},
+	{Source: "do_project_transport_plan_tractors_create", Method: "POST", Path: "/v1/project-transport-plan-tractors", Attributes: []string{"actualizer-window-end-at", "actualizer-window-start-at", "assignment-rule-override-reason", "automatically-adjust-overlapping-windows", "skip-assignment-rules-validation", "status"}, Relationships: []string{"project-transport-plan", "segment-end", "segment-start", "tractor"}},
+	{Source: "do_project_transport_plan_tractors_delete", Method: "DELETE", Path: "/v1/project-transport-plan-tractors/{id}"},
+	{Source: "do_project_transport_plan_tractors_update", Method: "PATCH", Path: "/v1/project-transport-plan-tractors/{id}", Attributes: []string{"actualizer-window-end-at", "actualizer-window-start-at", "assignment-rule-override-reason", "automatically-adjust-overlapping-windows", "skip-assignment-rules-validation", "status"}, Relationships: []string{"segment-end", "segment-start", "tractor"}},
+	{Source: "do_project_transport_plan_trailer_assignment_recommendations_create", Method: "POST", Path: "/v1/project-transport-plan-trailer-assignment-recommendations", Relationships: []string{"project-transport-plan-trailer"}},
+	{Source: "do_project_transport_plan_trailers_create", Method: "POST", Path: "/v1/project-transport-plan-trailers", Attributes: []string{"status"}, Relationships: []string{"project-transport-plan", "segment-end", "segment-start", "trailer"}},
+	{Source: "do_project_transport_plan_trailers_delete", Method: "DELETE", Path: "/v1/project-transport-plan-trailers/{id}"},
+	{Source: "do_project_transport_plan_trailers_update", Method: "PATCH", Path: "/v1/project-transport-plan-trailers/{id}", Attributes: []string{"status"}, Relationships: []string{"segment-end", "segment-start", "trailer"}},
+	{Source: "do_project_transport_plans_create", Method: "POST", Path: "/v1/project-transport-plans", Attributes: []string{"assignment-rule-override-reason", "skip-actualization", "skip-assignment-rules-validation"}, Relationships: []string{"project"}},
+	{Source: "do_project_transport_plans_delete", Method: "DELETE", Path: "/v1/project-transport-plans/{id}"},
+	{Source: "do_project_transport_plans_update", Method: "PATCH", Path: "/v1/project-transport-plans/{id}", Attributes: []string{"assignment-rule-override-reason", "skip-actualization", "skip-assignment-rules-validation", "status"}},
+	{Source: "do_project_truckers_create", Method: "POST", Path: "/v1/project-truckers", Attributes: []string{"is-excluded-from-time-card-payroll-certification-requirements"}, Relationships: []string{"project", "trucker"}},
+	{Source: "do_project_truckers_delete", Method: "DELETE", Path: "/v1/project-truckers/{id}"},
+	{Source: "do_project_truckers_update", Method: "PATCH", Path: "/v1/project-truckers/{id}", Attributes: []string{"is-excluded-from-time-card-payroll-certification-requirements"}},
+	{Source: "do_project_unabandonments_create", Method: "POST", Path: "/v1/project-unabandonments", Attributes: []string{"comment"}, Relationships: []string{"project"}},
+	{Source: "do_projects_create", Method: "POST", Path: "/v1/projects", Attributes: []string{"due-on", "enforce-number-uniqueness", "is-certification-required-explicit", "is-inactive-explicit", "is-managed", "is-one-way-job-explicit", "is-opportunity", "is-prevailing-wage-explicit", "is-time-card-payroll-certification-required-explicit", "is-transport-only", "name", "number", "start-on"}, Relationships: []string{"developer", "estimator", "project-manager", "project-office"}},
+	{Source: "do_projects_delete", Method: "DELETE", Path: "/v1/projects/{id}"},
+	{Source: "do_projects_file_imports_create", Method: "POST", Path: "/v1/projects-file-imports", Attributes: []string{"file-import-type", "is-dry-run"}, Relationships: []string{"file-import", "subject"}},
+	{Source: "do_projects_update", Method: "PATCH", Path: "/v1/projects/{id}", Attributes: []string{"due-on", "enforce-number-uniqueness", "is-certification-required-explicit", "is-inactive-explicit", "is-managed", "is-one-way-job-explicit", "is-opportunity", "is-prevailing-wage-explicit", "is-time-card-payroll-certification-required-explicit", "is-transport-only", "name", "number", "start-on"}, Relationships: []string{"actual-estimate-set", "bid-estimate-set", "estimator", "possible-estimate-set", "project-manager", "project-office", "project-transport-plan"}},
+	{Source: "do_prompt_prescriptions_create", Method: "POST", Path: "/v1/prompt-prescriptions", Attributes: []string{"email-address", "location-name", "name", "organization-name", "role", "symptoms"}},
+	{Source: "do_prompters_create", Method: "POST", Path: "/v1/prompters", Attributes: []string{"action-item-summary-prompt-template", "answer-answer-prompt-template", "condensable-condense-prompt-template", "glossary-term-definition-suggestions-prompt-template", "incident-headline-suggestion-suggestion-prompt-template", "is-active", "jpp-safety-risk-comm-suggestion-suggestion-prompt-template", "jpp-safety-risks-suggestion-suggestion-prompt-template", "name", "release-note-glossary-term-suggestions-prompt-template", "release-note-guess-has-navigation-instructions-prompt-template", "release-note-headline-suggestions-prompt-template"}},
+	{Source: "do_prompters_delete", Method: "GET", Path: "/v1/prompters/{id}"},
+	{Source: "do_prompters_delete", Method: "DELETE", Path: "/v1/prompters/{id}"},
+	{Source: "do_prompters_update", Method: "PATCH", Path: "/v1/prompters/{id}", Attributes: []string{"action-item-summary-prompt-template", "answer-answer-prompt-template", "condensable-condense-prompt-template", "glossary-term-definition-suggestions-prompt-template", "incident-headline-suggestion-suggestion-prompt-template", "is-active", "jpp-safety-risk-comm-suggestion-suggestion-prompt-template", "jpp-safety-risks-suggestion-suggestion-prompt-template", "name", "release-note-glossary-term-suggestions-prompt-template", "release-note-guess-has-navigation-instructions-prompt-template", "release-note-headline-suggestions-prompt-template"}},
+	{Source: "do_ptp_driver_summary_create", Method: "POST", Path: "/v1/project-transport-plan-driver-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_ptp_event_summary_create", Method: "POST", Path: "/v1/project-transport-plan-event-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_ptp_event_time_summary_create", Method: "POST", Path: "/v1/project-transport-plan-event-time-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_ptp_expected_event_time_summary_create", Method: "POST", Path: "/v1/project-transport-plan-expected-event-time-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_ptp_summary_create", Method: "POST", Path: "/v1/project-transport-plan-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_ptp_trailer_summary_create", Method: "POST", Path: "/v1/project-transport-plan-trailer-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_public_praise_culture_values_create", Method: "POST", Path: "/v1/public-praise-culture-values", Relationships: []string{"culture-value", "public-praise"}},
+	{Source: "do_public_praise_culture_values_delete", Method: "DELETE", Path: "/v1/public-praise-culture-values/{id}"},
+	{Source: "do_public_praise_culture_values_update", Method: "PATCH", Path: "/v1/public-praise-culture-values/{id}", Relationships: []string{"culture-value", "public-praise"}},
+	{Source: "do_public_praise_reactions_create", Method: "POST", Path: "/v1/public-praise-reactions", Relationships: []string{"created-by", "public-praise", "reaction-classification"}},
+	{Source: "do_public_praise_reactions_delete", Method: "DELETE", Path: "/v1/public-praise-reactions/{id}"},
+	{Source: "do_public_praise_summary_create", Method: "POST", Path: "/v1/public-praise-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_public_praises_create", Method: "POST", Path: "/v1/public-praises", Attributes: []string{"culture-value-ids", "description", "recipient-ids"}, Relationships: []string{"given-by", "organization", "received-by"}},
+	{Source: "do_public_praises_delete", Method: "DELETE", Path: "/v1/public-praises/{id}"},
+	{Source: "do_public_praises_update", Method: "PATCH", Path: "/v1/public-praises/{id}", Attributes: []string{"culture-value-ids", "description", "recipient-ids"}},
+	{Source: "do_quality_control_classifications_create", Method: "POST", Path: "/v1/quality-control-classifications", Attributes: []string{"description", "name"}, Relationships: []string{"broker"}},
+	{Source: "do_quality_control_classifications_delete", Method: "DELETE", Path: "/v1/quality-control-classifications/{id}"},
+	{Source: "do_quality_control_classifications_update", Method: "PATCH", Path: "/v1/quality-control-classifications/{id}", Attributes: []string{"description", "name"}},
+	{Source: "do_questions_create", Method: "POST", Path: "/v1/questions", Attributes: []string{"content", "ignore-organization-scoped-newsletters", "is-public", "is-public-to-organization-children", "source"}, Relationships: []string{"asked-by", "public-organization-scope"}},
+	{Source: "do_questions_delete", Method: "DELETE", Path: "/v1/questions/{id}"},
+	{Source: "do_questions_update", Method: "PATCH", Path: "/v1/questions/{id}", Attributes: []string{"content", "ignore-organization-scoped-newsletters", "is-public", "is-public-to-organization-children", "is-triaged", "motivation", "recreate-answer", "source"}, Relationships: []string{"asked-by", "assigned-to", "public-organization-scope"}},
+	{Source: "do_rate_adjustments_create", Method: "POST", Path: "/v1/rate-adjustments", Attributes: []string{"adjustment-max", "adjustment-min", "prevent-rating-when-index-value-missing", "zero-intercept-ratio", "zero-intercept-value"}, Relationships: []string{"cost-index", "rate"}},
+	{Source: "do_rate_adjustments_delete", Method: "DELETE", Path: "/v1/rate-adjustments/{id}"},
+	{Source: "do_rate_adjustments_update", Method: "PATCH", Path: "/v1/rate-adjustments/{id}", Attributes: []string{"adjustment-max", "adjustment-min", "prevent-rating-when-index-value-missing", "zero-intercept-ratio", "zero-intercept-value"}, Relationships: []string{"cost-index"}},
+	{Source: "do_rate_agreement_copier_works_create", Method: "POST", Path: "/v1/rate-agreement-copier-works", Attributes: []string{"note"}, Relationships: []string{"rate-agreement-template", "target-organization"}},
+	{Source: "do_rate_agreement_copier_works_update", Method: "PATCH", Path: "/v1/rate-agreement-copier-works/{id}", Attributes: []string{"note"}},
+	{Source: "do_rate_agreement_copiers_create", Method: "POST", Path: "/v1/rate-agreement-copiers", Relationships: []string{"target-organization", "template-rate-agreement"}},
+	{Source: "do_rate_agreements_copiers_create", Method: "POST", Path: "/v1/rate-agreements-copiers", Relationships: []string{"rate-agreement-template", "target-customers", "target-truckers"}},
+	{Source: "do_rate_agreements_create", Method: "POST", Path: "/v1/rate-agreements", Attributes: []string{"name", "status"}, Relationships: []string{"buyer", "seller"}},
+	{Source: "do_rate_agreements_delete", Method: "DELETE", Path: "/v1/rate-agreements/{id}"},
+	{Source: "do_rate_agreements_update", Method: "PATCH", Path: "/v1/rate-agreements/{id}", Attributes: []string{"name", "status"}, Relationships: []string{"buyer", "seller"}},
+	{Source: "do_rates_create", Method: "POST", Path: "/v1/rates", Attributes: []string{"currency-code", "importance", "maximum-travel-minutes", "name", "price-per-unit", "status"}, Relationships: []string{"rated", "service-type-unit-of-measure"}},
+	{Source: "do_rates_delete", Method: "DELETE", Path: "/v1/rates/{id}"},
+	{Source: "do_rates_update", Method: "PATCH", Path: "/v1/rates/{id}", Attributes: []string{"currency-code", "importance", "maximum-travel-minutes", "name", "price-per-unit", "status"}},
+	{Source: "do_raw_material_transaction_sales_customers_create", Method: "POST", Path: "/v1/raw-material-transaction-sales-customers", Attributes: []string{"raw-sales-customer-id"}, Relationships: []string{"customer"}},
+	{Source: "do_raw_material_transaction_sales_customers_delete", Method: "DELETE", Path: "/v1/raw-material-transaction-sales-customers/{id}"},
+	{Source: "do_raw_material_transaction_sales_customers_update", Method: "PATCH", Path: "/v1/raw-material-transaction-sales-customers/{id}", Attributes: []string{"raw-sales-customer-id"}, Relationships: []string{"customer"}},
+	{Source: "do_raw_material_transactions_update", Method: "PATCH", Path: "/v1/raw-material-transactions/{id}", Attributes: []string{"ticket-job-number"}},
+	{Source: "do_raw_transport_drivers_create", Method: "POST", Path: "/v1/raw-transport-drivers", Attributes: []string{"external-driver-id", "importer", "tables"}, Relationships: []string{"broker"}},
+	{Source: "do_raw_transport_drivers_delete", Method: "DELETE", Path: "/v1/raw-transport-drivers/{id}"},
+	{Source: "do_raw_transport_exports_create", Method: "POST", Path: "/v1/raw-transport-exports", Attributes: []string{"checksum", "export-results", "export-type", "exported-at", "external-order-number", "first-seen-at", "formatted-export", "headers", "is-exportable", "is-exported", "issue-type", "not-exportable-reasons", "rows", "sequence", "stp-numbers", "target-database", "target-table", "throttled-until"}, Relationships: []string{"broker", "created-by", "transport-order"}},
+	{Source: "do_raw_transport_orders_create", Method: "POST", Path: "/v1/raw-transport-orders", Attributes: []string{"external-order-number", "importer", "is-managed", "tables", "tables-rowversion-max", "tables-rowversion-min"}, Relationships: []string{"broker"}},
+	{Source: "do_raw_transport_orders_delete", Method: "DELETE", Path: "/v1/raw-transport-orders/{id}"},
+	{Source: "do_raw_transport_projects_create", Method: "POST", Path: "/v1/raw-transport-projects", Attributes: []string{"external-project-number", "importer", "is-managed", "tables", "tables-rowversion-max", "tables-rowversion-min"}, Relationships: []string{"broker"}},
+	{Source: "do_raw_transport_projects_delete", Method: "DELETE", Path: "/v1/raw-transport-projects/{id}"},
+	{Source: "do_raw_transport_tractors_create", Method: "POST", Path: "/v1/raw-transport-tractors", Attributes: []string{"external-tractor-id", "importer", "tables"}, Relationships: []string{"broker"}},
+	{Source: "do_raw_transport_tractors_delete", Method: "DELETE", Path: "/v1/raw-transport-tractors/{id}"},
+	{Source: "do_raw_transport_trailers_create", Method: "POST", Path: "/v1/raw-transport-trailers", Attributes: []string{"external-trailer-id", "importer", "tables"}, Relationships: []string{"broker"}},
+	{Source: "do_raw_transport_trailers_delete", Method: "DELETE", Path: "/v1/raw-transport-trailers/{id}"},
+	{Source: "do_reaction_classifications_create", Method: "POST", Path: "/v1/reaction-classifications", Attributes: []string{"created-by"}},
+	{Source: "do_release_notes_create", Method: "POST", Path: "/v1/release-notes", Attributes: []string{"description", "headline", "is-archived", "is-published", "released-on", "scopes"}},
+	{Source: "do_resource_classification_project_cost_classifications_create", Method: "POST", Path: "/v1/resource-classification-project-cost-classifications", Relationships: []string{"broker", "project-cost-classification", "resource-classification"}},
+	{Source: "do_resource_classification_project_cost_classifications_delete", Method: "DELETE", Path: "/v1/resource-classification-project-cost-classifications/{id}"},
+	{Source: "do_resource_unavailabilities_create", Method: "POST", Path: "/v1/resource-unavailabilities", Attributes: []string{"description", "end-at", "start-at"}, Relationships: []string{"resource"}},
+	{Source: "do_resource_unavailabilities_delete", Method: "DELETE", Path: "/v1/resource-unavailabilities/{id}"},
+	{Source: "do_resource_unavailabilities_update", Method: "PATCH", Path: "/v1/resource-unavailabilities/{id}", Attributes: []string{"description", "end-at", "start-at"}, Relationships: []string{"resource"}},
+	{Source: "do_retainer_deductions_create", Method: "POST", Path: "/v1/retainer-deductions", Attributes: []string{"amount", "note"}, Relationships: []string{"retainer"}},
+	{Source: "do_retainer_deductions_delete", Method: "DELETE", Path: "/v1/retainer-deductions/{id}"},
+	{Source: "do_retainer_deductions_update", Method: "PATCH", Path: "/v1/retainer-deductions/{id}", Attributes: []string{"amount", "note"}, Relationships: []string{"retainer"}},
+	{Source: "do_retainer_payments_create", Method: "POST", Path: "/v1/retainer-payments", Attributes: []string{"amount", "created-on", "status"}, Relationships: []string{"retainer-period"}},
+	{Source: "do_retainer_payments_delete", Method: "DELETE", Path: "/v1/retainer-payments/{id}"},
+	{Source: "do_retainer_payments_update", Method: "PATCH", Path: "/v1/retainer-payments/{id}", Attributes: []string{"amount", "created-on", "status"}, Relationships: []string{"retainer-period"}},
+	{Source: "do_retainer_periods_create", Method: "POST", Path: "/v1/retainer-periods", Attributes: []string{"end-on", "start-on", "weekly-payment-amount"}, Relationships: []string{"retainer"}},
+	{Source: "do_retainer_periods_delete", Method: "DELETE", Path: "/v1/retainer-periods/{id}"},
+	{Source: "do_retainer_periods_update", Method: "PATCH", Path: "/v1/retainer-periods/{id}", Attributes: []string{"end-on", "start-on", "weekly-payment-amount"}, Relationships: []string{"retainer"}},
+	{Source: "do_retainers_delete", Method: "DELETE", Path: "/v1/retainers/{id}"},
+	{Source: "do_retainers_update", Method: "PATCH", Path: "/v1/retainers/{id}", Attributes: []string{"billable-travel-minutes-per-travel-mile", "maximum-expected-daily-hours", "maximum-travel-minutes", "status", "terminated-on"}, Relationships: []string{"buyer", "seller"}},
+	{Source: "do_rmt_adjustments_create", Method: "POST", Path: "/v1/sombreros/rmt-adjustments", Attributes: []string{"note", "raw-data-adjustments", "rmt-ids", "update-if-invoiced"}},
+	{Source: "do_root_causes_create", Method: "POST", Path: "/v1/root-causes", Attributes: []string{"description", "is-triaged", "title"}, Relationships: []string{"incident", "root-cause"}},
+	{Source: "do_root_causes_delete", Method: "DELETE", Path: "/v1/root-causes/{id}"},
+	{Source: "do_root_causes_update", Method: "PATCH", Path: "/v1/root-causes/{id}", Attributes: []string{"description", "is-triaged", "title"}},
+	{Source: "do_safety_incidents_create", Method: "POST", Path: "/v1/safety-incidents", Attributes: []string{"description", "did-stop-work", "end-at", "headline", "kind", "natures", "net-impact-tons", "severity", "start-at", "status"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_safety_incidents_delete", Method: "DELETE", Path: "/v1/safety-incidents/{id}"},
+	{Source: "do_safety_incidents_update", Method: "PATCH", Path: "/v1/safety-incidents/{id}", Attributes: []string{"description", "did-stop-work", "end-at", "headline", "kind", "natures", "net-impact-tons", "new-type", "severity", "start-at", "status"}, Relationships: []string{"assignee", "equipment", "job-production-plan", "parent", "subject", "tender-job-schedule-shift"}},
+	{Source: "do_saml_code_redemptions_create", Method: "POST", Path: "/v1/saml-code-redemptions", Attributes: []string{"code"}},
+	{Source: "do_samsara_integrations_create", Method: "POST", Path: "/v1/samsara-integrations", Attributes: []string{"friendly-name", "integration-identifier"}, Relationships: []string{"broker", "integration-config"}},
+	{Source: "do_samsara_integrations_delete", Method: "GET", Path: "/v1/samsara-integrations/{id}"},
+	{Source: "do_samsara_integrations_delete", Method: "DELETE", Path: "/v1/samsara-integrations/{id}"},
+	{Source: "do_samsara_integrations_update", Method: "PATCH", Path: "/v1/samsara-integrations/{id}", Attributes: []string{"friendly-name", "integration-identifier"}},
+	{Source: "do_samsara_vehicles_update", Method: "PATCH", Path: "/v1/samsara-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_service_events_create", Method: "POST", Path: "/v1/service-events", Attributes: []string{"kind", "note", "occurred-at", "occurred-latitude", "occurred-longitude"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_service_events_delete", Method: "DELETE", Path: "/v1/service-events/{id}"},
+	{Source: "do_service_events_update", Method: "PATCH", Path: "/v1/service-events/{id}", Attributes: []string{"kind", "note", "occurred-at", "occurred-latitude", "occurred-longitude"}},
+	{Source: "do_service_sites_create", Method: "POST", Path: "/v1/service-sites", Attributes: []string{"address", "address-latitude", "address-longitude", "name", "skip-address-geocoding"}, Relationships: []string{"broker"}},
+	{Source: "do_service_sites_delete", Method: "DELETE", Path: "/v1/service-sites/{id}"},
+	{Source: "do_service_sites_update", Method: "PATCH", Path: "/v1/service-sites/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "name", "skip-address-geocoding"}},
+	{Source: "do_service_type_unit_of_measure_cohorts_create", Method: "POST", Path: "/v1/service-type-unit-of-measure-cohorts", Attributes: []string{"is-active", "name", "service-type-unit-of-measure-ids"}, Relationships: []string{"customer", "trigger"}},
+	{Source: "do_service_type_unit_of_measure_cohorts_delete", Method: "DELETE", Path: "/v1/service-type-unit-of-measure-cohorts/{id}"},
+	{Source: "do_service_type_unit_of_measure_cohorts_update", Method: "PATCH", Path: "/v1/service-type-unit-of-measure-cohorts/{id}", Attributes: []string{"is-active", "name", "service-type-unit-of-measure-ids"}, Relationships: []string{"customer", "trigger"}},
+	{Source: "do_service_type_unit_of_measure_quantities_create", Method: "POST", Path: "/v1/service-type-unit-of-measure-quantities", Attributes: []string{"explicit-quantity", "quantity"}, Relationships: []string{"material-type", "quantifies", "service-type-unit-of-measure", "trailer-classification"}},
+	{Source: "do_service_type_unit_of_measure_quantities_delete", Method: "DELETE", Path: "/v1/service-type-unit-of-measure-quantities/{id}"},
+	{Source: "do_service_type_unit_of_measure_quantities_update", Method: "PATCH", Path: "/v1/service-type-unit-of-measure-quantities/{id}", Attributes: []string{"explicit-quantity", "quantity"}, Relationships: []string{"material-type", "quantifies", "service-type-unit-of-measure", "trailer-classification"}},
+	{Source: "do_shift_acknowledgement_reminder_notification_subscriptions_create", Method: "POST", Path: "/v1/shift-acknowledgement-reminder-notification-subscriptions", Attributes: []string{"notify-by-email", "notify-by-txt"}, Relationships: []string{"trucker", "user"}},
+	{Source: "do_shift_acknowledgement_reminder_notification_subscriptions_delete", Method: "DELETE", Path: "/v1/shift-acknowledgement-reminder-notification-subscriptions/{id}"},
+	{Source: "do_shift_acknowledgement_reminder_notification_subscriptions_update", Method: "PATCH", Path: "/v1/shift-acknowledgement-reminder-notification-subscriptions/{id}", Attributes: []string{"notify-by-email", "notify-by-txt"}},
+	{Source: "do_shift_counters_create", Method: "POST", Path: "/v1/shift-counters", Attributes: []string{"start-at-min"}},
+	{Source: "do_shift_feedback_reasons_create", Method: "POST", Path: "/v1/shift-feedback-reasons", Attributes: []string{"corrective-action", "default-rating", "kind", "name", "slug"}},
+	{Source: "do_shift_feedback_reasons_delete", Method: "DELETE", Path: "/v1/shift-feedback-reasons/{id}"},
+	{Source: "do_shift_feedback_reasons_update", Method: "PATCH", Path: "/v1/shift-feedback-reasons/{id}", Attributes: []string{"corrective-action", "default-rating", "kind", "name", "slug"}},
+	{Source: "do_shift_feedbacks_create", Method: "POST", Path: "/v1/shift-feedbacks", Attributes: []string{"note", "rating"}, Relationships: []string{"reason", "tender-job-schedule-shift"}},
+	{Source: "do_shift_feedbacks_delete", Method: "DELETE", Path: "/v1/shift-feedbacks/{id}"},
+	{Source: "do_shift_feedbacks_update", Method: "PATCH", Path: "/v1/shift-feedbacks/{id}", Attributes: []string{"note", "rating"}, Relationships: []string{"reason"}},
+	{Source: "do_shift_scope_matches_create", Method: "POST", Path: "/v1/shift-scope-matches", Attributes: []string{"show-matching-shift-sql"}, Relationships: []string{"rate", "shift-scope", "shift-set-time-card-constraints", "tender", "tender-job-schedule-shift"}},
+	{Source: "do_shift_scope_tenders_create", Method: "POST", Path: "/v1/shift-scope-tenders", Attributes: []string{"filters", "limit"}, Relationships: []string{"rate", "shift-scope", "shift-set-time-card-constraint"}},
+	{Source: "do_shift_set_time_card_constraints_create", Method: "POST", Path: "/v1/shift-set-time-card-constraints", Attributes: []string{"calculated-constrained-amount-price-per-unit", "constrained-amount", "constrained-amount-type", "constraint-type", "currency-code", "name", "service-type-unit-of-measures-constraint-type", "shift-set-grouped-by", "status"}, Relationships: []string{"calculated-constrained-amount-unit-of-measure", "constrained", "material-types", "parent", "service-type-unit-of-measures", "shift-scope", "trailer-classifications"}},
+	{Source: "do_shift_set_time_card_constraints_delete", Method: "DELETE", Path: "/v1/shift-set-time-card-constraints/{id}"},
+	{Source: "do_shift_set_time_card_constraints_update", Method: "PATCH", Path: "/v1/shift-set-time-card-constraints/{id}", Attributes: []string{"calculated-constrained-amount-price-per-unit", "constrained-amount", "constrained-amount-type", "constraint-type", "currency-code", "name", "service-type-unit-of-measures-constraint-type", "shift-set-grouped-by", "status"}, Relationships: []string{"calculated-constrained-amount-unit-of-measure", "constrained", "material-types", "service-type-unit-of-measures", "shift-scope", "trailer-classifications"}},
+	{Source: "do_shift_summary_create", Method: "POST", Path: "/v1/shift-summaries", Attributes: []string{"end-on", "filters", "group-by", "included-metrics", "limit", "sort", "start-on"}},
+	{Source: "do_shift_time_card_requisitions_create", Method: "POST", Path: "/v1/shift-time-card-requisitions", Attributes: []string{"invalid-when-hourly"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_site_events_create", Method: "POST", Path: "/v1/site-events", Attributes: []string{"event-at", "event-details", "event-kind", "event-latitude", "event-longitude", "event-type"}, Relationships: []string{"event-site", "material-transaction", "tender-job-schedule-shift"}},
+	{Source: "do_site_events_update", Method: "PATCH", Path: "/v1/site-events/{id}", Attributes: []string{"event-at", "event-details", "event-latitude", "event-longitude"}},
+	{Source: "do_sourcing_searches_create", Method: "POST", Path: "/v1/sourcing-searches", Attributes: []string{"maximum-distance-miles", "maximum-result-count", "minimum-trucker-rating"}, Relationships: []string{"additional-certification-requirement-types", "customer-tender"}},
+	{Source: "do_stakeholder_classifications_create", Method: "POST", Path: "/v1/stakeholder-classifications", Attributes: []string{"leverage-factor", "objectives-narrative-explicit", "title"}},
+	{Source: "do_stakeholder_classifications_delete", Method: "DELETE", Path: "/v1/stakeholder-classifications/{id}"},
+	{Source: "do_stakeholder_classifications_update", Method: "PATCH", Path: "/v1/stakeholder-classifications/{id}", Attributes: []string{"leverage-factor", "objectives-narrative-explicit", "title"}},
+	{Source: "do_superior_bowen_apex_viewpoint_ticket_exports_create", Method: "POST", Path: "/v1/superior-bowen-apex-viewpoint-ticket-exports", Attributes: []string{"location-ids", "sale-date-max", "sale-date-min"}},
+	{Source: "do_superior_bowen_crew_ledgers_create", Method: "POST", Path: "/v1/superior-bowen-crew-ledgers", Relationships: []string{"job-production-plan"}},
+	{Source: "do_t3_equipmentshare_vehicles_update", Method: "PATCH", Path: "/v1/t3-equipmentshare-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_tag_categories_create", Method: "POST", Path: "/v1/tag-categories", Attributes: []string{"can-apply-to", "description", "name", "slug"}},
+	{Source: "do_tag_categories_delete", Method: "GET", Path: "/v1/tag-categories/{id}"},
+	{Source: "do_tag_categories_delete", Method: "DELETE", Path: "/v1/tag-categories/{id}"},
+	{Source: "do_tag_categories_update", Method: "PATCH", Path: "/v1/tag-categories/{id}", Attributes: []string{"can-apply-to", "description", "name", "slug"}},
+	{Source: "do_taggings_create", Method: "POST", Path: "/v1/taggings", Relationships: []string{"tag", "taggable"}},
+	{Source: "do_taggings_delete", Method: "DELETE", Path: "/v1/taggings/{id}"},
+	{Source: "do_tags_create", Method: "POST", Path: "/v1/tags", Attributes: []string{"name"}, Relationships: []string{"tag-category"}},
+	{Source: "do_tags_delete", Method: "DELETE", Path: "/v1/tags/{id}"},
+	{Source: "do_tags_update", Method: "PATCH", Path: "/v1/tags/{id}", Attributes: []string{"name"}},
+	{Source: "do_teletrac_navman_vehicles_update", Method: "PATCH", Path: "/v1/teletrac-navman-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_temeda_vehicles_update", Method: "PATCH", Path: "/v1/temeda-vehicles/{id}", Relationships: []string{"tractor", "trailer"}},
+	{Source: "do_tender_acceptances_create", Method: "POST", Path: "/v1/tender-acceptances", Attributes: []string{"comment", "rejected-tender-job-schedule-shift-ids", "skip-certification-validation"}, Relationships: []string{"tender"}},
+	{Source: "do_tender_cancellations_create", Method: "POST", Path: "/v1/tender-cancellations", Attributes: []string{"comment"}, Relationships: []string{"tender"}},
+	{Source: "do_tender_job_schedule_shift_cancellations_create", Method: "POST", Path: "/v1/tender-job-schedule-shift-cancellations", Attributes: []string{"is-returned", "job-production-plan-cancellation-comment", "skip-trucker-notifications", "status-change-comment"}, Relationships: []string{"status-changed-by", "tender-job-schedule-shift"}},
+	{Source: "do_tender_job_schedule_shift_cancelled_trucker_contact_notifications_update", Method: "PATCH", Path: "/v1/tender-job-schedule-shift-cancelled-trucker-contact-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_tender_job_schedule_shift_drivers_create", Method: "POST", Path: "/v1/tender-job-schedule-shift-drivers", Attributes: []string{"is-primary"}, Relationships: []string{"tender-job-schedule-shift", "user"}},
+	{Source: "do_tender_job_schedule_shift_drivers_delete", Method: "DELETE", Path: "/v1/tender-job-schedule-shift-drivers/{id}"},
+	{Source: "do_tender_job_schedule_shift_drivers_update", Method: "PATCH", Path: "/v1/tender-job-schedule-shift-drivers/{id}", Attributes: []string{"is-primary"}},
+	{Source: "do_tender_job_schedule_shift_fill_out_time_card_seller_notifications_update", Method: "PATCH", Path: "/v1/tender-job-schedule-shift-fill-out-time-card-seller-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_tender_job_schedule_shift_starting_seller_notifications_update", Method: "PATCH", Path: "/v1/tender-job-schedule-shift-starting-seller-notifications/{id}", Attributes: []string{"read"}},
+	{Source: "do_tender_job_schedule_shift_time_card_reviews_create", Method: "POST", Path: "/v1/tender-job-schedule-shift-time-card-reviews", Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_tender_job_schedule_shift_time_card_reviews_delete", Method: "DELETE", Path: "/v1/tender-job-schedule-shift-time-card-reviews/{id}"},
+	{Source: "do_tender_job_schedule_shifts_create", Method: "POST", Path: "/v1/tender-job-schedule-shifts", Attributes: []string{"all-trips-entered", "auto-check-in-driver-on-arrival-at-start-site", "billable-travel-minutes", "disable-pre-start-notifications", "driver-assignment-rule-override-reason", "expecting-material-transactions-message", "explicit-is-expecting-time-card", "explicit-material-transaction-tons-max", "explicit-notify-driver-when-gps-not-available", "gross-weight-legal-limit-lbs-explicit", "hours-after-which-overtime-applies", "is-automated-job-site-time-creation-disabled", "is-expecting-material-transactions", "is-time-card-creating-time-sheet-line-item-explicit", "is-time-card-payroll-certification-required-explicit", "loaded-tons-max", "material-transaction-status", "notify-after-shift-ends-hours", "notify-before-shift-starts-hours", "notify-driver-on-late-shift-assignment", "notify-driver-when-gps-not-available", "reset-hours-after-which-overtime-applies", "skip-validate-driver-assignment-rule-evaluation", "start-at", "travel-miles", "truck-count", "trucker-can-create-material-transactions"}, Relationships: []string{"job-schedule-shift", "retainer", "seller-operations-contact", "seller-operations-contact-draft", "tender", "tractor", "trailer", "trailer-draft"}},
+	{Source: "do_tender_job_schedule_shifts_delete", Method: "DELETE", Path: "/v1/tender-job-schedule-shifts/{id}"},
+	{Source: "do_tender_job_schedule_shifts_material_transactions_checksums_create", Method: "POST", Path: "/v1/tender-job-schedule-shifts-material-transactions-checksums", Attributes: []string{"job-production-plan-id", "material-site-ids", "raw-job-number", "transaction-at-max", "transaction-at-min"}},
+	{Source: "do_tender_job_schedule_shifts_update", Method: "PATCH", Path: "/v1/tender-job-schedule-shifts/{id}", Attributes: []string{"all-trips-entered", "auto-check-in-driver-on-arrival-at-start-site", "billable-travel-minutes", "disable-pre-start-notifications", "driver-assignment-rule-override-reason", "expecting-material-transactions-message", "explicit-is-expecting-time-card", "explicit-material-transaction-tons-max", "explicit-notify-driver-when-gps-not-available", "gross-weight-legal-limit-lbs-explicit", "hours-after-which-overtime-applies", "is-automated-job-site-time-creation-disabled", "is-expecting-material-transactions", "is-time-card-creating-time-sheet-line-item-explicit", "is-time-card-payroll-certification-required-explicit", "loaded-tons-max", "material-transaction-status", "notify-after-shift-ends-hours", "notify-before-shift-starts-hours", "notify-driver-on-late-shift-assignment", "notify-driver-when-gps-not-available", "reset-hours-after-which-overtime-applies", "skip-validate-driver-assignment-rule-evaluation", "start-at", "travel-miles", "truck-count", "trucker-can-create-material-transactions"}, Relationships: []string{"job-schedule-shift", "retainer", "seller-operations-contact", "seller-operations-contact-draft", "tender", "tractor", "trailer", "trailer-draft"}},
+	{Source: "do_tender_offers_create", Method: "POST", Path: "/v1/tender-offers", Attributes: []string{"comment", "skip-certification-validation"}, Relationships: []string{"tender"}},
+	{Source: "do_tender_raters_create", Method: "POST", Path: "/v1/tender-raters", Attributes: []string{"persist-changes", "replace-rates", "replace-shift-set-time-card-constraints", "skip-adjustment-cost-index-value-presence-validation", "skip-validate-customer-tender-hourly-rates"}, Relationships: []string{"tender"}},
+	{Source: "do_tender_re_rates_create", Method: "POST", Path: "/v1/sombreros/tender-re-rates", Attributes: []string{"re-constrain", "re-rate", "skip-update-travel-minutes", "skip-validate-customer-tender-hourly-rates", "tender-ids", "update-time-card-quantities"}},
+	{Source: "do_tender_rejections_create", Method: "POST", Path: "/v1/tender-rejections", Attributes: []string{"comment"}, Relationships: []string{"tender"}},
+	{Source: "do_tender_returns_create", Method: "POST", Path: "/v1/tender-returns", Attributes: []string{"comment"}, Relationships: []string{"tender"}},
+	{Source: "do_tenna_vehicles_update", Method: "PATCH", Path: "/v1/tenna-vehicles/{id}", Relationships: []string{"equipment", "tractor", "trailer"}},
+	{Source: "do_ticket_report_dispatches_create", Method: "POST", Path: "/v1/ticket-report-dispatches", Relationships: []string{"ticket-report"}},
+	{Source: "do_ticket_report_dispatches_delete", Method: "DELETE", Path: "/v1/ticket-report-dispatches/{id}"},
+	{Source: "do_ticket_report_imports_create", Method: "POST", Path: "/v1/ticket-report-imports", Relationships: []string{"ticket-report"}},
+	{Source: "do_ticket_report_imports_delete", Method: "DELETE", Path: "/v1/ticket-report-imports/{id}"},
+	{Source: "do_ticket_reports_create", Method: "POST", Path: "/v1/ticket-reports", Attributes: []string{"file", "file-name"}, Relationships: []string{"broker", "ticket-report-type"}},
+	{Source: "do_ticket_reports_delete", Method: "DELETE", Path: "/v1/ticket-reports/{id}"},
+	{Source: "do_ticket_reports_update", Method: "PATCH", Path: "/v1/ticket-reports/{id}", Attributes: []string{"file-name"}},
+	{Source: "do_time_card_approval_audits_create", Method: "POST", Path: "/v1/time-card-approval-audits", Attributes: []string{"note"}, Relationships: []string{"time-card", "user"}},
+	{Source: "do_time_card_approval_audits_delete", Method: "DELETE", Path: "/v1/time-card-approval-audits/{id}"},
+	{Source: "do_time_card_approval_audits_update", Method: "PATCH", Path: "/v1/time-card-approval-audits/{id}", Attributes: []string{"note"}},
+	{Source: "do_time_card_approvals_create", Method: "POST", Path: "/v1/time-card-approvals", Attributes: []string{"comment", "create-zero-ppu-missing-rates", "skip-quantity-validation"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_cost_code_allocations_create", Method: "POST", Path: "/v1/time-card-cost-code-allocations", Attributes: []string{"details"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_cost_code_allocations_delete", Method: "DELETE", Path: "/v1/time-card-cost-code-allocations/{id}"},
+	{Source: "do_time_card_cost_code_allocations_update", Method: "PATCH", Path: "/v1/time-card-cost-code-allocations/{id}", Attributes: []string{"details"}},
+	{Source: "do_time_card_payroll_certifications_create", Method: "POST", Path: "/v1/time-card-payroll-certifications", Relationships: []string{"time-card"}},
+	{Source: "do_time_card_payroll_certifications_delete", Method: "DELETE", Path: "/v1/time-card-payroll-certifications/{id}"},
+	{Source: "do_time_card_pre_approvals_create", Method: "POST", Path: "/v1/time-card-pre-approvals", Attributes: []string{"automatic-submission-delay-minutes", "delay-automatic-submission-after-hours", "explicit-down-minutes", "explicit-end-at", "explicit-start-at", "maximum-quantities-attributes", "note", "should-automatically-create-and-submit", "skip-quantity-validation"}, Relationships: []string{"tender-job-schedule-shift"}},
+	{Source: "do_time_card_pre_approvals_delete", Method: "DELETE", Path: "/v1/time-card-pre-approvals/{id}"},
+	{Source: "do_time_card_pre_approvals_update", Method: "PATCH", Path: "/v1/time-card-pre-approvals/{id}", Attributes: []string{"automatic-submission-delay-minutes", "delay-automatic-submission-after-hours", "explicit-down-minutes", "explicit-end-at", "explicit-start-at", "maximum-quantities-attributes", "note", "should-automatically-create-and-submit", "skip-quantity-validation"}},
+	{Source: "do_time_card_rejections_create", Method: "POST", Path: "/v1/time-card-rejections", Attributes: []string{"comment"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_scrappages_create", Method: "POST", Path: "/v1/time-card-scrappages", Attributes: []string{"comment"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_submissions_create", Method: "POST", Path: "/v1/time-card-submissions", Attributes: []string{"comment", "create-zero-ppu-missing-rates", "skip-positive-quantity-validation", "skip-quantity-validation"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_time_changes_create", Method: "POST", Path: "/v1/time-card-time-changes", Attributes: []string{"time-changes-attributes"}, Relationships: []string{"created-by", "time-card"}},
+	{Source: "do_time_card_time_changes_delete", Method: "DELETE", Path: "/v1/time-card-time-changes/{id}"},
+	{Source: "do_time_card_time_changes_update", Method: "PATCH", Path: "/v1/time-card-time-changes/{id}", Attributes: []string{"comment", "time-changes-attributes"}},
+	{Source: "do_time_card_unapprovals_create", Method: "POST", Path: "/v1/time-card-unapprovals", Attributes: []string{"comment"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_unscrappages_create", Method: "POST", Path: "/v1/time-card-unscrappages", Attributes: []string{"comment"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_card_unsubmissions_create", Method: "POST", Path: "/v1/time-card-unsubmissions", Attributes: []string{"comment"}, Relationships: []string{"time-card"}},
+	{Source: "do_time_cards_create", Method: "POST", Path: "/v1/time-cards", Attributes: []string{"approval-process", "down-minutes", "end-at", "enforce-ticket-number-uniqueness", "explicit-enforce-ticket-number-uniqueness", "explicit-is-invoiceable-when-approved", "explicit-is-trailer-required-for-approval", "generate-broker-invoice", "generate-trucker-invoice", "is-time-card-creating-time-sheet-line-item-explicit", "skip-auto-submission-upon-material-transaction-acceptance", "start-at", "submitted-customer-travel-minutes", "submitted-travel-minutes", "ticket-number"}, Relationships: []string{"broker-tender", "tender-job-schedule-shift"}},
+	{Source: "do_time_cards_delete", Method: "DELETE", Path: "/v1/time-cards/{id}"},
+	{Source: "do_time_cards_update", Method: "PATCH", Path: "/v1/time-cards/{id}", Attributes: []string{"approval-process", "down-minutes", "end-at", "enforce-ticket-number-uniqueness", "explicit-enforce-ticket-number-uniqueness", "explicit-is-invoiceable-when-approved", "explicit-is-trailer-required-for-approval", "generate-broker-invoice", "generate-trucker-invoice", "is-time-card-creating-time-sheet-line-item-explicit", "reset-submit-at", "skip-auto-submission-upon-material-transaction-acceptance", "start-at", "submitted-customer-travel-minutes", "submitted-travel-minutes", "ticket-number"}},
+	{Source: "do_time_sheet_approvals_create", Method: "POST", Path: "/v1/time-sheet-approvals", Attributes: []string{"comment"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheet_cost_code_allocations_create", Method: "POST", Path: "/v1/time-sheet-cost-code-allocations", Attributes: []string{"details"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheet_cost_code_allocations_delete", Method: "DELETE", Path: "/v1/time-sheet-cost-code-allocations/{id}"},
+	{Source: "do_time_sheet_cost_code_allocations_update", Method: "PATCH", Path: "/v1/time-sheet-cost-code-allocations/{id}", Attributes: []string{"details"}},
+	{Source: "do_time_sheet_line_item_classifications_create", Method: "POST", Path: "/v1/time-sheet-line-item-classifications", Attributes: []string{"description", "name", "subject-types"}},
+	{Source: "do_time_sheet_line_item_classifications_delete", Method: "DELETE", Path: "/v1/time-sheet-line-item-classifications/{id}"},
+	{Source: "do_time_sheet_line_item_classifications_update", Method: "PATCH", Path: "/v1/time-sheet-line-item-classifications/{id}", Attributes: []string{"description", "name", "subject-types"}},
+	{Source: "do_time_sheet_line_item_equipment_requirements_create", Method: "POST", Path: "/v1/time-sheet-line-item-equipment-requirements", Attributes: []string{"is-primary"}, Relationships: []string{"equipment-requirement", "time-sheet-line-item"}},
+	{Source: "do_time_sheet_line_item_equipment_requirements_delete", Method: "DELETE", Path: "/v1/time-sheet-line-item-equipment-requirements/{id}"},
+	{Source: "do_time_sheet_line_item_equipment_requirements_update", Method: "PATCH", Path: "/v1/time-sheet-line-item-equipment-requirements/{id}", Attributes: []string{"is-primary"}},
+	{Source: "do_time_sheet_line_items_create", Method: "POST", Path: "/v1/time-sheet-line-items", Attributes: []string{"break-minutes", "description", "end-at", "is-non-job-line-item", "skip-validate-overlap", "start-at"}, Relationships: []string{"cost-code", "craft-class", "equipment-requirement", "explicit-job-production-plan", "maintenance-requirement", "project-cost-classification", "time-sheet", "time-sheet-line-item-classification"}},
+	{Source: "do_time_sheet_line_items_delete", Method: "DELETE", Path: "/v1/time-sheet-line-items/{id}"},
+	{Source: "do_time_sheet_line_items_update", Method: "PATCH", Path: "/v1/time-sheet-line-items/{id}", Attributes: []string{"break-minutes", "description", "end-at", "is-non-job-line-item", "skip-validate-overlap", "start-at"}, Relationships: []string{"cost-code", "craft-class", "equipment-requirement", "explicit-job-production-plan", "maintenance-requirement", "project-cost-classification", "time-sheet-line-item-classification"}},
+	{Source: "do_time_sheet_no_shows_create", Method: "POST", Path: "/v1/time-sheet-no-shows", Attributes: []string{"no-show-reason"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheet_no_shows_delete", Method: "DELETE", Path: "/v1/time-sheet-no-shows/{id}"},
+	{Source: "do_time_sheet_no_shows_update", Method: "PATCH", Path: "/v1/time-sheet-no-shows/{id}", Attributes: []string{"no-show-reason"}},
+	{Source: "do_time_sheet_rejections_create", Method: "POST", Path: "/v1/time-sheet-rejections", Attributes: []string{"comment"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheet_submissions_create", Method: "POST", Path: "/v1/time-sheet-submissions", Attributes: []string{"comment"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheet_unapprovals_create", Method: "POST", Path: "/v1/time-sheet-unapprovals", Attributes: []string{"comment"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheet_unsubmissions_create", Method: "POST", Path: "/v1/time-sheet-unsubmissions", Attributes: []string{"comment"}, Relationships: []string{"time-sheet"}},
+	{Source: "do_time_sheets_create", Method: "POST", Path: "/v1/time-sheets", Attributes: []string{"break-minutes", "end-at", "notes", "skip-validate-overlap", "start-at"}, Relationships: []string{"driver", "subject"}},
+	{Source: "do_time_sheets_delete", Method: "DELETE", Path: "/v1/time-sheets/{id}"},
+	{Source: "do_time_sheets_exports_create", Method: "POST", Path: "/v1/time-sheets-exports", Relationships: []string{"organization-formatter", "time-sheets"}},
+	{Source: "do_time_sheets_update", Method: "PATCH", Path: "/v1/time-sheets/{id}", Attributes: []string{"break-minutes", "end-at", "notes", "skip-validate-overlap", "start-at"}},
+	{Source: "do_tractor_credentials_create", Method: "POST", Path: "/v1/tractor-credentials", Attributes: []string{"expires-on", "issued-on"}, Relationships: []string{"tractor", "tractor-trailer-credential-classification"}},
+	{Source: "do_tractor_credentials_delete", Method: "DELETE", Path: "/v1/tractor-credentials/{id}"},
+	{Source: "do_tractor_credentials_update", Method: "PATCH", Path: "/v1/tractor-credentials/{id}", Attributes: []string{"expires-on", "issued-on"}},
+	{Source: "do_tractor_fuel_consumption_readings_create", Method: "POST", Path: "/v1/tractor-fuel-consumption-readings", Attributes: []string{"date-sequence", "reading-on", "reading-time", "state-code", "value"}, Relationships: []string{"driver-day", "tractor", "unit-of-measure"}},
+	{Source: "do_tractor_fuel_consumption_readings_update", Method: "PATCH", Path: "/v1/tractor-fuel-consumption-readings/{id}", Attributes: []string{"date-sequence", "reading-on", "reading-time", "state-code", "value"}, Relationships: []string{"driver-day", "unit-of-measure"}},
+	{Source: "do_tractor_odometer_readings_create", Method: "POST", Path: "/v1/tractor-odometer-readings", Attributes: []string{"date-sequence", "reading-on", "reading-time", "state-code", "value"}, Relationships: []string{"driver-day", "tractor", "unit-of-measure"}},
+	{Source: "do_tractor_odometer_readings_delete", Method: "DELETE", Path: "/v1/tractor-odometer-readings/{id}"},
+	{Source: "do_tractor_odometer_readings_update", Method: "PATCH", Path: "/v1/tractor-odometer-readings/{id}", Attributes: []string{"date-sequence", "reading-on", "reading-time", "state-code", "value"}, Relationships: []string{"driver-day", "unit-of-measure"}},
+	{Source: "do_tractor_trailer_credential_classifications_create", Method: "POST", Path: "/v1/tractor-trailer-credential-classifications", Attributes: []string{"description", "external-id", "issuer-name", "name"}, Relationships: []string{"organization"}},
+	{Source: "do_tractor_trailer_credential_classifications_delete", Method: "DELETE", Path: "/v1/tractor-trailer-credential-classifications/{id}"},
+	{Source: "do_tractor_trailer_credential_classifications_update", Method: "PATCH", Path: "/v1/tractor-trailer-credential-classifications/{id}", Attributes: []string{"description", "external-id", "issuer-name", "name"}},
+	{Source: "do_tractors_create", Method: "POST", Path: "/v1/tractors", Attributes: []string{"apportioned", "color-name", "curb-weight-lbs", "height-inches", "in-service", "intransit-heat", "number", "parking-address", "parking-address-place-id", "parking-address-plus-code", "plate-jurisdiction-code", "plate-number", "skip-parking-address-geocoding", "truck-manufacturer-name", "truck-model-name", "truck-model-year", "vin"}, Relationships: []string{"brokered-tractor", "trucker"}},
+	{Source: "do_tractors_delete", Method: "DELETE", Path: "/v1/tractors/{id}"},
+	{Source: "do_tractors_update", Method: "PATCH", Path: "/v1/tractors/{id}", Attributes: []string{"apportioned", "color-name", "curb-weight-lbs", "height-inches", "in-service", "intransit-heat", "number", "parking-address", "parking-address-place-id", "parking-address-plus-code", "plate-jurisdiction-code", "plate-number", "skip-parking-address-geocoding", "truck-manufacturer-name", "truck-model-name", "truck-model-year", "vin"}, Relationships: []string{"brokered-tractor", "trucker"}},
+	{Source: "do_trading_partners_create", Method: "POST", Path: "/v1/trading-partners", Attributes: []string{"trading-partner-type"}, Relationships: []string{"organization", "partner"}},
+	{Source: "do_trading_partners_delete", Method: "DELETE", Path: "/v1/trading-partners/{id}"},
+	{Source: "do_trailer_credentials_create", Method: "POST", Path: "/v1/trailer-credentials", Attributes: []string{"expires-on", "issued-on"}, Relationships: []string{"tractor-trailer-credential-classification", "trailer"}},
+	{Source: "do_trailer_credentials_delete", Method: "DELETE", Path: "/v1/trailer-credentials/{id}"},
+	{Source: "do_trailer_credentials_update", Method: "PATCH", Path: "/v1/trailer-credentials/{id}", Attributes: []string{"expires-on", "issued-on"}},
+	{Source: "do_trailers_create", Method: "POST", Path: "/v1/trailers", Attributes: []string{"bed-liner", "can-pave", "capacity-lbs", "coal-chute", "composition", "curb-weight-lbs", "frame-kind", "has-overweight-permit-status", "height-inches", "hitch", "in-service", "insulated-bed", "intransit-heat", "kind", "number", "parking-address", "parking-address-place-id", "parking-address-plus-code", "rear-wheel-offset-inches", "skip-parking-address-geocoding", "sludge-locks", "tarp-kind", "vibrator"}, Relationships: []string{"brokered-trailer", "tractor", "trailer-classification", "trucker"}},
+	{Source: "do_trailers_delete", Method: "DELETE", Path: "/v1/trailers/{id}"},
+	{Source: "do_trailers_update", Method: "PATCH", Path: "/v1/trailers/{id}", Attributes: []string{"bed-liner", "can-pave", "capacity-lbs", "coal-chute", "composition", "curb-weight-lbs", "frame-kind", "has-overweight-permit-status", "height-inches", "hitch", "in-service", "insulated-bed", "intransit-heat", "kind", "number", "parking-address", "parking-address-place-id", "parking-address-plus-code", "rear-wheel-offset-inches", "skip-parking-address-geocoding", "sludge-locks", "tarp-kind", "vibrator"}, Relationships: []string{"brokered-trailer", "tractor", "trailer-classification", "trucker"}},
+	{Source: "do_transport_order_efficiency_summary_create", Method: "POST", Path: "/v1/transport-order-efficiency-summaries", Attributes: []string{"filters", "group-by", "included-metrics", "limit", "sort"}},
+	{Source: "do_transport_order_materials_create", Method: "POST", Path: "/v1/transport-order-materials", Attributes: []string{"quantity-explicit"}, Relationships: []string{"material-type", "transport-order", "unit-of-measure"}},
+	{Source: "do_transport_order_materials_delete", Method: "DELETE", Path: "/v1/transport-order-materials/{id}"},
+	{Source: "do_transport_order_materials_update", Method: "PATCH", Path: "/v1/transport-order-materials/{id}", Attributes: []string{"quantity-explicit"}, Relationships: []string{"material-type", "unit-of-measure"}},
+	{Source: "do_transport_order_project_transport_plan_strategy_set_predictions_create", Method: "POST", Path: "/v1/transport-order-project-transport-plan-strategy-set-predictions", Relationships: []string{"transport-order"}},
+	{Source: "do_transport_order_stop_materials_create", Method: "POST", Path: "/v1/transport-order-stop-materials", Attributes: []string{"quantity-explicit"}, Relationships: []string{"transport-order-material", "transport-order-stop"}},
+	{Source: "do_transport_order_stop_materials_delete", Method: "DELETE", Path: "/v1/transport-order-stop-materials/{id}"},
+	{Source: "do_transport_order_stop_materials_update", Method: "PATCH", Path: "/v1/transport-order-stop-materials/{id}", Attributes: []string{"quantity-explicit"}},
+	{Source: "do_transport_order_stops_create", Method: "POST", Path: "/v1/transport-order-stops", Attributes: []string{"at-max", "at-min", "external-tms-stop-number", "position", "role", "status"}, Relationships: []string{"location", "transport-order"}},
+	{Source: "do_transport_order_stops_update", Method: "PATCH", Path: "/v1/transport-order-stops/{id}", Attributes: []string{"at-max", "at-min", "external-tms-stop-number", "position", "role", "status"}, Relationships: []string{"location"}},
+	{Source: "do_transport_orders_create", Method: "POST", Path: "/v1/transport-orders", Attributes: []string{"billable-miles", "is-managed", "ordered-at", "status"}, Relationships: []string{"customer", "project", "project-category", "project-division", "project-office"}},
+	{Source: "do_transport_orders_delete", Method: "DELETE", Path: "/v1/transport-orders/{id}"},
+	{Source: "do_transport_orders_update", Method: "PATCH", Path: "/v1/transport-orders/{id}", Attributes: []string{"billable-miles", "is-managed", "ordered-at", "status"}, Relationships: []string{"project", "project-category", "project-division", "project-office"}},
+	{Source: "do_transport_references_create", Method: "POST", Path: "/v1/transport-references", Attributes: []string{"key", "position", "value"}, Relationships: []string{"subject"}},
+	{Source: "do_transport_references_delete", Method: "DELETE", Path: "/v1/transport-references/{id}"},
+	{Source: "do_transport_references_update", Method: "PATCH", Path: "/v1/transport-references/{id}", Attributes: []string{"key", "position", "value"}},
+	{Source: "do_transport_summary_create", Method: "POST", Path: "/v1/transport-summaries", Attributes: []string{"filters"}},
+	{Source: "do_trips_create", Method: "POST", Path: "/v1/trips", Attributes: []string{"destination-at", "destination-notes", "origin-at", "origin-notes", "submitted-mileage", "submitted-minutes"}, Relationships: []string{"destination", "driver-day", "origin", "tender-job-schedule-shift"}},
+	{Source: "do_trips_delete", Method: "DELETE", Path: "/v1/trips/{id}"},
+	{Source: "do_trips_update", Method: "PATCH", Path: "/v1/trips/{id}", Attributes: []string{"destination-at", "destination-notes", "origin-at", "origin-notes", "submitted-mileage", "submitted-minutes"}, Relationships: []string{"destination", "driver-day", "origin", "tender-job-schedule-shift"}},
+	{Source: "do_truck_scopes_create", Method: "POST", Path: "/v1/truck-scopes", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "address-proximity-meters", "authorized-state-codes", "skip-geocoding", "trailer-classification-ids"}, Relationships: []string{"organization"}},
+	{Source: "do_truck_scopes_delete", Method: "DELETE", Path: "/v1/truck-scopes/{id}"},
+	{Source: "do_truck_scopes_update", Method: "PATCH", Path: "/v1/truck-scopes/{id}", Attributes: []string{"address", "address-latitude", "address-longitude", "address-place-id", "address-plus-code", "address-proximity-meters", "authorized-state-codes", "skip-geocoding", "trailer-classification-ids"}},
+	{Source: "do_trucker_application_approvals_create", Method: "POST", Path: "/v1/trucker-application-approvals", Attributes: []string{"add-application-user-as-trucker-manager", "trucker-application-id"}},
+	{Source: "do_trucker_applications_create", Method: "POST", Path: "/v1/trucker-applications", Attributes: []string{"company-address", "company-address-place-id", "company-address-plus-code", "company-name", "estimated-trailer-capacity", "has-union-drivers", "notes", "referral-code", "skip-company-address-geocoding", "status", "via-dumptruckloadsdotcom"}, Relationships: []string{"broker", "user"}},
+	{Source: "do_trucker_applications_delete", Method: "DELETE", Path: "/v1/trucker-applications/{id}"},
+	{Source: "do_trucker_applications_update", Method: "PATCH", Path: "/v1/trucker-applications/{id}", Attributes: []string{"company-address", "company-address-place-id", "company-address-plus-code", "company-name", "estimated-trailer-capacity", "has-union-drivers", "notes", "referral-code", "skip-company-address-geocoding", "status", "via-dumptruckloadsdotcom"}, Relationships: []string{"broker", "user"}},
+	{Source: "do_trucker_brokerages_create", Method: "POST", Path: "/v1/trucker-brokerages", Relationships: []string{"brokered-trucker", "trucker"}},
+	{Source: "do_trucker_brokerages_delete", Method: "DELETE", Path: "/v1/trucker-brokerages/{id}"},
+	{Source: "do_trucker_brokerages_update", Method: "PATCH", Path: "/v1/trucker-brokerages/{id}", Relationships: []string{"brokered-trucker", "trucker"}},
+	{Source: "do_trucker_insurances_create", Method: "POST", Path: "/v1/trucker-insurances", Attributes: []string{"company-name", "contact-name", "phone-number"}, Relationships: []string{"trucker"}},
+	{Source: "do_trucker_insurances_delete", Method: "DELETE", Path: "/v1/trucker-insurances/{id}"},
+	{Source: "do_trucker_insurances_update", Method: "PATCH", Path: "/v1/trucker-insurances/{id}", Attributes: []string{"company-name", "contact-name", "phone-number"}},
+	{Source: "do_trucker_invoices_create", Method: "POST", Path: "/v1/trucker-invoices", Attributes: []string{"adjustment-amount", "currency-code", "due-on", "explicit-buyer-address", "explicit-buyer-name", "invoice-date", "notes"}, Relationships: []string{"buyer", "seller", "time-cards"}},
+	{Source: "do_trucker_invoices_delete", Method: "DELETE", Path: "/v1/trucker-invoices/{id}"},
+	{Source: "do_trucker_invoices_update", Method: "PATCH", Path: "/v1/trucker-invoices/{id}", Attributes: []string{"adjustment-amount", "currency-code", "due-on", "explicit-buyer-address", "explicit-buyer-name", "invoice-date", "notes"}, Relationships: []string{"buyer", "seller"}},
+	{Source: "do_trucker_memberships_create", Method: "POST", Path: "/v1/trucker-memberships", Relationships: []string{"organization", "project-office", "user"}},
+	{Source: "do_trucker_memberships_delete", Method: "GET", Path: "/v1/trucker-memberships/{id}"},
+	{Source: "do_trucker_memberships_delete", Method: "DELETE", Path: "/v1/trucker-memberships/{id}"},
+	{Source: "do_trucker_memberships_update", Method: "PATCH", Path: "/v1/trucker-memberships/{id}", Attributes: []string{"can-see-rates-as-driver", "can-see-rates-as-manager", "can-validate-profit-improvements", "color-hex", "drives-shift-type", "enable-inventory-capacity-notifications", "enable-recap-notifications", "end-at", "explicit-sort-order", "external-employee-id", "is-admin", "is-default-job-production-plan-subscriber", "is-geofence-violation-team-member", "is-unapproved-time-card-subscriber", "kind", "start-at", "title", "trailer-coassignments-reset-on"}},
+	{Source: "do_trucker_referral_codes_create", Method: "POST", Path: "/v1/trucker-referral-codes", Attributes: []string{"code", "value"}, Relationships: []string{"broker"}},
+	{Source: "do_trucker_referral_codes_delete", Method: "GET", Path: "/v1/trucker-referral-codes/{id}"},
+	{Source: "do_trucker_referral_codes_delete", Method: "DELETE", Path: "/v1/trucker-referral-codes/{id}"},
+	{Source: "do_trucker_referral_codes_update", Method: "PATCH", Path: "/v1/trucker-referral-codes/{id}", Attributes: []string{"code", "value"}, Relationships: []string{"broker"}},
+	{Source: "do_trucker_referrals_create", Method: "POST", Path: "/v1/trucker-referrals", Attributes: []string{"notes", "referred-on", "truck-first-shift-bonus-amount", "trucker-first-shift-bonus-amount"}, Relationships: []string{"trucker", "user"}},
+	{Source: "do_trucker_referrals_delete", Method: "DELETE", Path: "/v1/trucker-referrals/{id}"},
+	{Source: "do_trucker_referrals_update", Method: "PATCH", Path: "/v1/trucker-referrals/{id}", Attributes: []string{"notes", "referred-on", "truck-first-shift-bonus-amount", "trucker-first-shift-bonus-amount"}, Relationships: []string{"trucker", "user"}},
+	{Source: "do_trucker_settings_create", Method: "POST", Path: "/v1/trucker-settings", Relationships: []string{"trucker"}},
+	{Source: "do_trucker_settings_update", Method: "PATCH", Path: "/v1/trucker-settings/{id}", Attributes: []string{"auto-combine-overlapping-driver-days", "auto-generate-time-sheet-line-items-per-job", "billing-period-day-count", "billing-period-end-invoice-offset-day-count", "billing-period-start-on", "create-detected-production-incidents", "day-shift-assignment-reminder-time", "default-hours-after-which-overtime-applies", "default-payment-terms-and-conditions", "default-tender-dispatch-instructions", "default-time-sheet-line-item-classification-id", "deliver-new-invoices", "generate-daily-invoice", "group-daily-invoice-by-job-number", "invoice-date-calculation", "invoices-batch-processing-start-on", "invoices-grouped-by-time-card-start-date", "is-trucker-shift-rejection-permitted", "logo-svg", "minimum-driver-tracking-minutes", "night-shift-assignment-reminder-time", "notify-driver-when-gps-not-available", "primary-color", "restrict-line-item-classification-edit-to-time-sheet-approver", "sets-shift-material-transaction-expectations", "show-planner-info-to-drivers", "split-billing-periods-spanning-months"}},
+	{Source: "do_trucker_shift_sets_update", Method: "PATCH", Path: "/v1/trucker-shift-sets/{id}", Attributes: []string{"explicit-mobilization-before-minutes", "explicit-post-trip-minutes", "explicit-pre-trip-minutes", "is-broker-amount-constraint-enabled", "is-customer-amount-constraint-enabled", "is-time-sheet-enabled", "new-shift-ids", "odometer-end-value", "odometer-start-value", "odometer-unit-of-measure-explicit"}, Relationships: []string{"explicit-broker-amount-constraint"}},
+	{Source: "do_truckers_create", Method: "POST", Path: "/v1/truckers", Attributes: []string{"are-shifts-expecting-time-cards", "billing-requirement", "color-hex", "company-address", "company-address-place-id", "company-address-plus-code", "company-name", "default-payment-terms", "default-post-trip-minutes", "default-pre-trip-minutes", "estimated-trailer-capacity", "expecting-trucker-shift-set-time-sheets-on", "favorite", "fax-number", "generate-daily-invoice", "has-union-drivers", "is-accepting-open-door-issues", "is-active", "is-controlled-by-broker", "is-expecting-trucker-shift-set-time-sheets", "is-time-card-creating-time-sheet-line-item-explicit", "manage-driver-assignment-acknowledgement", "notes", "notify-default-financial-contact-of-time-card-pre-approvals", "notify-default-financial-contact-of-time-card-rejections", "payment-address-city", "payment-address-country-code", "payment-address-line-one", "payment-address-line-two", "payment-address-postal-code", "payment-address-state-code", "phone-number", "referral-source", "skip-company-address-geocoding", "skip-reasonable-default-operations-contact-validation", "skip-reasonable-default-trailer-validation", "tax-identifier", "time-sheet-submission-terms"}, Relationships: []string{"broker"}},
+	{Source: "do_truckers_delete", Method: "DELETE", Path: "/v1/truckers/{id}"},
+	{Source: "do_truckers_update", Method: "PATCH", Path: "/v1/truckers/{id}", Attributes: []string{"are-shifts-expecting-time-cards", "billing-requirement", "color-hex", "company-address", "company-address-place-id", "company-address-plus-code", "company-name", "default-payment-terms", "default-post-trip-minutes", "default-pre-trip-minutes", "estimated-trailer-capacity", "expecting-trucker-shift-set-time-sheets-on", "favorite", "fax-number", "generate-daily-invoice", "has-union-drivers", "is-accepting-open-door-issues", "is-active", "is-controlled-by-broker", "is-expecting-trucker-shift-set-time-sheets", "is-time-card-creating-time-sheet-line-item-explicit", "manage-driver-assignment-acknowledgement", "notes", "notify-default-financial-contact-of-time-card-pre-approvals", "notify-default-financial-contact-of-time-card-rejections", "payment-address-city", "payment-address-country-code", "payment-address-line-one", "payment-address-line-two", "payment-address-postal-code", "payment-address-state-code", "phone-number", "referral-source", "remit-to-address", "skip-company-address-geocoding", "skip-reasonable-default-operations-contact-validation", "skip-reasonable-default-trailer-validation", "tax-identifier", "time-sheet-submission-terms"}, Relationships: []string{"default-financial-contact", "default-operations-contact", "default-trailer"}},
+	{Source: "do_ui_tour_steps_create", Method: "POST", Path: "/v1/ui-tour-steps", Attributes: []string{"abbreviation", "content", "name", "sequence"}, Relationships: []string{"ui-tour"}},
+	{Source: "do_ui_tour_steps_delete", Method: "DELETE", Path: "/v1/ui-tour-steps/{id}"},
+	{Source: "do_ui_tour_steps_update", Method: "PATCH", Path: "/v1/ui-tour-steps/{id}", Attributes: []string{"abbreviation", "content", "name", "sequence"}},
+	{Source: "do_ui_tours_create", Method: "POST", Path: "/v1/ui-tours", Attributes: []string{"abbreviation", "description", "name"}},
+	{Source: "do_ui_tours_delete", Method: "GET", Path: "/v1/ui-tours/{id}"},
+	{Source: "do_ui_tours_delete", Method: "DELETE", Path: "/v1/ui-tours/{id}"},
+	{Source: "do_ui_tours_update", Method: "PATCH", Path: "/v1/ui-tours/{id}", Attributes: []string{"abbreviation", "description", "name"}},
+	{Source: "do_user_auth_token_resets_create", Method: "POST", Path: "/v1/user-auth-token-resets", Attributes: []string{"user-id"}},
+	{Source: "do_user_creator_feeds_create", Method: "POST", Path: "/v1/user-creator-feeds", Attributes: []string{"user-id"}},
+	{Source: "do_user_creator_feeds_delete", Method: "DELETE", Path: "/v1/user-creator-feeds/{id}"},
+	{Source: "do_user_creator_feeds_update", Method: "PATCH", Path: "/v1/user-creator-feeds/{id}", Attributes: []string{"user-id"}},
+	{Source: "do_user_credential_classifications_create", Method: "POST", Path: "/v1/user-credential-classifications", Attributes: []string{"description", "external-id", "issuer-name", "name"}, Relationships: []string{"organization"}},
+	{Source: "do_user_credential_classifications_delete", Method: "DELETE", Path: "/v1/user-credential-classifications/{id}"},
+	{Source: "do_user_credential_classifications_update", Method: "PATCH", Path: "/v1/user-credential-classifications/{id}", Attributes: []string{"description", "external-id", "issuer-name", "name"}},
+	{Source: "do_user_credentials_create", Method: "POST", Path: "/v1/user-credentials", Attributes: []string{"expires-on", "issued-on"}, Relationships: []string{"user", "user-credential-classification"}},
+	{Source: "do_user_credentials_delete", Method: "DELETE", Path: "/v1/user-credentials/{id}"},
+	{Source: "do_user_credentials_update", Method: "PATCH", Path: "/v1/user-credentials/{id}", Attributes: []string{"expires-on", "issued-on"}},
+	{Source: "do_user_device_location_tracking_requests_create", Method: "POST", Path: "/v1/user-device-location-tracking-requests", Attributes: []string{"location-tracking-action", "location-tracking-kind"}, Relationships: []string{"user"}},
+	{Source: "do_user_languages_create", Method: "POST", Path: "/v1/user-languages", Relationships: []string{"language", "user"}},
+	{Source: "do_user_languages_delete", Method: "DELETE", Path: "/v1/user-languages/{id}"},
+	{Source: "do_user_languages_update", Method: "PATCH", Path: "/v1/user-languages/{id}", Attributes: []string{"is-default"}},
+	{Source: "do_user_location_events_create", Method: "POST", Path: "/v1/user-location-events", Attributes: []string{"event-at", "event-latitude", "event-longitude", "provenance"}, Relationships: []string{"user"}},
+	{Source: "do_user_location_events_update", Method: "PATCH", Path: "/v1/user-location-events/{id}", Attributes: []string{"event-at", "event-latitude", "event-longitude", "provenance"}, Relationships: []string{"user"}},
+	{Source: "do_user_location_requests_create", Method: "POST", Path: "/v1/user-location-requests", Relationships: []string{"user"}},
+	{Source: "do_user_post_feed_posts_update", Method: "PATCH", Path: "/v1/user-post-feed-posts/{id}", Attributes: []string{"is-bookmarked", "subscription-end-at", "subscription-start-at"}},
+	{Source: "do_user_post_feeds_create", Method: "POST", Path: "/v1/user-post-feeds", Attributes: []string{"enable-vector-indexing", "user-id"}},
+	{Source: "do_user_post_feeds_delete", Method: "DELETE", Path: "/v1/user-post-feeds/{id}"},
+	{Source: "do_user_post_feeds_update", Method: "PATCH", Path: "/v1/user-post-feeds/{id}", Attributes: []string{"enable-vector-indexing"}},
+	{Source: "do_user_searches_create", Method: "POST", Path: "/v1/user-searches", Attributes: []string{"contact-method", "contact-value", "only-admin-or-member"}},
+	{Source: "do_user_ui_tours_create", Method: "POST", Path: "/v1/user-ui-tours", Attributes: []string{"completed-at", "skipped-at"}, Relationships: []string{"ui-tour", "user"}},
+	{Source: "do_user_ui_tours_delete", Method: "DELETE", Path: "/v1/user-ui-tours/{id}"},
+	{Source: "do_user_ui_tours_update", Method: "PATCH", Path: "/v1/user-ui-tours/{id}", Attributes: []string{"completed-at", "skipped-at"}},
+	{Source: "do_users_create", Method: "POST", Path: "/v1/users", Attributes: []string{"dark-mode", "default-contact-method", "email-address", "explicit-time-zone-id", "is-admin", "is-available-for-question-assignment", "is-contact-method-required", "is-customer-success", "is-generating-notification-posts-explicit", "is-notifiable", "is-potential-trucker-referrer", "is-read-only-mode-enabled", "is-sales", "is-suspended-from-driving", "mobile-number", "name", "notification-preferences-explicit", "notify-when-gps-not-available", "opt-out-of-check-in-request-notifications", "opt-out-of-pre-approval-notifications", "opt-out-of-shift-starting-notifications", "opt-out-of-time-card-approver-notifications", "reference-data", "slack-id"}},
+	{Source: "do_users_update", Method: "PATCH", Path: "/v1/users/{id}", Attributes: []string{"dark-mode", "default-contact-method", "email-address", "explicit-time-zone-id", "is-admin", "is-available-for-question-assignment", "is-contact-method-required", "is-customer-success", "is-generating-notification-posts-explicit", "is-notifiable", "is-potential-trucker-referrer", "is-read-only-mode-enabled", "is-sales", "is-suspended-from-driving", "mobile-number", "name", "notification-preferences-explicit", "notify-when-gps-not-available", "opt-out-of-check-in-request-notifications", "opt-out-of-pre-approval-notifications", "opt-out-of-shift-starting-notifications", "opt-out-of-time-card-approver-notifications", "reference-data", "slack-id"}},
+	{Source: "do_verizon_reveal_vehicles_update", Method: "PATCH", Path: "/v1/verizon-reveal-vehicles/{id}", Relationships: []string{"equipment", "tractor", "trailer"}},
+	{Source: "do_work_order_assignments_create", Method: "POST", Path: "/v1/work-order-assignments", Relationships: []string{"user", "work-order"}},
+	{Source: "do_work_order_assignments_delete", Method: "DELETE", Path: "/v1/work-order-assignments/{id}"},
+	{Source: "do_work_order_assignments_update", Method: "PATCH", Path: "/v1/work-order-assignments/{id}", Relationships: []string{"user", "work-order"}},
+	{Source: "do_work_order_service_codes_create", Method: "POST", Path: "/v1/work-order-service-codes", Attributes: []string{"code", "description"}, Relationships: []string{"broker"}},
+	{Source: "do_work_order_service_codes_delete", Method: "DELETE", Path: "/v1/work-order-service-codes/{id}"},
+	{Source: "do_work_order_service_codes_update", Method: "PATCH", Path: "/v1/work-order-service-codes/{id}", Attributes: []string{"code", "description"}},
+	{Source: "do_work_orders_create", Method: "POST", Path: "/v1/work-orders", Attributes: []string{"due-date", "estimated-labor-hours", "estimated-part-cost", "note", "priority", "safety-tag-status", "status"}, Relationships: []string{"broker", "custom-work-order-status", "responsible-party", "service-code", "service-site"}},
+	{Source: "do_work_orders_delete", Method: "DELETE", Path: "/v1/work-orders/{id}"},
+	{Source: "do_work_orders_update", Method: "PATCH", Path: "/v1/work-orders/{id}", Attributes: []string{"due-date", "estimated-labor-hours", "estimated-part-cost", "note", "priority", "safety-tag-status", "status"}, Relationships: []string{"custom-work-order-status", "responsible-party", "service-code", "service-site"}},
+	{Source: "driver_assignment_acknowledgements_list", Method: "GET", Path: "/v1/driver-assignment-acknowledgements", Filters: []string{"driver", "tender-job-schedule-shift"}},
+	{Source: "driver_assignment_acknowledgements_show", Method: "GET", Path: "/v1/driver-assignment-acknowledgements/{id}"},
+	{Source: "driver_assignment_refusals_list", Method: "GET", Path: "/v1/driver-assignment-refusals", Filters: []string{"created-at-max", "created-at-min", "driver", "tender_job_schedule_shift", "updated-at-max", "updated-at-min"}},
+	{Source: "driver_assignment_refusals_show", Method: "GET", Path: "/v1/driver-assignment-refusals/{id}"},
+	{Source: "driver_assignment_rules_list", Method: "GET", Path: "/v1/driver-assignment-rules", Filters: []string{"broker", "level"}},
+	{Source: "driver_assignment_rules_show", Method: "GET", Path: "/v1/driver-assignment-rules/{id}"},
+	{Source: "driver_day_adjustment_plans_list", Method: "GET", Path: "/v1/driver-day-adjustment-plans", Filters: []string{"trucker"}},
+	{Source: "driver_day_adjustment_plans_show", Method: "GET", Path: "/v1/driver-day-adjustment-plans/{id}"},
+	{Source: "driver_day_adjustments_list", Method: "GET", Path: "/v1/driver-day-adjustments", Filters: []string{"driver", "driver-day", "driver-id", "trucker", "trucker-id"}},
+	{Source: "driver_day_adjustments_show", Method: "GET", Path: "/v1/driver-day-adjustments/{id}"},
+	{Source: "driver_day_constraints_list", Method: "GET", Path: "/v1/driver-day-constraints", Filters: []string{"constraint", "driver_day"}},
+	{Source: "driver_day_constraints_show", Method: "GET", Path: "/v1/driver-day-constraints/{id}"},
+	{Source: "driver_day_shortfall_calculations_list", Method: "GET", Path: "/v1/driver-day-shortfall-calculations"},
+	{Source: "driver_day_shortfall_calculations_show", Method: "GET", Path: "/v1/driver-day-shortfall-calculations/{id}"},
+	{Source: "driver_day_trips_adjustments_list", Method: "GET", Path: "/v1/driver-day-trips-adjustments", Filters: []string{"broker", "created_by", "driver_day", "status", "tender_job_schedule_shift", "trucker"}},
+	{Source: "driver_day_trips_adjustments_show", Method: "GET", Path: "/v1/driver-day-trips-adjustments/{id}"},
+	{Source: "driver_managers_list", Method: "GET", Path: "/v1/driver-managers", Filters: []string{"broker", "created-at-max", "created-at-min", "managed-membership", "managed-user", "manager-membership", "manager-user", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "driver_managers_show", Method: "GET", Path: "/v1/driver-managers/{id}"},
+	{Source: "driver_movement_observations_list", Method: "GET", Path: "/v1/driver-movement-observations", Filters: []string{"created-at-max", "created-at-min", "is-current", "plan", "updated-at-max", "updated-at-min"}},
+	{Source: "driver_movement_observations_show", Method: "GET", Path: "/v1/driver-movement-observations/{id}"},
+	{Source: "driver_movement_segment_sets_list", Method: "GET", Path: "/v1/driver-movement-segment-sets", Filters: []string{"driver", "driver_day"}},
+	{Source: "driver_movement_segment_sets_show", Method: "GET", Path: "/v1/driver-movement-segment-sets/{id}"},
+	{Source: "driver_movement_segments_list", Method: "GET", Path: "/v1/driver-movement-segments", Filters: []string{"driver_movement_segment_set", "end-at-max", "end-at-min", "is_moving", "is_stationary", "site_kind", "start-at-max", "start-at-min", "tractor", "trailer"}},
+	{Source: "driver_movement_segments_show", Method: "GET", Path: "/v1/driver-movement-segments/{id}"},
+	{Source: "efficiency_incidents_list", Method: "GET", Path: "/v1/efficiency-incidents", Filters: []string{"action_items", "assignee", "broker", "contractor", "created_by", "customer", "developer", "did_stop_work", "end_at_max", "end_at_min", "equipment", "has_equipment", "has_live_action_items", "has_parent", "incident_tag", "incident_tag_slug", "job_number", "job_production_plan", "job_production_plan_project", "kind", "material_site", "material_supplier", "natures", "net_impact_dollars_max", "net_impact_dollars_min", "not_subject_type", "notifiable_to", "parent", "q", "responsible_person", "root_causes", "severity", "start_at_max", "start_at_min", "start_on", "start_on_max", "start_on_min", "status", "subject", "subject_type", "tender_job_schedule_shift", "tender_job_schedule_shift_driver", "tender_job_schedule_shift_trucker", "trucker", "user_has_stake", "zero_incident_tags"}},
+	{Source: "efficiency_incidents_show", Method: "GET", Path: "/v1/efficiency-incidents/{id}"},
+	{Source: "equipment_classifications_list", Method: "GET", Path: "/v1/equipment-classifications", Filters: []string{"abbreviation", "brokers", "is-root", "mobilization-method", "name", "parent", "with-children", "without-children"}},
+	{Source: "equipment_list", Method: "GET", Path: "/v1/equipment", Filters: []string{"broker", "business_unit", "by_organization", "equipment_classification", "is_active", "is_available", "is_rented", "mobilization_method", "nickname_like", "q", "tractor", "trailer"}},
+	{Source: "equipment_location_estimates_list", Method: "GET", Path: "/v1/equipment-location-estimates", Filters: []string{"as_of", "earliest_event_at", "equipment", "latest_event_at", "max_abs_latency_seconds", "max_latest_seconds"}},
+	{Source: "equipment_location_events_list", Method: "GET", Path: "/v1/equipment-location-events", Filters: []string{"created_at_max", "created_at_min", "equipment", "event_at_max", "event_at_min", "is_created_at", "is_event_at", "is_updated_at", "provenance", "updated_at_max", "updated_at_min", "updated_by"}},
+	{Source: "equipment_location_events_show", Method: "GET", Path: "/v1/equipment-location-events/{id}"},
+	{Source: "equipment_movement_requirement_locations_list", Method: "GET", Path: "/v1/equipment-movement-requirement-locations", Filters: []string{"broker", "name", "near", "q", "used-after"}},
+	{Source: "equipment_movement_requirement_locations_show", Method: "GET", Path: "/v1/equipment-movement-requirement-locations/{id}"},
+	{Source: "equipment_movement_requirements_list", Method: "GET", Path: "/v1/equipment-movement-requirements", Filters: []string{"broker", "created_at_max", "created_at_min", "destination_at_max_max", "destination_at_max_min", "equipment", "inbound_requirement", "origin_at_min_max", "origin_at_min_min", "outbound_requirement", "updated_at_max", "updated_at_min"}},
+	{Source: "equipment_movement_requirements_show", Method: "GET", Path: "/v1/equipment-movement-requirements/{id}"},
+	{Source: "equipment_movement_stop_completions_list", Method: "GET", Path: "/v1/equipment-movement-stop-completions", Filters: []string{"completed-at-max", "completed-at-min", "created-at-max", "created-at-min", "equipment", "is-completed-at", "is-created-at", "is-updated-at", "stop", "updated-at-max", "updated-at-min"}},
+	{Source: "equipment_movement_stop_completions_show", Method: "GET", Path: "/v1/equipment-movement-stop-completions/{id}"},
+	{Source: "equipment_movement_stop_requirements_list", Method: "GET", Path: "/v1/equipment-movement-stop-requirements", Filters: []string{"kind", "requirement", "stop"}},
+	{Source: "equipment_movement_stop_requirements_show", Method: "GET", Path: "/v1/equipment-movement-stop-requirements/{id}"},
+	{Source: "equipment_movement_stops_list", Method: "GET", Path: "/v1/equipment-movement-stops", Filters: []string{"created-at-max", "created-at-min", "location", "scheduled-arrival-at-max", "scheduled-arrival-at-min", "trip", "updated-at-max", "updated-at-min"}},
+	{Source: "equipment_movement_stops_show", Method: "GET", Path: "/v1/equipment-movement-stops/{id}"},
+	{Source: "equipment_movement_trip_customer_cost_allocations_list", Method: "GET", Path: "/v1/equipment-movement-trip-customer-cost-allocations", Filters: []string{"trip"}},
+	{Source: "equipment_movement_trip_customer_cost_allocations_show", Method: "GET", Path: "/v1/equipment-movement-trip-customer-cost-allocations/{id}"},
+	{Source: "equipment_movement_trip_dispatches_list", Method: "GET", Path: "/v1/equipment-movement-trip-dispatches", Filters: []string{"created_at_max", "created_at_min", "created_by", "driver", "equipment_movement_requirement", "equipment_movement_trip", "inbound_equipment_requirement", "lineup_dispatch", "outbound_equipment_requirement", "status", "trucker"}},
+	{Source: "equipment_movement_trip_dispatches_show", Method: "GET", Path: "/v1/equipment-movement-trip-dispatches/{id}"},
+	{Source: "equipment_movement_trip_job_production_plans_list", Method: "GET", Path: "/v1/equipment-movement-trip-job-production-plans", Filters: []string{"created_at_max", "created_at_min", "equipment_movement_trip", "job_production_plan", "updated_at_max", "updated_at_min"}},
+	{Source: "equipment_movement_trip_job_production_plans_show", Method: "GET", Path: "/v1/equipment-movement-trip-job-production-plans/{id}"},
+	{Source: "equipment_movement_trips_list", Method: "GET", Path: "/v1/equipment-movement-trips", Filters: []string{"broker", "job_production_plan", "job_production_plan_id", "max_origin_at_min_max", "max_origin_at_min_min"}},
+	{Source: "equipment_movement_trips_show", Method: "GET", Path: "/v1/equipment-movement-trips/{id}"},
+	{Source: "equipment_rentals_list", Method: "GET", Path: "/v1/equipment-rentals", Filters: []string{"broker", "end_on_max", "end_on_min", "equipment", "equipment_classification", "equipment_supplier", "start_on_max", "start_on_min", "status"}},
+	{Source: "equipment_requirements_list", Method: "GET", Path: "/v1/equipment-requirements", Filters: []string{"broker", "calculated-mobilization-method", "created-at-max", "created-at-min", "customer", "end-at-effective-max", "end-at-effective-min", "end-at-max", "end-at-min", "has-resource", "is-assignment-candidate-for", "is-created-at", "is-end-at", "is-expecting-time-sheet", "is-only-for-equipment-movement", "is-start-at", "is-updated-at", "job-production-plan", "job-production-plan-status", "labor-requirement", "labor-requirement-laborer", "labor-requirement-laborer-id", "labor-requirement-user", "labor-requirement-user-id", "project", "project-manager", "requires-inbound-movement", "requires-outbound-movement", "resource", "resource_classification", "resource_classification_type", "resource_type", "start-at-effective-max", "start-at-effective-min", "start-at-max", "start-at-min", "start-on-effective-max", "start-on-effective-min", "updated-at-max", "updated-at-min", "without-approved-time-sheet", "without-submitted-time-sheet"}},
+	{Source: "equipment_requirements_show", Method: "GET", Path: "/v1/equipment-requirements/{id}"},
+	{Source: "equipment_suppliers_list", Method: "GET", Path: "/v1/equipment-suppliers", Filters: []string{"broker", "name"}},
+	{Source: "equipment_suppliers_show", Method: "GET", Path: "/v1/equipment-suppliers/{id}"},
+	{Source: "equipment_utilization_readings_list", Method: "GET", Path: "/v1/equipment-utilization-readings", Filters: []string{"business-unit", "equipment", "reported-at-max", "reported-at-min", "source", "user"}},
+	{Source: "equipment_utilization_readings_show", Method: "GET", Path: "/v1/equipment-utilization-readings/{id}"},
+	{Source: "expected_time_of_arrivals_list", Method: "GET", Path: "/v1/expected-time-of-arrivals", Filters: []string{"created_by", "expected_at_max", "expected_at_min", "is_expected_at", "job_schedule_shift", "tender_job_schedule_shift", "unsure"}},
+	{Source: "expected_time_of_arrivals_show", Method: "GET", Path: "/v1/expected-time-of-arrivals/{id}"},
+	{Source: "exporter_configurations_list", Method: "GET", Path: "/v1/free-ticketing/exporter-configurations", Filters: []string{"broker", "integration-config"}},
+	{Source: "exporter_configurations_show", Method: "GET", Path: "/v1/free-ticketing/exporter-configurations/{id}"},
+	{Source: "external_identification_types_list", Method: "GET", Path: "/v1/external-identification-types", Filters: []string{"can-apply-to", "name"}},
+	{Source: "external_identifications_list", Method: "GET", Path: "/v1/external-identifications"},
+	{Source: "features_list", Method: "GET", Path: "/v1/features", Filters: []string{"differentiation-degree", "pdca-stage", "released-on-max", "released-on-min", "scale", "similar-to-text"}},
+	{Source: "features_show", Method: "GET", Path: "/v1/features/{id}"},
+	{Source: "file_attachments_list", Method: "GET", Path: "/v1/file-attachments", Filters: []string{"by_attached_to", "created_by"}},
+	{Source: "file_attachments_show", Method: "GET", Path: "/v1/file-attachments/{id}"},
+	{Source: "file_imports_list", Method: "GET", Path: "/v1/file-imports", Filters: []string{"broker", "created-by", "file-attachment"}},
+	{Source: "file_imports_show", Method: "GET", Path: "/v1/file-imports/{id}"},
+	{Source: "follows_list", Method: "GET", Path: "/v1/follows", Filters: []string{"created-at-max", "created-at-min", "creator", "creator-type", "follower", "is-created-at", "is-updated-at", "updated-at-max", "updated-at-min"}},
+	{Source: "follows_show", Method: "GET", Path: "/v1/follows/{id}"},
+	{Source: "gauge_vehicles_list", Method: "GET", Path: "/v1/gauge-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "gauge_vehicles_show", Method: "GET", Path: "/v1/gauge-vehicles/{id}"},
+	{Source: "geofence_restriction_violations_list", Method: "GET", Path: "/v1/geofence-restriction-violations", Filters: []string{"created-at-max", "created-at-min", "driver", "event-at-max", "event-at-min", "geofence", "notification-sent-at-max", "notification-sent-at-min", "should-notify", "tender-job-schedule-shift", "tractor", "trailer", "updated-at-max", "updated-at-min"}},
+	{Source: "geofence_restriction_violations_show", Method: "GET", Path: "/v1/geofence-restriction-violations/{id}"},
+	{Source: "geofence_restrictions_list", Method: "GET", Path: "/v1/geofence-restrictions", Filters: []string{"geofence", "status", "trucker"}},
+	{Source: "geofence_restrictions_show", Method: "GET", Path: "/v1/geofence-restrictions/{id}"},
+	{Source: "geofences_list", Method: "GET", Path: "/v1/geofences", Filters: []string{"broker", "name"}},
+	{Source: "geotab_vehicles_list", Method: "GET", Path: "/v1/geotab-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "geotab_vehicles_show", Method: "GET", Path: "/v1/geotab-vehicles/{id}"},
+	{Source: "glossary_terms_list", Method: "GET", Path: "/v1/glossary-terms", Filters: []string{"source"}},
+	{Source: "glossary_terms_show", Method: "GET", Path: "/v1/glossary-terms/{id}"},
+	{Source: "go_motive_integrations_list", Method: "GET", Path: "/v1/go-motive-integrations", Filters: []string{"broker", "created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "go_motive_integrations_show", Method: "GET", Path: "/v1/go-motive-integrations/{id}"},
+	{Source: "gps_insight_vehicles_list", Method: "GET", Path: "/v1/gps-insight-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "gps_insight_vehicles_show", Method: "GET", Path: "/v1/gps-insight-vehicles/{id}"},
+	{Source: "haskell_lemon_inbound_material_transaction_exports_list", Method: "GET", Path: "/v1/haskell-lemon-inbound-material-transaction-exports", Filters: []string{"created-at-max", "created-at-min", "created-by", "has-transaction-date", "is-created-at", "is-updated-at", "transaction-date", "transaction-date-max", "transaction-date-min", "updated-at-max", "updated-at-min"}},
+	{Source: "haskell_lemon_inbound_material_transaction_exports_show", Method: "GET", Path: "/v1/haskell-lemon-inbound-material-transaction-exports/{id}"},
+	{Source: "haskell_lemon_outbound_material_transaction_exports_list", Method: "GET", Path: "/v1/haskell-lemon-outbound-material-transaction-exports", Filters: []string{"created-by", "has-transaction-date", "transaction-date", "transaction-date-max", "transaction-date-min"}},
+	{Source: "haskell_lemon_outbound_material_transaction_exports_show", Method: "GET", Path: "/v1/haskell-lemon-outbound-material-transaction-exports/{id}"},
+	{Source: "hos_annotations_list", Method: "GET", Path: "/v1/hos-annotations", Filters: []string{"broker", "created-at-max", "created-at-min", "hos-day", "hos-event", "updated-at-max", "updated-at-min"}},
+	{Source: "hos_annotations_show", Method: "GET", Path: "/v1/hos-annotations/{id}"},
+	{Source: "hos_availability_snapshots_list", Method: "GET", Path: "/v1/hos-availability-snapshots", Filters: []string{"broker", "driver", "hos_day", "user"}},
+	{Source: "hos_availability_snapshots_show", Method: "GET", Path: "/v1/hos-availability-snapshots/{id}"},
+	{Source: "hos_day_regulation_sets_list", Method: "GET", Path: "/v1/hos-day-regulation-sets", Filters: []string{"broker", "created-at-max", "created-at-min", "driver", "hos-day", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "hos_day_regulation_sets_show", Method: "GET", Path: "/v1/hos-day-regulation-sets/{id}"},
+	{Source: "hos_days_list", Method: "GET", Path: "/v1/hos-days", Filters: []string{"broker", "created-at-max", "created-at-min", "driver", "has-service-date", "is-created-at", "is-updated-at", "regulation-set-code", "service-date", "service-date-max", "service-date-min", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "hos_days_show", Method: "GET", Path: "/v1/hos-days/{id}"},
+	{Source: "hos_events_list", Method: "GET", Path: "/v1/hos-events", Filters: []string{"broker", "hos_day", "user"}},
+	{Source: "hos_events_show", Method: "GET", Path: "/v1/hos-events/{id}"},
+	{Source: "hos_ruleset_assignments_list", Method: "GET", Path: "/v1/hos-ruleset-assignments", Filters: []string{"broker", "driver", "effective-at-max", "effective-at-min", "hos-day", "is-effective-at", "user"}},
+	{Source: "hos_ruleset_assignments_show", Method: "GET", Path: "/v1/hos-ruleset-assignments/{id}"},
+	{Source: "hos_violations_list", Method: "GET", Path: "/v1/hos-violations", Filters: []string{"broker", "driver", "end-at-max", "end-at-min", "hos_day", "start-at-max", "start-at-min", "user"}},
+	{Source: "hos_violations_show", Method: "GET", Path: "/v1/hos-violations/{id}"},
+	{Source: "importer_configurations_list", Method: "GET", Path: "/v1/free-ticketing/importer-configurations", Filters: []string{"broker", "integration-config"}},
+	{Source: "importer_configurations_show", Method: "GET", Path: "/v1/free-ticketing/importer-configurations/{id}"},
+	{Source: "incident_headline_suggestions_list", Method: "GET", Path: "/v1/incident-headline-suggestions", Filters: []string{"incident"}},
+	{Source: "incident_headline_suggestions_show", Method: "GET", Path: "/v1/incident-headline-suggestions/{id}"},
+	{Source: "incident_participants_list", Method: "GET", Path: "/v1/incident-participants", Filters: []string{"email-address", "incident", "mobile-number", "user"}},
+	{Source: "incident_participants_show", Method: "GET", Path: "/v1/incident-participants/{id}"},
+	{Source: "incident_request_approvals_list", Method: "GET", Path: "/v1/incident-request-approvals"},
+	{Source: "incident_request_approvals_show", Method: "GET", Path: "/v1/incident-request-approvals/{id}"},
+	{Source: "incident_request_rejections_list", Method: "GET", Path: "/v1/incident-request-rejections"},
+	{Source: "incident_request_rejections_show", Method: "GET", Path: "/v1/incident-request-rejections/{id}"},
+	{Source: "incident_requests_list", Method: "GET", Path: "/v1/incident-requests", Filters: []string{"assignee", "broker", "created_by", "customer", "end_at_max", "end_at_min", "start_at_max", "start_at_min", "status", "tender_job_schedule_shift", "time_value_type"}},
+	{Source: "incident_requests_show", Method: "GET", Path: "/v1/incident-requests/{id}"},
+	{Source: "incident_subscriptions_list", Method: "GET", Path: "/v1/incident-subscriptions", Filters: []string{"broker", "contact_method", "customer", "incident", "incident_start_on", "incident_start_on_max", "incident_start_on_min", "kind", "material_supplier", "user"}},
+	{Source: "incident_subscriptions_show", Method: "GET", Path: "/v1/incident-subscriptions/{id}"},
+	{Source: "incident_tag_incidents_list", Method: "GET", Path: "/v1/incident-tag-incidents", Filters: []string{"incident", "incident-tag"}},
+	{Source: "incident_tag_incidents_show", Method: "GET", Path: "/v1/incident-tag-incidents/{id}"},
+	{Source: "incident_tags_list", Method: "GET", Path: "/v1/incident-tags", Filters: []string{"slug"}},
+	{Source: "incident_unit_of_measure_quantities_list", Method: "GET", Path: "/v1/incident-unit-of-measure-quantities", Filters: []string{"incident", "incident_type", "unit-of-measure"}},
+	{Source: "incident_unit_of_measure_quantities_show", Method: "GET", Path: "/v1/incident-unit-of-measure-quantities/{id}"},
+	{Source: "incidents_list", Method: "GET", Path: "/v1/incidents", Filters: []string{"assignee", "broker", "created_by", "customer", "developer", "end_at_max", "end_at_min", "equipment", "has_equipment", "has_live_action_items", "has_parent", "incident_tag", "incident_tag_slug", "job_production_plan", "kind", "q", "severity", "start_at_max", "start_at_min", "start_on_max", "start_on_min", "status", "subject_type", "trucker"}},
+	{Source: "integration_configs_list", Method: "GET", Path: "/v1/integration-configs", Filters: []string{"broker", "friendly_name", "organization"}},
+	{Source: "integration_configs_show", Method: "GET", Path: "/v1/integration-configs/{id}"},
+	{Source: "integration_exports_list", Method: "GET", Path: "/v1/integration-exports", Filters: []string{"created-by"}},
+	{Source: "integration_exports_show", Method: "GET", Path: "/v1/integration-exports/{id}"},
+	{Source: "integration_invoices_batch_exports_list", Method: "GET", Path: "/v1/integration-invoices-batch-exports", Filters: []string{"integration-export", "organization-invoices-batch", "organization-invoices-batch-file"}},
+	{Source: "integration_invoices_batch_exports_show", Method: "GET", Path: "/v1/integration-invoices-batch-exports/{id}"},
+	{Source: "inventory_capacities_list", Method: "GET", Path: "/v1/inventory-capacities", Filters: []string{"material_site", "material_type"}},
+	{Source: "inventory_capacities_show", Method: "GET", Path: "/v1/inventory-capacities/{id}"},
+	{Source: "inventory_changes_list", Method: "GET", Path: "/v1/inventory-changes", Filters: []string{"forecast-start-at", "material-site", "material-supplier", "material-type"}},
+	{Source: "inventory_changes_show", Method: "GET", Path: "/v1/inventory-changes/{id}"},
+	{Source: "inventory_estimates_list", Method: "GET", Path: "/v1/inventory-estimates", Filters: []string{"amount-tons", "broker", "broker-id", "created-by", "estimated-at", "material-site", "material-supplier", "material-supplier-id", "material-type"}},
+	{Source: "inventory_estimates_show", Method: "GET", Path: "/v1/inventory-estimates/{id}"},
+	{Source: "invoice_approvals_list", Method: "GET", Path: "/v1/invoice-approvals"},
+	{Source: "invoice_approvals_show", Method: "GET", Path: "/v1/invoice-approvals/{id}"},
+	{Source: "invoice_generations_list", Method: "GET", Path: "/v1/invoice-generations", Filters: []string{"completed-at-max", "completed-at-min", "created-at-max", "created-at-min", "is-completed", "is-completed-at", "is-created-at", "is-updated-at", "organization", "organization-id", "organization-type", "time-cards", "updated-at-max", "updated-at-min"}},
+	{Source: "invoice_generations_show", Method: "GET", Path: "/v1/invoice-generations/{id}"},
+	{Source: "invoice_revisionizing_invoice_revisions_list", Method: "GET", Path: "/v1/invoice-revisionizing-invoice-revisions", Filters: []string{"created_at_max", "created_at_min", "invoice", "invoice_revision", "invoice_revisionizing_work", "updated_at_max", "updated_at_min"}},
+	{Source: "invoice_revisionizing_invoice_revisions_show", Method: "GET", Path: "/v1/invoice-revisionizing-invoice-revisions/{id}"},
+	{Source: "invoice_revisionizing_works_list", Method: "GET", Path: "/v1/invoice-revisionizing-works", Filters: []string{"broker", "created-by", "jid", "organization"}},
+	{Source: "invoice_revisionizing_works_show", Method: "GET", Path: "/v1/invoice-revisionizing-works/{id}"},
+	{Source: "invoice_revisions_list", Method: "GET", Path: "/v1/invoice-revisions", Filters: []string{"created-at-max", "created-at-min", "invoice", "invoice-id", "is-created-at", "is-updated-at", "revision", "updated-at-max", "updated-at-min"}},
+	{Source: "invoice_revisions_show", Method: "GET", Path: "/v1/invoice-revisions/{id}"},
+	{Source: "invoice_sends_list", Method: "GET", Path: "/v1/invoice-sends"},
+	{Source: "invoice_sends_show", Method: "GET", Path: "/v1/invoice-sends/{id}"},
+	{Source: "invoice_status_changes_list", Method: "GET", Path: "/v1/invoice-status-changes", Filters: []string{"invoice", "status"}},
+	{Source: "invoice_status_changes_show", Method: "GET", Path: "/v1/invoice-status-changes/{id}"},
+	{Source: "invoices_list", Method: "GET", Path: "/v1/invoices", Filters: []string{"allocated-cost-codes", "batch-status", "broker", "business-unit", "buyer", "customer", "due-on", "due-on-max", "due-on-min", "has-due-on", "has-invoice-date", "has-ticket-report", "having-plans-with-job-number-like", "invoice-date", "invoice-date-max", "invoice-date-min", "is-management-service-type", "material-transaction-cost-codes", "material-transaction-ticket-numbers", "not-business-unit", "seller", "status", "tender", "ticket-number"}},
+	{Source: "invoices_show", Method: "GET", Path: "/v1/invoices/{id}"},
+	{Source: "job_production_plan_alarm_subscribers_list", Method: "GET", Path: "/v1/job-production-plan-alarm-subscribers", Filters: []string{"job-production-plan-alarm", "subscriber"}},
+	{Source: "job_production_plan_alarm_subscribers_show", Method: "GET", Path: "/v1/job-production-plan-alarm-subscribers/{id}"},
+	{Source: "job_production_plan_alarms_list", Method: "GET", Path: "/v1/job-production-plan-alarms", Filters: []string{"job-production-plan"}},
+	{Source: "job_production_plan_alarms_show", Method: "GET", Path: "/v1/job-production-plan-alarms/{id}"},
+	{Source: "job_production_plan_broadcast_messages_list", Method: "GET", Path: "/v1/job-production-plan-broadcast-messages", Filters: []string{"created_by", "is_hidden", "job_production_plan"}},
+	{Source: "job_production_plan_broadcast_messages_show", Method: "GET", Path: "/v1/job-production-plan-broadcast-messages/{id}"},
+	{Source: "job_production_plan_cancellation_reason_types_list", Method: "GET", Path: "/v1/job-production-plan-cancellation-reason-types", Filters: []string{"slug"}},
+	{Source: "job_production_plan_cancellations_list", Method: "GET", Path: "/v1/job-production-plan-cancellations"},
+	{Source: "job_production_plan_cancellations_show", Method: "GET", Path: "/v1/job-production-plan-cancellations/{id}"},
+	{Source: "job_production_plan_change_sets_list", Method: "GET", Path: "/v1/job-production-plan-change-sets", Filters: []string{"broker", "change-new-material-type", "change-new-planner", "change-new-planner-nullify", "change-new-project-manager", "change-new-project-manager-nullify", "change-old-material-type", "created-by", "customer", "should-persist"}},
+	{Source: "job_production_plan_change_sets_show", Method: "GET", Path: "/v1/job-production-plan-change-sets/{id}"},
+	{Source: "job_production_plan_cost_codes_list", Method: "GET", Path: "/v1/job-production-plan-cost-codes", Filters: []string{"code", "cost_code", "description", "job_production_plan", "project_resource_classification", "q"}},
+	{Source: "job_production_plan_cost_codes_show", Method: "GET", Path: "/v1/job-production-plan-cost-codes/{id}"},
+	{Source: "job_production_plan_display_unit_of_measures_list", Method: "GET", Path: "/v1/job-production-plan-display-unit-of-measures", Filters: []string{"job-production-plan", "unit-of-measure"}},
+	{Source: "job_production_plan_display_unit_of_measures_show", Method: "GET", Path: "/v1/job-production-plan-display-unit-of-measures/{id}"},
+	{Source: "job_production_plan_duplication_works_list", Method: "GET", Path: "/v1/job-production-plan-duplication-works", Filters: []string{"job-production-plan-template-id", "processed-at"}},
+	{Source: "job_production_plan_duplication_works_show", Method: "GET", Path: "/v1/job-production-plan-duplication-works/{id}"},
+	{Source: "job_production_plan_inspectable_summaries_list", Method: "GET", Path: "/v1/job-production-plan-inspectable-summaries", Filters: []string{"developer", "job-number", "project", "start-on", "start-on-max", "start-on-min"}},
+	{Source: "job_production_plan_inspectable_summaries_show", Method: "GET", Path: "/v1/job-production-plan-inspectable-summaries/{id}"},
+	{Source: "job_production_plan_inspectors_list", Method: "GET", Path: "/v1/job-production-plan-inspectors", Filters: []string{"job-production-plan-id", "user"}},
+	{Source: "job_production_plan_inspectors_show", Method: "GET", Path: "/v1/job-production-plan-inspectors/{id}"},
+	{Source: "job_production_plan_job_site_changes_show", Method: "GET", Path: "/v1/job-production-plan-job-site-changes/{id}"},
+	{Source: "job_production_plan_job_site_location_estimates_list", Method: "GET", Path: "/v1/job-production-plan-job-site-location-estimates", Filters: []string{"job-production-plan"}},
+	{Source: "job_production_plan_job_site_location_estimates_show", Method: "GET", Path: "/v1/job-production-plan-job-site-location-estimates/{id}"},
+	{Source: "job_production_plan_locations_list", Method: "GET", Path: "/v1/job-production-plan-locations", Filters: []string{"broker-tender-job-schedule-shift", "job-production-plan", "segment"}},
+	{Source: "job_production_plan_locations_show", Method: "GET", Path: "/v1/job-production-plan-locations/{id}"},
+	{Source: "job_production_plan_material_site_changes_list", Method: "GET", Path: "/v1/job-production-plan-material-site-changes", Filters: []string{"created-at-max", "created-at-min", "updated-at-max", "updated-at-min"}},
+	{Source: "job_production_plan_material_site_changes_show", Method: "GET", Path: "/v1/job-production-plan-material-site-changes/{id}"},
+	{Source: "job_production_plan_material_sites_list", Method: "GET", Path: "/v1/job-production-plan-material-sites", Filters: []string{"is-default", "job-production-plan", "material-site", "miles-max", "miles-min"}},
+	{Source: "job_production_plan_material_sites_show", Method: "GET", Path: "/v1/job-production-plan-material-sites/{id}"},
+	{Source: "job_production_plan_material_type_quality_control_requirements_list", Method: "GET", Path: "/v1/job-production-plan-material-type-quality-control-requirements", Filters: []string{"job_production_plan_material_type", "quality_control_classification"}},
+	{Source: "job_production_plan_material_type_quality_control_requirements_show", Method: "GET", Path: "/v1/job-production-plan-material-type-quality-control-requirements/{id}"},
+	{Source: "job_production_plan_material_types_list", Method: "GET", Path: "/v1/job-production-plan-material-types", Filters: []string{"broker", "customer", "default-cost-code", "explicit-material-mix-design", "external-identification-value", "job-production-plan", "material-site", "material-supplier", "material-type", "start-on-max", "start-on-min", "status", "unit-of-measure"}},
+	{Source: "job_production_plan_material_types_show", Method: "GET", Path: "/v1/job-production-plan-material-types/{id}"},
+	{Source: "job_production_plan_project_phase_revenue_items_list", Method: "GET", Path: "/v1/job-production-plan-project-phase-revenue-items", Filters: []string{"job-production-plan", "job-production-plan-status", "project", "project-phase", "project-phase-revenue-item", "project-revenue-item"}},
+	{Source: "job_production_plan_project_phase_revenue_items_show", Method: "GET", Path: "/v1/job-production-plan-project-phase-revenue-items/{id}"},
+	{Source: "job_production_plan_recaps_list", Method: "GET", Path: "/v1/job-production-plan-recaps", Filters: []string{"created-at-max", "created-at-min", "plan", "updated-at-max", "updated-at-min"}},
+	{Source: "job_production_plan_recaps_show", Method: "GET", Path: "/v1/job-production-plan-recaps/{id}"},
+	{Source: "job_production_plan_rejections_list", Method: "GET", Path: "/v1/job-production-plan-rejections"},
+	{Source: "job_production_plan_rejections_show", Method: "GET", Path: "/v1/job-production-plan-rejections/{id}"},
+	{Source: "job_production_plan_safety_risk_communication_suggestions_list", Method: "GET", Path: "/v1/job-production-plan-safety-risk-communication-suggestions", Filters: []string{"created-at-max", "created-at-min", "job_production_plan", "updated-at-max", "updated-at-min"}},
+	{Source: "job_production_plan_safety_risk_communication_suggestions_show", Method: "GET", Path: "/v1/job-production-plan-safety-risk-communication-suggestions/{id}"},
+	{Source: "job_production_plan_safety_risks_list", Method: "GET", Path: "/v1/job-production-plan-safety-risks", Filters: []string{"job-production-plan"}},
+	{Source: "job_production_plan_safety_risks_show", Method: "GET", Path: "/v1/job-production-plan-safety-risks/{id}"},
+	{Source: "job_production_plan_safety_risks_suggestions_list", Method: "GET", Path: "/v1/job-production-plan-safety-risks-suggestions", Filters: []string{"job-production-plan"}},
+	{Source: "job_production_plan_safety_risks_suggestions_show", Method: "GET", Path: "/v1/job-production-plan-safety-risks-suggestions/{id}"},
+	{Source: "job_production_plan_schedule_change_works_list", Method: "GET", Path: "/v1/job-production-plan-schedule-change-works", Filters: []string{"broker", "created-by", "customer", "job-production-plan", "project", "time-kind"}},
+	{Source: "job_production_plan_schedule_change_works_show", Method: "GET", Path: "/v1/job-production-plan-schedule-change-works/{id}"},
+	{Source: "job_production_plan_segment_sets_list", Method: "GET", Path: "/v1/job-production-plan-segment-sets", Filters: []string{"job_production_plan"}},
+	{Source: "job_production_plan_segment_sets_show", Method: "GET", Path: "/v1/job-production-plan-segment-sets/{id}"},
+	{Source: "job_production_plan_segments_list", Method: "GET", Path: "/v1/job-production-plan-segments", Filters: []string{"job-production-plan", "material-site", "material-type"}},
+	{Source: "job_production_plan_segments_show", Method: "GET", Path: "/v1/job-production-plan-segments/{id}"},
+	{Source: "job_production_plan_service_type_unit_of_measure_cohorts_list", Method: "GET", Path: "/v1/job-production-plan-service-type-unit-of-measure-cohorts", Filters: []string{"job-production-plan", "service-type-unit-of-measure-cohort"}},
+	{Source: "job_production_plan_service_type_unit_of_measure_cohorts_show", Method: "GET", Path: "/v1/job-production-plan-service-type-unit-of-measure-cohorts/{id}"},
+	{Source: "job_production_plan_service_type_unit_of_measures_list", Method: "GET", Path: "/v1/job-production-plan-service-type-unit-of-measures", Filters: []string{"job-production-plan", "service-type-unit-of-measure"}},
+	{Source: "job_production_plan_service_type_unit_of_measures_show", Method: "GET", Path: "/v1/job-production-plan-service-type-unit-of-measures/{id}"},
+	{Source: "job_production_plan_status_changes_list", Method: "GET", Path: "/v1/job-production-plan-status-changes", Filters: []string{"created-at-max", "created-at-min", "customer", "job-production-plan", "job-production-plan-cancellation-reason-type", "start-on", "start-on-max", "start-on-min", "status", "updated-at-max", "updated-at-min"}},
+	{Source: "job_production_plan_status_changes_show", Method: "GET", Path: "/v1/job-production-plan-status-changes/{id}"},
+	{Source: "job_production_plan_subscriptions_list", Method: "GET", Path: "/v1/job-production-plan-subscriptions", Filters: []string{"contact-method", "job-production-plan", "user"}},
+	{Source: "job_production_plan_subscriptions_show", Method: "GET", Path: "/v1/job-production-plan-subscriptions/{id}"},
+	{Source: "job_production_plan_supply_demand_balances_list", Method: "GET", Path: "/v1/job-production-plan-supply-demand-balances", Filters: []string{"job-production-plan", "use-observed-supply-parameters"}},
+	{Source: "job_production_plan_supply_demand_balances_show", Method: "GET", Path: "/v1/job-production-plan-supply-demand-balances/{id}"},
+	{Source: "job_production_plan_time_card_approvers_list", Method: "GET", Path: "/v1/job-production-plan-time-card-approvers", Filters: []string{"job-production-plan", "user"}},
+	{Source: "job_production_plan_time_card_approvers_show", Method: "GET", Path: "/v1/job-production-plan-time-card-approvers/{id}"},
+	{Source: "job_production_plan_trailer_classifications_list", Method: "GET", Path: "/v1/job-production-plan-trailer-classifications", Filters: []string{"job-production-plan", "trailer-classification"}},
+	{Source: "job_production_plan_trailer_classifications_show", Method: "GET", Path: "/v1/job-production-plan-trailer-classifications/{id}"},
+	{Source: "job_production_plan_trucking_incident_detectors_list", Method: "GET", Path: "/v1/job-production-plan-trucking-incident-detectors", Filters: []string{"is-performed", "job-production-plan"}},
+	{Source: "job_production_plan_trucking_incident_detectors_show", Method: "GET", Path: "/v1/job-production-plan-trucking-incident-detectors/{id}"},
+	{Source: "job_production_plan_unabandonments_list", Method: "GET", Path: "/v1/job-production-plan-unabandonments"},
+	{Source: "job_production_plan_unabandonments_show", Method: "GET", Path: "/v1/job-production-plan-unabandonments/{id}"},
+	{Source: "job_production_plans_list", Method: "GET", Path: "/v1/job-production-plans", Filters: []string{"active-on", "broker", "broker-id", "business-unit", "checksum-difference", "checksum-difference-max", "checksum-difference-min", "contractor", "cost-code", "could-have-labor-requirements", "created-by", "customer", "default-time-card-approval-process", "default-trucker", "duplication-token", "external-identification-value", "has-checksum-difference", "has-crew-requirements", "has-equipment-requirements", "has-labor-requirements", "has-manager-assignment", "has-material-types-with-quality-control-requirements", "has-project-phase-revenue-items", "has-supply-demand-balance-cannot-compute-reasons", "is-auditing-time-card-approvals", "is-only-for-equipment-movement", "is-template", "is-using-volumetric-measurements", "job-name", "job-number", "job-site", "job-site-active-around", "material-site", "material-supplier", "material-type", "material-type-ultimate-parent-count-max", "material-type-ultimate-parent-count-min", "not-customer", "planner", "practically-start-on", "practically-start-on-between", "practically-start-on-max", "practically-start-on-min", "project", "project-manager", "q", "q-segments", "reference-data", "remaining-quantity-max", "remaining-quantity-min", "start-at-max", "start-at-min", "start-on", "start-on-max", "start-on-min", "start-time-max", "start-time-min", "status", "template", "template-name", "template-start-on-max", "template-start-on-min", "trailer-classification-or-equivalent", "trucker", "ultimate-material-types", "user-has-stake", "with-non-deletable-lineup-job-production-plans", "with-planned-tons-per-productive-segment-max", "with-planned-tons-per-productive-segment-min"}},
+	{Source: "job_production_plans_recap", Method: "GET", Path: "/v1/posts", Filters: []string{"creator", "post-type"}},
+	{Source: "job_production_plans_show", Method: "GET", Path: "/v1/job-production-plans/{id}"},
+	{Source: "job_schedule_shift_splits_list", Method: "GET", Path: "/v1/job-schedule-shift-splits", Filters: []string{"job-schedule-shift", "new-job-schedule-shift"}},
+	{Source: "job_schedule_shift_splits_show", Method: "GET", Path: "/v1/job-schedule-shift-splits/{id}"},
+	{Source: "job_schedule_shift_start_at_changes_list", Method: "GET", Path: "/v1/job-schedule-shift-start-at-changes", Filters: []string{"created-at-max", "created-at-min", "updated-at-max", "updated-at-min"}},
+	{Source: "job_schedule_shift_start_at_changes_show", Method: "GET", Path: "/v1/job-schedule-shift-start-at-changes/{id}"},
+	{Source: "job_schedule_shift_start_site_changes_list", Method: "GET", Path: "/v1/job-schedule-shift-start-site-changes", Filters: []string{"created-at-max", "created-at-min", "updated-at-max", "updated-at-min"}},
+	{Source: "job_schedule_shift_start_site_changes_show", Method: "GET", Path: "/v1/job-schedule-shift-start-site-changes/{id}"},
+	{Source: "job_schedule_shifts_list", Method: "GET", Path: "/v1/job-schedule-shifts", Filters: []string{"active-on-tender", "broker", "broker-id", "business-unit", "customer", "customer-id", "end-at-max", "end-at-min", "has-start-date", "is-cancelled", "is-end-at", "is-managed", "is-managed-or-alive", "is-start-at", "is-subsequent-shift-in-driver-day", "job", "job-production-plan-status", "matches-material-purchase-order-release", "on-accepted-broker-tender", "on-accepted-customer-tender", "ordered", "related-to-trucker-through-accepted-tender", "start-at-max", "start-at-min", "start-date", "start-date-max", "start-date-min", "unsourced"}},
+	{Source: "job_schedule_shifts_show", Method: "GET", Path: "/v1/job-schedule-shifts/{id}"},
+	{Source: "job_site_times_list", Method: "GET", Path: "/v1/job-site-times", Filters: []string{"created_at_max", "created_at_min", "end_at_max", "end_at_min", "is_created_at", "is_end_at", "is_start_at", "is_updated_at", "job_production_plan", "start_at_max", "start_at_min", "time_card", "updated_at_max", "updated_at_min", "user"}},
+	{Source: "job_site_times_show", Method: "GET", Path: "/v1/job-site-times/{id}"},
+	{Source: "job_sites_list", Method: "GET", Path: "/v1/job-sites", Filters: []string{"active-since", "address-near", "broker", "broker-id", "customer", "external-identification-value", "external-job-number", "has-material-site", "is-active", "is-stockpiling", "material-site", "name", "name-like", "q"}},
+	{Source: "jobs_list", Method: "GET", Path: "/v1/jobs", Filters: []string{"broker", "customer", "external-identification-value", "external-job-number", "job-production-plan", "job-site", "offered", "start-at-max", "start-at-min", "start-date", "trucker"}},
+	{Source: "jobs_show", Method: "GET", Path: "/v1/jobs/{id}"},
+	{Source: "keep_truckin_users_list", Method: "GET", Path: "/v1/keep-truckin-users", Filters: []string{"active", "assigned_at_max", "assigned_at_min", "broker", "has_user", "is_user_set_at", "role", "user", "user_set_at_max", "user_set_at_min"}},
+	{Source: "keep_truckin_users_show", Method: "GET", Path: "/v1/keep-truckin-users/{id}"},
+	{Source: "keep_truckin_vehicles_list", Method: "GET", Path: "/v1/keep-truckin-vehicles", Filters: []string{"active", "assigned_at_min", "broker", "created_at_max", "created_at_min", "has_tractor", "has_trailer", "integration_identifier", "is_created_at", "is_tractor_set_at", "is_trailer_set_at", "is_updated_at", "tractor", "tractor_set_at_max", "tractor_set_at_min", "trailer", "trailer_set_at_max", "trailer_set_at_min", "trucker", "trucker_id", "updated_at_max", "updated_at_min"}},
+	{Source: "keep_truckin_vehicles_show", Method: "GET", Path: "/v1/keep-truckin-vehicles/{id}"},
+	{Source: "key_result_changes_list", Method: "GET", Path: "/v1/key-result-changes", Filters: []string{"broker", "changed-by", "created-at-max", "created-at-min", "key-result", "objective", "organization", "updated-at-max", "updated-at-min"}},
+	{Source: "key_result_changes_show", Method: "GET", Path: "/v1/key-result-changes/{id}"},
+	{Source: "key_result_scrappages_list", Method: "GET", Path: "/v1/key-result-scrappages"},
+	{Source: "key_result_scrappages_show", Method: "GET", Path: "/v1/key-result-scrappages/{id}"},
+	{Source: "key_result_status_changes_list", Method: "GET", Path: "/v1/key-result-status-changes", Filters: []string{"key-result", "status"}},
+	{Source: "key_result_status_changes_show", Method: "GET", Path: "/v1/key-result-status-changes/{id}"},
+	{Source: "key_results_list", Method: "GET", Path: "/v1/key-results", Filters: []string{"customer-success-responsible-person", "has-customer-success-responsible-person", "is-template", "objective", "owner", "status"}},
+	{Source: "key_results_show", Method: "GET", Path: "/v1/key-results/{id}"},
+	{Source: "labor_classifications_list", Method: "GET", Path: "/v1/labor-classifications", Filters: []string{"abbreviation", "name"}},
+	{Source: "labor_requirements_list", Method: "GET", Path: "/v1/labor-requirements", Filters: []string{"broker", "calculated-mobilization-method", "customer", "end-at-effective-max", "end-at-effective-min", "end-at-max", "end-at-min", "has-resource", "is-assignment-candidate-for", "is-end-at", "is-expecting-time-sheet", "is-only-for-equipment-movement", "is-start-at", "job-production-plan", "job-production-plan-status", "labor-requirement", "labor-requirement-laborer", "labor-requirement-laborer-id", "labor-requirement-user", "labor-requirement-user-id", "laborer-user", "not-resource-classification-type", "not-resource-type", "project", "project-manager", "requires-inbound-movement", "requires-outbound-movement", "resource", "resource-classification", "resource-classification-type", "resource-type", "start-at-effective-max", "start-at-effective-min", "start-at-max", "start-at-min", "start-on-effective-max", "start-on-effective-min", "without-approved-time-sheet", "without-submitted-time-sheet"}},
+	{Source: "labor_requirements_show", Method: "GET", Path: "/v1/labor-requirements/{id}"},
+	{Source: "laborers_list", Method: "GET", Path: "/v1/laborers", Filters: []string{"business_units", "by_organization", "craft_class", "is_active", "labor_classification", "missing_craft_class", "q", "user"}},
+	{Source: "languages_list", Method: "GET", Path: "/v1/languages", Filters: []string{"code"}},
+	{Source: "lehman_roberts_apex_viewpoint_ticket_exports_list", Method: "GET", Path: "/v1/lehman-roberts-apex-viewpoint-ticket-exports"},
+	{Source: "liability_incidents_list", Method: "GET", Path: "/v1/liability-incidents", Filters: []string{"action_items", "assignee", "broker", "contractor", "created_by", "customer", "developer", "did_stop_work", "end_at_max", "end_at_min", "equipment", "has_equipment", "has_live_action_items", "has_parent", "incident_tag", "incident_tag_slug", "job_number", "job_production_plan", "job_production_plan_project", "kind", "material_site", "material_supplier", "natures", "net_impact_dollars_max", "net_impact_dollars_min", "net_impact_minutes_max", "net_impact_minutes_min", "notifiable_to", "parent", "q", "responsible_person", "root_causes", "severity", "start_at_max", "start_at_min", "start_on", "start_on_max", "start_on_min", "status", "subject", "subject_type", "tender_job_schedule_shift", "tender_job_schedule_shift_driver", "tender_job_schedule_shift_trucker", "trucker", "user_has_stake", "zero_incident_tags"}},
+	{Source: "liability_incidents_show", Method: "GET", Path: "/v1/liability-incidents/{id}"},
+	{Source: "lineup_dispatch_shifts_list", Method: "GET", Path: "/v1/lineup-dispatch-shifts", Filters: []string{"created-at-max", "created-at-min", "lineup-dispatch", "lineup-job-schedule-shift", "updated-at-max", "updated-at-min"}},
+	{Source: "lineup_dispatch_shifts_show", Method: "GET", Path: "/v1/lineup-dispatch-shifts/{id}"},
+	{Source: "lineup_dispatches_list", Method: "GET", Path: "/v1/lineup-dispatches", Filters: []string{"created-at-max", "created-at-min", "lineup", "updated-at-max", "updated-at-min"}},
+	{Source: "lineup_dispatches_show", Method: "GET", Path: "/v1/lineup-dispatches/{id}"},
+	{Source: "lineup_job_production_plans_list", Method: "GET", Path: "/v1/lineup-job-production-plans", Filters: []string{"job-production-plan", "lineup"}},
+	{Source: "lineup_job_production_plans_show", Method: "GET", Path: "/v1/lineup-job-production-plans/{id}"},
+	{Source: "lineup_job_schedule_shift_trucker_assignment_recommendations_list", Method: "GET", Path: "/v1/lineup-job-schedule-shift-trucker-assignment-recommendations", Filters: []string{"created-at-max", "created-at-min", "lineup-job-schedule-shift", "updated-at-max", "updated-at-min"}},
+	{Source: "lineup_job_schedule_shift_trucker_assignment_recommendations_show", Method: "GET", Path: "/v1/lineup-job-schedule-shift-trucker-assignment-recommendations/{id}"},
+	{Source: "lineup_job_schedule_shifts_list", Method: "GET", Path: "/v1/lineup-job-schedule-shifts", Filters: []string{"is-ready-to-dispatch", "job-schedule-shift", "lineup", "trailer-classification", "trailer-classification-equivalent-type", "trucker", "without-lineup-dispatch-shift"}},
+	{Source: "lineup_job_schedule_shifts_show", Method: "GET", Path: "/v1/lineup-job-schedule-shifts/{id}"},
+	{Source: "lineup_scenario_generators_list", Method: "GET", Path: "/v1/lineup-scenario-generators", Filters: []string{"broker", "completed-at-max", "completed-at-min", "date", "date-max", "date-min", "window"}},
+	{Source: "lineup_scenario_generators_show", Method: "GET", Path: "/v1/lineup-scenario-generators/{id}"},
+	{Source: "lineup_scenario_lineup_job_schedule_shifts_list", Method: "GET", Path: "/v1/lineup-scenario-lineup-job-schedule-shifts", Filters: []string{"lineup-job-schedule-shift", "lineup-scenario"}},
+	{Source: "lineup_scenario_lineup_job_schedule_shifts_show", Method: "GET", Path: "/v1/lineup-scenario-lineup-job-schedule-shifts/{id}"},
+	{Source: "lineup_scenario_lineups_list", Method: "GET", Path: "/v1/lineup-scenario-lineups", Filters: []string{"lineup", "lineup-scenario"}},
+	{Source: "lineup_scenario_lineups_show", Method: "GET", Path: "/v1/lineup-scenario-lineups/{id}"},
+	{Source: "lineup_scenario_solutions_list", Method: "GET", Path: "/v1/lineup-scenario-solutions", Filters: []string{"lineup-scenario"}},
+	{Source: "lineup_scenario_solutions_show", Method: "GET", Path: "/v1/lineup-scenario-solutions/{id}"},
+	{Source: "lineup_scenario_trailer_lineup_job_schedule_shifts_list", Method: "GET", Path: "/v1/lineup-scenario-trailer-lineup-job-schedule-shifts", Filters: []string{"lineup-scenario-lineup-job-schedule-shift", "lineup-scenario-trailer"}},
+	{Source: "lineup_scenario_trailer_lineup_job_schedule_shifts_show", Method: "GET", Path: "/v1/lineup-scenario-trailer-lineup-job-schedule-shifts/{id}"},
+	{Source: "lineup_scenario_trailers_list", Method: "GET", Path: "/v1/lineup-scenario-trailers", Filters: []string{"has-last-assigned-on", "last-assigned-on", "last-assigned-on-max", "last-assigned-on-min", "lineup-scenario", "lineup-scenario-id", "lineup-scenario-trucker", "trailer", "trucker", "trucker-id"}},
+	{Source: "lineup_scenario_trailers_show", Method: "GET", Path: "/v1/lineup-scenario-trailers/{id}"},
+	{Source: "lineup_scenario_truckers_list", Method: "GET", Path: "/v1/lineup-scenario-truckers", Filters: []string{"lineup-scenario", "trucker"}},
+	{Source: "lineup_scenario_truckers_show", Method: "GET", Path: "/v1/lineup-scenario-truckers/{id}"},
+	{Source: "lineup_scenarios_list", Method: "GET", Path: "/v1/lineup-scenarios", Filters: []string{"broker", "date", "date-max", "date-min", "generator", "window"}},
+	{Source: "lineup_scenarios_show", Method: "GET", Path: "/v1/lineup-scenarios/{id}"},
+	{Source: "lineup_summary_requests_list", Method: "GET", Path: "/v1/lineup-summary-requests", Filters: []string{"created-at-max", "created-at-min", "updated-at-max", "updated-at-min"}},
+	{Source: "lineup_summary_requests_show", Method: "GET", Path: "/v1/lineup-summary-requests/{id}"},
+	{Source: "lineups_list", Method: "GET", Path: "/v1/lineups", Filters: []string{"broker", "customer", "name_like", "start_at_max", "start_at_min"}},
+	{Source: "lineups_show", Method: "GET", Path: "/v1/lineups/{id}"},
+	{Source: "lowest_losing_bid_prediction_subject_details_list", Method: "GET", Path: "/v1/lowest-losing-bid-prediction-subject-details", Filters: []string{"bidder", "prediction_subject"}},
+	{Source: "lowest_losing_bid_prediction_subject_details_show", Method: "GET", Path: "/v1/lowest-losing-bid-prediction-subject-details/{id}"},
+	{Source: "maintenance_requirement_maintenance_requirement_parts_list", Method: "GET", Path: "/v1/maintenance-requirement-maintenance-requirement-parts", Filters: []string{"maintenance-requirement", "maintenance-requirement-part"}},
+	{Source: "maintenance_requirement_maintenance_requirement_parts_show", Method: "GET", Path: "/v1/maintenance-requirement-maintenance-requirement-parts/{id}"},
+	{Source: "maintenance_requirement_parts_list", Method: "GET", Path: "/v1/maintenance-requirement-parts", Filters: []string{"broker", "equipment_classification", "is_template", "maintenance_requirements", "make", "model", "year"}},
+	{Source: "maintenance_requirement_parts_show", Method: "GET", Path: "/v1/maintenance-requirement-parts/{id}"},
+	{Source: "maintenance_requirement_rule_maintenance_requirement_sets_list", Method: "GET", Path: "/v1/maintenance-requirement-rule-maintenance-requirement-sets", Filters: []string{"maintenance-requirement-rule", "maintenance-requirement-set"}},
+	{Source: "maintenance_requirement_rule_maintenance_requirement_sets_show", Method: "GET", Path: "/v1/maintenance-requirement-rule-maintenance-requirement-sets/{id}"},
+	{Source: "maintenance_requirement_rules_list", Method: "GET", Path: "/v1/maintenance-requirement-rules", Filters: []string{"broker", "business_unit", "equipment", "equipment_classification", "is_active"}},
+	{Source: "maintenance_requirement_rules_show", Method: "GET", Path: "/v1/maintenance-requirement-rules/{id}"},
+	{Source: "maintenance_requirement_set_maintenance_requirements_list", Method: "GET", Path: "/v1/maintenance-requirement-set-maintenance-requirements", Filters: []string{"maintenance-requirement", "maintenance-requirement-set"}},
+	{Source: "maintenance_requirement_set_maintenance_requirements_show", Method: "GET", Path: "/v1/maintenance-requirement-set-maintenance-requirements/{id}"},
+	{Source: "maintenance_requirement_sets_list", Method: "GET", Path: "/v1/maintenance-requirement-sets", Filters: []string{"broker", "completed-at-max", "completed-at-min", "equipment", "equipment_business_unit", "equipment_classification", "is-archived", "is-template", "maintenance-type", "q", "status"}},
+	{Source: "maintenance_requirement_sets_show", Method: "GET", Path: "/v1/maintenance-requirement-sets/{id}"},
+	{Source: "marketing_metrics_common", Method: "POST", Path: "/v1/marketing-metrics"},
+	{Source: "material_mix_designs_list", Method: "GET", Path: "/v1/material-mix-designs", Filters: []string{"as_of", "broker", "description", "description_like", "end_at_max", "end_at_min", "material_supplier", "material_type", "start_at_max", "start_at_min"}},
+	{Source: "material_purchase_order_release_redemptions_list", Method: "GET", Path: "/v1/material-purchase-order-release-redemptions", Filters: []string{"broker", "broker-id", "driver", "driver-id", "material-supplier", "material-supplier-id", "material-transaction", "material-type", "material-type-id", "purchase-order", "purchase-order-id", "release", "tender-job-schedule-shift", "tender-job-schedule-shift-id", "ticket-number", "trucker", "trucker-id"}},
+	{Source: "material_purchase_order_release_redemptions_show", Method: "GET", Path: "/v1/material-purchase-order-release-redemptions/{id}"},
+	{Source: "material_purchase_order_releases_list", Method: "GET", Path: "/v1/material-purchase-order-releases", Filters: []string{"active", "broker", "customer", "is-assigned", "job-schedule-shift", "material-supplier", "not-active", "purchase-order", "quantity", "status", "tender-job-schedule-shift", "token", "trucker", "valid-for-customer"}},
+	{Source: "material_purchase_order_releases_show", Method: "GET", Path: "/v1/material-purchase-order-releases/{id}"},
+	{Source: "material_purchase_orders_list", Method: "GET", Path: "/v1/material-purchase-orders", Filters: []string{"base-material-type", "broker", "customer", "external-identification-value", "external-purchase-order-id", "external-sales-order-id", "job-site", "material-site", "material-supplier", "material-type", "quantity", "status", "transaction-at-max", "transaction-at-min", "unit-of-measure"}},
+	{Source: "material_purchase_orders_show", Method: "GET", Path: "/v1/material-purchase-orders/{id}"},
+	{Source: "material_site_inventory_locations_list", Method: "GET", Path: "/v1/material-site-inventory-locations", Filters: []string{"broker", "broker_id", "material_site", "material_supplier", "material_supplier_id", "qualified_name", "unit_of_measure"}},
+	{Source: "material_site_inventory_locations_show", Method: "GET", Path: "/v1/material-site-inventory-locations/{id}"},
+	{Source: "material_site_measures_list", Method: "GET", Path: "/v1/material-site-measures", Filters: []string{"slug"}},
+	{Source: "material_site_measures_show", Method: "GET", Path: "/v1/material-site-measures/{id}"},
+	{Source: "material_site_mixing_lots_list", Method: "GET", Path: "/v1/material-site-mixing-lots", Filters: []string{"ac-temperature-avg", "ac-tons-per-hour-avg", "agg-tons-per-hour-avg", "broker", "end-at", "material-site", "material-site-reading-material-type", "material-supplier", "material-supplier-id", "material-type", "material-type-id", "start-at", "start-on-cached", "temperature-avg", "tons-per-hour-avg"}},
+	{Source: "material_site_mixing_lots_show", Method: "GET", Path: "/v1/material-site-mixing-lots/{id}"},
+	{Source: "material_site_reading_material_types_list", Method: "GET", Path: "/v1/material-site-reading-material-types", Filters: []string{"external-id", "material-site", "material-type"}},
+	{Source: "material_site_reading_material_types_show", Method: "GET", Path: "/v1/material-site-reading-material-types/{id}"},
+	{Source: "material_site_readings_list", Method: "GET", Path: "/v1/material-site-readings", Filters: []string{"broker", "material-site", "material-site-measure", "material-site-reading-material-type", "material-type", "material-type-id", "reading-at", "reading-at-max", "reading-at-min", "value", "value-max", "value-min"}},
+	{Source: "material_site_readings_show", Method: "GET", Path: "/v1/material-site-readings/{id}"},
+	{Source: "material_site_subscriptions_list", Method: "GET", Path: "/v1/material-site-subscriptions", Filters: []string{"contact_method", "material_site", "user"}},
+	{Source: "material_site_subscriptions_show", Method: "GET", Path: "/v1/material-site-subscriptions/{id}"},
+	{Source: "material_site_unavailabilities_list", Method: "GET", Path: "/v1/material-site-unavailabilities", Filters: []string{"created_at_max", "created_at_min", "end_at_max", "end_at_min", "is_created_at", "is_end_at", "is_start_at", "is_updated_at", "material_site", "start_at_max", "start_at_min", "updated_at_max", "updated_at_min"}},
+	{Source: "material_site_unavailabilities_show", Method: "GET", Path: "/v1/material-site-unavailabilities/{id}"},
+	{Source: "material_sites_list", Method: "GET", Path: "/v1/material-sites", Filters: []string{"active-around", "address-near", "broker", "has-activity-as-of", "is-active-effective", "is-available-at", "is-broker-active", "is-only-for-equipment-movement", "is-ticket-maker", "material-supplier", "material-transaction-integration", "material-types", "name", "operating-status", "parent", "q"}},
+	{Source: "material_supplier_memberships_list", Method: "GET", Path: "/v1/material-supplier-memberships", Filters: []string{"broker", "drives_shift_type", "external_employee_id", "is_default_job_production_plan_subscriber", "is_equipment_rental_team_member", "is_geofence_violation_team_member", "is_rate_editor", "is_time_card_auditor", "is_unapproved_time_card_subscriber", "kind", "organization", "project_office", "q", "user"}},
+	{Source: "material_supplier_memberships_show", Method: "GET", Path: "/v1/material-supplier-memberships/{id}"},
+	{Source: "material_suppliers_list", Method: "GET", Path: "/v1/material-suppliers", Filters: []string{"broker", "is-broker-active", "is_active", "name"}},
+	{Source: "material_transaction_cost_code_allocations_list", Method: "GET", Path: "/v1/material-transaction-cost-code-allocations", Filters: []string{"created-at-max", "created-at-min", "material-transaction", "updated-at-max", "updated-at-min"}},
+	{Source: "material_transaction_cost_code_allocations_show", Method: "GET", Path: "/v1/material-transaction-cost-code-allocations/{id}"},
+	{Source: "material_transaction_diversions_list", Method: "GET", Path: "/v1/material-transaction-diversions", Filters: []string{"broker", "broker-id", "created-by", "has-new-delivery-date", "material-transaction", "new-delivery-date", "new-delivery-date-max", "new-delivery-date-min"}},
+	{Source: "material_transaction_diversions_show", Method: "GET", Path: "/v1/material-transaction-diversions/{id}"},
+	{Source: "material_transaction_field_scopes_list", Method: "GET", Path: "/v1/material-transaction-field-scopes"},
+	{Source: "material_transaction_field_scopes_show", Method: "GET", Path: "/v1/material-transaction-field-scopes/{id}"},
+	{Source: "material_transaction_inspection_rejections_list", Method: "GET", Path: "/v1/material-transaction-inspection-rejections", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "material_transaction_inspection", "updated_at_max", "updated_at_min"}},
+	{Source: "material_transaction_inspection_rejections_show", Method: "GET", Path: "/v1/material-transaction-inspection-rejections/{id}"},
+	{Source: "material_transaction_inspections_list", Method: "GET", Path: "/v1/material-transaction-inspections", Filters: []string{"broker", "broker_id", "changed_by", "customer", "customer_id", "delivery_site", "job_production_plan", "job_production_plan_id", "material_supplier", "material_supplier_id", "material_transaction", "status", "strategy", "tender_job_schedule_shift", "tender_job_schedule_shift_id", "trip", "trip_id"}},
+	{Source: "material_transaction_inspections_show", Method: "GET", Path: "/v1/material-transaction-inspections/{id}"},
+	{Source: "material_transaction_preloads_list", Method: "GET", Path: "/v1/material-transaction-preloads", Filters: []string{"material-transaction", "preloaded-at-max", "preloaded-at-min", "trailer"}},
+	{Source: "material_transaction_preloads_show", Method: "GET", Path: "/v1/material-transaction-preloads/{id}"},
+	{Source: "material_transaction_rejections_list", Method: "GET", Path: "/v1/material-transaction-rejections"},
+	{Source: "material_transaction_rejections_show", Method: "GET", Path: "/v1/material-transaction-rejections/{id}"},
+	{Source: "material_transaction_shift_assignments_list", Method: "GET", Path: "/v1/material-transaction-shift-assignments", Filters: []string{"broker", "is-processed", "job-production-plan", "material-transactions", "tender-job-schedule-shift", "trucker"}},
+	{Source: "material_transaction_shift_assignments_show", Method: "GET", Path: "/v1/material-transaction-shift-assignments/{id}"},
+	{Source: "material_transaction_status_changes_list", Method: "GET", Path: "/v1/material-transaction-status-changes", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "material_transaction", "status", "updated_at_max", "updated_at_min"}},
+	{Source: "material_transaction_status_changes_show", Method: "GET", Path: "/v1/material-transaction-status-changes/{id}"},
+	{Source: "material_transaction_submissions_list", Method: "GET", Path: "/v1/material-transaction-submissions"},
+	{Source: "material_transaction_submissions_show", Method: "GET", Path: "/v1/material-transaction-submissions/{id}"},
+	{Source: "material_transaction_ticket_generators_list", Method: "GET", Path: "/v1/material-transaction-ticket-generators", Filters: []string{"broker", "organization", "organization_type"}},
+	{Source: "material_transaction_ticket_generators_show", Method: "GET", Path: "/v1/material-transaction-ticket-generators/{id}"},
+	{Source: "material_transactions_exports_list", Method: "GET", Path: "/v1/material-transactions-exports", Filters: []string{"broker", "created_by", "material_transactions", "not_organization_type", "organization", "organization_formatter", "organization_id", "organization_type", "status"}},
+	{Source: "material_transactions_exports_show", Method: "GET", Path: "/v1/material-transactions-exports/{id}"},
+	{Source: "material_transactions_list", Method: "GET", Path: "/v1/material-transactions", Filters: []string{"broker", "business_unit", "customer", "explicit_confirmation_of_match_accuracy", "from_import_source", "has_material_mix_design", "has_shift", "invoice", "is_plan_driver_expecting_material_transaction_inspection", "is_voided", "job_or_sales_customer", "job_production_plan", "job_site", "likely_job_production_plan", "material_mix_design", "material_site", "material_supplier", "material_type", "material_type_hierarchy_like", "material_type_not_ultimate_parent_material_type", "material_type_ultimate_parent_material_type", "missing_required_mix_design", "not_from_import_source", "project", "q", "raw_hauler_type", "raw_is_millings", "raw_is_voided", "raw_job_number", "raw_material_id", "raw_truck_name", "raw_trucker_name", "sales_customer", "shift_job_number", "shift_truck_number", "source", "source_type", "status", "tender_job_schedule_shift", "ticket_number", "transaction_date", "trucker", "trucker_shift_set"}},
+	{Source: "material_transactions_show", Method: "GET", Path: "/v1/material-transactions/{id}"},
+	{Source: "material_type_conversions_list", Method: "GET", Path: "/v1/material-type-conversions", Filters: []string{"foreign-material-site", "foreign-material-supplier", "foreign-material-type", "material-site", "material-supplier", "material-type"}},
+	{Source: "material_type_conversions_show", Method: "GET", Path: "/v1/material-type-conversions/{id}"},
+	{Source: "material_type_material_site_inventory_locations_list", Method: "GET", Path: "/v1/material-type-material-site-inventory-locations", Filters: []string{"material-site-inventory-location", "material-type"}},
+	{Source: "material_type_material_site_inventory_locations_show", Method: "GET", Path: "/v1/material-type-material-site-inventory-locations/{id}"},
+	{Source: "material_type_unavailabilities_list", Method: "GET", Path: "/v1/material-type-unavailabilities", Filters: []string{"end_at_max", "end_at_min", "material_type", "start_at_max", "start_at_min"}},
+	{Source: "material_type_unavailabilities_show", Method: "GET", Path: "/v1/material-type-unavailabilities/{id}"},
+	{Source: "material_types_list", Method: "GET", Path: "/v1/material-types", Filters: []string{"broker", "explicit-display-name", "for-material-suppliers", "has-inventory-estimates", "has-material-supplier", "hierarchy-like", "is-archived", "is-available-at", "is-soft-deleted", "material-sites", "material-sub-types", "material-supplier", "missing-required-mix-design", "mix-design-description-like", "name", "parent-material-type", "parent-material-type-id", "q", "requiring-mix-design", "ultimate-parent-material-type", "ultimate-parent-qualified-name"}},
+	{Source: "material_unit_of_measure_quantities_list", Method: "GET", Path: "/v1/material-unit-of-measure-quantities", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "material_unit_of_measure_quantities_show", Method: "GET", Path: "/v1/material-unit-of-measure-quantities/{id}"},
+	{Source: "mechanic_user_associations_list", Method: "GET", Path: "/v1/mechanic-user-associations", Filters: []string{"maintenance-requirement", "user"}},
+	{Source: "mechanic_user_associations_show", Method: "GET", Path: "/v1/mechanic-user-associations/{id}"},
+	{Source: "meeting_attendees_list", Method: "GET", Path: "/v1/meeting-attendees", Filters: []string{"is-presence-required", "is-present", "location-kind", "meeting", "user"}},
+	{Source: "meeting_attendees_show", Method: "GET", Path: "/v1/meeting-attendees/{id}"},
+	{Source: "meetings_list", Method: "GET", Path: "/v1/meetings", Filters: []string{"attendee", "broker", "created_at_max", "created_at_min", "end_at_max", "end_at_min", "is_created_at", "is_end_at", "is_safety_meeting", "is_start_at", "is_updated_at", "organization", "organizer", "start_at_max", "start_at_min", "updated_at_max", "updated_at_min"}},
+	{Source: "meetings_show", Method: "GET", Path: "/v1/meetings/{id}"},
+	{Source: "memberships_list", Method: "GET", Path: "/v1/memberships", Filters: []string{"broker", "drives_shift_type", "external_employee_id", "is_default_job_production_plan_subscriber", "is_equipment_rental_team_member", "is_geofence_violation_team_member", "is_rate_editor", "is_time_card_auditor", "is_unapproved_time_card_subscriber", "kind", "organization", "project_office", "q", "user"}},
+	{Source: "memberships_show", Method: "GET", Path: "/v1/memberships/{id}"},
+	{Source: "missing_rates_list", Method: "GET", Path: "/v1/missing-rates", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "missing_rates_show", Method: "GET", Path: "/v1/missing-rates/{id}"},
+	{Source: "native_app_releases_list", Method: "GET", Path: "/v1/native-app-releases", Filters: []string{"build-number", "git-sha", "git-tag", "release-channel", "release-status"}},
+	{Source: "native_app_releases_show", Method: "GET", Path: "/v1/native-app-releases/{id}"},
+	{Source: "newsletters_list", Method: "GET", Path: "/v1/newsletters", Filters: []string{"broker-id", "has-organization", "has-published-on", "is-public", "is-published", "organization", "organization-type", "published-on", "published-on-max", "published-on-min", "q"}},
+	{Source: "newsletters_show", Method: "GET", Path: "/v1/newsletters/{id}"},
+	{Source: "notification_delivery_decisions_list", Method: "GET", Path: "/v1/notification-delivery-decisions", Filters: []string{"notification", "notification-user"}},
+	{Source: "notification_delivery_decisions_show", Method: "GET", Path: "/v1/notification-delivery-decisions/{id}"},
+	{Source: "notification_subscriptions_list", Method: "GET", Path: "/v1/notification-subscriptions", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "notification_subscriptions_show", Method: "GET", Path: "/v1/notification-subscriptions/{id}"},
+	{Source: "notifications_list", Method: "GET", Path: "/v1/notifications", Filters: []string{"created-at-max", "created-at-min", "deliver-at", "deliver-at-max", "deliver-at-min", "delivery-decision-approach", "is-created-at", "is-ready-for-delivery", "is-updated-at", "notification-type", "read", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "notifications_show", Method: "GET", Path: "/v1/notifications/{id}"},
+	{Source: "objective_changes_list", Method: "GET", Path: "/v1/objective-changes", Filters: []string{"broker", "changed_by", "objective", "organization", "organization_id", "organization_type"}},
+	{Source: "objective_changes_show", Method: "GET", Path: "/v1/objective-changes/{id}"},
+	{Source: "objective_stakeholder_classification_quotes_list", Method: "GET", Path: "/v1/objective-stakeholder-classification-quotes", Filters: []string{"interest_degree_max", "interest_degree_min", "objective_stakeholder_classification"}},
+	{Source: "objective_stakeholder_classification_quotes_show", Method: "GET", Path: "/v1/objective-stakeholder-classification-quotes/{id}"},
+	{Source: "objective_stakeholder_classifications_list", Method: "GET", Path: "/v1/objective-stakeholder-classifications", Filters: []string{"interest-degree", "objective", "stakeholder-classification"}},
+	{Source: "objective_stakeholder_classifications_show", Method: "GET", Path: "/v1/objective-stakeholder-classifications/{id}"},
+	{Source: "objectives_list", Method: "GET", Path: "/v1/objectives", Filters: []string{"commitment", "end-on", "end-on-max", "end-on-min", "has-sales-responsible-person", "is-template", "name", "organization", "organization_id", "organization_type", "owner", "project", "sales-responsible-person", "slug", "start-on", "start-on-max", "start-on-min", "status", "template-scope", "without-customer-success-responsible-person"}},
+	{Source: "objectives_show", Method: "GET", Path: "/v1/objectives/{id}"},
+	{Source: "one_step_gps_vehicles_list", Method: "GET", Path: "/v1/one-step-gps-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "one_step_gps_vehicles_show", Method: "GET", Path: "/v1/one-step-gps-vehicles/{id}"},
+	{Source: "open_ai_realtime_sessions_list", Method: "GET", Path: "/v1/open-ai-realtime-sessions", Filters: []string{"client_feature", "client_secret_expires_at_max", "client_secret_expires_at_min", "created_at_max", "created_at_min", "is_client_secret_expires_at", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min", "user"}},
+	{Source: "open_ai_realtime_sessions_show", Method: "GET", Path: "/v1/open-ai-realtime-sessions/{id}"},
+	{Source: "open_ai_vector_stores_list", Method: "GET", Path: "/v1/open-ai-vector-stores", Filters: []string{"not_scope_type", "purpose", "scope", "scope_type"}},
+	{Source: "open_ai_vector_stores_show", Method: "GET", Path: "/v1/open-ai-vector-stores/{id}"},
+	{Source: "open_door_issues_list", Method: "GET", Path: "/v1/open-door-issues", Filters: []string{"created-at-max", "created-at-min", "organization", "organization-id", "organization-type", "updated-at-max", "updated-at-min"}},
+	{Source: "open_door_issues_show", Method: "GET", Path: "/v1/open-door-issues/{id}"},
+	{Source: "open_door_team_memberships_list", Method: "GET", Path: "/v1/open-door-team-memberships", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "membership", "organization", "updated-at-max", "updated-at-min"}},
+	{Source: "open_door_team_memberships_show", Method: "GET", Path: "/v1/open-door-team-memberships/{id}"},
+	{Source: "organization_formatters_list", Method: "GET", Path: "/v1/organization-formatters", Filters: []string{"formatter-type", "organization", "status"}},
+	{Source: "organization_formatters_show", Method: "GET", Path: "/v1/organization-formatters/{id}"},
+	{Source: "organization_invoices_batch_files_list", Method: "GET", Path: "/v1/organization-invoices-batch-files", Filters: []string{"organization-invoices-batch"}},
+	{Source: "organization_invoices_batch_files_show", Method: "GET", Path: "/v1/organization-invoices-batch-files/{id}"},
+	{Source: "organization_invoices_batch_invoice_batchings_list", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-batchings"},
+	{Source: "organization_invoices_batch_invoice_batchings_show", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-batchings/{id}"},
+	{Source: "organization_invoices_batch_invoice_failures_list", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-failures"},
+	{Source: "organization_invoices_batch_invoice_failures_show", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-failures/{id}"},
+	{Source: "organization_invoices_batch_invoice_status_changes_list", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-status-changes", Filters: []string{"organization-invoices-batch-invoice", "status"}},
+	{Source: "organization_invoices_batch_invoice_status_changes_show", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-status-changes/{id}"},
+	{Source: "organization_invoices_batch_invoice_unbatchings_list", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-unbatchings"},
+	{Source: "organization_invoices_batch_invoice_unbatchings_show", Method: "GET", Path: "/v1/organization-invoices-batch-invoice-unbatchings/{id}"},
+	{Source: "organization_invoices_batch_invoices_list", Method: "GET", Path: "/v1/organization-invoices-batch-invoices", Filters: []string{"changed-by", "created-by", "invoice", "invoice-id", "organization", "organization-id", "organization-invoices-batch", "organization-type", "successful"}},
+	{Source: "organization_invoices_batch_invoices_show", Method: "GET", Path: "/v1/organization-invoices-batch-invoices/{id}"},
+	{Source: "organization_invoices_batch_pdf_files_list", Method: "GET", Path: "/v1/organization-invoices-batch-pdf-files", Filters: []string{"created-at-max", "created-at-min", "invoice-revision", "pdf-generation", "status"}},
+	{Source: "organization_invoices_batch_pdf_files_show", Method: "GET", Path: "/v1/organization-invoices-batch-pdf-files/{id}"},
+	{Source: "organization_invoices_batch_pdf_generations_list", Method: "GET", Path: "/v1/organization-invoices-batch-pdf-generations", Filters: []string{"created-at-max", "created-at-min", "created-by", "organization-invoices-batch", "status"}},
+	{Source: "organization_invoices_batch_pdf_generations_show", Method: "GET", Path: "/v1/organization-invoices-batch-pdf-generations/{id}"},
+	{Source: "organization_invoices_batch_pdf_templates_list", Method: "GET", Path: "/v1/organization-invoices-batch-pdf-templates", Filters: []string{"broker", "created-at-max", "created-at-min", "created-by", "is-active", "is-created-at", "is-global", "organization", "organization-id", "organization-type"}},
+	{Source: "organization_invoices_batch_pdf_templates_show", Method: "GET", Path: "/v1/organization-invoices-batch-pdf-templates/{id}"},
+	{Source: "organization_invoices_batch_processes_list", Method: "GET", Path: "/v1/organization-invoices-batch-processes"},
+	{Source: "organization_invoices_batch_processes_show", Method: "GET", Path: "/v1/organization-invoices-batch-processes/{id}"},
+	{Source: "organization_invoices_batch_status_changes_list", Method: "GET", Path: "/v1/organization-invoices-batch-status-changes", Filters: []string{"organization-invoices-batch", "status"}},
+	{Source: "organization_invoices_batch_status_changes_show", Method: "GET", Path: "/v1/organization-invoices-batch-status-changes/{id}"},
+	{Source: "organization_invoices_batches_list", Method: "GET", Path: "/v1/organization-invoices-batches", Filters: []string{"broker", "changed-by", "created-at-max", "created-at-min", "created-by", "invoices", "invoices_id", "organization", "processed"}},
+	{Source: "organization_invoices_batches_show", Method: "GET", Path: "/v1/organization-invoices-batches/{id}"},
+	{Source: "parking_sites_list", Method: "GET", Path: "/v1/parking-sites", Filters: []string{"active_end_at_max", "active_end_at_min", "active_start_at_max", "active_start_at_min", "broker", "parked", "tractor", "trailer", "trucker"}},
+	{Source: "pave_frame_actual_hours_list", Method: "GET", Path: "/v1/pave-frame-actual-hours"},
+	{Source: "pave_frame_actual_hours_show", Method: "GET", Path: "/v1/pave-frame-actual-hours/{id}"},
+	{Source: "pave_frame_actual_statistics_list", Method: "GET", Path: "/v1/pave-frame-actual-statistics", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "updated-at-max", "updated-at-min"}},
+	{Source: "pave_frame_actual_statistics_show", Method: "GET", Path: "/v1/pave-frame-actual-statistics/{id}"},
+	{Source: "place_predictions_list", Method: "GET", Path: "/v1/place-predictions", Filters: []string{"q"}},
+	{Source: "places_show", Method: "GET", Path: "/v1/places/{id}"},
+	{Source: "platform_statuses_list", Method: "GET", Path: "/v1/platform-statuses"},
+	{Source: "platform_statuses_show", Method: "GET", Path: "/v1/platform-statuses/{id}"},
+	{Source: "post_actions_list", Method: "GET", Path: "/v1/post-actions", Filters: []string{"token"}},
+	{Source: "post_actions_show", Method: "GET", Path: "/v1/post-actions/{id}"},
+	{Source: "post_children_list", Method: "GET", Path: "/v1/post-children", Filters: []string{"child-post", "parent-post"}},
+	{Source: "post_children_show", Method: "GET", Path: "/v1/post-children/{id}"},
+	{Source: "post_router_jobs_list", Method: "GET", Path: "/v1/post-router-jobs", Filters: []string{"post", "post-router", "post-worker-class-name"}},
+	{Source: "post_router_jobs_show", Method: "GET", Path: "/v1/post-router-jobs/{id}"},
+	{Source: "post_routers_list", Method: "GET", Path: "/v1/post-routers", Filters: []string{"post", "status"}},
+	{Source: "post_routers_show", Method: "GET", Path: "/v1/post-routers/{id}"},
+	{Source: "post_views_list", Method: "GET", Path: "/v1/post-views", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "is-viewed-at", "post", "updated-at-max", "updated-at-min", "viewed-at-max", "viewed-at-min", "viewer"}},
+	{Source: "post_views_show", Method: "GET", Path: "/v1/post-views/{id}"},
+	{Source: "posts_list", Method: "GET", Path: "/v1/posts", Filters: []string{"creator", "post-type", "published-at-max", "published-at-min", "status", "subject-post"}},
+	{Source: "posts_show", Method: "GET", Path: "/v1/posts/{id}"},
+	{Source: "prediction_agents_list", Method: "GET", Path: "/v1/prediction-agents", Filters: []string{"created_by", "has_prediction", "prediction_subject"}},
+	{Source: "prediction_agents_show", Method: "GET", Path: "/v1/prediction-agents/{id}"},
+	{Source: "prediction_knowledge_base_answers_list", Method: "GET", Path: "/v1/prediction-knowledge-base-answers", Filters: []string{"prediction-knowledge-base-question"}},
+	{Source: "prediction_knowledge_base_answers_show", Method: "GET", Path: "/v1/prediction-knowledge-base-answers/{id}"},
+	{Source: "prediction_knowledge_base_questions_list", Method: "GET", Path: "/v1/prediction-knowledge-base-questions", Filters: []string{"in_tag_category", "prediction_knowledge_base", "prediction_subject", "status", "tagged_with", "tagged_with_all", "tagged_with_any"}},
+	{Source: "prediction_knowledge_base_questions_show", Method: "GET", Path: "/v1/prediction-knowledge-base-questions/{id}"},
+	{Source: "prediction_knowledge_bases_list", Method: "GET", Path: "/v1/prediction-knowledge-bases", Filters: []string{"broker"}},
+	{Source: "prediction_knowledge_bases_show", Method: "GET", Path: "/v1/prediction-knowledge-bases/{id}"},
+	{Source: "prediction_subject_bids_list", Method: "GET", Path: "/v1/prediction-subject-bids", Filters: []string{"bidder", "broker", "lowest-losing-bid-prediction-subject-detail", "prediction-subject"}},
+	{Source: "prediction_subject_bids_show", Method: "GET", Path: "/v1/prediction-subject-bids/{id}"},
+	{Source: "prediction_subject_gap_portions_list", Method: "GET", Path: "/v1/prediction-subject-gap-portions", Filters: []string{"prediction_subject_gap", "status"}},
+	{Source: "prediction_subject_gap_portions_show", Method: "GET", Path: "/v1/prediction-subject-gap-portions/{id}"},
+	{Source: "prediction_subject_gaps_list", Method: "GET", Path: "/v1/prediction-subject-gaps", Filters: []string{"gap-type", "prediction-subject", "status"}},
+	{Source: "prediction_subject_gaps_show", Method: "GET", Path: "/v1/prediction-subject-gaps/{id}"},
+	{Source: "prediction_subject_memberships_list", Method: "GET", Path: "/v1/prediction-subject-memberships", Filters: []string{"prediction_subject", "user"}},
+	{Source: "prediction_subject_memberships_show", Method: "GET", Path: "/v1/prediction-subject-memberships/{id}"},
+	{Source: "prediction_subject_recaps_list", Method: "GET", Path: "/v1/prediction-subject-recaps", Filters: []string{"prediction_subject"}},
+	{Source: "prediction_subject_recaps_show", Method: "GET", Path: "/v1/prediction-subject-recaps/{id}"},
+	{Source: "prediction_subjects_list", Method: "GET", Path: "/v1/prediction-subjects", Filters: []string{"actual", "actual-due-at", "actual-due-at-max", "actual-due-at-min", "actual-max", "actual-min", "bidder", "broker", "business-unit", "created-by", "has-actual-due-at", "has-predictions-due-at", "in-tag-category", "lowest-bid-amount-max", "lowest-bid-amount-min", "name", "parent", "predictions-due-at", "predictions-due-at-max", "predictions-due-at-min", "reference-number", "status", "tagged-with", "tagged-with-all", "tagged-with-any"}},
+	{Source: "prediction_subjects_show", Method: "GET", Path: "/v1/prediction-subjects/{id}"},
+	{Source: "predictions_list", Method: "GET", Path: "/v1/predictions", Filters: []string{"predicted-by", "prediction-agent", "prediction-subject", "status"}},
+	{Source: "predictions_show", Method: "GET", Path: "/v1/predictions/{id}"},
+	{Source: "press_releases_list", Method: "GET", Path: "/v1/press-releases", Filters: []string{"published", "released-at-max", "released-at-min", "slug"}},
+	{Source: "press_releases_show", Method: "GET", Path: "/v1/press-releases/{id}"},
+	{Source: "production_incident_detectors_list", Method: "GET", Path: "/v1/production-incident-detectors"},
+	{Source: "production_incident_detectors_show", Method: "GET", Path: "/v1/production-incident-detectors/{id}"},
+	{Source: "production_incidents_list", Method: "GET", Path: "/v1/production-incidents", Filters: []string{"action_items", "assignee", "broker", "contractor", "created_by", "customer", "developer", "did_stop_work", "end_at_max", "end_at_min", "equipment", "has_equipment", "has_live_action_items", "has_parent", "incident_tag", "incident_tag_slug", "job_number", "job_production_plan", "job_production_plan_project", "kind", "material_site", "material_supplier", "natures", "net_impact_dollars_max", "net_impact_dollars_min", "net_impact_minutes_max", "net_impact_minutes_min", "notifiable_to", "parent", "q", "responsible_person", "root_causes", "severity", "start_at_max", "start_at_min", "start_on", "start_on_max", "start_on_min", "status", "subject", "subject_type", "tender_job_schedule_shift", "tender_job_schedule_shift_driver", "tender_job_schedule_shift_trucker", "trucker", "user_has_stake", "zero_incident_tags"}},
+	{Source: "production_incidents_show", Method: "GET", Path: "/v1/production-incidents/{id}"},
+	{Source: "production_measurements_list", Method: "GET", Path: "/v1/production-measurements", Filters: []string{"job-production-plan-segment"}},
+	{Source: "production_measurements_show", Method: "GET", Path: "/v1/production-measurements/{id}"},
+	{Source: "proffer_likes_list", Method: "GET", Path: "/v1/proffer-likes", Filters: []string{"created-at-max", "created-at-min", "proffer", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "proffer_likes_show", Method: "GET", Path: "/v1/proffer-likes/{id}"},
+	{Source: "proffers_list", Method: "GET", Path: "/v1/proffers", Filters: []string{"created_by", "kind", "similar_to_text"}},
+	{Source: "proffers_show", Method: "GET", Path: "/v1/proffers/{id}"},
+	{Source: "profit_improvement_categories_list", Method: "GET", Path: "/v1/profit-improvement-categories", Filters: []string{"name"}},
+	{Source: "profit_improvement_subscriptions_list", Method: "GET", Path: "/v1/profit-improvement-subscriptions", Filters: []string{"contact_method", "profit_improvement", "user"}},
+	{Source: "profit_improvement_subscriptions_show", Method: "GET", Path: "/v1/profit-improvement-subscriptions/{id}"},
+	{Source: "profit_improvements_list", Method: "GET", Path: "/v1/profit-improvements", Filters: []string{"amount-estimated", "amount-estimated-max", "amount-estimated-min", "amount-validated", "amount-validated-max", "amount-validated-min", "broker", "created-by", "description", "gain-share-fee-end-on", "gain-share-fee-end-on-max", "gain-share-fee-end-on-min", "gain-share-fee-percentage", "gain-share-fee-percentage-max", "gain-share-fee-percentage-min", "gain-share-fee-start-on", "gain-share-fee-start-on-max", "gain-share-fee-start-on-min", "has-gain-share-fee-end-on", "has-gain-share-fee-start-on", "has-impact-end-on-estimated", "has-impact-end-on-validated", "has-impact-start-on-estimated", "has-impact-start-on-validated", "impact-end-on-estimated", "impact-end-on-estimated-max", "impact-end-on-estimated-min", "impact-end-on-validated", "impact-end-on-validated-max", "impact-end-on-validated-min", "impact-frequency-estimated", "impact-frequency-validated", "impact-interval-estimated", "impact-interval-validated", "impact-start-on-estimated", "impact-start-on-estimated-max", "impact-start-on-estimated-min", "impact-start-on-validated", "impact-start-on-validated-max", "impact-start-on-validated-min", "organization", "organization_id", "organization_type", "original", "owned-by", "profit-improvement-category", "status", "title", "validated-by"}},
+	{Source: "profit_improvements_show", Method: "GET", Path: "/v1/profit-improvements/{id}"},
+	{Source: "project_abandonments_list", Method: "GET", Path: "/v1/project-abandonments"},
+	{Source: "project_abandonments_show", Method: "GET", Path: "/v1/project-abandonments/{id}"},
+	{Source: "project_actuals_exports_list", Method: "GET", Path: "/v1/project-actuals-exports", Filters: []string{"broker", "created_by", "job_production_plans", "not_organization_type", "organization", "organization_formatter", "organization_id", "organization_type", "project", "status"}},
+	{Source: "project_actuals_exports_show", Method: "GET", Path: "/v1/project-actuals-exports/{id}"},
+	{Source: "project_approvals_list", Method: "GET", Path: "/v1/project-approvals"},
+	{Source: "project_approvals_show", Method: "GET", Path: "/v1/project-approvals/{id}"},
+	{Source: "project_bid_location_material_types_list", Method: "GET", Path: "/v1/project-bid-location-material-types", Filters: []string{"material-type", "project-bid-location"}},
+	{Source: "project_bid_location_material_types_show", Method: "GET", Path: "/v1/project-bid-location-material-types/{id}"},
+	{Source: "project_bid_locations_list", Method: "GET", Path: "/v1/project-bid-locations", Filters: []string{"county", "near", "project", "state-code"}},
+	{Source: "project_bid_locations_show", Method: "GET", Path: "/v1/project-bid-locations/{id}"},
+	{Source: "project_cancellations_list", Method: "GET", Path: "/v1/project-cancellations"},
+	{Source: "project_cancellations_show", Method: "GET", Path: "/v1/project-cancellations/{id}"},
+	{Source: "project_categories_list", Method: "GET", Path: "/v1/project-categories", Filters: []string{"abbreviation", "broker", "name", "q"}},
+	{Source: "project_completions_list", Method: "GET", Path: "/v1/project-completions"},
+	{Source: "project_completions_show", Method: "GET", Path: "/v1/project-completions/{id}"},
+	{Source: "project_cost_classifications_list", Method: "GET", Path: "/v1/project-cost-classifications", Filters: []string{"broker", "has_broker", "name", "parent", "project-phase-cost-items"}},
+	{Source: "project_cost_codes_list", Method: "GET", Path: "/v1/project-cost-codes", Filters: []string{"code", "cost_code", "customer", "description", "project", "q"}},
+	{Source: "project_customers_list", Method: "GET", Path: "/v1/project-customers", Filters: []string{"created_at_max", "created_at_min", "customer", "project", "updated_at_max", "updated_at_min"}},
+	{Source: "project_customers_show", Method: "GET", Path: "/v1/project-customers/{id}"},
+	{Source: "project_divisions_list", Method: "GET", Path: "/v1/project-divisions", Filters: []string{"abbreviation", "broker", "name", "q"}},
+	{Source: "project_estimate_file_imports_list", Method: "GET", Path: "/v1/project-estimate-file-imports"},
+	{Source: "project_estimate_sets_list", Method: "GET", Path: "/v1/project-estimate-sets", Filters: []string{"created_by", "is_actual", "is_bid", "is_possible", "project"}},
+	{Source: "project_estimate_sets_show", Method: "GET", Path: "/v1/project-estimate-sets/{id}"},
+	{Source: "project_import_file_verifications_list", Method: "GET", Path: "/v1/project-import-file-verifications", Filters: []string{"project"}},
+	{Source: "project_labor_classifications_list", Method: "GET", Path: "/v1/project-labor-classifications", Filters: []string{"labor_classification", "project"}},
+	{Source: "project_labor_classifications_show", Method: "GET", Path: "/v1/project-labor-classifications/{id}"},
+	{Source: "project_margin_matrices_list", Method: "GET", Path: "/v1/project-margin-matrices", Filters: []string{"project"}},
+	{Source: "project_margin_matrices_show", Method: "GET", Path: "/v1/project-margin-matrices/{id}"},
+	{Source: "project_material_type_quality_control_requirements_list", Method: "GET", Path: "/v1/project-material-type-quality-control-requirements", Filters: []string{"project-material-type", "quality-control-classification"}},
+	{Source: "project_material_type_quality_control_requirements_show", Method: "GET", Path: "/v1/project-material-type-quality-control-requirements/{id}"},
+	{Source: "project_material_types_list", Method: "GET", Path: "/v1/project-material-types", Filters: []string{"deliver_at_max_max", "deliver_at_max_min", "deliver_at_min_max", "deliver_at_min_min", "delivery-location", "material-type", "pickup-location", "pickup_at_max_max", "pickup_at_max_min", "pickup_at_min_max", "pickup_at_min_min", "project"}},
+	{Source: "project_material_types_show", Method: "GET", Path: "/v1/project-material-types/{id}"},
+	{Source: "project_offices_list", Method: "GET", Path: "/v1/project-offices", Filters: []string{"abbreviation", "broker", "is-active", "name", "q"}},
+	{Source: "project_phase_cost_item_actuals_list", Method: "GET", Path: "/v1/project-phase-cost-item-actuals", Filters: []string{"created-by", "job-production-plan", "job-production-plan-id", "job-production-plan-project-phase-revenue-item", "job-production-plan-project-phase-revenue-item-id", "project", "project-phase-cost-item", "project-phase-revenue-item-actual", "quantity"}},
+	{Source: "project_phase_cost_item_actuals_show", Method: "GET", Path: "/v1/project-phase-cost-item-actuals/{id}"},
+	{Source: "project_phase_cost_item_price_estimates_list", Method: "GET", Path: "/v1/project-phase-cost-item-price-estimates", Filters: []string{"created-by", "project-estimate-set", "project-phase-cost-item"}},
+	{Source: "project_phase_cost_item_price_estimates_show", Method: "GET", Path: "/v1/project-phase-cost-item-price-estimates/{id}"},
+	{Source: "project_phase_cost_item_quantity_estimates_list", Method: "GET", Path: "/v1/project-phase-cost-item-quantity-estimates", Filters: []string{"created-by", "project-estimate-set", "project-phase-cost-item"}},
+	{Source: "project_phase_cost_item_quantity_estimates_show", Method: "GET", Path: "/v1/project-phase-cost-item-quantity-estimates/{id}"},
+	{Source: "project_phase_cost_items_list", Method: "GET", Path: "/v1/project-phase-cost-items", Filters: []string{"is-revenue-quantity-driver", "project", "project-cost-classification", "project-phase", "project-phase-revenue-item", "project-resource-classification", "unit-of-measure"}},
+	{Source: "project_phase_cost_items_show", Method: "GET", Path: "/v1/project-phase-cost-items/{id}"},
+	{Source: "project_phase_dates_estimates_list", Method: "GET", Path: "/v1/project-phase-dates-estimates", Filters: []string{"created-by", "project", "project-estimate-set", "project-phase"}},
+	{Source: "project_phase_dates_estimates_show", Method: "GET", Path: "/v1/project-phase-dates-estimates/{id}"},
+	{Source: "project_phase_revenue_item_actual_exports_list", Method: "GET", Path: "/v1/project-phase-revenue-item-actual-exports", Filters: []string{"broker", "created_by", "has-revenue-date", "not_organization_type", "organization", "organization_formatter", "organization_id", "organization_type", "project", "project_phase_revenue_items", "revenue-date", "revenue-date-max", "revenue-date-min", "status"}},
+	{Source: "project_phase_revenue_item_actual_exports_show", Method: "GET", Path: "/v1/project-phase-revenue-item-actual-exports/{id}"},
+	{Source: "project_phase_revenue_item_actuals_list", Method: "GET", Path: "/v1/project-phase-revenue-item-actuals", Filters: []string{"created-by", "job-production-plan", "project", "project-phase-revenue-item", "revenue-date", "revenue-date-max", "revenue-date-min"}},
+	{Source: "project_phase_revenue_item_actuals_show", Method: "GET", Path: "/v1/project-phase-revenue-item-actuals/{id}"},
+	{Source: "project_phase_revenue_item_quantity_estimates_list", Method: "GET", Path: "/v1/project-phase-revenue-item-quantity-estimates", Filters: []string{"created-by", "project-estimate-set", "project-phase-revenue-item"}},
+	{Source: "project_phase_revenue_item_quantity_estimates_show", Method: "GET", Path: "/v1/project-phase-revenue-item-quantity-estimates/{id}"},
+	{Source: "project_phase_revenue_items_list", Method: "GET", Path: "/v1/project-phase-revenue-items", Filters: []string{"project", "project-phase", "project-revenue-classification", "project-revenue-item", "quantity-strategy"}},
+	{Source: "project_phase_revenue_items_show", Method: "GET", Path: "/v1/project-phase-revenue-items/{id}"},
+	{Source: "project_phases_list", Method: "GET", Path: "/v1/project-phases", Filters: []string{"project"}},
+	{Source: "project_project_cost_classifications_list", Method: "GET", Path: "/v1/project-project-cost-classifications", Filters: []string{"created_at_max", "created_at_min", "project", "project-cost-classification", "updated_at_max", "updated_at_min"}},
+	{Source: "project_project_cost_classifications_show", Method: "GET", Path: "/v1/project-project-cost-classifications/{id}"},
+	{Source: "project_resource_classifications_list", Method: "GET", Path: "/v1/project-resource-classifications", Filters: []string{"broker", "has_broker", "name", "parent"}},
+	{Source: "project_revenue_classifications_list", Method: "GET", Path: "/v1/project-revenue-classifications", Filters: []string{"broker", "has_broker", "name", "parent"}},
+	{Source: "project_revenue_item_price_estimates_list", Method: "GET", Path: "/v1/project-revenue-item-price-estimates", Filters: []string{"created-by", "project-estimate-set", "project-revenue-item"}},
+	{Source: "project_revenue_item_price_estimates_show", Method: "GET", Path: "/v1/project-revenue-item-price-estimates/{id}"},
+	{Source: "project_revenue_item_quantity_estimates_list", Method: "GET", Path: "/v1/project-revenue-item-quantity-estimates", Filters: []string{"created-by", "project-estimate-set", "project-revenue-item"}},
+	{Source: "project_revenue_item_quantity_estimates_show", Method: "GET", Path: "/v1/project-revenue-item-quantity-estimates/{id}"},
+	{Source: "project_revenue_items_list", Method: "GET", Path: "/v1/project-revenue-items", Filters: []string{"developer-quantity-estimate", "project", "revenue-classification", "unit-of-measure"}},
+	{Source: "project_revenue_items_show", Method: "GET", Path: "/v1/project-revenue-items/{id}"},
+	{Source: "project_status_changes_list", Method: "GET", Path: "/v1/project-status-changes", Filters: []string{"project", "status"}},
+	{Source: "project_status_changes_show", Method: "GET", Path: "/v1/project-status-changes/{id}"},
+	{Source: "project_submissions_list", Method: "GET", Path: "/v1/project-submissions"},
+	{Source: "project_submissions_show", Method: "GET", Path: "/v1/project-submissions/{id}"},
+	{Source: "project_subscriptions_list", Method: "GET", Path: "/v1/project-subscriptions", Filters: []string{"contact-method", "project", "user"}},
+	{Source: "project_subscriptions_show", Method: "GET", Path: "/v1/project-subscriptions/{id}"},
+	{Source: "project_trailer_classifications_list", Method: "GET", Path: "/v1/project-trailer-classifications", Filters: []string{"project", "project-labor-classification", "trailer-classification"}},
+	{Source: "project_trailer_classifications_show", Method: "GET", Path: "/v1/project-trailer-classifications/{id}"},
+	{Source: "project_transport_event_types_list", Method: "GET", Path: "/v1/project-transport-event-types", Filters: []string{"broker", "dwell-minutes-min-default", "q"}},
+	{Source: "project_transport_location_event_types_list", Method: "GET", Path: "/v1/project-transport-location-event-types", Filters: []string{"project-transport-event-type", "project-transport-location"}},
+	{Source: "project_transport_location_event_types_show", Method: "GET", Path: "/v1/project-transport-location-event-types/{id}"},
+	{Source: "project_transport_locations_list", Method: "GET", Path: "/v1/project-transport-locations", Filters: []string{"broker", "external-identification-value", "external-tms-company-id", "name", "near", "nearest-project-office-cached", "project-transport-organization", "q"}},
+	{Source: "project_transport_locations_show", Method: "GET", Path: "/v1/project-transport-locations/{id}"},
+	{Source: "project_transport_organizations_list", Method: "GET", Path: "/v1/project-transport-organizations", Filters: []string{"broker", "external-identification-value", "external-tms-master-company-id", "q"}},
+	{Source: "project_transport_organizations_show", Method: "GET", Path: "/v1/project-transport-organizations/{id}"},
+	{Source: "project_transport_plan_assignment_rules_list", Method: "GET", Path: "/v1/project-transport-plan-assignment-rules", Filters: []string{"asset-type", "broker", "is-active", "level", "level-type"}},
+	{Source: "project_transport_plan_assignment_rules_show", Method: "GET", Path: "/v1/project-transport-plan-assignment-rules/{id}"},
+	{Source: "project_transport_plan_driver_assignment_recommendations_list", Method: "GET", Path: "/v1/project-transport-plan-driver-assignment-recommendations", Filters: []string{"project-transport-plan-driver"}},
+	{Source: "project_transport_plan_driver_assignment_recommendations_show", Method: "GET", Path: "/v1/project-transport-plan-driver-assignment-recommendations/{id}"},
+	{Source: "project_transport_plan_driver_confirmations_list", Method: "GET", Path: "/v1/project-transport-plan-driver-confirmations", Filters: []string{"driver", "project-transport-plan", "project-transport-plan-driver", "status"}},
+	{Source: "project_transport_plan_driver_confirmations_show", Method: "GET", Path: "/v1/project-transport-plan-driver-confirmations/{id}"},
+	{Source: "project_transport_plan_drivers_list", Method: "GET", Path: "/v1/project-transport-plan-drivers", Filters: []string{"broker", "driver", "has-driver", "has-managed-transport-order-or-no-transport-order", "has-window-end-at-cached", "has-window-start-at-cached", "inbound-project-office", "most-recent", "project-transport-plan", "project-transport-plan-status", "segment-end", "segment-start", "status", "trucker", "window-end-at-cached", "window-end-at-cached-max", "window-end-at-cached-min", "window-start-at-cached", "window-start-at-cached-max", "window-start-at-cached-min"}},
+	{Source: "project_transport_plan_drivers_show", Method: "GET", Path: "/v1/project-transport-plan-drivers/{id}"},
+	{Source: "project_transport_plan_event_location_prediction_autopsies_list", Method: "GET", Path: "/v1/project-transport-plan-event-location-prediction-autopsies", Filters: []string{"project-transport-plan-event"}},
+	{Source: "project_transport_plan_event_location_prediction_autopsies_show", Method: "GET", Path: "/v1/project-transport-plan-event-location-prediction-autopsies/{id}"},
+	{Source: "project_transport_plan_event_location_predictions_list", Method: "GET", Path: "/v1/project-transport-plan-event-location-predictions", Filters: []string{"project-transport-plan-event", "transport-order"}},
+	{Source: "project_transport_plan_event_location_predictions_show", Method: "GET", Path: "/v1/project-transport-plan-event-location-predictions/{id}"},
+	{Source: "project_transport_plan_event_times_list", Method: "GET", Path: "/v1/project-transport-plan-event-times", Filters: []string{"at-max", "at-min", "changed-by", "end-at-max", "end-at-min", "is-at", "kind", "project-transport-plan", "project-transport-plan-event", "project-transport-plan-id", "start-at-max", "start-at-min"}},
+	{Source: "project_transport_plan_event_times_show", Method: "GET", Path: "/v1/project-transport-plan-event-times/{id}"},
+	{Source: "project_transport_plan_events_list", Method: "GET", Path: "/v1/project-transport-plan-events", Filters: []string{"external-tms-event-id", "project-material-type", "project-transport-event-type", "project-transport-location", "project-transport-organization", "project-transport-organization-id", "project-transport-plan", "project-transport-plan-stop"}},
+	{Source: "project_transport_plan_events_show", Method: "GET", Path: "/v1/project-transport-plan-events/{id}"},
+	{Source: "project_transport_plan_planned_event_time_schedules_list", Method: "GET", Path: "/v1/project-transport-plan-planned-event-time-schedules", Filters: []string{"project-transport-plan", "success", "transport-order"}},
+	{Source: "project_transport_plan_planned_event_time_schedules_show", Method: "GET", Path: "/v1/project-transport-plan-planned-event-time-schedules/{id}"},
+	{Source: "project_transport_plan_segment_drivers_list", Method: "GET", Path: "/v1/project-transport-plan-segment-drivers", Filters: []string{"created_at_max", "created_at_min", "driver", "project-transport-plan-segment", "updated_at_max", "updated_at_min"}},
+	{Source: "project_transport_plan_segment_drivers_show", Method: "GET", Path: "/v1/project-transport-plan-segment-drivers/{id}"},
+	{Source: "project_transport_plan_segment_sets_list", Method: "GET", Path: "/v1/project-transport-plan-segment-sets", Filters: []string{"external-tms-leg-number", "project-transport-plan", "segment-miles-sum", "trucker"}},
+	{Source: "project_transport_plan_segment_sets_show", Method: "GET", Path: "/v1/project-transport-plan-segment-sets/{id}"},
+	{Source: "project_transport_plan_segment_tractors_list", Method: "GET", Path: "/v1/project-transport-plan-segment-tractors", Filters: []string{"project-transport-plan-segment", "tractor"}},
+	{Source: "project_transport_plan_segment_tractors_show", Method: "GET", Path: "/v1/project-transport-plan-segment-tractors/{id}"},
+	{Source: "project_transport_plan_segment_trailers_list", Method: "GET", Path: "/v1/project-transport-plan-segment-trailers", Filters: []string{"created_at_max", "created_at_min", "project-transport-plan-segment", "trailer", "updated_at_max", "updated_at_min"}},
+	{Source: "project_transport_plan_segment_trailers_show", Method: "GET", Path: "/v1/project-transport-plan-segment-trailers/{id}"},
+	{Source: "project_transport_plan_segments_list", Method: "GET", Path: "/v1/project-transport-plan-segments", Filters: []string{"destination", "external-identification-value", "external-tms-movement-number", "external-tms-order-number", "origin", "project-transport-plan", "project-transport-plan-segment-set", "trucker"}},
+	{Source: "project_transport_plan_segments_show", Method: "GET", Path: "/v1/project-transport-plan-segments/{id}"},
+	{Source: "project_transport_plan_stop_insertions_list", Method: "GET", Path: "/v1/project-transport-plan-stop-insertions", Filters: []string{"boundary-choice", "created-by", "existing-project-transport-plan-stop", "mode", "planned-completion-event-type", "project-transport-location", "project-transport-plan", "project-transport-plan-id", "project-transport-plan-segment-set", "reference-project-transport-plan-stop", "reuse-half", "status", "stop-to-move"}},
+	{Source: "project_transport_plan_stop_insertions_show", Method: "GET", Path: "/v1/project-transport-plan-stop-insertions/{id}"},
+	{Source: "project_transport_plan_stop_order_stops_list", Method: "GET", Path: "/v1/project-transport-plan-stop-order-stops", Filters: []string{"project-transport-plan", "project-transport-plan-id", "project-transport-plan-stop", "transport-order", "transport-order-id", "transport-order-stop"}},
+	{Source: "project_transport_plan_stop_order_stops_show", Method: "GET", Path: "/v1/project-transport-plan-stop-order-stops/{id}"},
+	{Source: "project_transport_plan_stops_list", Method: "GET", Path: "/v1/project-transport-plan-stops", Filters: []string{"external-tms-stop-number", "planned-completion-event-type", "project-transport-location", "project-transport-plan"}},
+	{Source: "project_transport_plan_stops_show", Method: "GET", Path: "/v1/project-transport-plan-stops/{id}"},
+	{Source: "project_transport_plan_strategies_list", Method: "GET", Path: "/v1/project-transport-plan-strategies", Filters: []string{"name", "step-pattern"}},
+	{Source: "project_transport_plan_strategies_show", Method: "GET", Path: "/v1/project-transport-plan-strategies/{id}"},
+	{Source: "project_transport_plan_strategy_sets_list", Method: "GET", Path: "/v1/project-transport-plan-strategy-sets", Filters: []string{"strategy-pattern"}},
+	{Source: "project_transport_plan_strategy_sets_show", Method: "GET", Path: "/v1/project-transport-plan-strategy-sets/{id}"},
+	{Source: "project_transport_plan_strategy_steps_list", Method: "GET", Path: "/v1/project-transport-plan-strategy-steps", Filters: []string{"event-type", "position", "strategy"}},
+	{Source: "project_transport_plan_strategy_steps_show", Method: "GET", Path: "/v1/project-transport-plan-strategy-steps/{id}"},
+	{Source: "project_transport_plan_tractors_list", Method: "GET", Path: "/v1/project-transport-plan-tractors", Filters: []string{"actualizing", "has-window-end-at-cached", "has-window-start-at-cached", "most-recent", "project-transport-plan", "segment-end", "segment-start", "status", "tractor", "window-end-at-cached", "window-end-at-cached-max", "window-end-at-cached-min", "window-start-at-cached", "window-start-at-cached-max", "window-start-at-cached-min"}},
+	{Source: "project_transport_plan_tractors_show", Method: "GET", Path: "/v1/project-transport-plan-tractors/{id}"},
+	{Source: "project_transport_plan_trailer_assignment_recommendations_list", Method: "GET", Path: "/v1/project-transport-plan-trailer-assignment-recommendations", Filters: []string{"project-transport-plan-trailer"}},
+	{Source: "project_transport_plan_trailer_assignment_recommendations_show", Method: "GET", Path: "/v1/project-transport-plan-trailer-assignment-recommendations/{id}"},
+	{Source: "project_transport_plan_trailers_list", Method: "GET", Path: "/v1/project-transport-plan-trailers", Filters: []string{"most_recent", "project-transport-plan", "segment-end", "segment-start", "status", "trailer", "window_end_at_cached_max", "window_end_at_cached_min", "window_start_at_cached_max", "window_start_at_cached_min"}},
+	{Source: "project_transport_plan_trailers_show", Method: "GET", Path: "/v1/project-transport-plan-trailers/{id}"},
+	{Source: "project_transport_plans_list", Method: "GET", Path: "/v1/project-transport-plans", Filters: []string{"broker", "broker-id", "delivery-address-state-codes", "event-times-at-max-max", "event-times-at-max-min", "event-times-at-min-max", "event-times-at-min-min", "event-times-on-max", "event-times-on-max-max", "event-times-on-max-min", "event-times-on-min", "event-times-on-min-max", "event-times-on-min-min", "external-order-number", "has-event-times-on-max", "has-event-times-on-min", "is-event-times-at-max", "is-event-times-at-min", "is-managed", "maybe-active", "nearest-project-office-ids", "pickup-address-state-codes", "project", "project-category", "project-office", "project-transport-organization", "q", "segment-miles-max", "segment-miles-min", "transport-order-project-office-or-nearest-project-offices"}},
+	{Source: "project_transport_plans_show", Method: "GET", Path: "/v1/project-transport-plans/{id}"},
+	{Source: "project_truckers_list", Method: "GET", Path: "/v1/project-truckers", Filters: []string{"created_at_max", "created_at_min", "is_excluded_from_time_card_payroll_certification_requirements", "project", "trucker", "updated_at_max", "updated_at_min"}},
+	{Source: "project_truckers_show", Method: "GET", Path: "/v1/project-truckers/{id}"},
+	{Source: "project_unabandonments_list", Method: "GET", Path: "/v1/project-unabandonments"},
+	{Source: "project_unabandonments_show", Method: "GET", Path: "/v1/project-unabandonments/{id}"},
+	{Source: "projects_file_imports_list", Method: "GET", Path: "/v1/projects-file-imports", Filters: []string{"file-import", "file-import-type", "is-dry-run", "subject"}},
+	{Source: "projects_file_imports_show", Method: "GET", Path: "/v1/projects-file-imports/{id}"},
+	{Source: "projects_list", Method: "GET", Path: "/v1/projects", Filters: []string{"broker", "created_at_max", "created_at_min", "customer", "developer", "due-on", "due-on-max", "due-on-min", "estimator", "has-material-transaction-orders", "is-active", "is-managed", "is-project-manager", "is-transport-only", "job-production-plan-planner", "job-start-on", "job-start-on-max", "job-start-on-min", "name", "name-like", "number", "project-manager", "project-office", "project-transport-plan", "q", "status"}},
+	{Source: "prompt_prescriptions_list", Method: "GET", Path: "/v1/prompt-prescriptions", Filters: []string{"created_at_max", "created_at_min", "email-address", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "prompt_prescriptions_show", Method: "GET", Path: "/v1/prompt-prescriptions/{id}"},
+	{Source: "prompters_list", Method: "GET", Path: "/v1/prompters", Filters: []string{"is-active"}},
+	{Source: "prompters_show", Method: "GET", Path: "/v1/prompters/{id}"},
+	{Source: "public_praise_culture_values_list", Method: "GET", Path: "/v1/public-praise-culture-values", Filters: []string{"created-at-max", "created-at-min", "culture-value", "public-praise", "updated-at-max", "updated-at-min"}},
+	{Source: "public_praise_culture_values_show", Method: "GET", Path: "/v1/public-praise-culture-values/{id}"},
+	{Source: "public_praise_reactions_list", Method: "GET", Path: "/v1/public-praise-reactions", Filters: []string{"created-at-max", "created-at-min", "updated-at-max", "updated-at-min"}},
+	{Source: "public_praise_reactions_show", Method: "GET", Path: "/v1/public-praise-reactions/{id}"},
+	{Source: "public_praises_list", Method: "GET", Path: "/v1/public-praises", Filters: []string{"broker", "by_organization", "culture_value_ids", "given_by", "received_by", "recipient"}},
+	{Source: "quality_control_classifications_list", Method: "GET", Path: "/v1/quality-control-classifications", Filters: []string{"broker", "name"}},
+	{Source: "questions_list", Method: "GET", Path: "/v1/questions", Filters: []string{"asked_by", "assigned_to", "content", "created_by", "ignore_organization_scoped_newsletters", "is_assigned", "is_triaged", "motivation", "source", "with_feedback", "with_related_content", "without_feedback", "without_related_content"}},
+	{Source: "questions_show", Method: "GET", Path: "/v1/questions/{id}"},
+	{Source: "rate_adjustments_list", Method: "GET", Path: "/v1/rate-adjustments", Filters: []string{"cost-index", "is-parent-rate-adjustment", "parent-rate-adjustment", "rate"}},
+	{Source: "rate_adjustments_show", Method: "GET", Path: "/v1/rate-adjustments/{id}"},
+	{Source: "rate_agreement_copier_works_list", Method: "GET", Path: "/v1/rate-agreement-copier-works", Filters: []string{"broker", "created_by", "rate_agreement_template", "target_organization", "target_organization_id", "target_organization_type"}},
+	{Source: "rate_agreement_copier_works_show", Method: "GET", Path: "/v1/rate-agreement-copier-works/{id}"},
+	{Source: "rate_agreements_copiers_list", Method: "GET", Path: "/v1/rate-agreements-copiers", Filters: []string{"broker", "created-by", "rate-agreement-template"}},
+	{Source: "rate_agreements_copiers_show", Method: "GET", Path: "/v1/rate-agreements-copiers/{id}"},
+	{Source: "rate_agreements_list", Method: "GET", Path: "/v1/rate-agreements", Filters: []string{"buyer", "name", "q", "seller", "status"}},
+	{Source: "rate_agreements_show", Method: "GET", Path: "/v1/rate-agreements/{id}"},
+	{Source: "rates_list", Method: "GET", Path: "/v1/rates", Filters: []string{"material_site", "material_type", "name_like", "parent_rate", "rate_agreement", "rated", "service_type_unit_of_measure", "status", "trailer_classification"}},
+	{Source: "raw_material_transaction_import_results_list", Method: "GET", Path: "/v1/raw-material-transaction-import-results", Filters: []string{"batch-id", "broker", "disconnected-at-max", "disconnected-at-min", "earliest-created-transaction-at-max", "earliest-created-transaction-at-min", "has-errors", "is-disconnected-at", "is-earliest-created-transaction-at", "is-latest-created-transaction-at", "latest-created-transaction-at-max", "latest-created-transaction-at-min", "locationid", "source", "source-type"}},
+	{Source: "raw_material_transaction_import_results_show", Method: "GET", Path: "/v1/raw-material-transaction-import-results/{id}"},
+	{Source: "raw_material_transaction_sales_customers_list", Method: "GET", Path: "/v1/raw-material-transaction-sales-customers", Filters: []string{"broker", "customer"}},
+	{Source: "raw_material_transaction_sales_customers_show", Method: "GET", Path: "/v1/raw-material-transaction-sales-customers/{id}"},
+	{Source: "raw_material_transactions_list", Method: "GET", Path: "/v1/raw-material-transactions", Filters: []string{"broker", "hauler_type", "job_number", "material_name", "material_site", "material_supplier_name", "sales_customer_id", "site_id", "ticket_number", "transaction_at_max", "transaction_at_min", "transaction_date", "truck_name"}},
+	{Source: "raw_material_transactions_show", Method: "GET", Path: "/v1/raw-material-transactions/{id}"},
+	{Source: "raw_records_list", Method: "GET", Path: "/v1/ingest/raw-records", Filters: []string{"broker", "external-record-id", "external-record-type", "has-data", "integration-config", "integration-config-organization", "internal-record", "is-failed", "is-processed", "is-skipped"}},
+	{Source: "raw_records_show", Method: "GET", Path: "/v1/ingest/raw-records/{id}"},
+	{Source: "raw_transport_drivers_list", Method: "GET", Path: "/v1/raw-transport-drivers", Filters: []string{"broker", "import-status", "importer"}},
+	{Source: "raw_transport_drivers_show", Method: "GET", Path: "/v1/raw-transport-drivers/{id}"},
+	{Source: "raw_transport_exports_list", Method: "GET", Path: "/v1/raw-transport-exports", Filters: []string{"broker", "checksum", "created-at-max", "created-at-min", "export-type", "exported-at-max", "exported-at-min", "external-order-number", "first-seen-at-max", "first-seen-at-min", "is-created-at", "is-exportable", "is-exported", "is-exported-at", "is-first-seen-at", "issue-type", "recent", "sequence", "target-table", "transport-order"}},
+	{Source: "raw_transport_exports_show", Method: "GET", Path: "/v1/raw-transport-exports/{id}"},
+	{Source: "raw_transport_orders_list", Method: "GET", Path: "/v1/raw-transport-orders", Filters: []string{"broker", "tables_rowversion_max", "tables_rowversion_min"}},
+	{Source: "raw_transport_orders_show", Method: "GET", Path: "/v1/raw-transport-orders/{id}"},
+	{Source: "raw_transport_projects_list", Method: "GET", Path: "/v1/raw-transport-projects", Filters: []string{"broker", "tables-rowversion-max", "tables-rowversion-min"}},
+	{Source: "raw_transport_projects_show", Method: "GET", Path: "/v1/raw-transport-projects/{id}"},
+	{Source: "raw_transport_tractors_list", Method: "GET", Path: "/v1/raw-transport-tractors", Filters: []string{"broker", "import_status", "importer"}},
+	{Source: "raw_transport_tractors_show", Method: "GET", Path: "/v1/raw-transport-tractors/{id}"},
+	{Source: "raw_transport_trailers_list", Method: "GET", Path: "/v1/raw-transport-trailers", Filters: []string{"broker", "import-status", "importer"}},
+	{Source: "raw_transport_trailers_show", Method: "GET", Path: "/v1/raw-transport-trailers/{id}"},
+	{Source: "reaction_classifications_list", Method: "GET", Path: "/v1/reaction-classifications"},
+	{Source: "release_notes_list", Method: "GET", Path: "/v1/release-notes", Filters: []string{"created-by", "has-navigation-instructions-guess", "is-archived", "is-published", "q", "released-on-max", "released-on-min"}},
+	{Source: "release_notes_show", Method: "GET", Path: "/v1/release-notes/{id}"},
+	{Source: "resource_classification_project_cost_classifications_list", Method: "GET", Path: "/v1/resource-classification-project-cost-classifications", Filters: []string{"broker", "created-at-max", "created-at-min", "is-created-at", "is-updated-at", "project_cost_classification", "resource_classification", "resource_classification_type", "updated-at-max", "updated-at-min"}},
+	{Source: "resource_classification_project_cost_classifications_show", Method: "GET", Path: "/v1/resource-classification-project-cost-classifications/{id}"},
+	{Source: "resource_unavailabilities_list", Method: "GET", Path: "/v1/resource-unavailabilities", Filters: []string{"end_at_max", "end_at_min", "organization", "resource", "resource_type", "start_at_max", "start_at_min"}},
+	{Source: "resource_unavailabilities_show", Method: "GET", Path: "/v1/resource-unavailabilities/{id}"},
+	{Source: "retainer_deductions_list", Method: "GET", Path: "/v1/retainer-deductions", Filters: []string{"retainer"}},
+	{Source: "retainer_deductions_show", Method: "GET", Path: "/v1/retainer-deductions/{id}"},
+	{Source: "retainer_earning_statuses_list", Method: "GET", Path: "/v1/retainer-earning-statuses", Filters: []string{"calculated-on", "retainer"}},
+	{Source: "retainer_earning_statuses_show", Method: "GET", Path: "/v1/retainer-earning-statuses/{id}"},
+	{Source: "retainer_payment_deductions_list", Method: "GET", Path: "/v1/retainer-payment-deductions", Filters: []string{"created-at-max", "created-at-min", "updated-at-max", "updated-at-min"}},
+	{Source: "retainer_payment_deductions_show", Method: "GET", Path: "/v1/retainer-payment-deductions/{id}"},
+	{Source: "retainer_payments_list", Method: "GET", Path: "/v1/retainer-payments", Filters: []string{"buyer", "pay-on-min", "retainer-period", "retainer-type", "seller", "status"}},
+	{Source: "retainer_payments_show", Method: "GET", Path: "/v1/retainer-payments/{id}"},
+	{Source: "retainer_periods_list", Method: "GET", Path: "/v1/retainer-periods", Filters: []string{"retainer"}},
+	{Source: "retainer_periods_show", Method: "GET", Path: "/v1/retainer-periods/{id}"},
+	{Source: "retainers_list", Method: "GET", Path: "/v1/retainers", Filters: []string{"buyer", "created-at-max", "created-at-min", "seller", "status", "updated-at-max", "updated-at-min"}},
+	{Source: "retainers_show", Method: "GET", Path: "/v1/retainers/{id}"},
+	{Source: "root_causes_list", Method: "GET", Path: "/v1/root-causes", Filters: []string{"incident", "incident_type", "is-triaged"}},
+	{Source: "root_causes_show", Method: "GET", Path: "/v1/root-causes/{id}"},
+	{Source: "safety_incidents_list", Method: "GET", Path: "/v1/safety-incidents", Filters: []string{"action_items", "assignee", "broker", "contractor", "created_by", "customer", "developer", "did_stop_work", "end_at_max", "end_at_min", "equipment", "has_equipment", "has_live_action_items", "has_parent", "incident_tag", "incident_tag_slug", "job_number", "job_production_plan", "job_production_plan_project", "kind", "material_site", "material_supplier", "natures", "net_impact_tons_max", "net_impact_tons_min", "notifiable_to", "parent", "q", "responsible_person", "root_causes", "severity", "start_at_max", "start_at_min", "start_on", "start_on_max", "start_on_min", "status", "subject", "subject_id", "subject_type", "tender_job_schedule_shift", "tender_job_schedule_shift_driver", "tender_job_schedule_shift_trucker", "trucker", "user_has_stake", "zero_incident_tags"}},
+	{Source: "safety_incidents_show", Method: "GET", Path: "/v1/safety-incidents/{id}"},
+	{Source: "samsara_integrations_list", Method: "GET", Path: "/v1/samsara-integrations", Filters: []string{"broker"}},
+	{Source: "samsara_integrations_show", Method: "GET", Path: "/v1/samsara-integrations/{id}"},
+	{Source: "samsara_vehicles_list", Method: "GET", Path: "/v1/samsara-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "samsara_vehicles_show", Method: "GET", Path: "/v1/samsara-vehicles/{id}"},
+	{Source: "search_catalog_entries_list", Method: "GET", Path: "/v1/search-catalog-entries", Filters: []string{"broker", "customer", "entity-id", "entity-type", "fuzzy-search", "search", "trucker"}},
+	{Source: "search_catalog_entries_show", Method: "GET", Path: "/v1/search-catalog-entries/{id}"},
+	{Source: "service_events_list", Method: "GET", Path: "/v1/service-events", Filters: []string{"created-by", "is-occurred-at", "job-schedule-shift", "kind", "occurred-at-max", "occurred-at-min", "tender-job-schedule-shift", "via-gps", "via-material-transaction-acceptance"}},
+	{Source: "service_events_show", Method: "GET", Path: "/v1/service-events/{id}"},
+	{Source: "service_sites_list", Method: "GET", Path: "/v1/service-sites", Filters: []string{"broker", "name"}},
+	{Source: "service_sites_show", Method: "GET", Path: "/v1/service-sites/{id}"},
+	{Source: "service_type_unit_of_measure_cohorts_list", Method: "GET", Path: "/v1/service-type-unit-of-measure-cohorts", Filters: []string{"customer", "service-type-unit-of-measure-id"}},
+	{Source: "service_type_unit_of_measure_cohorts_show", Method: "GET", Path: "/v1/service-type-unit-of-measure-cohorts/{id}"},
+	{Source: "service_type_unit_of_measure_quantities_list", Method: "GET", Path: "/v1/service-type-unit-of-measure-quantities", Filters: []string{"broker", "calculated_quantity", "explicit_quantity", "quantifies", "quantifies_start_on_max", "quantifies_start_on_min", "quantifies_type", "quantity", "service_type_unit_of_measure"}},
+	{Source: "service_type_unit_of_measure_quantities_show", Method: "GET", Path: "/v1/service-type-unit-of-measure-quantities/{id}"},
+	{Source: "service_type_unit_of_measures_list", Method: "GET", Path: "/v1/service-type-unit-of-measures", Filters: []string{"quantifiable", "service-type", "unit-of-measure"}},
+	{Source: "service_type_unit_of_measures_show", Method: "GET", Path: "/v1/service-type-unit-of-measures/{id}"},
+	{Source: "service_types_list", Method: "GET", Path: "/v1/service-types", Filters: []string{"abbreviation", "name"}},
+	{Source: "shift_acknowledgement_reminder_notification_subscriptions_list", Method: "GET", Path: "/v1/shift-acknowledgement-reminder-notification-subscriptions", Filters: []string{"trucker", "trucker_id", "user"}},
+	{Source: "shift_acknowledgement_reminder_notification_subscriptions_show", Method: "GET", Path: "/v1/shift-acknowledgement-reminder-notification-subscriptions/{id}"},
+	{Source: "shift_counters_list", Method: "GET", Path: "/v1/shift-counters"},
+	{Source: "shift_feedback_reasons_list", Method: "GET", Path: "/v1/shift-feedback-reasons", Filters: []string{"default-rating", "has-bot", "has-corrective-action", "kind", "name", "slug"}},
+	{Source: "shift_feedbacks_list", Method: "GET", Path: "/v1/shift-feedbacks", Filters: []string{"automated", "broker", "created_by", "customer", "driver", "kind", "rating", "reason", "shift_date_max", "shift_date_min", "tender_job_schedule_shift", "trucker", "trucker_id"}},
+	{Source: "shift_set_time_card_constraints_list", Method: "GET", Path: "/v1/shift-set-time-card-constraints", Filters: []string{"constrained_amount", "constrained_model", "constrained_model_type", "constraint_type", "currency_code", "material_type", "name", "q", "rate_agreement", "scoped_to_shift", "scoped_to_tender", "service_type_unit_of_measures", "shift_scope", "status", "trailer_classification"}},
+	{Source: "shift_set_time_card_constraints_show", Method: "GET", Path: "/v1/shift-set-time-card-constraints/{id}"},
+	{Source: "shift_time_card_requisitions_list", Method: "GET", Path: "/v1/shift-time-card-requisitions", Filters: []string{"broker", "created_by", "driver", "job_production_plan", "status", "tender_job_schedule_shift", "time_card", "trucker"}},
+	{Source: "shift_time_card_requisitions_show", Method: "GET", Path: "/v1/shift-time-card-requisitions/{id}"},
+	{Source: "site_events_list", Method: "GET", Path: "/v1/site-events", Filters: []string{"broker", "driver_day", "event-at-max", "event-at-min", "event_type", "has_shift", "material_transaction", "most_recent_by_driver_day", "most_recent_by_shift", "tender_job_schedule_shift", "trucker"}},
+	{Source: "site_events_show", Method: "GET", Path: "/v1/site-events/{id}"},
+	{Source: "site_wait_time_notification_triggers_list", Method: "GET", Path: "/v1/site-wait-time-notification-triggers", Filters: []string{"created-at-max", "created-at-min", "event-at-max", "event-at-min", "is-created-at", "is-event-at", "is-updated-at", "job_production_plan", "site_id", "site_type", "tender_job_schedule_shift", "updated-at-max", "updated-at-min"}},
+	{Source: "site_wait_time_notification_triggers_show", Method: "GET", Path: "/v1/site-wait-time-notification-triggers/{id}"},
+	{Source: "stakeholder_classifications_list", Method: "GET", Path: "/v1/stakeholder-classifications", Filters: []string{"leverage-factor", "slug"}},
+	{Source: "superior_bowen_apex_viewpoint_ticket_exports_list", Method: "GET", Path: "/v1/superior-bowen-apex-viewpoint-ticket-exports"},
+	{Source: "t3_equipmentshare_vehicles_list", Method: "GET", Path: "/v1/t3-equipmentshare-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "t3_equipmentshare_vehicles_show", Method: "GET", Path: "/v1/t3-equipmentshare-vehicles/{id}"},
+	{Source: "tag_categories_list", Method: "GET", Path: "/v1/tag-categories", Filters: []string{"can-apply-to", "name", "slug"}},
+	{Source: "taggings_list", Method: "GET", Path: "/v1/taggings", Filters: []string{"tag-id", "taggable", "taggable-type"}},
+	{Source: "taggings_show", Method: "GET", Path: "/v1/taggings/{id}"},
+	{Source: "tags_list", Method: "GET", Path: "/v1/tags", Filters: []string{"for-taggable-type", "name", "tag-category-id", "tag-category-slug"}},
+	{Source: "teletrac_navman_vehicles_list", Method: "GET", Path: "/v1/teletrac-navman-vehicles", Filters: []string{"assigned_at_min", "broker", "created_at_max", "created_at_min", "has_tractor", "has_trailer", "integration_identifier", "is_created_at", "is_tractor_set_at", "is_trailer_set_at", "is_updated_at", "tractor", "tractor_set_at_max", "tractor_set_at_min", "trailer", "trailer_set_at_max", "trailer_set_at_min", "trucker", "updated_at_max", "updated_at_min"}},
+	{Source: "teletrac_navman_vehicles_show", Method: "GET", Path: "/v1/teletrac-navman-vehicles/{id}"},
+	{Source: "temeda_vehicles_list", Method: "GET", Path: "/v1/temeda-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "temeda_vehicles_show", Method: "GET", Path: "/v1/temeda-vehicles/{id}"},
+	{Source: "tender_acceptances_list", Method: "GET", Path: "/v1/tender-acceptances"},
+	{Source: "tender_acceptances_show", Method: "GET", Path: "/v1/tender-acceptances/{id}"},
+	{Source: "tender_job_schedule_shift_cancellations_list", Method: "GET", Path: "/v1/tender-job-schedule-shift-cancellations"},
+	{Source: "tender_job_schedule_shift_cancellations_show", Method: "GET", Path: "/v1/tender-job-schedule-shift-cancellations/{id}"},
+	{Source: "tender_job_schedule_shift_cancelled_trucker_contact_notifications_list", Method: "GET", Path: "/v1/tender-job-schedule-shift-cancelled-trucker-contact-notifications", Filters: []string{"deliver-at", "delivery-decision-approach", "is-ready-for-delivery", "notification-type", "read", "user"}},
+	{Source: "tender_job_schedule_shift_cancelled_trucker_contact_notifications_show", Method: "GET", Path: "/v1/tender-job-schedule-shift-cancelled-trucker-contact-notifications/{id}"},
+	{Source: "tender_job_schedule_shift_drivers_list", Method: "GET", Path: "/v1/tender-job-schedule-shift-drivers", Filters: []string{"tender_job_schedule_shift", "user"}},
+	{Source: "tender_job_schedule_shift_drivers_show", Method: "GET", Path: "/v1/tender-job-schedule-shift-drivers/{id}"},
+	{Source: "tender_job_schedule_shift_fill_out_time_card_seller_notifications_list", Method: "GET", Path: "/v1/tender-job-schedule-shift-fill-out-time-card-seller-notifications", Filters: []string{"created-at-max", "created-at-min", "deliver-at", "deliver-at-max", "deliver-at-min", "delivery-decision-approach", "is-created-at", "is-ready-for-delivery", "is-updated-at", "not-id", "notification-type", "read", "updated-at-max", "updated-at-min", "user"}},
+	{Source: "tender_job_schedule_shift_fill_out_time_card_seller_notifications_show", Method: "GET", Path: "/v1/tender-job-schedule-shift-fill-out-time-card-seller-notifications/{id}"},
+	{Source: "tender_job_schedule_shift_starting_seller_notifications_list", Method: "GET", Path: "/v1/tender-job-schedule-shift-starting-seller-notifications", Filters: []string{"deliver-at", "delivery-decision-approach", "is-ready-for-delivery", "notification-type", "read", "user"}},
+	{Source: "tender_job_schedule_shift_starting_seller_notifications_show", Method: "GET", Path: "/v1/tender-job-schedule-shift-starting-seller-notifications/{id}"},
+	{Source: "tender_job_schedule_shift_time_card_reviews_list", Method: "GET", Path: "/v1/tender-job-schedule-shift-time-card-reviews", Filters: []string{"tender_job_schedule_shift"}},
+	{Source: "tender_job_schedule_shift_time_card_reviews_show", Method: "GET", Path: "/v1/tender-job-schedule-shift-time-card-reviews/{id}"},
+	{Source: "tender_job_schedule_shifts_list", Method: "GET", Path: "/v1/tender-job-schedule-shifts", Filters: []string{"active_as_of", "allows_new_trip", "broker", "cancelled", "customer", "default_time_card_approval_process", "developer_trucker_certification", "developer_trucker_certification_classification", "driver_day_sequence_index", "drivers", "end_at_max", "end_at_min", "expects_time_cards", "is_managed", "is_managed_or_alive", "job", "job_production_plan", "job_schedule_shift", "job_site", "matches_material_purchase_order_release", "missing_assignment", "missing_driver_assignment_acknowledgement", "on_time_card", "related_tender_status", "retained", "retainer", "seller_operations_contact", "shift_ends_before", "shift_starts_after", "shift_starts_before", "sourced_with_trucker", "start_date", "tender", "tender_type", "time_card_status", "time_card_ticket_number", "tracked_pct_min", "tractor", "tractor_id", "trailer", "trailer_id", "trucker", "trucker_shift_set", "with_driver_assignment_refusals", "with_job_production_plan", "with_seller_operations_contact_assigned_or_drafted", "with_trailer_assigned_or_drafted", "without_ready_to_work_service_event", "without_seller_operations_contact"}},
+	{Source: "tender_job_schedule_shifts_material_transactions_checksums_list", Method: "GET", Path: "/v1/tender-job-schedule-shifts-material-transactions-checksums"},
+	{Source: "tender_job_schedule_shifts_material_transactions_checksums_show", Method: "GET", Path: "/v1/tender-job-schedule-shifts-material-transactions-checksums/{id}"},
+	{Source: "tender_job_schedule_shifts_show", Method: "GET", Path: "/v1/tender-job-schedule-shifts/{id}"},
+	{Source: "tender_offers_list", Method: "GET", Path: "/v1/tender-offers"},
+	{Source: "tender_offers_show", Method: "GET", Path: "/v1/tender-offers/{id}"},
+	{Source: "tender_re_rates_list", Method: "GET", Path: "/v1/sombreros/tender-re-rates"},
+	{Source: "tender_re_rates_show", Method: "GET", Path: "/v1/sombreros/tender-re-rates/{id}"},
+	{Source: "tender_rejections_list", Method: "GET", Path: "/v1/tender-rejections"},
+	{Source: "tender_rejections_show", Method: "GET", Path: "/v1/tender-rejections/{id}"},
+	{Source: "tender_returns_list", Method: "GET", Path: "/v1/tender-returns"},
+	{Source: "tender_returns_show", Method: "GET", Path: "/v1/tender-returns/{id}"},
+	{Source: "tender_status_changes_list", Method: "GET", Path: "/v1/tender-status-changes", Filters: []string{"status", "tender"}},
+	{Source: "tender_status_changes_show", Method: "GET", Path: "/v1/tender-status-changes/{id}"},
+	{Source: "tenders_list", Method: "GET", Path: "/v1/tenders", Filters: []string{"broker", "business-unit", "buyer", "created-at-max", "created-at-min", "end-at-max", "has-flexible-shifts", "job", "job-number", "job-production-plan-material-sites", "job-production-plan-material-types", "job-production-plan-name-or-number-like", "job-production-plan-trailer-classification-or-equivalent", "job-site", "seller", "start-at-max", "start-at-min", "status", "updated-at-max", "updated-at-min", "with-alive-shifts"}},
+	{Source: "tenders_show", Method: "GET", Path: "/v1/tenders/{id}"},
+	{Source: "tenna_vehicles_list", Method: "GET", Path: "/v1/tenna-vehicles", Filters: []string{"assigned_at_min", "broker", "created_at_max", "created_at_min", "equipment", "equipment_assigned_at_min", "equipment_set_at_max", "equipment_set_at_min", "has_equipment", "has_tractor", "has_trailer", "integration_identifier", "is_created_at", "is_equipment_set_at", "is_tractor_set_at", "is_trailer_set_at", "is_updated_at", "tractor", "tractor_set_at_max", "tractor_set_at_min", "trailer", "trailer_set_at_max", "trailer_set_at_min", "trucker", "updated_at_max", "updated_at_min"}},
+	{Source: "tenna_vehicles_show", Method: "GET", Path: "/v1/tenna-vehicles/{id}"},
+	{Source: "text_messages_list", Method: "GET", Path: "/v1/text-messages", Filters: []string{"date-sent", "date-sent-after", "date-sent-before", "from", "max-messages", "page-size", "to"}},
+	{Source: "text_messages_show", Method: "GET", Path: "/v1/text-messages/{id}"},
+	{Source: "ticket_report_dispatches_list", Method: "GET", Path: "/v1/ticket-report-dispatches", Filters: []string{"ticket-report"}},
+	{Source: "ticket_report_dispatches_show", Method: "GET", Path: "/v1/ticket-report-dispatches/{id}"},
+	{Source: "ticket_report_imports_list", Method: "GET", Path: "/v1/ticket-report-imports", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "ticket_report_imports_show", Method: "GET", Path: "/v1/ticket-report-imports/{id}"},
+	{Source: "ticket_reports_list", Method: "GET", Path: "/v1/ticket-reports", Filters: []string{"broker", "ticket-report-type"}},
+	{Source: "ticket_reports_show", Method: "GET", Path: "/v1/ticket-reports/{id}"},
+	{Source: "time_card_approval_audits_list", Method: "GET", Path: "/v1/time-card-approval-audits", Filters: []string{"time_card", "user"}},
+	{Source: "time_card_approval_audits_show", Method: "GET", Path: "/v1/time-card-approval-audits/{id}"},
+	{Source: "time_card_cost_code_allocations_list", Method: "GET", Path: "/v1/time-card-cost-code-allocations", Filters: []string{"time-card"}},
+	{Source: "time_card_cost_code_allocations_show", Method: "GET", Path: "/v1/time-card-cost-code-allocations/{id}"},
+	{Source: "time_card_invoices_list", Method: "GET", Path: "/v1/time-card-invoices", Filters: []string{"invoice-status", "invoice-type", "seller", "shift-starts-after"}},
+	{Source: "time_card_invoices_show", Method: "GET", Path: "/v1/time-card-invoices/{id}"},
+	{Source: "time_card_payroll_certifications_list", Method: "GET", Path: "/v1/time-card-payroll-certifications", Filters: []string{"created_by", "time_card"}},
+	{Source: "time_card_payroll_certifications_show", Method: "GET", Path: "/v1/time-card-payroll-certifications/{id}"},
+	{Source: "time_card_pre_approvals_list", Method: "GET", Path: "/v1/time-card-pre-approvals", Filters: []string{"tender_job_schedule_shift"}},
+	{Source: "time_card_pre_approvals_show", Method: "GET", Path: "/v1/time-card-pre-approvals/{id}"},
+	{Source: "time_card_scrappages_list", Method: "GET", Path: "/v1/time-card-scrappages"},
+	{Source: "time_card_scrappages_show", Method: "GET", Path: "/v1/time-card-scrappages/{id}"},
+	{Source: "time_card_status_changes_list", Method: "GET", Path: "/v1/time-card-status-changes", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "status", "time_card", "updated_at_max", "updated_at_min"}},
+	{Source: "time_card_status_changes_show", Method: "GET", Path: "/v1/time-card-status-changes/{id}"},
+	{Source: "time_card_time_changes_list", Method: "GET", Path: "/v1/time-card-time-changes", Filters: []string{"broker", "created-by", "is-processed", "time-card"}},
+	{Source: "time_card_time_changes_show", Method: "GET", Path: "/v1/time-card-time-changes/{id}"},
+	{Source: "time_card_unapprovals_list", Method: "GET", Path: "/v1/time-card-unapprovals"},
+	{Source: "time_card_unapprovals_show", Method: "GET", Path: "/v1/time-card-unapprovals/{id}"},
+	{Source: "time_card_unscrappages_list", Method: "GET", Path: "/v1/time-card-unscrappages"},
+	{Source: "time_card_unscrappages_show", Method: "GET", Path: "/v1/time-card-unscrappages/{id}"},
+	{Source: "time_cards_list", Method: "GET", Path: "/v1/time-cards", Filters: []string{"approvable-by", "approval-count", "approval-count-max", "approval-count-min", "approved-on-max", "approved-on-min", "broker", "broker-invoiced", "broker-tender", "business-unit", "contractor", "contractor-id", "cost-codes", "customer", "customer-id", "developer", "driver", "driver-id", "generate-broker-invoice", "generate-trucker-invoice", "has-shift-date", "has-submit-scheduled", "has-ticket-report", "invoice", "is-audited", "is-start-at", "job-number", "job-schedule-shift", "job-schedule-shift-id", "not-broker-invoiced", "not-trucker-invoiced", "on-shortfall-broker-tender", "on-shortfall-customer-tender", "shift-date", "shift-date-between", "shift-date-max", "shift-date-min", "start-at-max", "start-at-min", "status", "tender-job-schedule-shift", "ticket-number", "trailer", "trailer-id", "trucker", "trucker-id", "trucker-invoiced", "with-payroll-certification-requirement", "with-payroll-certification-requirement-met"}},
+	{Source: "time_cards_show", Method: "GET", Path: "/v1/time-cards/{id}"},
+	{Source: "time_sheet_approvals_list", Method: "GET", Path: "/v1/time-sheet-approvals"},
+	{Source: "time_sheet_approvals_show", Method: "GET", Path: "/v1/time-sheet-approvals/{id}"},
+	{Source: "time_sheet_cost_code_allocations_list", Method: "GET", Path: "/v1/time-sheet-cost-code-allocations", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "time_sheet", "updated_at_max", "updated_at_min"}},
+	{Source: "time_sheet_cost_code_allocations_show", Method: "GET", Path: "/v1/time-sheet-cost-code-allocations/{id}"},
+	{Source: "time_sheet_line_item_classifications_list", Method: "GET", Path: "/v1/time-sheet-line-item-classifications"},
+	{Source: "time_sheet_line_item_equipment_requirements_list", Method: "GET", Path: "/v1/time-sheet-line-item-equipment-requirements", Filters: []string{"equipment-requirement", "time-sheet-line-item"}},
+	{Source: "time_sheet_line_item_equipment_requirements_show", Method: "GET", Path: "/v1/time-sheet-line-item-equipment-requirements/{id}"},
+	{Source: "time_sheet_line_items_list", Method: "GET", Path: "/v1/time-sheet-line-items", Filters: []string{"broker", "cost-code", "craft-class", "craft-class-effective", "craft-class-effective-id", "customer", "end-at-max", "end-at-min", "is-end-at", "is-start-at", "maintenance-requirement", "start-at-max", "start-at-min", "time-card", "time-sheet", "trucker"}},
+	{Source: "time_sheet_line_items_show", Method: "GET", Path: "/v1/time-sheet-line-items/{id}"},
+	{Source: "time_sheet_no_shows_list", Method: "GET", Path: "/v1/time-sheet-no-shows", Filters: []string{"created_at_max", "created_at_min", "updated_at_max", "updated_at_min"}},
+	{Source: "time_sheet_no_shows_show", Method: "GET", Path: "/v1/time-sheet-no-shows/{id}"},
+	{Source: "time_sheet_rejections_list", Method: "GET", Path: "/v1/time-sheet-rejections"},
+	{Source: "time_sheet_rejections_show", Method: "GET", Path: "/v1/time-sheet-rejections/{id}"},
+	{Source: "time_sheet_status_changes_list", Method: "GET", Path: "/v1/time-sheet-status-changes", Filters: []string{"status", "time_sheet"}},
+	{Source: "time_sheet_status_changes_show", Method: "GET", Path: "/v1/time-sheet-status-changes/{id}"},
+	{Source: "time_sheet_unapprovals_list", Method: "GET", Path: "/v1/time-sheet-unapprovals"},
+	{Source: "time_sheet_unapprovals_show", Method: "GET", Path: "/v1/time-sheet-unapprovals/{id}"},
+	{Source: "time_sheet_unsubmissions_list", Method: "GET", Path: "/v1/time-sheet-unsubmissions"},
+	{Source: "time_sheet_unsubmissions_show", Method: "GET", Path: "/v1/time-sheet-unsubmissions/{id}"},
+	{Source: "time_sheets_exports_list", Method: "GET", Path: "/v1/time-sheets-exports", Filters: []string{"broker", "created-by", "organization", "organization-formatter", "organization-id", "organization-type", "status", "time-sheets"}},
+	{Source: "time_sheets_exports_show", Method: "GET", Path: "/v1/time-sheets-exports/{id}"},
+	{Source: "time_sheets_list", Method: "GET", Path: "/v1/time-sheets", Filters: []string{"broker", "driver", "end_at_max", "end_at_min", "equipment", "has_exports", "laborer", "laborer_user", "missing_craft_class", "start_at_max", "start_at_min", "start_on_max", "start_on_min", "status", "subject", "trucker"}},
+	{Source: "time_sheets_show", Method: "GET", Path: "/v1/time-sheets/{id}"},
+	{Source: "tractor_credentials_list", Method: "GET", Path: "/v1/tractor-credentials", Filters: []string{"active_on", "tractor", "tractor_trailer_credential_classification"}},
+	{Source: "tractor_fuel_consumption_readings_list", Method: "GET", Path: "/v1/tractor-fuel-consumption-readings", Filters: []string{"driver_day", "has-reading-on", "reading-on", "reading-on-max", "reading-on-min", "tractor", "trucker", "unit_of_measure"}},
+	{Source: "tractor_fuel_consumption_readings_show", Method: "GET", Path: "/v1/tractor-fuel-consumption-readings/{id}"},
+	{Source: "tractor_odometer_readings_list", Method: "GET", Path: "/v1/tractor-odometer-readings", Filters: []string{"created_by", "driver_day", "reading_on_max", "reading_on_min", "tractor", "trucker", "unit_of_measure"}},
+	{Source: "tractor_odometer_readings_show", Method: "GET", Path: "/v1/tractor-odometer-readings/{id}"},
+	{Source: "tractor_trailer_credential_classifications_list", Method: "GET", Path: "/v1/tractor-trailer-credential-classifications", Filters: []string{"name", "organization"}},
+	{Source: "tractors_list", Method: "GET", Path: "/v1/tractors", Filters: []string{"broker", "in_service", "number", "number_like", "trucker"}},
+	{Source: "trading_partners_list", Method: "GET", Path: "/v1/trading-partners", Filters: []string{"created-at-max", "created-at-min", "external-identification-value", "not-organization-type", "not-partner-type", "organization", "organization-id", "organization-type", "partner", "partner-id", "partner-type", "trading-partner-type", "updated-at-max", "updated-at-min"}},
+	{Source: "trading_partners_show", Method: "GET", Path: "/v1/trading-partners/{id}"},
+	{Source: "trailer_classifications_list", Method: "GET", Path: "/v1/trailer-classifications"},
+	{Source: "trailer_credentials_list", Method: "GET", Path: "/v1/trailer-credentials", Filters: []string{"active_on", "tractor_trailer_credential_classification", "trailer"}},
+	{Source: "trailers_list", Method: "GET", Path: "/v1/trailers", Filters: []string{"broker", "in_service", "number", "number_like", "q", "tractor", "trailer_classification", "trucker"}},
+	{Source: "transport_order_materials_list", Method: "GET", Path: "/v1/transport-order-materials", Filters: []string{"material_type", "quantity_explicit", "transport_order", "unit_of_measure"}},
+	{Source: "transport_order_materials_show", Method: "GET", Path: "/v1/transport-order-materials/{id}"},
+	{Source: "transport_order_project_transport_plan_strategy_set_predictions_list", Method: "GET", Path: "/v1/transport-order-project-transport-plan-strategy-set-predictions", Filters: []string{"transport-order"}},
+	{Source: "transport_order_project_transport_plan_strategy_set_predictions_show", Method: "GET", Path: "/v1/transport-order-project-transport-plan-strategy-set-predictions/{id}"},
+	{Source: "transport_order_stop_materials_list", Method: "GET", Path: "/v1/transport-order-stop-materials", Filters: []string{"quantity-explicit", "transport-order-material", "transport-order-stop"}},
+	{Source: "transport_order_stop_materials_show", Method: "GET", Path: "/v1/transport-order-stop-materials/{id}"},
+	{Source: "transport_order_stops_list", Method: "GET", Path: "/v1/transport-order-stops", Filters: []string{"at-max-max", "at-max-min", "at-min-max", "at-min-min", "created-at-max", "created-at-min", "external-identification-value", "external-tms-stop-number", "is-at-max", "is-at-min", "is-created-at", "is-updated-at", "location", "role", "transport-order", "updated-at-max", "updated-at-min"}},
+	{Source: "transport_order_stops_show", Method: "GET", Path: "/v1/transport-order-stops/{id}"},
+	{Source: "transport_orders_list", Method: "GET", Path: "/v1/transport-orders", Filters: []string{"%s-address-state-codes", "active", "billable-miles", "billable-miles-max", "billable-miles-min", "broker", "customer", "delivery-at-max", "delivery-at-min", "delivery-location", "ext-order-number", "is-managed", "material-type", "maybe-active", "near-delivery-location", "near-pickup-location", "ordered-at", "ordered-at-max", "ordered-at-min", "pickup-at-max", "pickup-at-min", "pickup-location", "project", "project-category", "project-division", "project-office", "project-transport-organization", "q", "status", "stops-at-max-min", "stops-at-min-max", "unplanned"}},
+	{Source: "transport_references_list", Method: "GET", Path: "/v1/transport-references", Filters: []string{"key", "position", "subject"}},
+	{Source: "transport_references_show", Method: "GET", Path: "/v1/transport-references/{id}"},
+	{Source: "transport_routes_list", Method: "GET", Path: "/v1/transport-routes", Filters: []string{"near-destination-location", "near-origin-location"}},
+	{Source: "transport_routes_show", Method: "GET", Path: "/v1/transport-routes/{id}"},
+	{Source: "trips_list", Method: "GET", Path: "/v1/trips", Filters: []string{"driver_day", "job_sites", "material_sites", "parking_sites", "tender", "tender_job_schedule_shift", "unloaded_subject"}},
+	{Source: "truck_scopes_list", Method: "GET", Path: "/v1/truck-scopes"},
+	{Source: "trucker_applications_list", Method: "GET", Path: "/v1/trucker-applications", Filters: []string{"broker", "company-address-within", "phone-number", "q", "status"}},
+	{Source: "trucker_applications_show", Method: "GET", Path: "/v1/trucker-applications/{id}"},
+	{Source: "trucker_brokerages_list", Method: "GET", Path: "/v1/trucker-brokerages", Filters: []string{"brokered-trucker", "created-at-max", "created-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "trucker_brokerages_show", Method: "GET", Path: "/v1/trucker-brokerages/{id}"},
+	{Source: "trucker_insurances_list", Method: "GET", Path: "/v1/trucker-insurances", Filters: []string{"trucker"}},
+	{Source: "trucker_invoice_payments_list", Method: "GET", Path: "/v1/trucker-invoice-payments", Filters: []string{"trucker", "trucker_id"}},
+	{Source: "trucker_invoice_payments_show", Method: "GET", Path: "/v1/trucker-invoice-payments/{id}"},
+	{Source: "trucker_invoices_list", Method: "GET", Path: "/v1/trucker-invoices", Filters: []string{"allocated-cost-codes", "batch-status", "broker", "business-unit", "buyer", "customer", "due-on", "due-on-max", "due-on-min", "has-due-on", "has-invoice-date", "has-ticket-report", "having-plans-with-job-number-like", "invoice-date", "invoice-date-max", "invoice-date-min", "is-management-service-type", "material-transaction-cost-codes", "material-transaction-ticket-numbers", "not-business-unit", "quickbooks-id", "seller", "status", "tender", "ticket-number"}},
+	{Source: "trucker_invoices_show", Method: "GET", Path: "/v1/trucker-invoices/{id}"},
+	{Source: "trucker_memberships_list", Method: "GET", Path: "/v1/trucker-memberships", Filters: []string{"broker", "drives_shift_type", "external_employee_id", "is_default_job_production_plan_subscriber", "is_equipment_rental_team_member", "is_geofence_violation_team_member", "is_rate_editor", "is_time_card_auditor", "is_unapproved_time_card_subscriber", "kind", "organization", "project_office", "q", "user"}},
+	{Source: "trucker_memberships_show", Method: "GET", Path: "/v1/trucker-memberships/{id}"},
+	{Source: "trucker_referral_codes_list", Method: "GET", Path: "/v1/trucker-referral-codes", Filters: []string{"code", "created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min"}},
+	{Source: "trucker_referral_codes_show", Method: "GET", Path: "/v1/trucker-referral-codes/{id}"},
+	{Source: "trucker_referrals_list", Method: "GET", Path: "/v1/trucker-referrals", Filters: []string{"broker", "referred-on", "referred-on-max", "referred-on-min", "trucker", "user"}},
+	{Source: "trucker_referrals_show", Method: "GET", Path: "/v1/trucker-referrals/{id}"},
+	{Source: "trucker_settings_list", Method: "GET", Path: "/v1/trucker-settings", Filters: []string{"trucker", "trucker_id"}},
+	{Source: "trucker_settings_show", Method: "GET", Path: "/v1/trucker-settings/{id}"},
+	{Source: "trucker_shift_sets_list", Method: "GET", Path: "/v1/trucker-shift-sets", Filters: []string{"broker", "broker-id", "business-unit", "customer", "driver", "driver-day-on", "driver-id", "driver-name", "has-constraint", "has-driver", "has-start-on", "is-expecting-time-sheet", "number-of-shifts-eq", "number-of-shifts-gte", "odometer-end-value", "odometer-end-value-max", "odometer-end-value-min", "odometer-start-value", "odometer-start-value-max", "odometer-start-value-min", "shifts", "start-at", "start-on", "start-on-max", "start-on-min", "tractor", "trailer", "trailer-classification", "trucker", "with-missing-time-card-approvals", "without-approved-time-card", "without-approved-time-sheet", "without-submitted-time-sheet", "without-time-card"}},
+	{Source: "trucker_shift_sets_show", Method: "GET", Path: "/v1/trucker-shift-sets/{id}"},
+	{Source: "truckers_list", Method: "GET", Path: "/v1/truckers", Filters: []string{"broker", "broker-rating", "broker-vendor-id", "company-address-within", "company_name", "favorite", "is_active", "last-shift-start-at-max", "last-shift-start-at-min", "managing-customer", "phone-number", "q", "tax-identifier", "trailer-classifications", "with-uninvoiced-approved-time-card", "within-customer-truckers-of"}},
+	{Source: "ui_tour_steps_list", Method: "GET", Path: "/v1/ui-tour-steps", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "not-id", "updated-at-max", "updated-at-min"}},
+	{Source: "ui_tour_steps_show", Method: "GET", Path: "/v1/ui-tour-steps/{id}"},
+	{Source: "ui_tours_list", Method: "GET", Path: "/v1/ui-tours", Filters: []string{"abbreviation"}},
+	{Source: "ui_tours_show", Method: "GET", Path: "/v1/ui-tours/{id}"},
+	{Source: "unit_of_measures_list", Method: "GET", Path: "/v1/unit-of-measures", Filters: []string{"measurement-system", "metric", "name"}},
+	{Source: "user_creator_feed_creators_list", Method: "GET", Path: "/v1/user-creator-feed-creators", Filters: []string{"creator", "creator-id", "creator-type", "follow", "user", "user-creator-feed", "user-id"}},
+	{Source: "user_creator_feed_creators_show", Method: "GET", Path: "/v1/user-creator-feed-creators/{id}"},
+	{Source: "user_creator_feeds_list", Method: "GET", Path: "/v1/user-creator-feeds", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min", "user"}},
+	{Source: "user_creator_feeds_show", Method: "GET", Path: "/v1/user-creator-feeds/{id}"},
+	{Source: "user_credential_classifications_list", Method: "GET", Path: "/v1/user-credential-classifications", Filters: []string{"name", "organization"}},
+	{Source: "user_credentials_list", Method: "GET", Path: "/v1/user-credentials", Filters: []string{"active_on", "user", "user_credential_classification"}},
+	{Source: "user_languages_list", Method: "GET", Path: "/v1/user-languages", Filters: []string{"is-default", "language", "user"}},
+	{Source: "user_languages_show", Method: "GET", Path: "/v1/user-languages/{id}"},
+	{Source: "user_location_estimates_list", Method: "GET", Path: "/v1/user-location-estimates", Filters: []string{"as-of", "earliest-event-at", "latest-event-at", "max-abs-latency-seconds", "max-latest-seconds", "user"}},
+	{Source: "user_location_events_list", Method: "GET", Path: "/v1/user-location-events", Filters: []string{"event-at-max", "event-at-min", "is-event-at", "provenance", "updated-by", "user"}},
+	{Source: "user_location_events_show", Method: "GET", Path: "/v1/user-location-events/{id}"},
+	{Source: "user_location_requests_list", Method: "GET", Path: "/v1/user-location-requests", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "updated-at-max", "updated-at-min", "updated-by", "user"}},
+	{Source: "user_location_requests_show", Method: "GET", Path: "/v1/user-location-requests/{id}"},
+	{Source: "user_post_feed_posts_list", Method: "GET", Path: "/v1/user-post-feed-posts", Filters: []string{"creator", "feed-at-max", "feed-at-min", "is-feed-at", "is-subscription-end-at", "is-subscription-start-at", "post", "post-type", "score", "subscription-end-at-max", "subscription-end-at-min", "subscription-start-at-max", "subscription-start-at-min", "user", "user-id"}},
+	{Source: "user_post_feed_posts_show", Method: "GET", Path: "/v1/user-post-feed-posts/{id}"},
+	{Source: "user_post_feeds_list", Method: "GET", Path: "/v1/user-post-feeds", Filters: []string{"created_at_max", "created_at_min", "is_created_at", "is_updated_at", "updated_at_max", "updated_at_min", "user"}},
+	{Source: "user_post_feeds_show", Method: "GET", Path: "/v1/user-post-feeds/{id}"},
+	{Source: "user_searches_list", Method: "GET", Path: "/v1/user-searches"},
+	{Source: "user_ui_tours_list", Method: "GET", Path: "/v1/user-ui-tours", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "not-id", "updated-at-max", "updated-at-min"}},
+	{Source: "user_ui_tours_show", Method: "GET", Path: "/v1/user-ui-tours/{id}"},
+	{Source: "users_list", Method: "GET", Path: "/v1/users", Filters: []string{"can-manage-projects-for", "dark-mode", "email-address", "email-address-like", "has-notifai-text", "having-customer-membership-with", "having-manager-trucker-membership-with", "having-trucker-membership-with", "is-available-for-question-assignment", "is-driver", "is-suspended-from-driving", "is_admin", "mobile-number", "q", "slack-id"}},
+	{Source: "vehicle_location_events_list", Method: "GET", Path: "/v1/vehicle-location-events", Filters: []string{"created_at_max", "created_at_min", "event_at_max", "event_at_min", "include_device_location_events", "is_created_at", "is_event_at", "is_updated_at", "time_slice_min", "total_count_max", "tractor", "trailer", "updated_at_max", "updated_at_min"}},
+	{Source: "vehicle_location_events_show", Method: "GET", Path: "/v1/vehicle-location-events/{id}"},
+	{Source: "verizon_reveal_vehicles_list", Method: "GET", Path: "/v1/verizon-reveal-vehicles", Filters: []string{"assigned-at-min", "broker", "created-at-max", "created-at-min", "equipment", "equipment-assigned-at-min", "equipment-set-at-max", "equipment-set-at-min", "has-equipment", "has-tractor", "has-trailer", "integration-identifier", "is-created-at", "is-equipment-set-at", "is-tractor-set-at", "is-trailer-set-at", "is-updated-at", "tractor", "tractor-set-at-max", "tractor-set-at-min", "trailer", "trailer-set-at-max", "trailer-set-at-min", "trucker", "updated-at-max", "updated-at-min"}},
+	{Source: "verizon_reveal_vehicles_show", Method: "GET", Path: "/v1/verizon-reveal-vehicles/{id}"},
+	{Source: "version_events_list", Method: "GET", Path: "/v1/version-events", Filters: []string{"created-at-max", "created-at-min", "is-created-at", "is-updated-at", "updated-at-max", "updated-at-min"}},
+	{Source: "version_events_show", Method: "GET", Path: "/v1/version-events/{id}"},
+	{Source: "work_order_assignments_list", Method: "GET", Path: "/v1/work-order-assignments", Filters: []string{"user", "work-order"}},
+	{Source: "work_order_assignments_show", Method: "GET", Path: "/v1/work-order-assignments/{id}"},
+	{Source: "work_order_service_codes_list", Method: "GET", Path: "/v1/work-order-service-codes", Filters: []string{"broker"}},
+	{Source: "work_order_service_codes_show", Method: "GET", Path: "/v1/work-order-service-codes/{id}"},
+	{Source: "work_orders_list", Method: "GET", Path: "/v1/work-orders", Filters: []string{"broker", "custom_work_order_status", "due_date_max", "due_date_min", "priority", "responsible_party", "safety_tag_status", "service_code", "service_site", "status"}},
+}
@@ -0,0 +1,1456 @@
+// Code generated by build_tools/rowschemas; DO NOT EDIT.
+
+package cli
+
+import "reflect"
+
+// commandOutputTypes maps command source files to the type they print with --json.
+var commandOutputTypes = map[string]reflect.Type{
+	"action_item_key_results_list":                                                reflect.TypeOf(([]actionItemKeyResultRow)(nil)),
+	"action_item_line_items_list":                                                 reflect.TypeOf(([]actionItemLineItemRow)(nil)),
+	"action_item_team_members_list":                                               reflect.TypeOf(([]actionItemTeamMemberRow)(nil)),
+	"action_item_tracker_update_requests_list":                                    reflect.TypeOf(([]actionItemTrackerUpdateRequestRow)(nil)),
+	"action_item_trackers_list":                                                   reflect.TypeOf(([]actionItemTrackerRow)(nil)),
+	"action_items_list":                                                           reflect.TypeOf(([]actionItemRow)(nil)),
+	"administrative_incidents_list":                                               reflect.TypeOf(([]administrativeIncidentRow)(nil)),
+	"answer_feedbacks_list":                                                       reflect.TypeOf(([]answerFeedbackRow)(nil)),
+	"answer_related_contents_list":                                                reflect.TypeOf(([]answerRelatedContentRow)(nil)),
+	"answers_list":                                                                reflect.TypeOf(([]answerRow)(nil)),
+	"api_tokens_list":                                                             reflect.TypeOf(([]apiTokenRow)(nil)),
+	"application_settings_list":                                                   reflect.TypeOf(([]applicationSettingRow)(nil)),
+	"base_summary_templates_list":                                                 reflect.TypeOf(([]baseSummaryTemplateRow)(nil)),
+	"bidders_list":                                                                reflect.TypeOf(([]bidderRow)(nil)),
+	"broker_certification_types_list":                                             reflect.TypeOf(([]brokerCertificationTypeRow)(nil)),
+	"broker_commitments_list":                                                     reflect.TypeOf(([]brokerCommitmentRow)(nil)),
+	"broker_customers_list":                                                       reflect.TypeOf(([]brokerCustomerRow)(nil)),
+	"broker_equipment_classifications_list":                                       reflect.TypeOf(([]brokerEquipmentClassificationRow)(nil)),
+	"broker_invoices_list":                                                        reflect.TypeOf(([]brokerInvoiceRow)(nil)),
+	"broker_memberships_list":                                                     reflect.TypeOf(([]brokerMembershipRow)(nil)),
+	"broker_project_transport_event_types_list":                                   reflect.TypeOf(([]brokerProjectTransportEventTypeRow)(nil)),
+	"broker_retainers_list":                                                       reflect.TypeOf(([]brokerRetainerRow)(nil)),
+	"broker_settings_list":                                                        reflect.TypeOf(([]brokerSettingRow)(nil)),
+	"broker_tender_cancelled_seller_notifications_list":                           reflect.TypeOf(([]brokerTenderCancelledSellerNotificationRow)(nil)),
+	"broker_tender_offered_seller_notification_subscriptions_list":                reflect.TypeOf(([]brokerTenderOfferedSellerNotificationSubscriptionRow)(nil)),
+	"broker_tender_offered_seller_notifications_list":                             reflect.TypeOf(([]brokerTenderOfferedSellerNotificationRow)(nil)),
+	"broker_tender_returned_buyer_notifications_list":                             reflect.TypeOf(([]brokerTenderReturnedBuyerNotificationRow)(nil)),
+	"broker_tenders_list":                                                         reflect.TypeOf(([]brokerTenderRow)(nil)),
+	"broker_trucker_ratings_list":                                                 reflect.TypeOf(([]brokerTruckerRatingRow)(nil)),
+	"broker_vendors_list":                                                         reflect.TypeOf(([]brokerVendorRow)(nil)),
+	"brokers_list":                                                                reflect.TypeOf(([]brokerRow)(nil)),
+	"business_unit_customers_list":                                                reflect.TypeOf(([]businessUnitCustomerRow)(nil)),
+	"business_unit_equipments_list":                                               reflect.TypeOf(([]businessUnitEquipmentRow)(nil)),
+	"business_unit_laborers_list":                                                 reflect.TypeOf(([]businessUnitLaborerRow)(nil)),
+	"business_unit_memberships_list":                                              reflect.TypeOf(([]businessUnitMembershipRow)(nil)),
+	"business_units_list":                                                         reflect.TypeOf(([]businessUnitRow)(nil)),
+	"certification_requirements_list":                                             reflect.TypeOf(([]certificationRequirementRow)(nil)),
+	"certification_types_list":                                                    reflect.TypeOf(([]certificationTypeRow)(nil)),
+	"certifications_list":                                                         reflect.TypeOf(([]certificationRow)(nil)),
+	"change_requests_list":                                                        reflect.TypeOf(([]changeRequestRow)(nil)),
+	"comment_reactions_list":                                                      reflect.TypeOf(([]commentReactionRow)(nil)),
+	"comments_list":                                                               reflect.TypeOf(([]commentRow)(nil)),
+	"commitment_items_list":                                                       reflect.TypeOf(([]commitmentItemRow)(nil)),
+	"commitment_simulation_periods_list":                                          reflect.TypeOf(([]commitmentSimulationPeriodRow)(nil)),
+	"commitment_simulation_sets_list":                                             reflect.TypeOf(([]commitmentSimulationSetRow)(nil)),
+	"commitment_simulations_list":                                                 reflect.TypeOf(([]commitmentSimulationRow)(nil)),
+	"commitments_list":                                                            reflect.TypeOf(([]commitmentRow)(nil)),
+	"communications_list":                                                         reflect.TypeOf(([]communicationRow)(nil)),
+	"contractors_list":                                                            reflect.TypeOf(([]contractorRow)(nil)),
+	"cost_code_trucking_cost_summaries_list":                                      reflect.TypeOf(([]costCodeTruckingCostSummaryRow)(nil)),
+	"cost_codes_list":                                                             reflect.TypeOf(([]costCodeRow)(nil)),
+	"cost_index_entries_list":                                                     reflect.TypeOf(([]costIndexEntryRow)(nil)),
+	"cost_indexes_list":                                                           reflect.TypeOf(([]costIndexRow)(nil)),
+	"craft_classes_list":                                                          reflect.TypeOf(([]craftClassRow)(nil)),
+	"crafts_list":                                                                 reflect.TypeOf(([]craftRow)(nil)),
+	"crew_assignment_confirmations_list":                                          reflect.TypeOf(([]crewAssignmentConfirmationRow)(nil)),
+	"crew_rates_list":                                                             reflect.TypeOf(([]crewRateRow)(nil)),
+	"crew_requirement_credential_classifications_list":                            reflect.TypeOf(([]crewRequirementCredentialClassificationRow)(nil)),
+	"crew_requirements_list":                                                      reflect.TypeOf(([]crewRequirementRow)(nil)),
+	"culture_values_list":                                                         reflect.TypeOf(([]cultureValueRow)(nil)),
+	"custom_work_order_statuses_list":                                             reflect.TypeOf(([]customWorkOrderStatusRow)(nil)),
+	"customer_applications_list":                                                  reflect.TypeOf(([]customerApplicationRow)(nil)),
+	"customer_certification_types_list":                                           reflect.TypeOf(([]customerCertificationTypeRow)(nil)),
+	"customer_commitments_list":                                                   reflect.TypeOf(([]customerCommitmentRow)(nil)),
+	"customer_incident_default_assignees_list":                                    reflect.TypeOf(([]customerIncidentDefaultAssigneeRow)(nil)),
+	"customer_memberships_list":                                                   reflect.TypeOf(([]membershipRow)(nil)),
+	"customer_retainers_list":                                                     reflect.TypeOf(([]customerRetainerRow)(nil)),
+	"customer_settings_list":                                                      reflect.TypeOf(([]customerSettingRow)(nil)),
+	"customer_tender_offered_buyer_notification_subscriptions_list":               reflect.TypeOf(([]customerTenderOfferedBuyerNotificationSubscriptionRow)(nil)),
+	"customer_tender_offered_buyer_notifications_list":                            reflect.TypeOf(([]customerTenderOfferedBuyerNotificationRow)(nil)),
+	"customer_tenders_list":                                                       reflect.TypeOf(([]customerTenderRow)(nil)),
+	"customer_truckers_list":                                                      reflect.TypeOf(([]customerTruckerRow)(nil)),
+	"customer_vendors_list":                                                       reflect.TypeOf(([]customerVendorRow)(nil)),
+	"customers_list":                                                              reflect.TypeOf(([]customerRow)(nil)),
+	"deere_equipments_list":                                                       reflect.TypeOf(([]deereEquipmentRow)(nil)),
+	"deere_integrations_list":                                                     reflect.TypeOf(([]deereIntegrationRow)(nil)),
+	"developer_certified_weighers_list":                                           reflect.TypeOf(([]developerCertifiedWeigherRow)(nil)),
+	"developer_memberships_list":                                                  reflect.TypeOf(([]developerMembershipRow)(nil)),
+	"developer_reference_types_list":                                              reflect.TypeOf(([]developerReferenceTypeRow)(nil)),
+	"developer_references_list":                                                   reflect.TypeOf(([]developerReferenceRow)(nil)),
+	"developer_trucker_certification_classifications_list":                        reflect.TypeOf(([]developerTruckerCertificationClassificationRow)(nil)),
+	"developer_trucker_certification_multipliers_list":                            reflect.TypeOf(([]developerTruckerCertificationMultiplierRow)(nil)),
+	"developer_trucker_certifications_list":                                       reflect.TypeOf(([]developerTruckerCertificationRow)(nil)),
+	"developers_list":                                                             reflect.TypeOf(([]developerRow)(nil)),
+	"device_diagnostics_list":                                                     reflect.TypeOf(([]deviceDiagnosticRow)(nil)),
+	"devices_list":                                                                reflect.TypeOf(([]deviceRow)(nil)),
+	"digital_fleet_ticket_events_list":                                            reflect.TypeOf(([]digitalFleetTicketEventRow)(nil)),
+	"digital_fleet_trucks_list":                                                   reflect.TypeOf(([]digitalFleetTruckRow)(nil)),
+	"do_action_item_key_results_create":                                           reflect.TypeOf(actionItemKeyResultRow{}),
+	"do_action_item_line_items_create":                                            reflect.TypeOf(actionItemLineItemRow{}),
+	"do_action_item_line_items_update":                                            reflect.TypeOf(actionItemLineItemRow{}),
+	"do_action_item_team_members_create":                                          reflect.TypeOf(actionItemTeamMemberDetails{}),
+	"do_action_item_team_members_update":                                          reflect.TypeOf(actionItemTeamMemberDetails{}),
+	"do_action_item_tracker_update_requests_create":                               reflect.TypeOf(actionItemTrackerUpdateRequestRow{}),
+	"do_action_item_tracker_update_requests_update":                               reflect.TypeOf(actionItemTrackerUpdateRequestRow{}),
+	"do_action_item_trackers_create":                                              reflect.TypeOf(actionItemTrackerDetails{}),
+	"do_action_item_trackers_update":                                              reflect.TypeOf(actionItemTrackerDetails{}),
+	"do_action_items_create":                                                      reflect.TypeOf(actionItemDetails{}),
+	"do_action_items_delete":                                                      reflect.TypeOf(actionItemDetails{}),
+	"do_action_items_update":                                                      reflect.TypeOf(actionItemDetails{}),
+	"do_administrative_incidents_create":                                          reflect.TypeOf(administrativeIncidentDetails{}),
+	"do_administrative_incidents_update":                                          reflect.TypeOf(administrativeIncidentDetails{}),
+	"do_answer_feedbacks_create":                                                  reflect.TypeOf(answerFeedbackRow{}),
+	"do_answer_feedbacks_update":                                                  reflect.TypeOf(answerFeedbackRow{}),
+	"do_answer_related_contents_create":                                           reflect.TypeOf(answerRelatedContentRow{}),
+	"do_answer_related_contents_update":                                           reflect.TypeOf(answerRelatedContentRow{}),
+	"do_api_tokens_create":                                                        reflect.TypeOf(apiTokenRow{}),
+	"do_api_tokens_update":                                                        reflect.TypeOf(apiTokenRow{}),
+	"do_application_settings_create":                                              reflect.TypeOf(applicationSettingRow{}),
+	"do_application_settings_delete":                                              reflect.TypeOf(applicationSettingRow{}),
+	"do_application_settings_update":                                              reflect.TypeOf(applicationSettingRow{}),
+	"do_base_summary_templates_create":                                            reflect.TypeOf(baseSummaryTemplateDetails{}),
+	"do_base_summary_templates_delete":                                            reflect.TypeOf(baseSummaryTemplateDetails{}),
+	"do_bidders_create":                                                           reflect.TypeOf(bidderRow{}),
+	"do_bidders_update":                                                           reflect.TypeOf(bidderRow{}),
+	"do_broker_certification_types_create":                                        reflect.TypeOf(brokerCertificationTypeRow{}),
+	"do_broker_certification_types_delete":                                        reflect.TypeOf(brokerCertificationTypeRow{}),
+	"do_broker_certification_types_update":                                        reflect.TypeOf(brokerCertificationTypeRow{}),
+	"do_broker_commitments_create":                                                reflect.TypeOf(brokerCommitmentRow{}),
+	"do_broker_commitments_update":                                                reflect.TypeOf(brokerCommitmentRow{}),
+	"do_broker_customers_create":                                                  reflect.TypeOf(brokerCustomerRow{}),
+	"do_broker_customers_update":                                                  reflect.TypeOf(brokerCustomerRow{}),
+	"do_broker_equipment_classifications_create":                                  reflect.TypeOf(brokerEquipmentClassificationRow{}),
+	"do_broker_equipment_classifications_delete":                                  reflect.TypeOf(brokerEquipmentClassificationRow{}),
+	"do_broker_equipment_classifications_update":                                  reflect.TypeOf(brokerEquipmentClassificationRow{}),
+	"do_broker_invoices_create":                                                   reflect.TypeOf(brokerInvoiceRow{}),
+	"do_broker_invoices_update":                                                   reflect.TypeOf(brokerInvoiceRow{}),
+	"do_broker_memberships_create":                                                reflect.TypeOf(brokerMembershipDetails{}),
+	"do_broker_memberships_delete":                                                reflect.TypeOf(brokerMembershipDetails{}),
+	"do_broker_memberships_update":                                                reflect.TypeOf(brokerMembershipDetails{}),
+	"do_broker_project_transport_event_types_create":                              reflect.TypeOf(brokerProjectTransportEventTypeRow{}),
+	"do_broker_project_transport_event_types_update":                              reflect.TypeOf(brokerProjectTransportEventTypeRow{}),
+	"do_broker_retainer_payment_forecasts_create":                                 reflect.TypeOf(brokerRetainerPaymentForecastRow{}),
+	"do_broker_retainers_create":                                                  reflect.TypeOf(brokerRetainerRow{}),
+	"do_broker_retainers_update":                                                  reflect.TypeOf(brokerRetainerRow{}),
+	"do_broker_settings_update":                                                   reflect.TypeOf(brokerSettingRow{}),
+	"do_broker_tender_cancelled_seller_notifications_update":                      reflect.TypeOf(brokerTenderCancelledSellerNotificationDetails{}),
+	"do_broker_tender_offered_seller_notification_subscriptions_create":           reflect.TypeOf(brokerTenderOfferedSellerNotificationSubscriptionRow{}),
+	"do_broker_tender_offered_seller_notification_subscriptions_update":           reflect.TypeOf(brokerTenderOfferedSellerNotificationSubscriptionRow{}),
+	"do_broker_tender_offered_seller_notifications_update":                        reflect.TypeOf(brokerTenderOfferedSellerNotificationRow{}),
+	"do_broker_tender_returned_buyer_notifications_update":                        reflect.TypeOf(brokerTenderReturnedBuyerNotificationDetails{}),
+	"do_broker_tenders_create":                                                    reflect.TypeOf(brokerTenderRow{}),
+	"do_broker_tenders_update":                                                    reflect.TypeOf(brokerTenderRow{}),
+	"do_broker_trucker_ratings_create":                                            reflect.TypeOf(brokerTruckerRatingRow{}),
+	"do_broker_trucker_ratings_delete":                                            reflect.TypeOf(brokerTruckerRatingRow{}),
+	"do_broker_trucker_ratings_update":                                            reflect.TypeOf(brokerTruckerRatingRow{}),
+	"do_broker_vendors_create":                                                    reflect.TypeOf(brokerVendorRow{}),
+	"do_broker_vendors_update":                                                    reflect.TypeOf(brokerVendorRow{}),
+	"do_built_time_cards_create":                                                  reflect.TypeOf(builtTimeCardRow{}),
+	"do_business_unit_customers_create":                                           reflect.TypeOf(businessUnitCustomerRow{}),
+	"do_business_unit_equipments_create":                                          reflect.TypeOf(businessUnitEquipmentRow{}),
+	"do_business_unit_laborers_create":                                            reflect.TypeOf(businessUnitLaborerRow{}),
+	"do_business_unit_memberships_create":                                         reflect.TypeOf(businessUnitMembershipDetails{}),
+	"do_business_unit_memberships_update":                                         reflect.TypeOf(businessUnitMembershipDetails{}),
+	"do_business_units_create":                                                    reflect.TypeOf(businessUnitRow{}),
+	"do_business_units_update":                                                    reflect.TypeOf(businessUnitRow{}),
+	"do_certification_requirements_create":                                        reflect.TypeOf(certificationRequirementRow{}),
+	"do_certification_requirements_update":                                        reflect.TypeOf(certificationRequirementRow{}),
+	"do_certification_types_create":                                               reflect.TypeOf(certificationTypeRow{}),
+	"do_certification_types_delete":                                               reflect.TypeOf(certificationTypeRow{}),
+	"do_certification_types_update":                                               reflect.TypeOf(certificationTypeRow{}),
+	"do_certifications_create":                                                    reflect.TypeOf(certificationRow{}),
+	"do_certifications_update":                                                    reflect.TypeOf(certificationRow{}),
+	"do_change_requests_create":                                                   reflect.TypeOf(changeRequestRow{}),
+	"do_change_requests_update":                                                   reflect.TypeOf(changeRequestRow{}),
+	"do_comment_reactions_create":                                                 reflect.TypeOf(commentReactionRow{}),
+	"do_comments_create":                                                          reflect.TypeOf(commentRow{}),
+	"do_comments_update":                                                          reflect.TypeOf(commentRow{}),
+	"do_commitment_items_create":                                                  reflect.TypeOf(commitmentItemRow{}),
+	"do_commitment_items_update":                                                  reflect.TypeOf(commitmentItemRow{}),
+	"do_commitment_simulation_sets_create":                                        reflect.TypeOf(commitmentSimulationSetRow{}),
+	"do_commitment_simulations_create":                                            reflect.TypeOf(commitmentSimulationRow{}),
+	"do_contractors_create":                                                       reflect.TypeOf(contractorRow{}),
+	"do_contractors_update":                                                       reflect.TypeOf(contractorRow{}),
+	"do_cost_code_trucking_cost_summaries_create":                                 reflect.TypeOf(costCodeTruckingCostSummaryRow{}),
+	"do_cost_code_trucking_cost_summaries_update":                                 reflect.TypeOf(costCodeTruckingCostSummaryRow{}),
+	"do_cost_codes_create":                                                        reflect.TypeOf(costCodeRow{}),
+	"do_cost_codes_update":                                                        reflect.TypeOf(costCodeRow{}),
+	"do_cost_index_entries_create":                                                reflect.TypeOf(costIndexEntryRow{}),
+	"do_cost_index_entries_update":                                                reflect.TypeOf(costIndexEntryRow{}),
+	"do_cost_indexes_create":                                                      reflect.TypeOf(costIndexRow{}),
+	"do_cost_indexes_update":                                                      reflect.TypeOf(costIndexRow{}),
+	"do_craft_classes_create":                                                     reflect.TypeOf(craftClassRow{}),
+	"do_craft_classes_update":                                                     reflect.TypeOf(craftClassRow{}),
+	"do_crafts_create":                                                            reflect.TypeOf(craftRow{}),
+	"do_crafts_update":                                                            reflect.TypeOf(craftRow{}),
+	"do_crew_assignment_confirmations_create":                                     reflect.TypeOf(crewAssignmentConfirmationRow{}),
+	"do_crew_assignment_confirmations_update":                                     reflect.TypeOf(crewAssignmentConfirmationRow{}),
+	"do_crew_rates_create":                                                        reflect.TypeOf(crewRateRow{}),
+	"do_crew_rates_update":                                                        reflect.TypeOf(crewRateRow{}),
+	"do_crew_requirement_credential_classifications_create":                       reflect.TypeOf(crewRequirementCredentialClassificationRow{}),
+	"do_crew_requirements_create":                                                 reflect.TypeOf(crewRequirementRow{}),
+	"do_crew_requirements_update":                                                 reflect.TypeOf(crewRequirementRow{}),
+	"do_culture_values_create":                                                    reflect.TypeOf(cultureValueRow{}),
+	"do_culture_values_delete":                                                    reflect.TypeOf(cultureValueRow{}),
+	"do_culture_values_update":                                                    reflect.TypeOf(cultureValueRow{}),
+	"do_custom_work_order_statuses_create":                                        reflect.TypeOf(customWorkOrderStatusRow{}),
+	"do_custom_work_order_statuses_update":                                        reflect.TypeOf(customWorkOrderStatusRow{}),
+	"do_customer_application_approvals_create":                                    reflect.TypeOf(customerApplicationApprovalRow{}),
+	"do_customer_applications_create":                                             reflect.TypeOf(customerApplicationRow{}),
+	"do_customer_applications_update":                                             reflect.TypeOf(customerApplicationRow{}),
+	"do_customer_certification_types_create":                                      reflect.TypeOf(customerCertificationTypeRow{}),
+	"do_customer_certification_types_delete":                                      reflect.TypeOf(customerCertificationTypeRow{}),
+	"do_customer_certification_types_update":                                      reflect.TypeOf(customerCertificationTypeRow{}),
+	"do_customer_commitments_create":                                              reflect.TypeOf(customerCommitmentRow{}),
+	"do_customer_commitments_update":                                              reflect.TypeOf(customerCommitmentRow{}),
+	"do_customer_incident_default_assignees_create":                               reflect.TypeOf(customerIncidentDefaultAssigneeRow{}),
+	"do_customer_incident_default_assignees_update":                               reflect.TypeOf(customerIncidentDefaultAssigneeRow{}),
+	"do_customer_memberships_create":                                              reflect.TypeOf(membershipDetails{}),
+	"do_customer_memberships_delete":                                              reflect.TypeOf(membershipDetails{}),
+	"do_customer_memberships_update":                                              reflect.TypeOf(membershipDetails{}),
+	"do_customer_retainers_create":                                                reflect.TypeOf(customerRetainerRow{}),
+	"do_customer_retainers_update":                                                reflect.TypeOf(customerRetainerRow{}),
+	"do_customer_settings_update":                                                 reflect.TypeOf(customerSettingRow{}),
+	"do_customer_tender_offered_buyer_notification_subscriptions_create":          reflect.TypeOf(customerTenderOfferedBuyerNotificationSubscriptionRow{}),
+	"do_customer_tender_offered_buyer_notification_subscriptions_update":          reflect.TypeOf(customerTenderOfferedBuyerNotificationSubscriptionRow{}),
+	"do_customer_tender_offered_buyer_notifications_update":                       reflect.TypeOf(customerTenderOfferedBuyerNotificationDetails{}),
+	"do_customer_tenders_create":                                                  reflect.TypeOf(customerTenderRow{}),
+	"do_customer_tenders_update":                                                  reflect.TypeOf(customerTenderRow{}),
+	"do_customer_truckers_create":                                                 reflect.TypeOf(customerTruckerRow{}),
+	"do_customer_vendors_create":                                                  reflect.TypeOf(customerVendorRow{}),
+	"do_customer_vendors_update":                                                  reflect.TypeOf(customerVendorRow{}),
+	"do_cycle_time_comparisons_create":                                            reflect.TypeOf(cycleTimeComparisonRow{}),
+	"do_deere_equipments_update":                                                  reflect.TypeOf(deereEquipmentRow{}),
+	"do_deere_integrations_create":                                                reflect.TypeOf(deereIntegrationDetails{}),
+	"do_deere_integrations_delete":                                                reflect.TypeOf(deereIntegrationDetails{}),
+	"do_deere_integrations_update":                                                reflect.TypeOf(deereIntegrationDetails{}),
+	"do_developer_certified_weighers_create":                                      reflect.TypeOf(developerCertifiedWeigherRow{}),
+	"do_developer_certified_weighers_update":                                      reflect.TypeOf(developerCertifiedWeigherRow{}),
+	"do_developer_memberships_create":                                             reflect.TypeOf(developerMembershipDetails{}),
+	"do_developer_memberships_delete":                                             reflect.TypeOf(developerMembershipDetails{}),
+	"do_developer_memberships_update":                                             reflect.TypeOf(developerMembershipDetails{}),
+	"do_developer_reference_types_create":                                         reflect.TypeOf(developerReferenceTypeRow{}),
+	"do_developer_reference_types_update":                                         reflect.TypeOf(developerReferenceTypeRow{}),
+	"do_developer_references_create":                                              reflect.TypeOf(developerReferenceRow{}),
+	"do_developer_references_update":                                              reflect.TypeOf(developerReferenceRow{}),
+	"do_developer_trucker_certification_classifications_create":                   reflect.TypeOf(developerTruckerCertificationClassificationRow{}),
+	"do_developer_trucker_certification_classifications_update":                   reflect.TypeOf(developerTruckerCertificationClassificationRow{}),
+	"do_developer_trucker_certification_multipliers_create":                       reflect.TypeOf(developerTruckerCertificationMultiplierDetails{}),
+	"do_developer_trucker_certification_multipliers_update":                       reflect.TypeOf(developerTruckerCertificationMultiplierDetails{}),
+	"do_developer_trucker_certifications_create":                                  reflect.TypeOf(developerTruckerCertificationRow{}),
+	"do_developer_trucker_certifications_update":                                  reflect.TypeOf(developerTruckerCertificationRow{}),
+	"do_developers_create":                                                        reflect.TypeOf(developerRow{}),
+	"do_developers_update":                                                        reflect.TypeOf(developerRow{}),
+	"do_device_diagnostics_create":                                                reflect.TypeOf(deviceDiagnosticDetails{}),
+	"do_device_location_event_summary_create":                                     reflect.TypeOf(deviceLocationEventSummaryOutput{}),
+	"do_device_location_events_create":                                            reflect.TypeOf(deviceLocationEventDetails{}),
+	"do_devices_update":                                                           reflect.TypeOf(deviceRow{}),
+	"do_digital_fleet_trucks_update":                                              reflect.TypeOf(digitalFleetTruckRow{}),
+	"do_dispatch_user_matchers_create":                                            reflect.TypeOf(dispatchUserMatcherRow{}),
+	"do_down_minutes_estimates_create":                                            reflect.TypeOf(downMinutesEstimateRow{}),
+	"do_driver_assignment_acknowledgements_create":                                reflect.TypeOf(driverAssignmentAcknowledgementRow{}),
+	"do_driver_assignment_refusals_create":                                        reflect.TypeOf(driverAssignmentRefusalRow{}),
+	"do_driver_assignment_rules_create":                                           reflect.TypeOf(driverAssignmentRuleRow{}),
+	"do_driver_assignment_rules_update":                                           reflect.TypeOf(driverAssignmentRuleRow{}),
+	"do_driver_day_adjustment_plans_create":                                       reflect.TypeOf(driverDayAdjustmentPlanRow{}),
+	"do_driver_day_adjustment_plans_update":                                       reflect.TypeOf(driverDayAdjustmentPlanRow{}),
+	"do_driver_day_adjustments_create":                                            reflect.TypeOf(driverDayAdjustmentRow{}),
+	"do_driver_day_adjustments_update":                                            reflect.TypeOf(driverDayAdjustmentRow{}),
+	"do_driver_day_constraints_create":                                            reflect.TypeOf(driverDayConstraintRow{}),
+	"do_driver_day_constraints_update":                                            reflect.TypeOf(driverDayConstraintRow{}),
+	"do_driver_day_shortfall_allocations_create":                                  reflect.TypeOf(driverDayShortfallAllocationRow{}),
+	"do_driver_day_shortfall_calculations_create":                                 reflect.TypeOf(driverDayShortfallCalculationDetails{}),
+	"do_driver_day_summary_create":                                                reflect.TypeOf(driverDaySummaryOutput{}),
+	"do_driver_day_trips_adjustments_create":                                      reflect.TypeOf(driverDayTripsAdjustmentRow{}),
+	"do_driver_day_trips_adjustments_update":                                      reflect.TypeOf(driverDayTripsAdjustmentRow{}),
+	"do_driver_managers_create":                                                   reflect.TypeOf(driverManagerRow{}),
+	"do_driver_managers_update":                                                   reflect.TypeOf(driverManagerRow{}),
+	"do_efficiency_incidents_create":                                              reflect.TypeOf(efficiencyIncidentDetails{}),
+	"do_efficiency_incidents_update":                                              reflect.TypeOf(efficiencyIncidentDetails{}),
+	"do_email_address_statuses_create":                                            reflect.TypeOf(emailAddressStatusDetails{}),
+	"do_equipment_classifications_create":                                         reflect.TypeOf(equipmentClassificationRow{}),
+	"do_equipment_classifications_delete":                                         reflect.TypeOf(equipmentClassificationRow{}),
+	"do_equipment_classifications_update":                                         reflect.TypeOf(equipmentClassificationRow{}),
+	"do_equipment_create":                                                         reflect.TypeOf(equipmentRow{}),
+	"do_equipment_location_events_create":                                         reflect.TypeOf(equipmentLocationEventRow{}),
+	"do_equipment_location_events_update":                                         reflect.TypeOf(equipmentLocationEventRow{}),
+	"do_equipment_movement_requirement_locations_create":                          reflect.TypeOf(equipmentMovementRequirementLocationRow{}),
+	"do_equipment_movement_requirement_locations_update":                          reflect.TypeOf(equipmentMovementRequirementLocationRow{}),
+	"do_equipment_movement_requirements_create":                                   reflect.TypeOf(equipmentMovementRequirementRow{}),
+	"do_equipment_movement_requirements_update":                                   reflect.TypeOf(equipmentMovementRequirementRow{}),
+	"do_equipment_movement_stop_completions_create":                               reflect.TypeOf(equipmentMovementStopCompletionRow{}),
+	"do_equipment_movement_stop_completions_update":                               reflect.TypeOf(equipmentMovementStopCompletionRow{}),
+	"do_equipment_movement_stop_requirements_create":                              reflect.TypeOf(equipmentMovementStopRequirementRow{}),
+	"do_equipment_movement_stops_create":                                          reflect.TypeOf(equipmentMovementStopRow{}),
+	"do_equipment_movement_stops_update":                                          reflect.TypeOf(equipmentMovementStopRow{}),
+	"do_equipment_movement_trip_customer_cost_allocations_create":                 reflect.TypeOf(equipmentMovementTripCustomerCostAllocationRow{}),
+	"do_equipment_movement_trip_customer_cost_allocations_update":                 reflect.TypeOf(equipmentMovementTripCustomerCostAllocationRow{}),
+	"do_equipment_movement_trip_dispatch_fulfillment_clerks_create":               reflect.TypeOf(equipmentMovementTripDispatchFulfillmentClerkRow{}),
+	"do_equipment_movement_trip_dispatches_create":                                reflect.TypeOf(equipmentMovementTripDispatchRow{}),
+	"do_equipment_movement_trip_dispatches_update":                                reflect.TypeOf(equipmentMovementTripDispatchRow{}),
+	"do_equipment_movement_trips_create":                                          reflect.TypeOf(equipmentMovementTripRow{}),
+	"do_equipment_movement_trips_update":                                          reflect.TypeOf(equipmentMovementTripRow{}),
+	"do_equipment_rentals_create":                                                 reflect.TypeOf(equipmentRentalRow{}),
+	"do_equipment_rentals_update":                                                 reflect.TypeOf(equipmentRentalRow{}),
+	"do_equipment_requirements_create":                                            reflect.TypeOf(equipmentRequirementRow{}),
+	"do_equipment_requirements_update":                                            reflect.TypeOf(equipmentRequirementRow{}),
+	"do_equipment_suppliers_create":                                               reflect.TypeOf(equipmentSupplierRow{}),
+	"do_equipment_suppliers_update":                                               reflect.TypeOf(equipmentSupplierRow{}),
+	"do_equipment_update":                                                         reflect.TypeOf(equipmentRow{}),
+	"do_equipment_utilization_readings_create":                                    reflect.TypeOf(equipmentUtilizationReadingRow{}),
+	"do_equipment_utilization_readings_update":                                    reflect.TypeOf(equipmentUtilizationReadingRow{}),
+	"do_expected_time_of_arrivals_create":                                         reflect.TypeOf(expectedTimeOfArrivalRow{}),
+	"do_expected_time_of_arrivals_update":                                         reflect.TypeOf(expectedTimeOfArrivalRow{}),
+	"do_exporter_configurations_create":                                           reflect.TypeOf(exporterConfigurationDetails{}),
+	"do_exporter_configurations_delete":                                           reflect.TypeOf(exporterConfigurationDetails{}),
+	"do_exporter_configurations_update":                                           reflect.TypeOf(exporterConfigurationDetails{}),
+	"do_external_identification_types_create":                                     reflect.TypeOf(externalIdentificationTypeRow{}),
+	"do_external_identification_types_delete":                                     reflect.TypeOf(externalIdentificationTypeRow{}),
+	"do_external_identification_types_update":                                     reflect.TypeOf(externalIdentificationTypeRow{}),
+	"do_external_identifications_create":                                          reflect.TypeOf(externalIdentificationRow{}),
+	"do_external_identifications_update":                                          reflect.TypeOf(externalIdentificationRow{}),
+	"do_features_create":                                                          reflect.TypeOf(featureRow{}),
+	"do_features_update":                                                          reflect.TypeOf(featureRow{}),
+	"do_file_attachment_signed_urls_create":                                       reflect.TypeOf(fileAttachmentSignedURLRow{}),
+	"do_file_attachments_create":                                                  reflect.TypeOf(fileAttachmentRow{}),
+	"do_file_attachments_update":                                                  reflect.TypeOf(fileAttachmentRow{}),
+	"do_file_imports_create":                                                      reflect.TypeOf(fileImportRow{}),
+	"do_file_imports_update":                                                      reflect.TypeOf(fileImportRow{}),
+	"do_follows_create":                                                           reflect.TypeOf(followRow{}),
+	"do_follows_update":                                                           reflect.TypeOf(followRow{}),
+	"do_gauge_vehicles_update":                                                    reflect.TypeOf(gaugeVehicleRow{}),
+	"do_geofence_restrictions_create":                                             reflect.TypeOf(geofenceRestrictionRow{}),
+	"do_geofence_restrictions_update":                                             reflect.TypeOf(geofenceRestrictionRow{}),
+	"do_geofences_create":                                                         reflect.TypeOf(geofenceRow{}),
+	"do_geofences_update":                                                         reflect.TypeOf(geofenceRow{}),
+	"do_geotab_vehicles_update":                                                   reflect.TypeOf(geotabVehicleRow{}),
+	"do_glossary_terms_create":                                                    reflect.TypeOf(glossaryTermDetails{}),
+	"do_glossary_terms_delete":                                                    reflect.TypeOf(glossaryTermDetails{}),
+	"do_glossary_terms_update":                                                    reflect.TypeOf(glossaryTermDetails{}),
+	"do_go_motive_integrations_create":                                            reflect.TypeOf(goMotiveIntegrationRow{}),
+	"do_go_motive_integrations_delete":                                            reflect.TypeOf(goMotiveIntegrationRow{}),
+	"do_go_motive_integrations_update":                                            reflect.TypeOf(goMotiveIntegrationRow{}),
+	"do_gps_insight_vehicles_update":                                              reflect.TypeOf(gpsInsightVehicleRow{}),
+	"do_haskell_lemon_inbound_material_transaction_exports_create":                reflect.TypeOf(haskellLemonInboundMaterialTransactionExportRow{}),
+	"do_haskell_lemon_outbound_material_transaction_exports_create":               reflect.TypeOf(haskellLemonOutboundMaterialTransactionExportRow{}),
+	"do_hos_days_update":                                                          reflect.TypeOf(hosDayRow{}),
+	"do_importer_configurations_create":                                           reflect.TypeOf(importerConfigurationDetails{}),
+	"do_importer_configurations_delete":                                           reflect.TypeOf(importerConfigurationDetails{}),
+	"do_importer_configurations_update":                                           reflect.TypeOf(importerConfigurationDetails{}),
+	"do_incident_headline_suggestions_create":                                     reflect.TypeOf(incidentHeadlineSuggestionRow{}),
+	"do_incident_participants_create":                                             reflect.TypeOf(incidentParticipantDetails{}),
+	"do_incident_participants_update":                                             reflect.TypeOf(incidentParticipantDetails{}),
+	"do_incident_request_approvals_create":                                        reflect.TypeOf(incidentRequestApprovalRow{}),
+	"do_incident_request_cancellations_create":                                    reflect.TypeOf(incidentRequestCancellationRow{}),
+	"do_incident_request_rejections_create":                                       reflect.TypeOf(incidentRequestRejectionRow{}),
+	"do_incident_requests_create":                                                 reflect.TypeOf(incidentRequestDetails{}),
+	"do_incident_requests_update":                                                 reflect.TypeOf(incidentRequestDetails{}),
+	"do_incident_subscriptions_create":                                            reflect.TypeOf(incidentSubscriptionRow{}),
+	"do_incident_subscriptions_update":                                            reflect.TypeOf(incidentSubscriptionRow{}),
+	"do_incident_tag_incidents_create":                                            reflect.TypeOf(incidentTagIncidentRow{}),
+	"do_incident_unit_of_measure_quantities_create":                               reflect.TypeOf(incidentUnitOfMeasureQuantityRow{}),
+	"do_incident_unit_of_measure_quantities_update":                               reflect.TypeOf(incidentUnitOfMeasureQuantityRow{}),
+	"do_inventory_changes_create":                                                 reflect.TypeOf(inventoryChangeRow{}),
+	"do_inventory_estimates_create":                                               reflect.TypeOf(inventoryEstimateRow{}),
+	"do_inventory_estimates_update":                                               reflect.TypeOf(inventoryEstimateRow{}),
+	"do_invoice_addresses_create":                                                 reflect.TypeOf(invoiceAddressRow{}),
+	"do_invoice_approvals_create":                                                 reflect.TypeOf(invoiceApprovalRow{}),
+	"do_invoice_exports_create":                                                   reflect.TypeOf(invoiceExportRow{}),
+	"do_invoice_generations_create":                                               reflect.TypeOf(invoiceGenerationRow{}),
+	"do_invoice_pdf_emails_create":                                                reflect.TypeOf(invoicePdfEmailRow{}),
+	"do_invoice_rejections_create":                                                reflect.TypeOf(invoiceRejectionRow{}),
+	"do_invoice_revisionables_create":                                             reflect.TypeOf(invoiceRevisionableRow{}),
+	"do_invoice_revisionizings_create":                                            reflect.TypeOf(invoiceRevisionizingRow{}),
+	"do_invoice_sends_create":                                                     reflect.TypeOf(invoiceSendRow{}),
+	"do_job_production_plan_abandonments_create":                                  reflect.TypeOf(jobProductionPlanAbandonmentRow{}),
+	"do_job_production_plan_alarm_subscribers_create":                             reflect.TypeOf(jobProductionPlanAlarmSubscriberRow{}),
+	"do_job_production_plan_alarms_create":                                        reflect.TypeOf(jobProductionPlanAlarmRow{}),
+	"do_job_production_plan_alarms_update":                                        reflect.TypeOf(jobProductionPlanAlarmRow{}),
+	"do_job_production_plan_approvals_create":                                     reflect.TypeOf(jobProductionPlanApprovalRow{}),
+	"do_job_production_plan_broadcast_messages_create":                            reflect.TypeOf(jobProductionPlanBroadcastMessageRow{}),
+	"do_job_production_plan_broadcast_messages_update":                            reflect.TypeOf(jobProductionPlanBroadcastMessageRow{}),
+	"do_job_production_plan_cancellation_reason_types_create":                     reflect.TypeOf(jobProductionPlanCancellationReasonTypeRow{}),
+	"do_job_production_plan_cancellation_reason_types_update":                     reflect.TypeOf(jobProductionPlanCancellationReasonTypeRow{}),
+	"do_job_production_plan_cancellations_create":                                 reflect.TypeOf(jobProductionPlanCancellationRow{}),
+	"do_job_production_plan_change_sets_create":                                   reflect.TypeOf(jobProductionPlanChangeSetRow{}),
+	"do_job_production_plan_change_sets_update":                                   reflect.TypeOf(jobProductionPlanChangeSetRow{}),
+	"do_job_production_plan_completions_create":                                   reflect.TypeOf(jobProductionPlanCompletionRow{}),
+	"do_job_production_plan_cost_codes_create":                                    reflect.TypeOf(jobProductionPlanCostCodeRow{}),
+	"do_job_production_plan_cost_codes_update":                                    reflect.TypeOf(jobProductionPlanCostCodeRow{}),
+	"do_job_production_plan_display_unit_of_measures_create":                      reflect.TypeOf(jobProductionPlanDisplayUnitOfMeasureRow{}),
+	"do_job_production_plan_display_unit_of_measures_update":                      reflect.TypeOf(jobProductionPlanDisplayUnitOfMeasureRow{}),
+	"do_job_production_plan_driver_movements_create":                              reflect.TypeOf(jobProductionPlanDriverMovementRow{}),
+	"do_job_production_plan_duplications_create":                                  reflect.TypeOf(jobProductionPlanDuplicationRow{}),
+	"do_job_production_plan_inspectors_create":                                    reflect.TypeOf(jobProductionPlanInspectorRow{}),
+	"do_job_production_plan_job_site_changes_create":                              reflect.TypeOf(jobProductionPlanJobSiteChangeRow{}),
+	"do_job_production_plan_locations_create":                                     reflect.TypeOf(jobProductionPlanLocationRow{}),
+	"do_job_production_plan_locations_update":                                     reflect.TypeOf(jobProductionPlanLocationRow{}),
+	"do_job_production_plan_material_site_changes_create":                         reflect.TypeOf(jobProductionPlanMaterialSiteChangeRow{}),
+	"do_job_production_plan_material_sites_create":                                reflect.TypeOf(jobProductionPlanMaterialSiteRow{}),
+	"do_job_production_plan_material_sites_update":                                reflect.TypeOf(jobProductionPlanMaterialSiteRow{}),
+	"do_job_production_plan_material_transaction_summaries_create":                reflect.TypeOf(jobProductionPlanMaterialTransactionSummary{}),
+	"do_job_production_plan_material_type_quality_control_requirements_create":    reflect.TypeOf(jobProductionPlanMaterialTypeQualityControlRequirementRow{}),
+	"do_job_production_plan_material_type_quality_control_requirements_update":    reflect.TypeOf(jobProductionPlanMaterialTypeQualityControlRequirementRow{}),
+	"do_job_production_plan_material_types_create":                                reflect.TypeOf(jobProductionPlanMaterialTypeCreateResult{}),
+	"do_job_production_plan_material_types_update":                                reflect.TypeOf(jobProductionPlanMaterialTypeCreateResult{}),
+	"do_job_production_plan_project_phase_revenue_items_create":                   reflect.TypeOf(jobProductionPlanProjectPhaseRevenueItemRow{}),
+	"do_job_production_plan_project_phase_revenue_items_update":                   reflect.TypeOf(jobProductionPlanProjectPhaseRevenueItemRow{}),
+	"do_job_production_plan_recap_generations_create":                             reflect.TypeOf(jobProductionPlanRecapGenerationRow{}),
+	"do_job_production_plan_rejections_create":                                    reflect.TypeOf(jobProductionPlanRejectionRow{}),
+	"do_job_production_plan_safety_risk_communication_suggestions_create":         reflect.TypeOf(jobProductionPlanSafetyRiskCommunicationSuggestionRow{}),
+	"do_job_production_plan_safety_risks_create":                                  reflect.TypeOf(jobProductionPlanSafetyRiskRow{}),
+	"do_job_production_plan_safety_risks_suggestions_create":                      reflect.TypeOf(jobProductionPlanSafetyRisksSuggestionRow{}),
+	"do_job_production_plan_safety_risks_update":                                  reflect.TypeOf(jobProductionPlanSafetyRiskRow{}),
+	"do_job_production_plan_schedule_changes_create":                              reflect.TypeOf(jobProductionPlanScheduleChangeRow{}),
+	"do_job_production_plan_scrappages_create":                                    reflect.TypeOf(jobProductionPlanScrappageRow{}),
+	"do_job_production_plan_segment_sets_create":                                  reflect.TypeOf(jobProductionPlanSegmentSetRow{}),
+	"do_job_production_plan_segment_sets_update":                                  reflect.TypeOf(jobProductionPlanSegmentSetRow{}),
+	"do_job_production_plan_segments_create":                                      reflect.TypeOf(jobProductionPlanSegmentRow{}),
+	"do_job_production_plan_segments_update":                                      reflect.TypeOf(jobProductionPlanSegmentRow{}),
+	"do_job_production_plan_service_type_unit_of_measure_cohorts_create":          reflect.TypeOf(jobProductionPlanServiceTypeUnitOfMeasureCohortRow{}),
+	"do_job_production_plan_service_type_unit_of_measures_create":                 reflect.TypeOf(jobProductionPlanServiceTypeUnitOfMeasureRow{}),
+	"do_job_production_plan_service_type_unit_of_measures_update":                 reflect.TypeOf(jobProductionPlanServiceTypeUnitOfMeasureRow{}),
+	"do_job_production_plan_status_changes_update":                                reflect.TypeOf(jobProductionPlanStatusChangeRow{}),
+	"do_job_production_plan_submissions_create":                                   reflect.TypeOf(jobProductionPlanSubmissionRow{}),
+	"do_job_production_plan_subscriptions_create":                                 reflect.TypeOf(jobProductionPlanSubscriptionRow{}),
+	"do_job_production_plan_subscriptions_update":                                 reflect.TypeOf(jobProductionPlanSubscriptionRow{}),
+	"do_job_production_plan_summary_create":                                       reflect.TypeOf(jobProductionPlanSummaryOutput{}),
+	"do_job_production_plan_time_card_approvers_create":                           reflect.TypeOf(jobProductionPlanTimeCardApproverRow{}),
+	"do_job_production_plan_trailer_classifications_create":                       reflect.TypeOf(jobProductionPlanTrailerClassificationRow{}),
+	"do_job_production_plan_trailer_classifications_update":                       reflect.TypeOf(jobProductionPlanTrailerClassificationRow{}),
+	"do_job_production_plan_trucking_incident_detectors_create":                   reflect.TypeOf(jobProductionPlanTruckingIncidentDetectorRow{}),
+	"do_job_production_plan_unabandonments_create":                                reflect.TypeOf(jobProductionPlanUnabandonmentRow{}),
+	"do_job_production_plan_unapprovals_create":                                   reflect.TypeOf(jobProductionPlanUnapprovalRow{}),
+	"do_job_production_plan_uncancellations_create":                               reflect.TypeOf(jobProductionPlanUncancellationRow{}),
+	"do_job_production_plan_uncompletions_create":                                 reflect.TypeOf(jobProductionPlanUncompletionRow{}),
+	"do_job_production_plan_unscrappages_create":                                  reflect.TypeOf(jobProductionPlanUnscrappageRow{}),
+	"do_job_production_plans_create":                                              reflect.TypeOf(jobProductionPlanRow{}),
+	"do_job_production_plans_update":                                              reflect.TypeOf(jobProductionPlanRow{}),
+	"do_job_schedule_shift_is_managed_toggles_create":                             reflect.TypeOf(jobScheduleShiftIsManagedToggleRow{}),
+	"do_job_schedule_shift_splits_create":                                         reflect.TypeOf(jobScheduleShiftSplitRow{}),
+	"do_job_schedule_shift_start_at_changes_create":                               reflect.TypeOf(jobScheduleShiftStartAtChangeCreateRow{}),
+	"do_job_schedule_shift_start_site_changes_create":                             reflect.TypeOf(jobScheduleShiftStartSiteChangeRow{}),
+	"do_job_schedule_shifts_create":                                               reflect.TypeOf(jobScheduleShiftRow{}),
+	"do_job_schedule_shifts_update":                                               reflect.TypeOf(jobScheduleShiftRow{}),
+	"do_job_site_times_create":                                                    reflect.TypeOf(jobSiteTimeRow{}),
+	"do_job_site_times_update":                                                    reflect.TypeOf(jobSiteTimeRow{}),
+	"do_job_sites_create":                                                         reflect.TypeOf(jobSiteRow{}),
+	"do_job_sites_update":                                                         reflect.TypeOf(jobSiteRow{}),
+	"do_jobs_create":                                                              reflect.TypeOf(jobRow{}),
+	"do_jobs_update":                                                              reflect.TypeOf(jobRow{}),
+	"do_keep_truckin_users_update":                                                reflect.TypeOf(keepTruckinUserDetails{}),
+	"do_keep_truckin_vehicles_update":                                             reflect.TypeOf(keepTruckinVehicleRow{}),
+	"do_key_result_scrappages_create":                                             reflect.TypeOf(keyResultScrappageRow{}),
+	"do_key_result_unscrappages_create":                                           reflect.TypeOf(keyResultUnscrappageRow{}),
+	"do_labor_classifications_create":                                             reflect.TypeOf(laborClassificationRow{}),
+	"do_labor_classifications_delete":                                             reflect.TypeOf(laborClassificationRow{}),
+	"do_labor_classifications_update":                                             reflect.TypeOf(laborClassificationRow{}),
+	"do_labor_requirements_create":                                                reflect.TypeOf(laborRequirementRow{}),
+	"do_labor_requirements_update":                                                reflect.TypeOf(laborRequirementRow{}),
+	"do_laborers_create":                                                          reflect.TypeOf(laborerRow{}),
+	"do_laborers_update":                                                          reflect.TypeOf(laborerRow{}),
+	"do_lane_summary_create":                                                      reflect.TypeOf(laneSummaryOutput{}),
+	"do_lehman_roberts_apex_viewpoint_ticket_exports_create":                      reflect.TypeOf(lehmanRobertsApexViewpointTicketExportDetails{}),
+	"do_liability_incidents_create":                                               reflect.TypeOf(liabilityIncidentRow{}),
+	"do_liability_incidents_update":                                               reflect.TypeOf(liabilityIncidentRow{}),
+	"do_lineup_dispatch_fulfillment_clerks_create":                                reflect.TypeOf(lineupDispatchFulfillmentClerkRow{}),
+	"do_lineup_dispatch_statuses_create":                                          reflect.TypeOf(lineupDispatchStatusRow{}),
+	"do_lineup_dispatches_create":                                                 reflect.TypeOf(lineupDispatchRow{}),
+	"do_lineup_dispatches_update":                                                 reflect.TypeOf(lineupDispatchRow{}),
+	"do_lineup_job_production_plans_create":                                       reflect.TypeOf(lineupJobProductionPlanRow{}),
+	"do_lineup_job_schedule_shift_trucker_assignment_recommendations_create":      reflect.TypeOf(lineupJobScheduleShiftTruckerAssignmentRecommendationRow{}),
+	"do_lineup_job_schedule_shifts_create":                                        reflect.TypeOf(lineupJobScheduleShiftDetails{}),
+	"do_lineup_job_schedule_shifts_update":                                        reflect.TypeOf(lineupJobScheduleShiftDetails{}),
+	"do_lineup_scenario_generators_create":                                        reflect.TypeOf(lineupScenarioGeneratorRow{}),
+	"do_lineup_scenario_lineup_job_schedule_shifts_create":                        reflect.TypeOf(lineupScenarioLineupJobScheduleShiftRow{}),
+	"do_lineup_scenario_lineups_create":                                           reflect.TypeOf(lineupScenarioLineupRow{}),
+	"do_lineup_scenario_solutions_create":                                         reflect.TypeOf(lineupScenarioSolutionRow{}),
+	"do_lineup_scenario_trailer_lineup_job_schedule_shifts_create":                reflect.TypeOf(lineupScenarioTrailerLineupJobScheduleShiftRow{}),
+	"do_lineup_scenario_trailer_lineup_job_schedule_shifts_update":                reflect.TypeOf(lineupScenarioTrailerLineupJobScheduleShiftRow{}),
+	"do_lineup_scenario_trailers_create":                                          reflect.TypeOf(lineupScenarioTrailerRow{}),
+	"do_lineup_scenario_trailers_update":                                          reflect.TypeOf(lineupScenarioTrailerRow{}),
+	"do_lineup_scenario_truckers_create":                                          reflect.TypeOf(lineupScenarioTruckerRow{}),
+	"do_lineup_scenario_truckers_update":                                          reflect.TypeOf(lineupScenarioTruckerRow{}),
+	"do_lineup_scenarios_create":                                                  reflect.TypeOf(lineupScenarioRow{}),
+	"do_lineup_scenarios_update":                                                  reflect.TypeOf(lineupScenarioRow{}),
+	"do_lineup_summary_requests_create":                                           reflect.TypeOf(lineupSummaryRequestRow{}),
+	"do_lineups_create":                                                           reflect.TypeOf(lineupDetails{}),
+	"do_lineups_update":                                                           reflect.TypeOf(lineupDetails{}),
+	"do_login_code_redemptions_create":                                            reflect.TypeOf(loginCodeRedemptionRow{}),
+	"do_login_code_requests_create":                                               reflect.TypeOf(loginCodeRequestRow{}),
+	"do_lowest_losing_bid_prediction_subject_details_create":                      reflect.TypeOf(lowestLosingBidPredictionSubjectDetailRow{}),
+	"do_lowest_losing_bid_prediction_subject_details_update":                      reflect.TypeOf(lowestLosingBidPredictionSubjectDetailRow{}),
+	"do_maintenance_requirement_maintenance_requirement_parts_create":             reflect.TypeOf(maintenanceRequirementMaintenanceRequirementPartRow{}),
+	"do_maintenance_requirement_maintenance_requirement_parts_update":             reflect.TypeOf(maintenanceRequirementMaintenanceRequirementPartRow{}),
+	"do_maintenance_requirement_parts_create":                                     reflect.TypeOf(maintenanceRequirementPartRow{}),
+	"do_maintenance_requirement_parts_update":                                     reflect.TypeOf(maintenanceRequirementPartRow{}),
+	"do_maintenance_requirement_rule_evaluation_clerks_create":                    reflect.TypeOf(maintenanceRequirementRuleEvaluationClerkRow{}),
+	"do_maintenance_requirement_rule_maintenance_requirement_sets_create":         reflect.TypeOf(maintenanceRequirementRuleMaintenanceRequirementSetRow{}),
+	"do_maintenance_requirement_rules_create":                                     reflect.TypeOf(maintenanceRequirementRuleRow{}),
+	"do_maintenance_requirement_rules_update":                                     reflect.TypeOf(maintenanceRequirementRuleRow{}),
+	"do_maintenance_requirement_set_maintenance_requirements_create":              reflect.TypeOf(maintenanceRequirementSetMaintenanceRequirementRow{}),
+	"do_maintenance_requirement_set_maintenance_requirements_update":              reflect.TypeOf(maintenanceRequirementSetMaintenanceRequirementRow{}),
+	"do_maintenance_requirement_sets_create":                                      reflect.TypeOf(maintenanceRequirementSetRow{}),
+	"do_maintenance_requirement_sets_update":                                      reflect.TypeOf(maintenanceRequirementSetRow{}),
+	"do_marketing_metrics_create":                                                 reflect.TypeOf(marketingMetricsDetails{}),
+	"do_material_mix_design_matches_create":                                       reflect.TypeOf(materialMixDesignMatchRow{}),
+	"do_material_mix_designs_create":                                              reflect.TypeOf(materialMixDesignRow{}),
+	"do_material_mix_designs_update":                                              reflect.TypeOf(materialMixDesignRow{}),
+	"do_material_purchase_order_release_redemptions_create":                       reflect.TypeOf(materialPurchaseOrderReleaseRedemptionRow{}),
+	"do_material_purchase_order_release_redemptions_update":                       reflect.TypeOf(materialPurchaseOrderReleaseRedemptionRow{}),
+	"do_material_purchase_order_releases_create":                                  reflect.TypeOf(materialPurchaseOrderReleaseRow{}),
+	"do_material_purchase_order_releases_update":                                  reflect.TypeOf(materialPurchaseOrderReleaseRow{}),
+	"do_material_site_inventory_locations_create":                                 reflect.TypeOf(materialSiteInventoryLocationRow{}),
+	"do_material_site_inventory_locations_update":                                 reflect.TypeOf(materialSiteInventoryLocationRow{}),
+	"do_material_site_mergers_create":                                             reflect.TypeOf(materialSiteMergerRow{}),
+	"do_material_site_reading_material_types_create":                              reflect.TypeOf(materialSiteReadingMaterialTypeRow{}),
+	"do_material_site_reading_material_types_update":                              reflect.TypeOf(materialSiteReadingMaterialTypeRow{}),
+	"do_material_site_reading_summary_create":                                     reflect.TypeOf(materialSiteReadingSummaryOutput{}),
+	"do_material_site_readings_create":                                            reflect.TypeOf(materialSiteReadingRow{}),
+	"do_material_site_readings_update":                                            reflect.TypeOf(materialSiteReadingRow{}),
+	"do_material_site_subscriptions_create":                                       reflect.TypeOf(materialSiteSubscriptionRow{}),
+	"do_material_site_subscriptions_update":                                       reflect.TypeOf(materialSiteSubscriptionRow{}),
+	"do_material_site_unavailabilities_create":                                    reflect.TypeOf(materialSiteUnavailabilityRow{}),
+	"do_material_site_unavailabilities_update":                                    reflect.TypeOf(materialSiteUnavailabilityRow{}),
+	"do_material_sites_create":                                                    reflect.TypeOf(materialSiteRow{}),
+	"do_material_sites_update":                                                    reflect.TypeOf(materialSiteRow{}),
+	"do_material_supplier_memberships_create":                                     reflect.TypeOf(membershipDetails{}),
+	"do_material_supplier_memberships_delete":                                     reflect.TypeOf(membershipDetails{}),
+	"do_material_supplier_memberships_update":                                     reflect.TypeOf(membershipDetails{}),
+	"do_material_suppliers_create":                                                reflect.TypeOf(materialSupplierRow{}),
+	"do_material_suppliers_update":                                                reflect.TypeOf(materialSupplierRow{}),
+	"do_material_transaction_acceptances_create":                                  reflect.TypeOf(materialTransactionAcceptanceRow{}),
+	"do_material_transaction_cost_code_allocations_create":                        reflect.TypeOf(materialTransactionCostCodeAllocationDetails{}),
+	"do_material_transaction_cost_code_allocations_update":                        reflect.TypeOf(materialTransactionCostCodeAllocationDetails{}),
+	"do_material_transaction_denials_create":                                      reflect.TypeOf(materialTransactionDenialRow{}),
+	"do_material_transaction_diversions_create":                                   reflect.TypeOf(materialTransactionDiversionRow{}),
+	"do_material_transaction_diversions_update":                                   reflect.TypeOf(materialTransactionDiversionRow{}),
+	"do_material_transaction_field_scopes_create":                                 reflect.TypeOf(materialTransactionFieldScopeRow{}),
+	"do_material_transaction_inspection_rejections_create":                        reflect.TypeOf(materialTransactionInspectionRejectionRow{}),
+	"do_material_transaction_inspection_rejections_update":                        reflect.TypeOf(materialTransactionInspectionRejectionRow{}),
+	"do_material_transaction_inspections_create":                                  reflect.TypeOf(materialTransactionInspectionCreateRow{}),
+	"do_material_transaction_inspections_update":                                  reflect.TypeOf(materialTransactionInspectionCreateRow{}),
+	"do_material_transaction_invalidations_create":                                reflect.TypeOf(materialTransactionInvalidationRow{}),
+	"do_material_transaction_preloads_create":                                     reflect.TypeOf(materialTransactionPreloadDetails{}),
+	"do_material_transaction_rate_summaries_create":                               reflect.TypeOf(materialTransactionRateSummaryOutput{}),
+	"do_material_transaction_rejections_create":                                   reflect.TypeOf(materialTransactionRejectionRow{}),
+	"do_material_transaction_shift_assignments_create":                            reflect.TypeOf(materialTransactionShiftAssignmentRow{}),
+	"do_material_transaction_submissions_create":                                  reflect.TypeOf(materialTransactionSubmissionRow{}),
+	"do_material_transaction_summary_create":                                      reflect.TypeOf(materialTransactionSummaryOutput{}),
+	"do_material_transaction_ticket_generators_create":                            reflect.TypeOf(materialTransactionTicketGeneratorRow{}),
+	"do_material_transaction_ticket_generators_update":                            reflect.TypeOf(materialTransactionTicketGeneratorRow{}),
+	"do_material_transactions_create":                                             reflect.TypeOf(materialTransactionCreateRow{}),
+	"do_material_transactions_exports_create":                                     reflect.TypeOf(materialTransactionsExportRow{}),
+	"do_material_type_conversions_create":                                         reflect.TypeOf(materialTypeConversionDetails{}),
+	"do_material_type_conversions_update":                                         reflect.TypeOf(materialTypeConversionDetails{}),
+	"do_material_type_material_site_inventory_locations_create":                   reflect.TypeOf(materialTypeMaterialSiteInventoryLocationRow{}),
+	"do_material_type_material_site_inventory_locations_update":                   reflect.TypeOf(materialTypeMaterialSiteInventoryLocationRow{}),
+	"do_material_type_unavailabilities_create":                                    reflect.TypeOf(materialTypeUnavailabilityRow{}),
+	"do_material_type_unavailabilities_update":                                    reflect.TypeOf(materialTypeUnavailabilityRow{}),
+	"do_material_unit_of_measure_quantities_create":                               reflect.TypeOf(materialUnitOfMeasureQuantityRow{}),
+	"do_material_unit_of_measure_quantities_update":                               reflect.TypeOf(materialUnitOfMeasureQuantityRow{}),
+	"do_mechanic_user_associations_create":                                        reflect.TypeOf(mechanicUserAssociationRow{}),
+	"do_mechanic_user_associations_update":                                        reflect.TypeOf(mechanicUserAssociationRow{}),
+	"do_meeting_attendees_create":                                                 reflect.TypeOf(meetingAttendeeRow{}),
+	"do_meeting_attendees_update":                                                 reflect.TypeOf(meetingAttendeeRow{}),
+	"do_meetings_create":                                                          reflect.TypeOf(meetingRow{}),
+	"do_meetings_update":                                                          reflect.TypeOf(meetingRow{}),
+	"do_memberships_create":                                                       reflect.TypeOf(membershipDetails{}),
+	"do_memberships_delete":                                                       reflect.TypeOf(membershipDetails{}),
+	"do_memberships_update":                                                       reflect.TypeOf(membershipDetails{}),
+	"do_missing_rates_create":                                                     reflect.TypeOf(missingRateRow{}),
+	"do_model_filter_infos_create":                                                reflect.TypeOf(modelFilterInfoDetails{}),
+	"do_native_app_releases_create":                                               reflect.TypeOf(nativeAppReleaseRow{}),
+	"do_native_app_releases_update":                                               reflect.TypeOf(nativeAppReleaseRow{}),
+	"do_notifications_update":                                                     reflect.TypeOf(notificationRow{}),
+	"do_objective_stakeholder_classification_quotes_create":                       reflect.TypeOf(objectiveStakeholderClassificationQuoteRow{}),
+	"do_objective_stakeholder_classification_quotes_update":                       reflect.TypeOf(objectiveStakeholderClassificationQuoteRow{}),
+	"do_objective_stakeholder_classifications_create":                             reflect.TypeOf(objectiveStakeholderClassificationRow{}),
+	"do_objective_stakeholder_classifications_update":                             reflect.TypeOf(objectiveStakeholderClassificationRow{}),
+	"do_one_step_gps_vehicles_update":                                             reflect.TypeOf(oneStepGpsVehicleRow{}),
+	"do_open_ai_realtime_sessions_create":                                         reflect.TypeOf(openAiRealtimeSessionCreateResult{}),
+	"do_open_ai_vector_stores_create":                                             reflect.TypeOf(openAiVectorStoreRow{}),
+	"do_open_ai_vector_stores_update":                                             reflect.TypeOf(openAiVectorStoreRow{}),
+	"do_open_door_issues_create":                                                  reflect.TypeOf(openDoorIssueRow{}),
+	"do_open_door_issues_update":                                                  reflect.TypeOf(openDoorIssueRow{}),
+	"do_open_door_team_memberships_create":                                        reflect.TypeOf(openDoorTeamMembershipDetails{}),
+	"do_open_door_team_memberships_update":                                        reflect.TypeOf(openDoorTeamMembershipDetails{}),
+	"do_organization_formatters_create":                                           reflect.TypeOf(organizationFormatterRow{}),
+	"do_organization_formatters_update":                                           reflect.TypeOf(organizationFormatterRow{}),
+	"do_organization_invoices_batch_file_exports_create":                          reflect.TypeOf(organizationInvoicesBatchFileExportRow{}),
+	"do_organization_invoices_batch_files_create":                                 reflect.TypeOf(organizationInvoicesBatchFileRow{}),
+	"do_organization_invoices_batch_invoice_batchings_create":                     reflect.TypeOf(organizationInvoicesBatchInvoiceBatchingRow{}),
+	"do_organization_invoices_batch_invoice_failures_create":                      reflect.TypeOf(organizationInvoicesBatchInvoiceFailureRow{}),
+	"do_organization_invoices_batch_invoice_unbatchings_create":                   reflect.TypeOf(organizationInvoicesBatchInvoiceUnbatchingRowCreate{}),
+	"do_organization_invoices_batch_pdf_generations_create":                       reflect.TypeOf(organizationInvoicesBatchPdfGenerationRow{}),
+	"do_organization_invoices_batch_pdf_templates_create":                         reflect.TypeOf(organizationInvoicesBatchPdfTemplateRow{}),
+	"do_organization_invoices_batch_pdf_templates_update":                         reflect.TypeOf(organizationInvoicesBatchPdfTemplateRow{}),
+	"do_organization_invoices_batch_processes_create":                             reflect.TypeOf(organizationInvoicesBatchProcessRow{}),
+	"do_organization_invoices_batches_create":                                     reflect.TypeOf(organizationInvoicesBatchRow{}),
+	"do_organization_project_actuals_exports_create":                              reflect.TypeOf(organizationProjectActualsExportRow{}),
+	"do_ozinga_tk_batch_file_exports_create":                                      reflect.TypeOf(ozingaTkBatchFileExportDetails{}),
+	"do_parking_sites_create":                                                     reflect.TypeOf(parkingSiteRow{}),
+	"do_parking_sites_update":                                                     reflect.TypeOf(parkingSiteRow{}),
+	"do_pave_frame_actual_hours_create":                                           reflect.TypeOf(paveFrameActualHourRow{}),
+	"do_pave_frame_actual_hours_update":                                           reflect.TypeOf(paveFrameActualHourRow{}),
+	"do_pave_frame_actual_statistics_create":                                      reflect.TypeOf(paveFrameActualStatisticRow{}),
+	"do_pave_frame_actual_statistics_update":                                      reflect.TypeOf(paveFrameActualStatisticRow{}),
+	"do_platform_statuses_create":                                                 reflect.TypeOf(platformStatusRow{}),
+	"do_platform_statuses_update":                                                 reflect.TypeOf(platformStatusRow{}),
+	"do_post_children_create":                                                     reflect.TypeOf(postChildRow{}),
+	"do_post_routers_create":                                                      reflect.TypeOf(postRouterDetails{}),
+	"do_post_views_create":                                                        reflect.TypeOf(postViewRow{}),
+	"do_posts_create":                                                             reflect.TypeOf(postRow{}),
+	"do_posts_update":                                                             reflect.TypeOf(postRow{}),
+	"do_prediction_agents_create":                                                 reflect.TypeOf(predictionAgentRow{}),
+	"do_prediction_agents_update":                                                 reflect.TypeOf(predictionAgentRow{}),
+	"do_prediction_knowledge_base_questions_create":                               reflect.TypeOf(predictionKnowledgeBaseQuestionRow{}),
+	"do_prediction_knowledge_base_questions_update":                               reflect.TypeOf(predictionKnowledgeBaseQuestionRow{}),
+	"do_prediction_knowledge_bases_create":                                        reflect.TypeOf(predictionKnowledgeBaseRow{}),
+	"do_prediction_subject_bids_create":                                           reflect.TypeOf(predictionSubjectBidRow{}),
+	"do_prediction_subject_bids_update":                                           reflect.TypeOf(predictionSubjectBidRow{}),
+	"do_prediction_subject_gap_portions_create":                                   reflect.TypeOf(predictionSubjectGapPortionRow{}),
+	"do_prediction_subject_gap_portions_update":                                   reflect.TypeOf(predictionSubjectGapPortionRow{}),
+	"do_prediction_subject_gaps_create":                                           reflect.TypeOf(predictionSubjectGapRow{}),
+	"do_prediction_subject_gaps_update":                                           reflect.TypeOf(predictionSubjectGapRow{}),
+	"do_prediction_subject_memberships_create":                                    reflect.TypeOf(predictionSubjectMembershipRow{}),
+	"do_prediction_subject_memberships_update":                                    reflect.TypeOf(predictionSubjectMembershipRow{}),
+	"do_prediction_subject_recap_generations_create":                              reflect.TypeOf(predictionSubjectRecapGenerationRow{}),
+	"do_prediction_subjects_create":                                               reflect.TypeOf(predictionSubjectRow{}),
+	"do_prediction_subjects_delete":                                               reflect.TypeOf(predictionSubjectRow{}),
+	"do_prediction_subjects_update":                                               reflect.TypeOf(predictionSubjectRow{}),
+	"do_predictions_create":                                                       reflect.TypeOf(predictionRow{}),
+	"do_predictions_update":                                                       reflect.TypeOf(predictionRow{}),
+	"do_process_non_processed_time_card_time_changes_create":                      reflect.TypeOf(processNonProcessedTimeCardTimeChangesRow{}),
+	"do_production_incident_detectors_create":                                     reflect.TypeOf(productionIncidentDetectorDetails{}),
+	"do_production_incidents_create":                                              reflect.TypeOf(productionIncidentRow{}),
+	"do_production_incidents_update":                                              reflect.TypeOf(productionIncidentRow{}),
+	"do_production_measurements_create":                                           reflect.TypeOf(productionMeasurementRow{}),
+	"do_production_measurements_update":                                           reflect.TypeOf(productionMeasurementRow{}),
+	"do_proffer_likes_create":                                                     reflect.TypeOf(profferLikeRow{}),
+	"do_proffers_update":                                                          reflect.TypeOf(profferRow{}),
+	"do_profit_improvement_categories_create":                                     reflect.TypeOf(profitImprovementCategoryRow{}),
+	"do_profit_improvement_categories_update":                                     reflect.TypeOf(profitImprovementCategoryRow{}),
+	"do_profit_improvement_subscriptions_create":                                  reflect.TypeOf(profitImprovementSubscriptionRow{}),
+	"do_profit_improvement_subscriptions_update":                                  reflect.TypeOf(profitImprovementSubscriptionRow{}),
+	"do_profit_improvements_create":                                               reflect.TypeOf(profitImprovementRow{}),
+	"do_profit_improvements_update":                                               reflect.TypeOf(profitImprovementRow{}),
+	"do_project_abandonments_create":                                              reflect.TypeOf(projectAbandonmentRowCreate{}),
+	"do_project_actuals_exports_create":                                           reflect.TypeOf(projectActualsExportRow{}),
+	"do_project_approvals_create":                                                 reflect.TypeOf(projectApprovalRow{}),
+	"do_project_bid_location_material_types_create":                               reflect.TypeOf(projectBidLocationMaterialTypeRow{}),
+	"do_project_bid_location_material_types_update":                               reflect.TypeOf(projectBidLocationMaterialTypeRow{}),
+	"do_project_bid_locations_create":                                             reflect.TypeOf(projectBidLocationRow{}),
+	"do_project_bid_locations_update":                                             reflect.TypeOf(projectBidLocationRow{}),
+	"do_project_cancellations_create":                                             reflect.TypeOf(projectCancellationRowCreate{}),
+	"do_project_completions_create":                                               reflect.TypeOf(projectCompletionRowCreate{}),
+	"do_project_cost_classifications_create":                                      reflect.TypeOf(projectCostClassificationRow{}),
+	"do_project_cost_classifications_update":                                      reflect.TypeOf(projectCostClassificationRow{}),
+	"do_project_cost_codes_create":                                                reflect.TypeOf(projectCostCodeRow{}),
+	"do_project_cost_codes_update":                                                reflect.TypeOf(projectCostCodeRow{}),
+	"do_project_duplications_create":                                              reflect.TypeOf(projectDuplicationCreateResult{}),
+	"do_project_estimate_file_imports_create":                                     reflect.TypeOf(projectEstimateFileImportDetails{}),
+	"do_project_estimate_sets_create":                                             reflect.TypeOf(projectEstimateSetRow{}),
+	"do_project_estimate_sets_update":                                             reflect.TypeOf(projectEstimateSetRow{}),
+	"do_project_labor_classifications_create":                                     reflect.TypeOf(projectLaborClassificationRow{}),
+	"do_project_labor_classifications_update":                                     reflect.TypeOf(projectLaborClassificationRow{}),
+	"do_project_margin_matrices_create":                                           reflect.TypeOf(projectMarginMatrixRowCreate{}),
+	"do_project_material_type_quality_control_requirements_create":                reflect.TypeOf(projectMaterialTypeQualityControlRequirementRow{}),
+	"do_project_material_type_quality_control_requirements_update":                reflect.TypeOf(projectMaterialTypeQualityControlRequirementRow{}),
+	"do_project_material_types_create":                                            reflect.TypeOf(projectMaterialTypeRow{}),
+	"do_project_material_types_update":                                            reflect.TypeOf(projectMaterialTypeRow{}),
+	"do_project_offices_create":                                                   reflect.TypeOf(projectOfficeRow{}),
+	"do_project_offices_update":                                                   reflect.TypeOf(projectOfficeRow{}),
+	"do_project_phase_cost_item_actuals_create":                                   reflect.TypeOf(projectPhaseCostItemActualRow{}),
+	"do_project_phase_cost_item_actuals_update":                                   reflect.TypeOf(projectPhaseCostItemActualRow{}),
+	"do_project_phase_cost_item_price_estimates_create":                           reflect.TypeOf(projectPhaseCostItemPriceEstimateRowCreate{}),
+	"do_project_phase_cost_item_price_estimates_update":                           reflect.TypeOf(projectPhaseCostItemPriceEstimateRowCreate{}),
+	"do_project_phase_cost_item_quantity_estimates_create":                        reflect.TypeOf(projectPhaseCostItemQuantityEstimateRow{}),
+	"do_project_phase_cost_item_quantity_estimates_update":                        reflect.TypeOf(projectPhaseCostItemQuantityEstimateRow{}),
+	"do_project_phase_cost_items_create":                                          reflect.TypeOf(projectPhaseCostItemRow{}),
+	"do_project_phase_cost_items_update":                                          reflect.TypeOf(projectPhaseCostItemRow{}),
+	"do_project_phase_dates_estimates_create":                                     reflect.TypeOf(projectPhaseDatesEstimateRow{}),
+	"do_project_phase_dates_estimates_update":                                     reflect.TypeOf(projectPhaseDatesEstimateRow{}),
+	"do_project_phase_revenue_item_actual_exports_create":                         reflect.TypeOf(projectPhaseRevenueItemActualExportRow{}),
+	"do_project_phase_revenue_item_actuals_create":                                reflect.TypeOf(projectPhaseRevenueItemActualRow{}),
+	"do_project_phase_revenue_item_actuals_update":                                reflect.TypeOf(projectPhaseRevenueItemActualRow{}),
+	"do_project_phase_revenue_item_quantity_estimates_create":                     reflect.TypeOf(projectPhaseRevenueItemQuantityEstimateRow{}),
+	"do_project_phase_revenue_item_quantity_estimates_update":                     reflect.TypeOf(projectPhaseRevenueItemQuantityEstimateRow{}),
+	"do_project_phase_revenue_items_create":                                       reflect.TypeOf(projectPhaseRevenueItemRow{}),
+	"do_project_phase_revenue_items_update":                                       reflect.TypeOf(projectPhaseRevenueItemRow{}),
+	"do_project_phases_create":                                                    reflect.TypeOf(projectPhaseRow{}),
+	"do_project_phases_update":                                                    reflect.TypeOf(projectPhaseRow{}),
+	"do_project_project_cost_classifications_create":                              reflect.TypeOf(projectProjectCostClassificationRow{}),
+	"do_project_project_cost_classifications_update":                              reflect.TypeOf(projectProjectCostClassificationRow{}),
+	"do_project_rejections_create":                                                reflect.TypeOf(projectRejectionRow{}),
+	"do_project_resource_classifications_create":                                  reflect.TypeOf(projectResourceClassificationRow{}),
+	"do_project_resource_classifications_update":                                  reflect.TypeOf(projectResourceClassificationRow{}),
+	"do_project_revenue_classifications_create":                                   reflect.TypeOf(projectRevenueClassificationRow{}),
+	"do_project_revenue_classifications_update":                                   reflect.TypeOf(projectRevenueClassificationRow{}),
+	"do_project_revenue_item_price_estimates_create":                              reflect.TypeOf(projectRevenueItemPriceEstimateRow{}),
+	"do_project_revenue_item_price_estimates_update":                              reflect.TypeOf(projectRevenueItemPriceEstimateRow{}),
+	"do_project_revenue_item_quantity_estimates_create":                           reflect.TypeOf(projectRevenueItemQuantityEstimateRow{}),
+	"do_project_revenue_item_quantity_estimates_update":                           reflect.TypeOf(projectRevenueItemQuantityEstimateRow{}),
+	"do_project_revenue_items_create":                                             reflect.TypeOf(projectRevenueItemRow{}),
+	"do_project_revenue_items_update":                                             reflect.TypeOf(projectRevenueItemRow{}),
+	"do_project_submissions_create":                                               reflect.TypeOf(projectSubmissionRow{}),
+	"do_project_subscriptions_create":                                             reflect.TypeOf(projectSubscriptionRow{}),
+	"do_project_subscriptions_update":                                             reflect.TypeOf(projectSubscriptionRow{}),
+	"do_project_trailer_classifications_create":                                   reflect.TypeOf(projectTrailerClassificationDetails{}),
+	"do_project_trailer_classifications_update":                                   reflect.TypeOf(projectTrailerClassificationDetails{}),
+	"do_project_transport_event_types_create":                                     reflect.TypeOf(projectTransportEventTypeRow{}),
+	"do_project_transport_event_types_update":                                     reflect.TypeOf(projectTransportEventTypeRow{}),
+	"do_project_transport_location_event_types_create":                            reflect.TypeOf(projectTransportLocationEventTypeRow{}),
+	"do_project_transport_locations_create":                                       reflect.TypeOf(projectTransportLocationRow{}),
+	"do_project_transport_locations_update":                                       reflect.TypeOf(projectTransportLocationRow{}),
+	"do_project_transport_plan_assignment_rules_create":                           reflect.TypeOf(projectTransportPlanAssignmentRuleRow{}),
+	"do_project_transport_plan_assignment_rules_update":                           reflect.TypeOf(projectTransportPlanAssignmentRuleRow{}),
+	"do_project_transport_plan_driver_assignment_recommendations_create":          reflect.TypeOf(projectTransportPlanDriverAssignmentRecommendationDetails{}),
+	"do_project_transport_plan_driver_confirmations_update":                       reflect.TypeOf(projectTransportPlanDriverConfirmationRow{}),
+	"do_project_transport_plan_drivers_create":                                    reflect.TypeOf(projectTransportPlanDriverRow{}),
+	"do_project_transport_plan_drivers_update":                                    reflect.TypeOf(projectTransportPlanDriverRow{}),
+	"do_project_transport_plan_event_location_predictions_create":                 reflect.TypeOf(projectTransportPlanEventLocationPredictionDetails{}),
+	"do_project_transport_plan_event_location_predictions_update":                 reflect.TypeOf(projectTransportPlanEventLocationPredictionDetails{}),
+	"do_project_transport_plan_event_times_create":                                reflect.TypeOf(projectTransportPlanEventTimeRow{}),
+	"do_project_transport_plan_event_times_update":                                reflect.TypeOf(projectTransportPlanEventTimeRow{}),
+	"do_project_transport_plan_events_create":                                     reflect.TypeOf(projectTransportPlanEventRow{}),
+	"do_project_transport_plan_events_update":                                     reflect.TypeOf(projectTransportPlanEventRow{}),
+	"do_project_transport_plan_planned_event_time_schedules_create":               reflect.TypeOf(projectTransportPlanPlannedEventTimeScheduleDetails{}),
+	"do_project_transport_plan_segment_sets_create":                               reflect.TypeOf(projectTransportPlanSegmentSetRow{}),
+	"do_project_transport_plan_segment_sets_update":                               reflect.TypeOf(projectTransportPlanSegmentSetRow{}),
+	"do_project_transport_plan_segment_tractors_create":                           reflect.TypeOf(projectTransportPlanSegmentTractorDetails{}),
+	"do_project_transport_plan_segments_create":                                   reflect.TypeOf(projectTransportPlanSegmentDetails{}),
+	"do_project_transport_plan_segments_update":                                   reflect.TypeOf(projectTransportPlanSegmentDetails{}),
+	"do_project_transport_plan_stop_insertions_create":                            reflect.TypeOf(projectTransportPlanStopInsertionRow{}),
+	"do_project_transport_plan_stop_order_stops_create":                           reflect.TypeOf(projectTransportPlanStopOrderStopRow{}),
+	"do_project_transport_plan_stops_create":                                      reflect.TypeOf(projectTransportPlanStopRow{}),
+	"do_project_transport_plan_stops_update":                                      reflect.TypeOf(projectTransportPlanStopRow{}),
+	"do_project_transport_plan_tractors_create":                                   reflect.TypeOf(projectTransportPlanTractorDetails{}),
+	"do_project_transport_plan_tractors_update":                                   reflect.TypeOf(projectTransportPlanTractorDetails{}),
+	"do_project_transport_plan_trailer_assignment_recommendations_create":         reflect.TypeOf(projectTransportPlanTrailerAssignmentRecommendationDetails{}),
+	"do_project_transport_plan_trailers_create":                                   reflect.TypeOf(projectTransportPlanTrailerDetails{}),
+	"do_project_transport_plan_trailers_update":                                   reflect.TypeOf(projectTransportPlanTrailerDetails{}),
+	"do_project_transport_plans_create":                                           reflect.TypeOf(projectTransportPlanRow{}),
+	"do_project_transport_plans_update":                                           reflect.TypeOf(projectTransportPlanRow{}),
+	"do_project_unabandonments_create":                                            reflect.TypeOf(projectUnabandonmentRow{}),
+	"do_projects_file_imports_create":                                             reflect.TypeOf(projectsFileImportDetails{}),
+	"do_prompt_prescriptions_create":                                              reflect.TypeOf(promptPrescriptionRow{}),
+	"do_prompters_create":                                                         reflect.TypeOf(prompterRow{}),
+	"do_prompters_delete":                                                         reflect.TypeOf(prompterRow{}),
+	"do_prompters_update":                                                         reflect.TypeOf(prompterRow{}),
+	"do_ptp_driver_summary_create":                                                reflect.TypeOf(ptpDriverSummaryOutput{}),
+	"do_ptp_event_summary_create":                                                 reflect.TypeOf(ptpEventSummaryOutput{}),
+	"do_ptp_event_time_summary_create":                                            reflect.TypeOf(ptpEventTimeSummaryOutput{}),
+	"do_ptp_expected_event_time_summary_create":                                   reflect.TypeOf(ptpExpectedEventTimeSummaryOutput{}),
+	"do_ptp_summary_create":                                                       reflect.TypeOf(ptpSummaryOutput{}),
+	"do_ptp_trailer_summary_create":                                               reflect.TypeOf(ptpTrailerSummaryOutput{}),
+	"do_public_praise_culture_values_create":                                      reflect.TypeOf(publicPraiseCultureValueRow{}),
+	"do_public_praise_culture_values_update":                                      reflect.TypeOf(publicPraiseCultureValueDetails{}),
+	"do_public_praise_reactions_create":                                           reflect.TypeOf(publicPraiseReactionRow{}),
+	"do_public_praise_summary_create":                                             reflect.TypeOf(publicPraiseSummaryOutput{}),
+	"do_public_praises_create":                                                    reflect.TypeOf(publicPraiseRow{}),
+	"do_public_praises_update":                                                    reflect.TypeOf(publicPraiseRow{}),
+	"do_quality_control_classifications_create":                                   reflect.TypeOf(qualityControlClassificationRow{}),
+	"do_quality_control_classifications_update":                                   reflect.TypeOf(qualityControlClassificationRow{}),
+	"do_questions_create":                                                         reflect.TypeOf(questionRow{}),
+	"do_questions_update":                                                         reflect.TypeOf(questionRow{}),
+	"do_rate_adjustments_create":                                                  reflect.TypeOf(rateAdjustmentRow{}),
+	"do_rate_adjustments_update":                                                  reflect.TypeOf(rateAdjustmentRow{}),
+	"do_rate_agreement_copier_works_create":                                       reflect.TypeOf(rateAgreementCopierWorkRow{}),
+	"do_rate_agreement_copier_works_update":                                       reflect.TypeOf(rateAgreementCopierWorkRow{}),
+	"do_rate_agreement_copiers_create":                                            reflect.TypeOf(rateAgreementCopierRow{}),
+	"do_rate_agreements_create":                                                   reflect.TypeOf(rateAgreementRow{}),
+	"do_rate_agreements_update":                                                   reflect.TypeOf(rateAgreementRow{}),
+	"do_rates_create":                                                             reflect.TypeOf(rateRow{}),
+	"do_rates_update":                                                             reflect.TypeOf(rateRow{}),
+	"do_raw_material_transaction_sales_customers_create":                          reflect.TypeOf(rawMaterialTransactionSalesCustomerRow{}),
+	"do_raw_material_transaction_sales_customers_update":                          reflect.TypeOf(rawMaterialTransactionSalesCustomerRow{}),
+	"do_raw_material_transactions_update":                                         reflect.TypeOf(rawMaterialTransactionUpdateResult{}),
+	"do_raw_transport_drivers_create":                                             reflect.TypeOf(rawTransportDriverRow{}),
+	"do_raw_transport_exports_create":                                             reflect.TypeOf(rawTransportExportRow{}),
+	"do_raw_transport_orders_create":                                              reflect.TypeOf(rawTransportOrderRow{}),
+	"do_raw_transport_projects_create":                                            reflect.TypeOf(rawTransportProjectRow{}),
+	"do_raw_transport_tractors_create":                                            reflect.TypeOf(rawTransportTractorRow{}),
+	"do_raw_transport_trailers_create":                                            reflect.TypeOf(rawTransportTrailerRow{}),
+	"do_resource_classification_project_cost_classifications_create":              reflect.TypeOf(resourceClassificationProjectCostClassificationDetails{}),
+	"do_resource_unavailabilities_create":                                         reflect.TypeOf(resourceUnavailabilityRow{}),
+	"do_resource_unavailabilities_update":                                         reflect.TypeOf(resourceUnavailabilityRow{}),
+	"do_retainer_deductions_create":                                               reflect.TypeOf(retainerDeductionRow{}),
+	"do_retainer_deductions_update":                                               reflect.TypeOf(retainerDeductionRow{}),
+	"do_retainer_payments_create":                                                 reflect.TypeOf(retainerPaymentRow{}),
+	"do_retainer_payments_update":                                                 reflect.TypeOf(retainerPaymentRow{}),
+	"do_retainer_periods_create":                                                  reflect.TypeOf(retainerPeriodRow{}),
+	"do_retainer_periods_update":                                                  reflect.TypeOf(retainerPeriodRow{}),
+	"do_retainers_create":                                                         reflect.TypeOf(retainerRow{}),
+	"do_retainers_update":                                                         reflect.TypeOf(retainerRow{}),
+	"do_rmt_adjustments_create":                                                   reflect.TypeOf(rmtAdjustmentRow{}),
+	"do_root_causes_create":                                                       reflect.TypeOf(rootCauseRow{}),
+	"do_root_causes_update":                                                       reflect.TypeOf(rootCauseRow{}),
+	"do_safety_incidents_create":                                                  reflect.TypeOf(safetyIncidentDetails{}),
+	"do_safety_incidents_update":                                                  reflect.TypeOf(safetyIncidentDetails{}),
+	"do_saml_code_redemptions_create":                                             reflect.TypeOf(samlCodeRedemptionRow{}),
+	"do_samsara_integrations_create":                                              reflect.TypeOf(samsaraIntegrationDetails{}),
+	"do_samsara_integrations_delete":                                              reflect.TypeOf(samsaraIntegrationDetails{}),
+	"do_samsara_integrations_update":                                              reflect.TypeOf(samsaraIntegrationDetails{}),
+	"do_samsara_vehicles_update":                                                  reflect.TypeOf(samsaraVehicleRow{}),
+	"do_service_events_create":                                                    reflect.TypeOf(serviceEventRow{}),
+	"do_service_events_update":                                                    reflect.TypeOf(serviceEventRow{}),
+	"do_service_sites_create":                                                     reflect.TypeOf(serviceSiteRow{}),
+	"do_service_sites_update":                                                     reflect.TypeOf(serviceSiteRow{}),
+	"do_service_type_unit_of_measure_cohorts_create":                              reflect.TypeOf(serviceTypeUnitOfMeasureCohortRow{}),
+	"do_service_type_unit_of_measure_cohorts_update":                              reflect.TypeOf(serviceTypeUnitOfMeasureCohortRow{}),
+	"do_service_type_unit_of_measure_quantities_create":                           reflect.TypeOf(serviceTypeUnitOfMeasureQuantityRow{}),
+	"do_service_type_unit_of_measure_quantities_update":                           reflect.TypeOf(serviceTypeUnitOfMeasureQuantityRow{}),
+	"do_shift_acknowledgement_reminder_notification_subscriptions_create":         reflect.TypeOf(shiftAcknowledgementReminderNotificationSubscriptionRow{}),
+	"do_shift_acknowledgement_reminder_notification_subscriptions_update":         reflect.TypeOf(shiftAcknowledgementReminderNotificationSubscriptionRow{}),
+	"do_shift_counters_create":                                                    reflect.TypeOf(shiftCounterDetails{}),
+	"do_shift_feedback_reasons_create":                                            reflect.TypeOf(shiftFeedbackReasonRow{}),
+	"do_shift_feedback_reasons_update":                                            reflect.TypeOf(shiftFeedbackReasonRow{}),
+	"do_shift_feedbacks_create":                                                   reflect.TypeOf(shiftFeedbackRow{}),
+	"do_shift_feedbacks_update":                                                   reflect.TypeOf(shiftFeedbackRow{}),
+	"do_shift_scope_matches_create":                                               reflect.TypeOf(shiftScopeMatchRow{}),
+	"do_shift_scope_tenders_create":                                               reflect.TypeOf(shiftScopeTenderRow{}),
+	"do_shift_set_time_card_constraints_create":                                   reflect.TypeOf(shiftSetTimeCardConstraintRow{}),
+	"do_shift_set_time_card_constraints_update":                                   reflect.TypeOf(shiftSetTimeCardConstraintRow{}),
+	"do_shift_summary_create":                                                     reflect.TypeOf(shiftSummaryOutput{}),
+	"do_shift_time_card_requisitions_create":                                      reflect.TypeOf(shiftTimeCardRequisitionRow{}),
+	"do_site_events_create":                                                       reflect.TypeOf(siteEventRow{}),
+	"do_site_events_update":                                                       reflect.TypeOf(siteEventRow{}),
+	"do_sourcing_searches_create":                                                 reflect.TypeOf(sourcingSearchRow{}),
+	"do_stakeholder_classifications_create":                                       reflect.TypeOf(stakeholderClassificationRow{}),
+	"do_stakeholder_classifications_update":                                       reflect.TypeOf(stakeholderClassificationRow{}),
+	"do_superior_bowen_apex_viewpoint_ticket_exports_create":                      reflect.TypeOf(superiorBowenApexViewpointTicketExportDetails{}),
+	"do_superior_bowen_crew_ledgers_create":                                       reflect.TypeOf(superiorBowenCrewLedgerRow{}),
+	"do_t3_equipmentshare_vehicles_update":                                        reflect.TypeOf(t3EquipmentshareVehicleRow{}),
+	"do_tag_categories_create":                                                    reflect.TypeOf(tagCategoryRow{}),
+	"do_tag_categories_delete":                                                    reflect.TypeOf(tagCategoryRow{}),
+	"do_tag_categories_update":                                                    reflect.TypeOf(tagCategoryRow{}),
+	"do_taggings_create":                                                          reflect.TypeOf(taggingRow{}),
+	"do_tags_create":                                                              reflect.TypeOf(tagRow{}),
+	"do_tags_update":                                                              reflect.TypeOf(tagRow{}),
+	"do_teletrac_navman_vehicles_update":                                          reflect.TypeOf(teletracNavmanVehicleRow{}),
+	"do_temeda_vehicles_update":                                                   reflect.TypeOf(temedaVehicleRow{}),
+	"do_tender_acceptances_create":                                                reflect.TypeOf(tenderAcceptanceRow{}),
+	"do_tender_cancellations_create":                                              reflect.TypeOf(tenderCancellationRow{}),
+	"do_tender_job_schedule_shift_cancellations_create":                           reflect.TypeOf(tenderJobScheduleShiftCancellationRow{}),
+	"do_tender_job_schedule_shift_cancelled_trucker_contact_notifications_update": reflect.TypeOf(tenderJobScheduleShiftCancelledTruckerContactNotificationDetails{}),
+	"do_tender_job_schedule_shift_drivers_create":                                 reflect.TypeOf(tenderJobScheduleShiftDriverRow{}),
+	"do_tender_job_schedule_shift_drivers_update":                                 reflect.TypeOf(tenderJobScheduleShiftDriverRow{}),
+	"do_tender_job_schedule_shift_fill_out_time_card_seller_notifications_update": reflect.TypeOf(tenderJobScheduleShiftFillOutTimeCardSellerNotificationRow{}),
+	"do_tender_job_schedule_shift_starting_seller_notifications_update":           reflect.TypeOf(tenderJobScheduleShiftStartingSellerNotificationDetails{}),
+	"do_tender_job_schedule_shift_time_card_reviews_create":                       reflect.TypeOf(tenderJobScheduleShiftTimeCardReviewRow{}),
+	"do_tender_job_schedule_shifts_create":                                        reflect.TypeOf(tenderJobScheduleShiftRow{}),
+	"do_tender_job_schedule_shifts_material_transactions_checksums_create":        reflect.TypeOf(tenderJobScheduleShiftsMaterialTransactionsChecksumDetails{}),
+	"do_tender_job_schedule_shifts_update":                                        reflect.TypeOf(tenderJobScheduleShiftDetails{}),
+	"do_tender_offers_create":                                                     reflect.TypeOf(tenderOfferRow{}),
+	"do_tender_raters_create":                                                     reflect.TypeOf(tenderRaterRow{}),
+	"do_tender_re_rates_create":                                                   reflect.TypeOf(tenderReRateDetails{}),
+	"do_tender_rejections_create":                                                 reflect.TypeOf(tenderRejectionRow{}),
+	"do_tender_returns_create":                                                    reflect.TypeOf(tenderReturnRowCreate{}),
+	"do_tenna_vehicles_update":                                                    reflect.TypeOf(tennaVehicleRow{}),
+	"do_ticket_report_dispatches_create":                                          reflect.TypeOf(ticketReportDispatchRow{}),
+	"do_ticket_report_imports_create":                                             reflect.TypeOf(ticketReportImportRow{}),
+	"do_ticket_reports_create":                                                    reflect.TypeOf(ticketReportRow{}),
+	"do_ticket_reports_update":                                                    reflect.TypeOf(ticketReportRow{}),
+	"do_time_card_approval_audits_create":                                         reflect.TypeOf(timeCardApprovalAuditRow{}),
+	"do_time_card_approval_audits_update":                                         reflect.TypeOf(timeCardApprovalAuditRow{}),
+	"do_time_card_approvals_create":                                               reflect.TypeOf(timeCardApprovalRow{}),
+	"do_time_card_cost_code_allocations_create":                                   reflect.TypeOf(timeCardCostCodeAllocationRow{}),
+	"do_time_card_cost_code_allocations_update":                                   reflect.TypeOf(timeCardCostCodeAllocationRow{}),
+	"do_time_card_payroll_certifications_create":                                  reflect.TypeOf(timeCardPayrollCertificationRow{}),
+	"do_time_card_pre_approvals_create":                                           reflect.TypeOf(timeCardPreApprovalRow{}),
+	"do_time_card_pre_approvals_update":                                           reflect.TypeOf(timeCardPreApprovalRow{}),
+	"do_time_card_rejections_create":                                              reflect.TypeOf(timeCardRejectionRow{}),
+	"do_time_card_scrappages_create":                                              reflect.TypeOf(timeCardScrappageRow{}),
+	"do_time_card_submissions_create":                                             reflect.TypeOf(timeCardSubmissionRow{}),
+	"do_time_card_time_changes_create":                                            reflect.TypeOf(timeCardTimeChangeRow{}),
+	"do_time_card_time_changes_update":                                            reflect.TypeOf(timeCardTimeChangeRow{}),
+	"do_time_card_unapprovals_create":                                             reflect.TypeOf(timeCardUnapprovalRow{}),
+	"do_time_card_unscrappages_create":                                            reflect.TypeOf(timeCardUnscrappageRow{}),
+	"do_time_card_unsubmissions_create":                                           reflect.TypeOf(timeCardUnsubmissionRow{}),
+	"do_time_cards_create":                                                        reflect.TypeOf(timeCardRow{}),
+	"do_time_cards_update":                                                        reflect.TypeOf(timeCardRow{}),
+	"do_time_sheet_approvals_create":                                              reflect.TypeOf(timeSheetApprovalRow{}),
+	"do_time_sheet_cost_code_allocations_create":                                  reflect.TypeOf(timeSheetCostCodeAllocationRow{}),
+	"do_time_sheet_cost_code_allocations_update":                                  reflect.TypeOf(timeSheetCostCodeAllocationRow{}),
+	"do_time_sheet_line_item_classifications_create":                              reflect.TypeOf(timeSheetLineItemClassificationRow{}),
+	"do_time_sheet_line_item_classifications_update":                              reflect.TypeOf(timeSheetLineItemClassificationRow{}),
+	"do_time_sheet_line_item_equipment_requirements_create":                       reflect.TypeOf(timeSheetLineItemEquipmentRequirementRow{}),
+	"do_time_sheet_line_item_equipment_requirements_update":                       reflect.TypeOf(timeSheetLineItemEquipmentRequirementRow{}),
+	"do_time_sheet_line_items_create":                                             reflect.TypeOf(timeSheetLineItemDetails{}),
+	"do_time_sheet_line_items_update":                                             reflect.TypeOf(timeSheetLineItemDetails{}),
+	"do_time_sheet_no_shows_create":                                               reflect.TypeOf(timeSheetNoShowRow{}),
+	"do_time_sheet_no_shows_update":                                               reflect.TypeOf(timeSheetNoShowRow{}),
+	"do_time_sheet_rejections_create":                                             reflect.TypeOf(timeSheetRejectionRow{}),
+	"do_time_sheet_submissions_create":                                            reflect.TypeOf(timeSheetSubmissionRow{}),
+	"do_time_sheet_unapprovals_create":                                            reflect.TypeOf(timeSheetUnapprovalRow{}),
+	"do_time_sheet_unsubmissions_create":                                          reflect.TypeOf(timeSheetUnsubmissionRow{}),
+	"do_time_sheets_create":                                                       reflect.TypeOf(timeSheetRow{}),
+	"do_time_sheets_exports_create":                                               reflect.TypeOf(timeSheetsExportCreateRow{}),
+	"do_time_sheets_update":                                                       reflect.TypeOf(timeSheetRow{}),
+	"do_tractor_credentials_create":                                               reflect.TypeOf(tractorCredentialRow{}),
+	"do_tractor_credentials_update":                                               reflect.TypeOf(tractorCredentialRow{}),
+	"do_tractor_fuel_consumption_readings_create":                                 reflect.TypeOf(tractorFuelConsumptionReadingRow{}),
+	"do_tractor_fuel_consumption_readings_update":                                 reflect.TypeOf(tractorFuelConsumptionReadingRow{}),
+	"do_tractor_odometer_readings_create":                                         reflect.TypeOf(tractorOdometerReadingRow{}),
+	"do_tractor_odometer_readings_update":                                         reflect.TypeOf(tractorOdometerReadingRow{}),
+	"do_tractor_trailer_credential_classifications_create":                        reflect.TypeOf(tractorTrailerCredentialClassificationRow{}),
+	"do_tractor_trailer_credential_classifications_update":                        reflect.TypeOf(tractorTrailerCredentialClassificationRow{}),
+	"do_tractors_create":                                                          reflect.TypeOf(tractorRow{}),
+	"do_tractors_update":                                                          reflect.TypeOf(tractorRow{}),
+	"do_trading_partners_create":                                                  reflect.TypeOf(tradingPartnerRow{}),
+	"do_trailer_credentials_create":                                               reflect.TypeOf(trailerCredentialRow{}),
+	"do_trailer_credentials_update":                                               reflect.TypeOf(trailerCredentialRow{}),
+	"do_trailers_create":                                                          reflect.TypeOf(trailerRow{}),
+	"do_trailers_update":                                                          reflect.TypeOf(trailerRow{}),
+	"do_transport_order_efficiency_summary_create":                                reflect.TypeOf(transportOrderEfficiencySummaryOutput{}),
+	"do_transport_order_stops_create":                                             reflect.TypeOf(transportOrderStopRow{}),
+	"do_transport_order_stops_update":                                             reflect.TypeOf(transportOrderStopRow{}),
+	"do_transport_references_create":                                              reflect.TypeOf(transportReferenceRow{}),
+	"do_transport_references_update":                                              reflect.TypeOf(transportReferenceRow{}),
+	"do_trips_create":                                                             reflect.TypeOf(tripRow{}),
+	"do_trips_update":                                                             reflect.TypeOf(tripRow{}),
+	"do_truck_scopes_create":                                                      reflect.TypeOf(truckScopeRow{}),
+	"do_truck_scopes_update":                                                      reflect.TypeOf(truckScopeRow{}),
+	"do_trucker_application_approvals_create":                                     reflect.TypeOf(truckerApplicationApprovalRow{}),
+	"do_trucker_applications_create":                                              reflect.TypeOf(truckerApplicationDetails{}),
+	"do_trucker_applications_update":                                              reflect.TypeOf(truckerApplicationDetails{}),
+	"do_trucker_brokerages_create":                                                reflect.TypeOf(truckerBrokerageDetails{}),
+	"do_trucker_brokerages_update":                                                reflect.TypeOf(truckerBrokerageDetails{}),
+	"do_trucker_insurances_create":                                                reflect.TypeOf(truckerInsuranceRow{}),
+	"do_trucker_insurances_update":                                                reflect.TypeOf(truckerInsuranceRow{}),
+	"do_trucker_invoices_create":                                                  reflect.TypeOf(truckerInvoiceRow{}),
+	"do_trucker_invoices_update":                                                  reflect.TypeOf(truckerInvoiceRow{}),
+	"do_trucker_memberships_create":                                               reflect.TypeOf(membershipDetails{}),
+	"do_trucker_memberships_delete":                                               reflect.TypeOf(membershipDetails{}),
+	"do_trucker_memberships_update":                                               reflect.TypeOf(membershipDetails{}),
+	"do_trucker_referral_codes_create":                                            reflect.TypeOf(truckerReferralCodeRow{}),
+	"do_trucker_referral_codes_delete":                                            reflect.TypeOf(truckerReferralCodeRow{}),
+	"do_trucker_referral_codes_update":                                            reflect.TypeOf(truckerReferralCodeRow{}),
+	"do_trucker_referrals_create":                                                 reflect.TypeOf(truckerReferralDetails{}),
+	"do_trucker_referrals_update":                                                 reflect.TypeOf(truckerReferralDetails{}),
+	"do_trucker_settings_create":                                                  reflect.TypeOf(truckerSettingDetails{}),
+	"do_trucker_settings_update":                                                  reflect.TypeOf(truckerSettingDetails{}),
+	"do_trucker_shift_sets_update":                                                reflect.TypeOf(truckerShiftSetDetails{}),
+	"do_truckers_create":                                                          reflect.TypeOf(truckerRow{}),
+	"do_truckers_update":                                                          reflect.TypeOf(truckerRow{}),
+	"do_ui_tour_steps_create":                                                     reflect.TypeOf(uiTourStepRow{}),
+	"do_ui_tour_steps_update":                                                     reflect.TypeOf(uiTourStepRow{}),
+	"do_ui_tours_create":                                                          reflect.TypeOf(uiTourRow{}),
+	"do_ui_tours_delete":                                                          reflect.TypeOf(uiTourRow{}),
+	"do_ui_tours_update":                                                          reflect.TypeOf(uiTourRow{}),
+	"do_user_auth_token_resets_create":                                            reflect.TypeOf(userAuthTokenResetRow{}),
+	"do_user_creator_feeds_create":                                                reflect.TypeOf(userCreatorFeedRow{}),
+	"do_user_creator_feeds_update":                                                reflect.TypeOf(userCreatorFeedRow{}),
+	"do_user_credential_classifications_create":                                   reflect.TypeOf(userCredentialClassificationRow{}),
+	"do_user_credential_classifications_update":                                   reflect.TypeOf(userCredentialClassificationRow{}),
+	"do_user_credentials_create":                                                  reflect.TypeOf(userCredentialRow{}),
+	"do_user_credentials_update":                                                  reflect.TypeOf(userCredentialRow{}),
+	"do_user_device_location_tracking_requests_create":                            reflect.TypeOf(userDeviceLocationTrackingRequestRow{}),
+	"do_user_languages_create":                                                    reflect.TypeOf(userLanguageRow{}),
+	"do_user_languages_update":                                                    reflect.TypeOf(userLanguageRow{}),
+	"do_user_location_events_create":                                              reflect.TypeOf(userLocationEventRow{}),
+	"do_user_location_events_update":                                              reflect.TypeOf(userLocationEventRow{}),
+	"do_user_location_requests_create":                                            reflect.TypeOf(userLocationRequestDetails{}),
+	"do_user_post_feed_posts_update":                                              reflect.TypeOf(userPostFeedPostDetails{}),
+	"do_user_post_feeds_create":                                                   reflect.TypeOf(userPostFeedRow{}),
+	"do_user_post_feeds_update":                                                   reflect.TypeOf(userPostFeedRow{}),
+	"do_user_searches_create":                                                     reflect.TypeOf(userSearchRow{}),
+	"do_user_ui_tours_create":                                                     reflect.TypeOf(userUiTourRow{}),
+	"do_user_ui_tours_update":                                                     reflect.TypeOf(userUiTourRow{}),
+	"do_users_create":                                                             reflect.TypeOf(userRow{}),
+	"do_users_update":                                                             reflect.TypeOf(userRow{}),
+	"do_verizon_reveal_vehicles_update":                                           reflect.TypeOf(verizonRevealVehicleRow{}),
+	"do_work_order_assignments_create":                                            reflect.TypeOf(workOrderAssignmentDetails{}),
+	"do_work_order_assignments_update":                                            reflect.TypeOf(workOrderAssignmentDetails{}),
+	"do_work_order_service_codes_create":                                          reflect.TypeOf(workOrderServiceCodeRow{}),
+	"do_work_order_service_codes_update":                                          reflect.TypeOf(workOrderServiceCodeRow{}),
+	"do_work_orders_create":                                                       reflect.TypeOf(workOrderRow{}),
+	"do_work_orders_update":                                                       reflect.TypeOf(workOrderRow{}),
+	"driver_assignment_acknowledgements_list":                                     reflect.TypeOf(([]driverAssignmentAcknowledgementRow)(nil)),
+	"driver_assignment_refusals_list":                                             reflect.TypeOf(([]driverAssignmentRefusalRow)(nil)),
+	"driver_assignment_rules_list":                                                reflect.TypeOf(([]driverAssignmentRuleRow)(nil)),
+	"driver_day_adjustment_plans_list":                                            reflect.TypeOf(([]driverDayAdjustmentPlanRow)(nil)),
+	"driver_day_adjustments_list":                                                 reflect.TypeOf(([]driverDayAdjustmentRow)(nil)),
+	"driver_day_constraints_list":                                                 reflect.TypeOf(([]driverDayConstraintRow)(nil)),
+	"driver_day_shortfall_calculations_list":                                      reflect.TypeOf(([]driverDayShortfallCalculationRow)(nil)),
+	"driver_day_trips_adjustments_list":                                           reflect.TypeOf(([]driverDayTripsAdjustmentRow)(nil)),
+	"driver_managers_list":                                                        reflect.TypeOf(([]driverManagerRow)(nil)),
+	"driver_movement_observations_list":                                           reflect.TypeOf(([]driverMovementObservationRow)(nil)),
+	"driver_movement_segment_sets_list":                                           reflect.TypeOf(([]driverMovementSegmentSetRow)(nil)),
+	"driver_movement_segments_list":                                               reflect.TypeOf(([]driverMovementSegmentRow)(nil)),
+	"efficiency_incidents_list":                                                   reflect.TypeOf(([]efficiencyIncidentRow)(nil)),
+	"equipment_classifications_list":                                              reflect.TypeOf(([]equipmentClassificationRow)(nil)),
+	"equipment_list":                                                              reflect.TypeOf(([]equipmentRow)(nil)),
+	"equipment_location_estimates_list":                                           reflect.TypeOf(([]equipmentLocationEstimateRow)(nil)),
+	"equipment_location_events_list":                                              reflect.TypeOf(([]equipmentLocationEventRow)(nil)),
+	"equipment_movement_requirement_locations_list":                               reflect.TypeOf(([]equipmentMovementRequirementLocationRow)(nil)),
+	"equipment_movement_requirements_list":                                        reflect.TypeOf(([]equipmentMovementRequirementRow)(nil)),
+	"equipment_movement_stop_completions_list":                                    reflect.TypeOf(([]equipmentMovementStopCompletionRow)(nil)),
+	"equipment_movement_stop_requirements_list":                                   reflect.TypeOf(([]equipmentMovementStopRequirementRow)(nil)),
+	"equipment_movement_stops_list":                                               reflect.TypeOf(([]equipmentMovementStopRow)(nil)),
+	"equipment_movement_trip_customer_cost_allocations_list":                      reflect.TypeOf(([]equipmentMovementTripCustomerCostAllocationRow)(nil)),
+	"equipment_movement_trip_dispatches_list":                                     reflect.TypeOf(([]equipmentMovementTripDispatchRow)(nil)),
+	"equipment_movement_trip_job_production_plans_list":                           reflect.TypeOf(([]equipmentMovementTripJobProductionPlanRow)(nil)),
+	"equipment_movement_trips_list":                                               reflect.TypeOf(([]equipmentMovementTripRow)(nil)),
+	"equipment_rentals_list":                                                      reflect.TypeOf(([]equipmentRentalRow)(nil)),
+	"equipment_requirements_list":                                                 reflect.TypeOf(([]equipmentRequirementRow)(nil)),
+	"equipment_suppliers_list":                                                    reflect.TypeOf(([]equipmentSupplierRow)(nil)),
+	"equipment_utilization_readings_list":                                         reflect.TypeOf(([]equipmentUtilizationReadingRow)(nil)),
+	"expected_time_of_arrivals_list":                                              reflect.TypeOf(([]expectedTimeOfArrivalRow)(nil)),
+	"exporter_configurations_list":                                                reflect.TypeOf(([]exporterConfigurationRow)(nil)),
+	"external_identification_types_list":                                          reflect.TypeOf(([]externalIdentificationTypeRow)(nil)),
+	"external_identifications_list":                                               reflect.TypeOf(([]externalIdentificationRow)(nil)),
+	"features_list":                                                               reflect.TypeOf(([]featureRow)(nil)),
+	"file_attachments_list":                                                       reflect.TypeOf(([]fileAttachmentRow)(nil)),
+	"file_imports_list":                                                           reflect.TypeOf(([]fileImportRow)(nil)),
+	"follows_list":                                                                reflect.TypeOf(([]followRow)(nil)),
+	"gauge_vehicles_list":                                                         reflect.TypeOf(([]gaugeVehicleRow)(nil)),
+	"geofence_restriction_violations_list":                                        reflect.TypeOf(([]geofenceRestrictionViolationRow)(nil)),
+	"geofence_restrictions_list":                                                  reflect.TypeOf(([]geofenceRestrictionRow)(nil)),
+	"geofences_list":                                                              reflect.TypeOf(([]geofenceRow)(nil)),
+	"geotab_vehicles_list":                                                        reflect.TypeOf(([]geotabVehicleRow)(nil)),
+	"glossary_terms_list":                                                         reflect.TypeOf(([]glossaryTermRow)(nil)),
+	"go_motive_integrations_list":                                                 reflect.TypeOf(([]goMotiveIntegrationRow)(nil)),
+	"gps_insight_vehicles_list":                                                   reflect.TypeOf(([]gpsInsightVehicleRow)(nil)),
+	"haskell_lemon_inbound_material_transaction_exports_list":                     reflect.TypeOf(([]haskellLemonInboundMaterialTransactionExportRow)(nil)),
+	"haskell_lemon_outbound_material_transaction_exports_list":                    reflect.TypeOf(([]haskellLemonOutboundMaterialTransactionExportRow)(nil)),
+	"hos_annotations_list":                                                        reflect.TypeOf(([]hosAnnotationRow)(nil)),
+	"hos_availability_snapshots_list":                                             reflect.TypeOf(([]hosAvailabilitySnapshotRow)(nil)),
+	"hos_day_regulation_sets_list":                                                reflect.TypeOf(([]hosDayRegulationSetRow)(nil)),
+	"hos_days_list":                                                               reflect.TypeOf(([]hosDayRow)(nil)),
+	"hos_events_list":                                                             reflect.TypeOf(([]hosEventRow)(nil)),
+	"hos_ruleset_assignments_list":                                                reflect.TypeOf(([]hosRulesetAssignmentRow)(nil)),
+	"hos_violations_list":                                                         reflect.TypeOf(([]hosViolationRow)(nil)),
+	"importer_configurations_list":                                                reflect.TypeOf(([]importerConfigurationRow)(nil)),
+	"incident_headline_suggestions_list":                                          reflect.TypeOf(([]incidentHeadlineSuggestionRow)(nil)),
+	"incident_participants_list":                                                  reflect.TypeOf(([]incidentParticipantRow)(nil)),
+	"incident_request_approvals_list":                                             reflect.TypeOf(([]incidentRequestApprovalRow)(nil)),
+	"incident_request_rejections_list":                                            reflect.TypeOf(([]incidentRequestRejectionRow)(nil)),
+	"incident_requests_list":                                                      reflect.TypeOf(([]incidentRequestRow)(nil)),
+	"incident_subscriptions_list":                                                 reflect.TypeOf(([]incidentSubscriptionRow)(nil)),
+	"incident_tag_incidents_list":                                                 reflect.TypeOf(([]incidentTagIncidentRow)(nil)),
+	"incident_tags_list":                                                          reflect.TypeOf(([]incidentTagRow)(nil)),
+	"incident_unit_of_measure_quantities_list":                                    reflect.TypeOf(([]incidentUnitOfMeasureQuantityRow)(nil)),
+	"incidents_list":                                                              reflect.TypeOf(([]incidentRow)(nil)),
+	"integration_configs_list":                                                    reflect.TypeOf(([]integrationConfigRow)(nil)),
+	"integration_exports_list":                                                    reflect.TypeOf(([]integrationExportRow)(nil)),
+	"integration_invoices_batch_exports_list":                                     reflect.TypeOf(([]integrationInvoicesBatchExportRow)(nil)),
+	"inventory_capacities_list":                                                   reflect.TypeOf(([]inventoryCapacityRow)(nil)),
+	"inventory_changes_list":                                                      reflect.TypeOf(([]inventoryChangeRow)(nil)),
+	"inventory_estimates_list":                                                    reflect.TypeOf(([]inventoryEstimateRow)(nil)),
+	"invoice_approvals_list":                                                      reflect.TypeOf(([]invoiceApprovalRow)(nil)),
+	"invoice_generations_list":                                                    reflect.TypeOf(([]invoiceGenerationRow)(nil)),
+	"invoice_revisionizing_invoice_revisions_list":                                reflect.TypeOf(([]invoiceRevisionizingInvoiceRevisionRow)(nil)),
+	"invoice_revisionizing_works_list":                                            reflect.TypeOf(([]invoiceRevisionizingWorkRow)(nil)),
+	"invoice_revisions_list":                                                      reflect.TypeOf(([]invoiceRevisionRow)(nil)),
+	"invoice_sends_list":                                                          reflect.TypeOf(([]invoiceSendRow)(nil)),
+	"invoice_status_changes_list":                                                 reflect.TypeOf(([]invoiceStatusChangeRow)(nil)),
+	"invoices_list":                                                               reflect.TypeOf(([]invoiceRow)(nil)),
+	"job_production_plan_alarm_subscribers_list":                                  reflect.TypeOf(([]jobProductionPlanAlarmSubscriberRow)(nil)),
+	"job_production_plan_alarms_list":                                             reflect.TypeOf(([]jobProductionPlanAlarmRow)(nil)),
+	"job_production_plan_broadcast_messages_list":                                 reflect.TypeOf(([]jobProductionPlanBroadcastMessageRow)(nil)),
+	"job_production_plan_cancellation_reason_types_list":                          reflect.TypeOf(([]jobProductionPlanCancellationReasonTypeRow)(nil)),
+	"job_production_plan_cancellations_list":                                      reflect.TypeOf(([]jobProductionPlanCancellationRow)(nil)),
+	"job_production_plan_change_sets_list":                                        reflect.TypeOf(([]jobProductionPlanChangeSetRow)(nil)),
+	"job_production_plan_cost_codes_list":                                         reflect.TypeOf(([]jobProductionPlanCostCodeRow)(nil)),
+	"job_production_plan_display_unit_of_measures_list":                           reflect.TypeOf(([]jobProductionPlanDisplayUnitOfMeasureRow)(nil)),
+	"job_production_plan_duplication_works_list":                                  reflect.TypeOf(([]jobProductionPlanDuplicationWorkRow)(nil)),
+	"job_production_plan_inspectable_summaries_list":                              reflect.TypeOf(([]jobProductionPlanInspectableSummaryRow)(nil)),
+	"job_production_plan_inspectors_list":                                         reflect.TypeOf(([]jobProductionPlanInspectorRow)(nil)),
+	"job_production_plan_job_site_location_estimates_list":                        reflect.TypeOf(([]jobProductionPlanJobSiteLocationEstimateRow)(nil)),
+	"job_production_plan_locations_list":                                          reflect.TypeOf(([]jobProductionPlanLocationRow)(nil)),
+	"job_production_plan_material_site_changes_list":                              reflect.TypeOf(([]jobProductionPlanMaterialSiteChangeRow)(nil)),
+	"job_production_plan_material_sites_list":                                     reflect.TypeOf(([]jobProductionPlanMaterialSiteRow)(nil)),
+	"job_production_plan_material_type_quality_control_requirements_list":         reflect.TypeOf(([]jobProductionPlanMaterialTypeQualityControlRequirementRow)(nil)),
+	"job_production_plan_material_types_list":                                     reflect.TypeOf(([]jobProductionPlanMaterialTypeRow)(nil)),
+	"job_production_plan_project_phase_revenue_items_list":                        reflect.TypeOf(([]jobProductionPlanProjectPhaseRevenueItemRow)(nil)),
+	"job_production_plan_recaps_list":                                             reflect.TypeOf(([]jobProductionPlanRecapRow)(nil)),
+	"job_production_plan_rejections_list":                                         reflect.TypeOf(([]jobProductionPlanRejectionRow)(nil)),
+	"job_production_plan_safety_risk_communication_suggestions_list":              reflect.TypeOf(([]jobProductionPlanSafetyRiskCommunicationSuggestionRow)(nil)),
+	"job_production_plan_safety_risks_list":                                       reflect.TypeOf(([]jobProductionPlanSafetyRiskRow)(nil)),
+	"job_production_plan_safety_risks_suggestions_list":                           reflect.TypeOf(([]jobProductionPlanSafetyRisksSuggestionRow)(nil)),
+	"job_production_plan_schedule_change_works_list":                              reflect.TypeOf(([]jobProductionPlanScheduleChangeWorkRow)(nil)),
+	"job_production_plan_segment_sets_list":                                       reflect.TypeOf(([]jobProductionPlanSegmentSetRow)(nil)),
+	"job_production_plan_segments_list":                                           reflect.TypeOf(([]jobProductionPlanSegmentRow)(nil)),
+	"job_production_plan_service_type_unit_of_measure_cohorts_list":               reflect.TypeOf(([]jobProductionPlanServiceTypeUnitOfMeasureCohortRow)(nil)),
+	"job_production_plan_service_type_unit_of_measures_list":                      reflect.TypeOf(([]jobProductionPlanServiceTypeUnitOfMeasureRow)(nil)),
+	"job_production_plan_status_changes_list":                                     reflect.TypeOf(([]jobProductionPlanStatusChangeRow)(nil)),
+	"job_production_plan_subscriptions_list":                                      reflect.TypeOf(([]jobProductionPlanSubscriptionRow)(nil)),
+	"job_production_plan_supply_demand_balances_list":                             reflect.TypeOf(([]jobProductionPlanSupplyDemandBalanceRow)(nil)),
+	"job_production_plan_time_card_approvers_list":                                reflect.TypeOf(([]jobProductionPlanTimeCardApproverRow)(nil)),
+	"job_production_plan_trailer_classifications_list":                            reflect.TypeOf(([]jobProductionPlanTrailerClassificationRow)(nil)),
+	"job_production_plan_trucking_incident_detectors_list":                        reflect.TypeOf(([]jobProductionPlanTruckingIncidentDetectorRow)(nil)),
+	"job_production_plan_unabandonments_list":                                     reflect.TypeOf(([]jobProductionPlanUnabandonmentRow)(nil)),
+	"job_production_plans_list":                                                   reflect.TypeOf(([]jobProductionPlanRow)(nil)),
+	"job_schedule_shift_splits_list":                                              reflect.TypeOf(([]jobScheduleShiftSplitRow)(nil)),
+	"job_schedule_shift_start_at_changes_list":                                    reflect.TypeOf(([]jobScheduleShiftStartAtChangeRow)(nil)),
+	"job_schedule_shift_start_site_changes_list":                                  reflect.TypeOf(([]jobScheduleShiftStartSiteChangeRow)(nil)),
+	"job_schedule_shifts_list":                                                    reflect.TypeOf(([]jobScheduleShiftRow)(nil)),
+	"job_site_times_list":                                                         reflect.TypeOf(([]jobSiteTimeRow)(nil)),
+	"job_sites_list":                                                              reflect.TypeOf(([]jobSiteRow)(nil)),
+	"jobs_list":                                                                   reflect.TypeOf(([]jobRow)(nil)),
+	"keep_truckin_users_list":                                                     reflect.TypeOf(([]keepTruckinUserRow)(nil)),
+	"keep_truckin_vehicles_list":                                                  reflect.TypeOf(([]keepTruckinVehicleRow)(nil)),
+	"key_result_changes_list":                                                     reflect.TypeOf(([]keyResultChangeRow)(nil)),
+	"key_result_scrappages_list":                                                  reflect.TypeOf(([]keyResultScrappageRow)(nil)),
+	"key_result_status_changes_list":                                              reflect.TypeOf(([]keyResultStatusChangeRow)(nil)),
+	"key_results_list":                                                            reflect.TypeOf(([]keyResultRow)(nil)),
+	"labor_classifications_list":                                                  reflect.TypeOf(([]laborClassificationRow)(nil)),
+	"labor_requirements_list":                                                     reflect.TypeOf(([]laborRequirementRow)(nil)),
+	"laborers_list":                                                               reflect.TypeOf(([]laborerRow)(nil)),
+	"languages_list":                                                              reflect.TypeOf(([]languageRow)(nil)),
+	"lehman_roberts_apex_viewpoint_ticket_exports_list":                           reflect.TypeOf(([]lehmanRobertsApexViewpointTicketExportRow)(nil)),
+	"liability_incidents_list":                                                    reflect.TypeOf(([]liabilityIncidentRow)(nil)),
+	"lineup_dispatch_shifts_list":                                                 reflect.TypeOf(([]lineupDispatchShiftRow)(nil)),
+	"lineup_dispatches_list":                                                      reflect.TypeOf(([]lineupDispatchRow)(nil)),
+	"lineup_job_production_plans_list":                                            reflect.TypeOf(([]lineupJobProductionPlanRow)(nil)),
+	"lineup_job_schedule_shift_trucker_assignment_recommendations_list":           reflect.TypeOf(([]lineupJobScheduleShiftTruckerAssignmentRecommendationRow)(nil)),
+	"lineup_job_schedule_shifts_list":                                             reflect.TypeOf(([]lineupJobScheduleShiftRow)(nil)),
+	"lineup_scenario_generators_list":                                             reflect.TypeOf(([]lineupScenarioGeneratorRow)(nil)),
+	"lineup_scenario_lineup_job_schedule_shifts_list":                             reflect.TypeOf(([]lineupScenarioLineupJobScheduleShiftRow)(nil)),
+	"lineup_scenario_lineups_list":                                                reflect.TypeOf(([]lineupScenarioLineupRow)(nil)),
+	"lineup_scenario_solutions_list":                                              reflect.TypeOf(([]lineupScenarioSolutionRow)(nil)),
+	"lineup_scenario_trailer_lineup_job_schedule_shifts_list":                     reflect.TypeOf(([]lineupScenarioTrailerLineupJobScheduleShiftRow)(nil)),
+	"lineup_scenario_trailers_list":                                               reflect.TypeOf(([]lineupScenarioTrailerRow)(nil)),
+	"lineup_scenario_truckers_list":                                               reflect.TypeOf(([]lineupScenarioTruckerRow)(nil)),
+	"lineup_scenarios_list":                                                       reflect.TypeOf(([]lineupScenarioRow)(nil)),
+	"lineup_summary_requests_list":                                                reflect.TypeOf(([]lineupSummaryRequestRow)(nil)),
+	"lineups_list":                                                                reflect.TypeOf(([]lineupRow)(nil)),
+	"lowest_losing_bid_prediction_subject_details_list":                           reflect.TypeOf(([]lowestLosingBidPredictionSubjectDetailRow)(nil)),
+	"maintenance_requirement_maintenance_requirement_parts_list":                  reflect.TypeOf(([]maintenanceRequirementMaintenanceRequirementPartRow)(nil)),
+	"maintenance_requirement_parts_list":                                          reflect.TypeOf(([]maintenanceRequirementPartRow)(nil)),
+	"maintenance_requirement_rule_maintenance_requirement_sets_list":              reflect.TypeOf(([]maintenanceRequirementRuleMaintenanceRequirementSetRow)(nil)),
+	"maintenance_requirement_rules_list":                                          reflect.TypeOf(([]maintenanceRequirementRuleRow)(nil)),
+	"maintenance_requirement_set_maintenance_requirements_list":                   reflect.TypeOf(([]maintenanceRequirementSetMaintenanceRequirementRow)(nil)),
+	"maintenance_requirement_sets_list":                                           reflect.TypeOf(([]maintenanceRequirementSetRow)(nil)),
+	"material_mix_designs_list":                                                   reflect.TypeOf(([]materialMixDesignRow)(nil)),
+	"material_purchase_order_release_redemptions_list":                            reflect.TypeOf(([]materialPurchaseOrderReleaseRedemptionRow)(nil)),
+	"material_purchase_order_releases_list":                                       reflect.TypeOf(([]materialPurchaseOrderReleaseRow)(nil)),
+	"material_purchase_orders_list":                                               reflect.TypeOf(([]materialPurchaseOrderRow)(nil)),
+	"material_site_inventory_locations_list":                                      reflect.TypeOf(([]materialSiteInventoryLocationRow)(nil)),
+	"material_site_measures_list":                                                 reflect.TypeOf(([]materialSiteMeasureRow)(nil)),
+	"material_site_mixing_lots_list":                                              reflect.TypeOf(([]materialSiteMixingLotRow)(nil)),
+	"material_site_reading_material_types_list":                                   reflect.TypeOf(([]materialSiteReadingMaterialTypeRow)(nil)),
+	"material_site_readings_list":                                                 reflect.TypeOf(([]materialSiteReadingRow)(nil)),
+	"material_site_subscriptions_list":                                            reflect.TypeOf(([]materialSiteSubscriptionRow)(nil)),
+	"material_site_unavailabilities_list":                                         reflect.TypeOf(([]materialSiteUnavailabilityRow)(nil)),
+	"material_sites_list":                                                         reflect.TypeOf(([]materialSiteRow)(nil)),
+	"material_supplier_memberships_list":                                          reflect.TypeOf(([]membershipRow)(nil)),
+	"material_suppliers_list":                                                     reflect.TypeOf(([]materialSupplierRow)(nil)),
+	"material_transaction_cost_code_allocations_list":                             reflect.TypeOf(([]materialTransactionCostCodeAllocationRow)(nil)),
+	"material_transaction_diversions_list":                                        reflect.TypeOf(([]materialTransactionDiversionRow)(nil)),
+	"material_transaction_field_scopes_list":                                      reflect.TypeOf(([]materialTransactionFieldScopeRow)(nil)),
+	"material_transaction_inspection_rejections_list":                             reflect.TypeOf(([]materialTransactionInspectionRejectionRow)(nil)),
+	"material_transaction_inspections_list":                                       reflect.TypeOf(([]materialTransactionInspectionRow)(nil)),
+	"material_transaction_preloads_list":                                          reflect.TypeOf(([]materialTransactionPreloadRow)(nil)),
+	"material_transaction_rejections_list":                                        reflect.TypeOf(([]materialTransactionRejectionRow)(nil)),
+	"material_transaction_shift_assignments_list":                                 reflect.TypeOf(([]materialTransactionShiftAssignmentRow)(nil)),
+	"material_transaction_status_changes_list":                                    reflect.TypeOf(([]materialTransactionStatusChangeRow)(nil)),
+	"material_transaction_submissions_list":                                       reflect.TypeOf(([]materialTransactionSubmissionRow)(nil)),
+	"material_transaction_ticket_generators_list":                                 reflect.TypeOf(([]materialTransactionTicketGeneratorRow)(nil)),
+	"material_transactions_exports_list":                                          reflect.TypeOf(([]materialTransactionsExportRow)(nil)),
+	"material_transactions_list":                                                  reflect.TypeOf(([]materialTransactionRow)(nil)),
+	"material_type_conversions_list":                                              reflect.TypeOf(([]materialTypeConversionRow)(nil)),
+	"material_type_material_site_inventory_locations_list":                        reflect.TypeOf(([]materialTypeMaterialSiteInventoryLocationRow)(nil)),
+	"material_type_unavailabilities_list":                                         reflect.TypeOf(([]materialTypeUnavailabilityRow)(nil)),
+	"material_types_list":                                                         reflect.TypeOf(([]materialTypeRow)(nil)),
+	"material_unit_of_measure_quantities_list":                                    reflect.TypeOf(([]materialUnitOfMeasureQuantityRow)(nil)),
+	"mechanic_user_associations_list":                                             reflect.TypeOf(([]mechanicUserAssociationRow)(nil)),
+	"meeting_attendees_list":                                                      reflect.TypeOf(([]meetingAttendeeRow)(nil)),
+	"meetings_list":                                                               reflect.TypeOf(([]meetingRow)(nil)),
+	"memberships_list":                                                            reflect.TypeOf(([]membershipRow)(nil)),
+	"missing_rates_list":                                                          reflect.TypeOf(([]missingRateRow)(nil)),
+	"native_app_releases_list":                                                    reflect.TypeOf(([]nativeAppReleaseRow)(nil)),
+	"newsletters_list":                                                            reflect.TypeOf(([]newsletterRow)(nil)),
+	"notification_delivery_decisions_list":                                        reflect.TypeOf(([]notificationDeliveryDecisionRow)(nil)),
+	"notification_subscriptions_list":                                             reflect.TypeOf(([]notificationSubscriptionRow)(nil)),
+	"notifications_list":                                                          reflect.TypeOf(([]notificationRow)(nil)),
+	"objective_changes_list":                                                      reflect.TypeOf(([]objectiveChangeRow)(nil)),
+	"objective_stakeholder_classification_quotes_list":                            reflect.TypeOf(([]objectiveStakeholderClassificationQuoteRow)(nil)),
+	"objective_stakeholder_classifications_list":                                  reflect.TypeOf(([]objectiveStakeholderClassificationRow)(nil)),
+	"objectives_list":                                                             reflect.TypeOf(([]objectiveRow)(nil)),
+	"one_step_gps_vehicles_list":                                                  reflect.TypeOf(([]oneStepGpsVehicleRow)(nil)),
+	"open_ai_realtime_sessions_list":                                              reflect.TypeOf(([]openAiRealtimeSessionRow)(nil)),
+	"open_ai_vector_stores_list":                                                  reflect.TypeOf(([]openAiVectorStoreRow)(nil)),
+	"open_door_issues_list":                                                       reflect.TypeOf(([]openDoorIssueRow)(nil)),
+	"open_door_team_memberships_list":                                             reflect.TypeOf(([]openDoorTeamMembershipRow)(nil)),
+	"organization_formatters_list":                                                reflect.TypeOf(([]organizationFormatterRow)(nil)),
+	"organization_invoices_batch_files_list":                                      reflect.TypeOf(([]organizationInvoicesBatchFileRow)(nil)),
+	"organization_invoices_batch_invoice_batchings_list":                          reflect.TypeOf(([]organizationInvoicesBatchInvoiceBatchingRow)(nil)),
+	"organization_invoices_batch_invoice_failures_list":                           reflect.TypeOf(([]organizationInvoicesBatchInvoiceFailureRow)(nil)),
+	"organization_invoices_batch_invoice_status_changes_list":                     reflect.TypeOf(([]organizationInvoicesBatchInvoiceStatusChangeRow)(nil)),
+	"organization_invoices_batch_invoice_unbatchings_list":                        reflect.TypeOf(([]organizationInvoicesBatchInvoiceUnbatchingRow)(nil)),
+	"organization_invoices_batch_invoices_list":                                   reflect.TypeOf(([]organizationInvoicesBatchInvoiceRow)(nil)),
+	"organization_invoices_batch_pdf_files_list":                                  reflect.TypeOf(([]organizationInvoicesBatchPdfFileRow)(nil)),
+	"organization_invoices_batch_pdf_generations_list":                            reflect.TypeOf(([]organizationInvoicesBatchPdfGenerationRow)(nil)),
+	"organization_invoices_batch_pdf_templates_list":                              reflect.TypeOf(([]organizationInvoicesBatchPdfTemplateRow)(nil)),
+	"organization_invoices_batch_processes_list":                                  reflect.TypeOf(([]organizationInvoicesBatchProcessRow)(nil)),
+	"organization_invoices_batch_status_changes_list":                             reflect.TypeOf(([]organizationInvoicesBatchStatusChangeRow)(nil)),
+	"organization_invoices_batches_list":                                          reflect.TypeOf(([]organizationInvoicesBatchRow)(nil)),
+	"parking_sites_list":                                                          reflect.TypeOf(([]parkingSiteRow)(nil)),
+	"pave_frame_actual_hours_list":                                                reflect.TypeOf(([]paveFrameActualHourRow)(nil)),
+	"pave_frame_actual_statistics_list":                                           reflect.TypeOf(([]paveFrameActualStatisticRow)(nil)),
+	"place_predictions_list":                                                      reflect.TypeOf(([]placePredictionRow)(nil)),
+	"platform_statuses_list":                                                      reflect.TypeOf(([]platformStatusRow)(nil)),
+	"post_actions_list":                                                           reflect.TypeOf(([]postActionRow)(nil)),
+	"post_children_list":                                                          reflect.TypeOf(([]postChildRow)(nil)),
+	"post_router_jobs_list":                                                       reflect.TypeOf(([]postRouterJobRow)(nil)),
+	"post_routers_list":                                                           reflect.TypeOf(([]postRouterRow)(nil)),
+	"post_views_list":                                                             reflect.TypeOf(([]postViewRow)(nil)),
+	"posts_list":                                                                  reflect.TypeOf(([]postRow)(nil)),
+	"prediction_agents_list":                                                      reflect.TypeOf(([]predictionAgentRow)(nil)),
+	"prediction_knowledge_base_answers_list":                                      reflect.TypeOf(([]predictionKnowledgeBaseAnswerRow)(nil)),
+	"prediction_knowledge_base_questions_list":                                    reflect.TypeOf(([]predictionKnowledgeBaseQuestionRow)(nil)),
+	"prediction_knowledge_bases_list":                                             reflect.TypeOf(([]predictionKnowledgeBaseRow)(nil)),
+	"prediction_subject_bids_list":                                                reflect.TypeOf(([]predictionSubjectBidRow)(nil)),
+	"prediction_subject_gap_portions_list":                                        reflect.TypeOf(([]predictionSubjectGapPortionRow)(nil)),
+	"prediction_subject_gaps_list":                                                reflect.TypeOf(([]predictionSubjectGapRow)(nil)),
+	"prediction_subject_memberships_list":                                         reflect.TypeOf(([]predictionSubjectMembershipRow)(nil)),
+	"prediction_subject_recaps_list":                                              reflect.TypeOf(([]predictionSubjectRecapRow)(nil)),
+	"prediction_subjects_list":                                                    reflect.TypeOf(([]predictionSubjectRow)(nil)),
+	"predictions_list":                                                            reflect.TypeOf(([]predictionRow)(nil)),
+	"press_releases_list":                                                         reflect.TypeOf(([]pressReleaseRow)(nil)),
+	"production_incident_detectors_list":                                          reflect.TypeOf(([]productionIncidentDetectorRow)(nil)),
+	"production_incidents_list":                                                   reflect.TypeOf(([]productionIncidentRow)(nil)),
+	"production_measurements_list":                                                reflect.TypeOf(([]productionMeasurementRow)(nil)),
+	"proffer_likes_list":                                                          reflect.TypeOf(([]profferLikeRow)(nil)),
+	"proffers_list":                                                               reflect.TypeOf(([]profferRow)(nil)),
+	"profit_improvement_categories_list":                                          reflect.TypeOf(([]profitImprovementCategoryRow)(nil)),
+	"profit_improvement_subscriptions_list":                                       reflect.TypeOf(([]profitImprovementSubscriptionRow)(nil)),
+	"profit_improvements_list":                                                    reflect.TypeOf(([]profitImprovementRow)(nil)),
+	"project_abandonments_list":                                                   reflect.TypeOf(([]projectAbandonmentRow)(nil)),
+	"project_actuals_exports_list":                                                reflect.TypeOf(([]projectActualsExportRow)(nil)),
+	"project_approvals_list":                                                      reflect.TypeOf(([]projectApprovalListRow)(nil)),
+	"project_bid_location_material_types_list":                                    reflect.TypeOf(([]projectBidLocationMaterialTypeRow)(nil)),
+	"project_bid_locations_list":                                                  reflect.TypeOf(([]projectBidLocationRow)(nil)),
+	"project_cancellations_list":                                                  reflect.TypeOf(([]projectCancellationRow)(nil)),
+	"project_categories_list":                                                     reflect.TypeOf(([]projectCategoryRow)(nil)),
+	"project_completions_list":                                                    reflect.TypeOf(([]projectCompletionRow)(nil)),
+	"project_cost_classifications_list":                                           reflect.TypeOf(([]projectCostClassificationRow)(nil)),
+	"project_cost_codes_list":                                                     reflect.TypeOf(([]projectCostCodeRow)(nil)),
+	"project_customers_list":                                                      reflect.TypeOf(([]projectCustomerRow)(nil)),
+	"project_divisions_list":                                                      reflect.TypeOf(([]projectDivisionRow)(nil)),
+	"project_estimate_file_imports_list":                                          reflect.TypeOf(([]projectEstimateFileImportRow)(nil)),
+	"project_estimate_sets_list":                                                  reflect.TypeOf(([]projectEstimateSetRow)(nil)),
+	"project_import_file_verifications_list":                                      reflect.TypeOf(([]projectImportFileVerificationRow)(nil)),
+	"project_labor_classifications_list":                                          reflect.TypeOf(([]projectLaborClassificationRow)(nil)),
+	"project_margin_matrices_list":                                                reflect.TypeOf(([]projectMarginMatrixRow)(nil)),
+	"project_material_type_quality_control_requirements_list":                     reflect.TypeOf(([]projectMaterialTypeQualityControlRequirementRow)(nil)),
+	"project_material_types_list":                                                 reflect.TypeOf(([]projectMaterialTypeRow)(nil)),
+	"project_offices_list":                                                        reflect.TypeOf(([]projectOfficeRow)(nil)),
+	"project_phase_cost_item_actuals_list":                                        reflect.TypeOf(([]projectPhaseCostItemActualRow)(nil)),
+	"project_phase_cost_item_price_estimates_list":                                reflect.TypeOf(([]projectPhaseCostItemPriceEstimateRow)(nil)),
+	"project_phase_cost_item_quantity_estimates_list":                             reflect.TypeOf(([]projectPhaseCostItemQuantityEstimateRow)(nil)),
+	"project_phase_cost_items_list":                                               reflect.TypeOf(([]projectPhaseCostItemRow)(nil)),
+	"project_phase_dates_estimates_list":                                          reflect.TypeOf(([]projectPhaseDatesEstimateRow)(nil)),
+	"project_phase_revenue_item_actual_exports_list":                              reflect.TypeOf(([]projectPhaseRevenueItemActualExportRow)(nil)),
+	"project_phase_revenue_item_actuals_list":                                     reflect.TypeOf(([]projectPhaseRevenueItemActualRow)(nil)),
+	"project_phase_revenue_item_quantity_estimates_list":                          reflect.TypeOf(([]projectPhaseRevenueItemQuantityEstimateRow)(nil)),
+	"project_phase_revenue_items_list":                                            reflect.TypeOf(([]projectPhaseRevenueItemRow)(nil)),
+	"project_phases_list":                                                         reflect.TypeOf(([]projectPhaseRow)(nil)),
+	"project_project_cost_classifications_list":                                   reflect.TypeOf(([]projectProjectCostClassificationRow)(nil)),
+	"project_resource_classifications_list":                                       reflect.TypeOf(([]projectResourceClassificationRow)(nil)),
+	"project_revenue_classifications_list":                                        reflect.TypeOf(([]projectRevenueClassificationRow)(nil)),
+	"project_revenue_item_price_estimates_list":                                   reflect.TypeOf(([]projectRevenueItemPriceEstimateRow)(nil)),
+	"project_revenue_item_quantity_estimates_list":                                reflect.TypeOf(([]projectRevenueItemQuantityEstimateRow)(nil)),
+	"project_revenue_items_list":                                                  reflect.TypeOf(([]projectRevenueItemRow)(nil)),
+	"project_status_changes_list":                                                 reflect.TypeOf(([]projectStatusChangeRow)(nil)),
+	"project_submissions_list":                                                    reflect.TypeOf(([]projectSubmissionListRow)(nil)),
+	"project_subscriptions_list":                                                  reflect.TypeOf(([]projectSubscriptionRow)(nil)),
+	"project_trailer_classifications_list":                                        reflect.TypeOf(([]projectTrailerClassificationRow)(nil)),
+	"project_transport_event_types_list":                                          reflect.TypeOf(([]projectTransportEventTypeRow)(nil)),
+	"project_transport_location_event_types_list":                                 reflect.TypeOf(([]projectTransportLocationEventTypeRow)(nil)),
+	"project_transport_locations_list":                                            reflect.TypeOf(([]projectTransportLocationRow)(nil)),
+	"project_transport_organizations_list":                                        reflect.TypeOf(([]projectTransportOrganizationRow)(nil)),
+	"project_transport_plan_assignment_rules_list":                                reflect.TypeOf(([]projectTransportPlanAssignmentRuleRow)(nil)),
+	"project_transport_plan_driver_assignment_recommendations_list":               reflect.TypeOf(([]projectTransportPlanDriverAssignmentRecommendationRow)(nil)),
+	"project_transport_plan_driver_confirmations_list":                            reflect.TypeOf(([]projectTransportPlanDriverConfirmationRow)(nil)),
+	"project_transport_plan_drivers_list":                                         reflect.TypeOf(([]projectTransportPlanDriverRow)(nil)),
+	"project_transport_plan_event_location_prediction_autopsies_list":             reflect.TypeOf(([]projectTransportPlanEventLocationPredictionAutopsyRow)(nil)),
+	"project_transport_plan_event_location_predictions_list":                      reflect.TypeOf(([]projectTransportPlanEventLocationPredictionRow)(nil)),
+	"project_transport_plan_event_times_list":                                     reflect.TypeOf(([]projectTransportPlanEventTimeRow)(nil)),
+	"project_transport_plan_events_list":                                          reflect.TypeOf(([]projectTransportPlanEventRow)(nil)),
+	"project_transport_plan_planned_event_time_schedules_list":                    reflect.TypeOf(([]projectTransportPlanPlannedEventTimeScheduleRow)(nil)),
+	"project_transport_plan_segment_drivers_list":                                 reflect.TypeOf(([]projectTransportPlanSegmentDriverRow)(nil)),
+	"project_transport_plan_segment_sets_list":                                    reflect.TypeOf(([]projectTransportPlanSegmentSetRow)(nil)),
+	"project_transport_plan_segment_tractors_list":                                reflect.TypeOf(([]projectTransportPlanSegmentTractorRow)(nil)),
+	"project_transport_plan_segment_trailers_list":                                reflect.TypeOf(([]projectTransportPlanSegmentTrailerRow)(nil)),
+	"project_transport_plan_segments_list":                                        reflect.TypeOf(([]projectTransportPlanSegmentRow)(nil)),
+	"project_transport_plan_stop_insertions_list":                                 reflect.TypeOf(([]projectTransportPlanStopInsertionRow)(nil)),
+	"project_transport_plan_stop_order_stops_list":                                reflect.TypeOf(([]projectTransportPlanStopOrderStopRow)(nil)),
+	"project_transport_plan_stops_list":                                           reflect.TypeOf(([]projectTransportPlanStopRow)(nil)),
+	"project_transport_plan_strategies_list":                                      reflect.TypeOf(([]projectTransportPlanStrategyRow)(nil)),
+	"project_transport_plan_strategy_sets_list":                                   reflect.TypeOf(([]projectTransportPlanStrategySetRow)(nil)),
+	"project_transport_plan_strategy_steps_list":                                  reflect.TypeOf(([]projectTransportPlanStrategyStepRow)(nil)),
+	"project_transport_plan_tractors_list":                                        reflect.TypeOf(([]projectTransportPlanTractorRow)(nil)),
+	"project_transport_plan_trailer_assignment_recommendations_list":              reflect.TypeOf(([]projectTransportPlanTrailerAssignmentRecommendationRow)(nil)),
+	"project_transport_plan_trailers_list":                                        reflect.TypeOf(([]projectTransportPlanTrailerRow)(nil)),
+	"project_transport_plans_list":                                                reflect.TypeOf(([]projectTransportPlanRow)(nil)),
+	"project_truckers_list":                                                       reflect.TypeOf(([]projectTruckerRow)(nil)),
+	"project_unabandonments_list":                                                 reflect.TypeOf(([]projectUnabandonmentListRow)(nil)),
+	"projects_file_imports_list":                                                  reflect.TypeOf(([]projectsFileImportRow)(nil)),
+	"projects_list":                                                               reflect.TypeOf(([]projectRow)(nil)),
+	"prompt_prescriptions_list":                                                   reflect.TypeOf(([]promptPrescriptionRow)(nil)),
+	"prompters_list":                                                              reflect.TypeOf(([]prompterRow)(nil)),
+	"public_praise_culture_values_list":                                           reflect.TypeOf(([]publicPraiseCultureValueRow)(nil)),
+	"public_praise_reactions_list":                                                reflect.TypeOf(([]publicPraiseReactionRow)(nil)),
+	"public_praises_list":                                                         reflect.TypeOf(([]publicPraiseRow)(nil)),
+	"quality_control_classifications_list":                                        reflect.TypeOf(([]qualityControlClassificationRow)(nil)),
+	"questions_list":                                                              reflect.TypeOf(([]questionRow)(nil)),
+	"rate_adjustments_list":                                                       reflect.TypeOf(([]rateAdjustmentRow)(nil)),
+	"rate_agreement_copier_works_list":                                            reflect.TypeOf(([]rateAgreementCopierWorkRow)(nil)),
+	"rate_agreements_copiers_list":                                                reflect.TypeOf(([]rateAgreementsCopierRow)(nil)),
+	"rate_agreements_list":                                                        reflect.TypeOf(([]rateAgreementRow)(nil)),
+	"rates_list":                                                                  reflect.TypeOf(([]rateRow)(nil)),
+	"raw_material_transaction_import_results_list":                                reflect.TypeOf(([]rawMaterialTransactionImportResultRow)(nil)),
+	"raw_material_transaction_sales_customers_list":                               reflect.TypeOf(([]rawMaterialTransactionSalesCustomerRow)(nil)),
+	"raw_material_transactions_list":                                              reflect.TypeOf(([]rawMaterialTransactionRow)(nil)),
+	"raw_records_list":                                                            reflect.TypeOf(([]rawRecordRow)(nil)),
+	"raw_transport_drivers_list":                                                  reflect.TypeOf(([]rawTransportDriverRow)(nil)),
+	"raw_transport_exports_list":                                                  reflect.TypeOf(([]rawTransportExportRow)(nil)),
+	"raw_transport_orders_list":                                                   reflect.TypeOf(([]rawTransportOrderRow)(nil)),
+	"raw_transport_projects_list":                                                 reflect.TypeOf(([]rawTransportProjectRow)(nil)),
+	"raw_transport_tractors_list":                                                 reflect.TypeOf(([]rawTransportTractorRow)(nil)),
+	"raw_transport_trailers_list":                                                 reflect.TypeOf(([]rawTransportTrailerRow)(nil)),
+	"reaction_classifications_list":                                               reflect.TypeOf(([]reactionClassificationRow)(nil)),
+	"release_notes_list":                                                          reflect.TypeOf(([]releaseNoteRow)(nil)),
+	"resource_classification_project_cost_classifications_list":                   reflect.TypeOf(([]resourceClassificationProjectCostClassificationRow)(nil)),
+	"resource_unavailabilities_list":                                              reflect.TypeOf(([]resourceUnavailabilityRow)(nil)),
+	"retainer_deductions_list":                                                    reflect.TypeOf(([]retainerDeductionRow)(nil)),
+	"retainer_earning_statuses_list":                                              reflect.TypeOf(([]retainerEarningStatusRow)(nil)),
+	"retainer_payment_deductions_list":                                            reflect.TypeOf(([]retainerPaymentDeductionRow)(nil)),
+	"retainer_payments_list":                                                      reflect.TypeOf(([]retainerPaymentRow)(nil)),
+	"retainer_periods_list":                                                       reflect.TypeOf(([]retainerPeriodRow)(nil)),
+	"retainers_list":                                                              reflect.TypeOf(([]retainerRow)(nil)),
+	"root_causes_list":                                                            reflect.TypeOf(([]rootCauseRow)(nil)),
+	"safety_incidents_list":                                                       reflect.TypeOf(([]safetyIncidentRow)(nil)),
+	"samsara_integrations_list":                                                   reflect.TypeOf(([]samsaraIntegrationRow)(nil)),
+	"samsara_vehicles_list":                                                       reflect.TypeOf(([]samsaraVehicleRow)(nil)),
+	"search_catalog_entries_list":                                                 reflect.TypeOf(([]searchCatalogEntryRow)(nil)),
+	"service_events_list":                                                         reflect.TypeOf(([]serviceEventRow)(nil)),
+	"service_sites_list":                                                          reflect.TypeOf(([]serviceSiteRow)(nil)),
+	"service_type_unit_of_measure_cohorts_list":                                   reflect.TypeOf(([]serviceTypeUnitOfMeasureCohortRow)(nil)),
+	"service_type_unit_of_measure_quantities_list":                                reflect.TypeOf(([]serviceTypeUnitOfMeasureQuantityRow)(nil)),
+	"service_type_unit_of_measures_list":                                          reflect.TypeOf(([]serviceTypeUnitOfMeasureRow)(nil)),
+	"service_types_list":                                                          reflect.TypeOf(([]serviceTypeRow)(nil)),
+	"shift_acknowledgement_reminder_notification_subscriptions_list":              reflect.TypeOf(([]shiftAcknowledgementReminderNotificationSubscriptionRow)(nil)),
+	"shift_counters_list":                                                         reflect.TypeOf(([]shiftCounterRow)(nil)),
+	"shift_feedback_reasons_list":                                                 reflect.TypeOf(([]shiftFeedbackReasonRow)(nil)),
+	"shift_feedbacks_list":                                                        reflect.TypeOf(([]shiftFeedbackRow)(nil)),
+	"shift_set_time_card_constraints_list":                                        reflect.TypeOf(([]shiftSetTimeCardConstraintRow)(nil)),
+	"shift_time_card_requisitions_list":                                           reflect.TypeOf(([]shiftTimeCardRequisitionRow)(nil)),
+	"site_events_list":                                                            reflect.TypeOf(([]siteEventRow)(nil)),
+	"site_wait_time_notification_triggers_list":                                   reflect.TypeOf(([]siteWaitTimeNotificationTriggerRow)(nil)),
+	"stakeholder_classifications_list":                                            reflect.TypeOf(([]stakeholderClassificationRow)(nil)),
+	"superior_bowen_apex_viewpoint_ticket_exports_list":                           reflect.TypeOf(([]superiorBowenApexViewpointTicketExportRow)(nil)),
+	"t3_equipmentshare_vehicles_list":                                             reflect.TypeOf(([]t3EquipmentshareVehicleRow)(nil)),
+	"tag_categories_list":                                                         reflect.TypeOf(([]tagCategoryRow)(nil)),
+	"taggings_list":                                                               reflect.TypeOf(([]taggingRow)(nil)),
+	"tags_list":                                                                   reflect.TypeOf(([]tagRow)(nil)),
+	"teletrac_navman_vehicles_list":                                               reflect.TypeOf(([]teletracNavmanVehicleRow)(nil)),
+	"temeda_vehicles_list":                                                        reflect.TypeOf(([]temedaVehicleRow)(nil)),
+	"tender_acceptances_list":                                                     reflect.TypeOf(([]tenderAcceptanceRow)(nil)),
+	"tender_job_schedule_shift_cancellations_list":                                reflect.TypeOf(([]tenderJobScheduleShiftCancellationRow)(nil)),
+	"tender_job_schedule_shift_cancelled_trucker_contact_notifications_list":      reflect.TypeOf(([]tenderJobScheduleShiftCancelledTruckerContactNotificationRow)(nil)),
+	"tender_job_schedule_shift_drivers_list":                                      reflect.TypeOf(([]tenderJobScheduleShiftDriverRow)(nil)),
+	"tender_job_schedule_shift_fill_out_time_card_seller_notifications_list":      reflect.TypeOf(([]tenderJobScheduleShiftFillOutTimeCardSellerNotificationRow)(nil)),
+	"tender_job_schedule_shift_starting_seller_notifications_list":                reflect.TypeOf(([]tenderJobScheduleShiftStartingSellerNotificationRow)(nil)),
+	"tender_job_schedule_shift_time_card_reviews_list":                            reflect.TypeOf(([]tenderJobScheduleShiftTimeCardReviewRow)(nil)),
+	"tender_job_schedule_shifts_list":                                             reflect.TypeOf(([]tenderJobScheduleShiftRow)(nil)),
+	"tender_job_schedule_shifts_material_transactions_checksums_list":             reflect.TypeOf(([]tenderJobScheduleShiftsMaterialTransactionsChecksumRow)(nil)),
+	"tender_offers_list":                                                          reflect.TypeOf(([]tenderOfferRow)(nil)),
+	"tender_re_rates_list":                                                        reflect.TypeOf(([]tenderReRateListRow)(nil)),
+	"tender_rejections_list":                                                      reflect.TypeOf(([]tenderRejectionRow)(nil)),
+	"tender_returns_list":                                                         reflect.TypeOf(([]tenderReturnRow)(nil)),
+	"tender_status_changes_list":                                                  reflect.TypeOf(([]tenderStatusChangeRow)(nil)),
+	"tenders_list":                                                                reflect.TypeOf(([]tenderRow)(nil)),
+	"tenna_vehicles_list":                                                         reflect.TypeOf(([]tennaVehicleRow)(nil)),
+	"text_messages_list":                                                          reflect.TypeOf(([]textMessageRow)(nil)),
+	"ticket_report_dispatches_list":                                               reflect.TypeOf(([]ticketReportDispatchRow)(nil)),
+	"ticket_report_imports_list":                                                  reflect.TypeOf(([]ticketReportImportRow)(nil)),
+	"ticket_reports_list":                                                         reflect.TypeOf(([]ticketReportRow)(nil)),
+	"time_card_approval_audits_list":                                              reflect.TypeOf(([]timeCardApprovalAuditRow)(nil)),
+	"time_card_cost_code_allocations_list":                                        reflect.TypeOf(([]timeCardCostCodeAllocationRow)(nil)),
+	"time_card_invoices_list":                                                     reflect.TypeOf(([]timeCardInvoiceRow)(nil)),
+	"time_card_payroll_certifications_list":                                       reflect.TypeOf(([]timeCardPayrollCertificationRow)(nil)),
+	"time_card_pre_approvals_list":                                                reflect.TypeOf(([]timeCardPreApprovalRow)(nil)),
+	"time_card_scrappages_list":                                                   reflect.TypeOf(([]timeCardScrappageRow)(nil)),
+	"time_card_status_changes_list":                                               reflect.TypeOf(([]timeCardStatusChangeRow)(nil)),
+	"time_card_time_changes_list":                                                 reflect.TypeOf(([]timeCardTimeChangeRow)(nil)),
+	"time_card_unapprovals_list":                                                  reflect.TypeOf(([]timeCardUnapprovalRow)(nil)),
+	"time_card_unscrappages_list":                                                 reflect.TypeOf(([]timeCardUnscrappageRow)(nil)),
+	"time_cards_list":                                                             reflect.TypeOf(([]timeCardRow)(nil)),
+	"time_sheet_approvals_list":                                                   reflect.TypeOf(([]timeSheetApprovalRow)(nil)),
+	"time_sheet_cost_code_allocations_list":                                       reflect.TypeOf(([]timeSheetCostCodeAllocationRow)(nil)),
+	"time_sheet_line_item_classifications_list":                                   reflect.TypeOf(([]timeSheetLineItemClassificationRow)(nil)),
+	"time_sheet_line_item_equipment_requirements_list":                            reflect.TypeOf(([]timeSheetLineItemEquipmentRequirementRow)(nil)),
+	"time_sheet_line_items_list":                                                  reflect.TypeOf(([]timeSheetLineItemRow)(nil)),
+	"time_sheet_no_shows_list":                                                    reflect.TypeOf(([]timeSheetNoShowRow)(nil)),
+	"time_sheet_rejections_list":                                                  reflect.TypeOf(([]timeSheetRejectionRow)(nil)),
+	"time_sheet_status_changes_list":                                              reflect.TypeOf(([]timeSheetStatusChangeRow)(nil)),
+	"time_sheet_unapprovals_list":                                                 reflect.TypeOf(([]timeSheetUnapprovalRow)(nil)),
+	"time_sheet_unsubmissions_list":                                               reflect.TypeOf(([]timeSheetUnsubmissionRow)(nil)),
+	"time_sheets_exports_list":                                                    reflect.TypeOf(([]timeSheetsExportRow)(nil)),
+	"time_sheets_list":                                                            reflect.TypeOf(([]timeSheetRow)(nil)),
+	"tractor_credentials_list":                                                    reflect.TypeOf(([]tractorCredentialRow)(nil)),
+	"tractor_fuel_consumption_readings_list":                                      reflect.TypeOf(([]tractorFuelConsumptionReadingRow)(nil)),
+	"tractor_odometer_readings_list":                                              reflect.TypeOf(([]tractorOdometerReadingRow)(nil)),
+	"tractor_trailer_credential_classifications_list":                             reflect.TypeOf(([]tractorTrailerCredentialClassificationRow)(nil)),
+	"tractors_list":                                                               reflect.TypeOf(([]tractorRow)(nil)),
+	"trading_partners_list":                                                       reflect.TypeOf(([]tradingPartnerRow)(nil)),
+	"trailer_classifications_list":                                                reflect.TypeOf(([]trailerClassificationRow)(nil)),
+	"trailer_credentials_list":                                                    reflect.TypeOf(([]trailerCredentialRow)(nil)),
+	"trailers_list":                                                               reflect.TypeOf(([]trailerRow)(nil)),
+	"transport_order_materials_list":                                              reflect.TypeOf(([]transportOrderMaterialRow)(nil)),
+	"transport_order_project_transport_plan_strategy_set_predictions_list":        reflect.TypeOf(([]transportOrderProjectTransportPlanStrategySetPredictionRow)(nil)),
+	"transport_order_stop_materials_list":                                         reflect.TypeOf(([]transportOrderStopMaterialRow)(nil)),
+	"transport_order_stops_list":                                                  reflect.TypeOf(([]transportOrderStopRow)(nil)),
+	"transport_orders_list":                                                       reflect.TypeOf(([]transportOrderRow)(nil)),
+	"transport_references_list":                                                   reflect.TypeOf(([]transportReferenceRow)(nil)),
+	"transport_routes_list":                                                       reflect.TypeOf(([]transportRouteRow)(nil)),
+	"trips_list":                                                                  reflect.TypeOf(([]tripRow)(nil)),
+	"truck_scopes_list":                                                           reflect.TypeOf(([]truckScopeRow)(nil)),
+	"trucker_applications_list":                                                   reflect.TypeOf(([]truckerApplicationRow)(nil)),
+	"trucker_brokerages_list":                                                     reflect.TypeOf(([]truckerBrokerageRow)(nil)),
+	"trucker_insurances_list":                                                     reflect.TypeOf(([]truckerInsuranceRow)(nil)),
+	"trucker_invoice_payments_list":                                               reflect.TypeOf(([]truckerInvoicePaymentRow)(nil)),
+	"trucker_invoices_list":                                                       reflect.TypeOf(([]truckerInvoiceRow)(nil)),
+	"trucker_memberships_list":                                                    reflect.TypeOf(([]membershipRow)(nil)),
+	"trucker_referral_codes_list":                                                 reflect.TypeOf(([]truckerReferralCodeRow)(nil)),
+	"trucker_referrals_list":                                                      reflect.TypeOf(([]truckerReferralRow)(nil)),
+	"trucker_settings_list":                                                       reflect.TypeOf(([]truckerSettingRow)(nil)),
+	"trucker_shift_sets_list":                                                     reflect.TypeOf(([]truckerShiftSetRow)(nil)),
+	"truckers_list":                                                               reflect.TypeOf(([]truckerRow)(nil)),
+	"ui_tour_steps_list":                                                          reflect.TypeOf(([]uiTourStepRow)(nil)),
+	"ui_tours_list":                                                               reflect.TypeOf(([]uiTourRow)(nil)),
+	"unit_of_measures_list":                                                       reflect.TypeOf(([]unitOfMeasureRow)(nil)),
+	"user_creator_feed_creators_list":                                             reflect.TypeOf(([]userCreatorFeedCreatorRow)(nil)),
+	"user_creator_feeds_list":                                                     reflect.TypeOf(([]userCreatorFeedRow)(nil)),
+	"user_credential_classifications_list":                                        reflect.TypeOf(([]userCredentialClassificationRow)(nil)),
+	"user_credentials_list":                                                       reflect.TypeOf(([]userCredentialRow)(nil)),
+	"user_languages_list":                                                         reflect.TypeOf(([]userLanguageRow)(nil)),
+	"user_location_estimates_list":                                                reflect.TypeOf(([]userLocationEstimateRow)(nil)),
+	"user_location_events_list":                                                   reflect.TypeOf(([]userLocationEventRow)(nil)),
+	"user_location_requests_list":                                                 reflect.TypeOf(([]userLocationRequestRow)(nil)),
+	"user_post_feed_posts_list":                                                   reflect.TypeOf(([]userPostFeedPostRow)(nil)),
+	"user_post_feeds_list":                                                        reflect.TypeOf(([]userPostFeedRow)(nil)),
+	"user_searches_list":                                                          reflect.TypeOf(([]userSearchRow)(nil)),
+	"user_ui_tours_list":                                                          reflect.TypeOf(([]userUiTourRow)(nil)),
+	"users_list":                                                                  reflect.TypeOf(([]userRow)(nil)),
+	"vehicle_location_events_list":                                                reflect.TypeOf(([]vehicleLocationEventRow)(nil)),
+	"verizon_reveal_vehicles_list":                                                reflect.TypeOf(([]verizonRevealVehicleRow)(nil)),
+	"version_events_list":                                                         reflect.TypeOf(([]versionEventRow)(nil)),
+	"work_order_assignments_list":                                                 reflect.TypeOf(([]workOrderAssignmentRow)(nil)),
+	"work_order_service_codes_list":                                               reflect.TypeOf(([]workOrderServiceCodeRow)(nil)),
+	"work_orders_list":                                                            reflect.TypeOf(([]workOrderRow)(nil)),
+}
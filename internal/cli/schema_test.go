@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+func TestFlagEnum(t *testing.T) {
+	cases := []struct {
+		usage, validation string
+		want              []string
+	}{
+		{"Filter by processed status (true/false)", "", []string{"true", "false"}},
+		{"Organization type (brokers|truckers|customers) (required)", "", []string{"brokers", "truckers", "customers"}},
+		{"Feedback kind (required, e.g., positive, negative)", "Must be one of: driver, equipment, admin.", []string{"driver", "equipment", "admin"}},
+		{"Part source", "One of: stock, or purchase.", []string{"stock", "purchase"}},
+		{"Set as active (default true)", "", nil},
+		{"Comma-separated kinds (resources,commands)", "", nil},
+		{"Filter by organization (Type|ID)", "Format: Type|ID", nil},
+		{"Broker ID", "Only one of --broker, --customer may be specified.", nil},
+	}
+	for _, tc := range cases {
+		if got := flagEnum(tc.usage, tc.validation); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("flagEnum(%q, %q) = %v, want %v", tc.usage, tc.validation, got, tc.want)
+		}
+	}
+}
+
+func TestDurationFlagSchema(t *testing.T) {
+	flags := pflag.NewFlagSet("watch", pflag.ContinueOnError)
+	flags.Duration("interval", 30*time.Second, "Time between polls")
+	schema := flagSchema(flags.Lookup("interval"), nil)
+	if schema["format"] != nil || schema["default"] != "30s" {
+		t.Fatalf("schema = %v", schema)
+	}
+	pattern := regexp.MustCompile(schema["pattern"].(string))
+	for _, value := range []string{"30s", "1h30m", "1.5h", "0", "-5m", "300ms"} {
+		if _, err := time.ParseDuration(value); err != nil || !pattern.MatchString(value) {
+			t.Errorf("%q: pattern match %v, ParseDuration error %v", value, pattern.MatchString(value), err)
+		}
+	}
+	for _, value := range []string{"PT1H", "30", "1d", ""} {
+		if pattern.MatchString(value) {
+			t.Errorf("pattern matches %q", value)
+		}
+	}
+}
+
+func TestParseUseArgs(t *testing.T) {
+	args := parseUseArgs("commands <resource-name> [path...]")
+	want := []commandArg{
+		{property: "resource_name", label: "resource-name", required: true},
+		{property: "path", label: "path", variadic: true},
+	}
+	if !reflect.DeepEqual(args, want) {
+		t.Fatalf("parseUseArgs = %+v", args)
+	}
+}
+
+func TestReflectSchema(t *testing.T) {
+	type nested struct {
+		Name string `json:"name"`
+	}
+	type row struct {
+		ID      string   `json:"id"`
+		Count   int      `json:"count,omitempty"`
+		Active  *bool    `json:"active"`
+		Tags    []string `json:"tags"`
+		Nested  nested   `json:"nested"`
+		Skipped string   `json:"-"`
+	}
+	schema := reflectSchema(reflect.TypeOf([]row(nil)), map[reflect.Type]bool{})
+	items := schema["items"].(map[string]any)
+	properties := items["properties"].(map[string]any)
+	if len(properties) != 5 {
+		t.Fatalf("properties = %v", properties)
+	}
+	if !reflect.DeepEqual(properties["active"], map[string]any{"type": []string{"boolean", "null"}}) {
+		t.Errorf("active = %v", properties["active"])
+	}
+	if !reflect.DeepEqual(items["required"], []string{"active", "id", "nested", "tags"}) {
+		t.Errorf("required = %v", items["required"])
+	}
+	if commandOutputTypes["brokers_list"] != reflect.TypeOf([]brokerRow(nil)) {
+		t.Errorf("brokers_list output type = %v; run go generate ./internal/cli", commandOutputTypes["brokers_list"])
+	}
+}
+
+func TestRouteResource(t *testing.T) {
+	resources := resourceMap{Resources: map[string]resourceSpec{"brokers": {}, "exporter-configurations": {}}}
+	cases := map[string]string{
+		"/v1/brokers":      "brokers",
+		"/v1/brokers/{id}": "brokers",
+		"/v1/free-ticketing/exporter-configurations/{id}": "exporter-configurations",
+		"/v1/users/me": "me",
+	}
+	for path, want := range cases {
+		if got := routeResource(path, resources); got != want {
+			t.Errorf("routeResource(%q) = %q, want %q", path, got, want)
+		}
+	}
+	if got := pathParameters("/v1/a/{id}/b/{id2}"); !reflect.DeepEqual(got, []string{"id", "id2"}) {
+		t.Errorf("pathParameters = %v", got)
+	}
+}
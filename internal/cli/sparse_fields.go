@@ -415,6 +415,7 @@ func renderSparseListIfRequested(cmd *cobra.Command, resp jsonAPIResponse) (bool
 		if versionChangesRequested(cmd) {
 			attachVersionChangesToRows(rows, resp.Data)
 		}
+		setOutputColumns(append([]string{"id"}, selection.Fields...))
 		return true, writeJSON(cmd.OutOrStdout(), rows)
 	}
 	if err := renderSparseTable(cmd, selection, rows); err != nil {
@@ -465,6 +466,7 @@ func renderSparseShowIfRequested(cmd *cobra.Command, resp jsonAPISingleResponse)
 		if versionChangesRequested(cmd) {
 			attachVersionChangesToRow(rows[0], resp.Data.Meta)
 		}
+		setOutputColumns(append([]string{"id"}, selection.Fields...))
 		return true, writeJSON(cmd.OutOrStdout(), rows[0])
 	}
 	if err := renderSparseTable(cmd, selection, rows); err != nil {
@@ -0,0 +1,1030 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+	"github.com/xbe-inc/xbe-cli/internal/auth"
+	"golang.org/x/term"
+)
+
+const (
+	tuiDefaultPageSize = 50
+	tuiRequestTimeout  = 30 * time.Second
+	tuiResizePoll      = 300 * time.Millisecond
+)
+
+const tuiKeyHelp = `Keys:
+  j/k, arrows     Move the selection (PgUp/PgDn and g/G jump)
+  Enter, l        Open the selected resource, record, relationship, or value
+  Esc, h, Bksp    Go back
+  /               Filter: "key=value ..." sends API filters (e.g. status=active
+                  broker=12); plain text searches with filter[q] where the list
+                  supports it and matches loaded rows otherwise
+  n / p           Next / previous page
+  r               Reload
+  o               Open the record's web page in the browser
+  ?               Toggle help
+  q, Ctrl-C       Quit`
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui [resource [id]]",
+	Short: "Browse resources in an interactive terminal UI",
+	Long: `Browse resources in a full-screen terminal UI.
+
+Start from the resource picker, a resource's list, or a single record. Lists
+page through the API; the detail view shows a record's attributes, its
+relationships, and the lists of other resources that point back at it, so a
+job production plan leads to its shifts, a shift to its time cards, and so on
+without copying IDs between commands.
+
+` + tuiKeyHelp,
+	Example: `  # Pick a resource to browse
+  xbe tui
+
+  # Start at a list
+  xbe tui job-production-plans
+
+  # Start at a record
+  xbe tui job-production-plans 123`,
+	Annotations: map[string]string{"group": GroupUtility},
+	Args:        cobra.MaximumNArgs(2),
+	RunE:        runTUI,
+}
+
+func init() {
+	tuiCmd.Flags().Int("page-size", tuiDefaultPageSize, "Records per list page")
+	tuiCmd.Flags().String("base-url", defaultBaseURL(), "API base URL")
+	tuiCmd.Flags().String("token", "", "API token (optional)")
+	rootCmd.AddCommand(tuiCmd)
+}
+
+type tuiPaneKind int
+
+const (
+	tuiResourcesPane tuiPaneKind = iota
+	tuiListPane
+	tuiDetailPane
+	tuiValuePane
+)
+
+// tuiRow is one selectable line. Rows with a Resource navigate: to a record
+// when ID is set, to a fixed set of records when IDs is set, and to a list
+// (optionally filtered) otherwise. Rows with Text open a value pane.
+type tuiRow struct {
+	Key      string
+	Value    string
+	Header   bool
+	Resource string
+	ID       string
+	IDs      []string
+	Filters  map[string]string
+	Text     string
+	Record   *jsonAPIResource
+}
+
+func (r tuiRow) navigable() bool {
+	return r.Resource != "" || r.Text != ""
+}
+
+type tuiPane struct {
+	kind     tuiPaneKind
+	title    string
+	resource string
+	id       string
+	filters  map[string]string
+	ids      []string
+	match    string
+	offset   int
+	hasMore  bool
+	rows     []tuiRow
+	cursor   int
+	top      int
+	record   *jsonAPIResource
+	included []jsonAPIResource
+}
+
+type tuiApp struct {
+	cmd            *cobra.Command
+	client         *api.Client
+	resources      resourceMap
+	typeToResource map[string]string
+	pageSize       int
+	stack          []*tuiPane
+	status         string
+	prompting      bool
+	prompt         string
+	help           bool
+	quit           bool
+	width          int
+	height         int
+	out            io.Writer
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return fmt.Errorf("%w: xbe tui needs an interactive terminal", errInvalidInput)
+	}
+	out, ok := cmd.OutOrStdout().(*os.File)
+	if !ok || !term.IsTerminal(int(out.Fd())) {
+		return fmt.Errorf("%w: xbe tui needs an interactive terminal", errInvalidInput)
+	}
+	resources, err := loadResourceMap()
+	if err != nil {
+		return fmt.Errorf("load resource map: %w", err)
+	}
+	if len(args) > 0 {
+		if _, ok := resources.Resources[args[0]]; !ok {
+			return fmt.Errorf("%w: unknown resource %q (see 'xbe knowledge resources')", errInvalidInput, args[0])
+		}
+	}
+	pageSize := getIntFlag(cmd, "page-size")
+	if pageSize <= 0 {
+		return fmt.Errorf("--page-size must be greater than 0")
+	}
+
+	baseURL := getStringFlag(cmd, "base-url")
+	token := strings.TrimSpace(getStringFlag(cmd, "token"))
+	if token == "" {
+		if resolved, _, err := auth.ResolveToken(baseURL, ""); err == nil {
+			token = resolved
+		}
+	}
+
+	app := newTUIApp(cmd, api.NewClient(baseURL, token), resources, pageSize)
+	app.out = out
+	app.push(app.resourcesPane())
+	switch len(args) {
+	case 1:
+		app.push(&tuiPane{kind: tuiListPane, resource: args[0]})
+	case 2:
+		app.push(&tuiPane{kind: tuiDetailPane, resource: args[0], id: args[1]})
+	}
+	return app.run(cmd.Context())
+}
+
+func newTUIApp(cmd *cobra.Command, client *api.Client, resources resourceMap, pageSize int) *tuiApp {
+	typeToResource := map[string]string{}
+	for name, spec := range resources.Resources {
+		for _, serverType := range spec.ServerTypes {
+			if _, exists := typeToResource[serverType]; !exists && serverType != "" {
+				typeToResource[serverType] = name
+			}
+		}
+	}
+	return &tuiApp{
+		cmd:            cmd,
+		client:         client,
+		resources:      resources,
+		typeToResource: typeToResource,
+		pageSize:       pageSize,
+		width:          80,
+		height:         24,
+	}
+}
+
+func (a *tuiApp) run(ctx context.Context) error {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+	// Alternate screen with a hidden cursor; both are undone on exit.
+	fmt.Fprint(a.out, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(a.out, "\x1b[?25h\x1b[?1049l")
+
+	keys := make(chan string)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 256)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if n > 0 {
+				keys <- string(buf[:n])
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	a.resize()
+	a.draw()
+	ticker := time.NewTicker(tuiResizePoll)
+	defer ticker.Stop()
+	for !a.quit {
+		select {
+		case <-ctx.Done():
+			return nil
+		case chunk, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			for _, key := range tuiKeys(chunk) {
+				a.handleKey(key)
+				if a.quit {
+					break
+				}
+			}
+			a.resize()
+			a.draw()
+		case <-ticker.C:
+			if a.resize() {
+				a.draw()
+			}
+		}
+	}
+	return nil
+}
+
+// resize refreshes the terminal size and reports whether it changed.
+func (a *tuiApp) resize() bool {
+	width, height, err := term.GetSize(int(os.Stdin.Fd()))
+	if err != nil || width <= 0 || height <= 0 || (width == a.width && height == a.height) {
+		return false
+	}
+	a.width, a.height = width, height
+	return true
+}
+
+func (a *tuiApp) draw() {
+	if a.out == nil {
+		return
+	}
+	lines := a.render(a.width, a.height)
+	fmt.Fprint(a.out, "\x1b[H\x1b[2J"+strings.Join(lines, "\r\n"))
+}
+
+func (a *tuiApp) current() *tuiPane {
+	return a.stack[len(a.stack)-1]
+}
+
+// push opens a pane, loading its rows; a pane that fails to load is not
+// opened and the error is shown in the status line.
+func (a *tuiApp) push(pane *tuiPane) {
+	if pane.title == "" {
+		pane.title = tuiPaneTitle(pane)
+	}
+	if err := a.load(pane); err != nil {
+		a.status = err.Error()
+		if len(a.stack) > 0 {
+			return
+		}
+	}
+	a.stack = append(a.stack, pane)
+}
+
+func (a *tuiApp) pop() {
+	if len(a.stack) > 1 {
+		a.stack = a.stack[:len(a.stack)-1]
+	}
+}
+
+func tuiPaneTitle(pane *tuiPane) string {
+	switch pane.kind {
+	case tuiListPane:
+		return pane.resource
+	case tuiDetailPane:
+		return pane.resource + " " + pane.id
+	}
+	return "resources"
+}
+
+func (a *tuiApp) resourcesPane() *tuiPane {
+	names := make([]string, 0, len(a.resources.Resources))
+	for name := range a.resources.Resources {
+		if listCommandRoute(name) != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	rows := make([]tuiRow, 0, len(names))
+	for _, name := range names {
+		rows = append(rows, tuiRow{Key: name, Resource: name})
+	}
+	return &tuiPane{kind: tuiResourcesPane, title: "resources", rows: rows}
+}
+
+func (a *tuiApp) load(pane *tuiPane) error {
+	switch pane.kind {
+	case tuiListPane:
+		return a.loadList(pane)
+	case tuiDetailPane:
+		return a.loadDetail(pane)
+	}
+	return nil
+}
+
+func (a *tuiApp) loadList(pane *tuiPane) error {
+	if pane.ids != nil {
+		// A to-many relationship lists fixed records, built when it opens.
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("page[limit]", strconv.Itoa(a.pageSize))
+	query.Set("page[offset]", strconv.Itoa(pane.offset))
+	for key, value := range pane.filters {
+		query.Set("filter["+key+"]", value)
+	}
+	var resp jsonAPIResponse
+	if err := a.get("/v1/"+pane.resource, query, &resp); err != nil {
+		return err
+	}
+	pane.rows = tuiListRows(pane.resource, resp.Data, a.resources.Resources[pane.resource].LabelFields)
+	pane.hasMore = len(resp.Data) >= a.pageSize
+	pane.cursor, pane.top = 0, 0
+	return nil
+}
+
+func (a *tuiApp) loadDetail(pane *tuiPane) error {
+	var resp jsonAPISingleResponse
+	if err := a.get("/v1/"+pane.resource+"/"+url.PathEscape(pane.id), nil, &resp); err != nil {
+		return err
+	}
+	record := resp.Data
+	pane.record = &record
+	pane.included = resp.Included
+	if label := completionLabel(record.Attributes, a.resources.Resources[pane.resource].LabelFields); label != "" {
+		pane.title = pane.resource + " " + pane.id + "  " + label
+	}
+	pane.rows = tuiDetailRows(pane.resource, record, a.resources, a.typeToResource)
+	if pane.cursor >= len(pane.rows) {
+		pane.cursor = 0
+	}
+	pane.move(0)
+	return nil
+}
+
+func (a *tuiApp) get(path string, query url.Values, target any) error {
+	a.status = "Loading " + strings.TrimPrefix(path, "/v1/") + "..."
+	a.draw()
+	ctx := a.cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, tuiRequestTimeout)
+	defer cancel()
+	body, _, err := a.client.Get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	a.status = ""
+	return nil
+}
+
+func tuiListRows(resource string, records []jsonAPIResource, labels []string) []tuiRow {
+	rows := make([]tuiRow, 0, len(records))
+	for i := range records {
+		record := records[i]
+		rows = append(rows, tuiRow{
+			Key:      record.ID,
+			Value:    completionLabel(record.Attributes, labels),
+			Resource: resource,
+			ID:       record.ID,
+			Record:   &record,
+		})
+	}
+	return rows
+}
+
+// tuiDetailRows lays out a record: its attributes, its relationships (each
+// followable to the related record or records), and the lists of other
+// resources whose relationship to this resource is also a list filter.
+func tuiDetailRows(resource string, record jsonAPIResource, resources resourceMap, typeToResource map[string]string) []tuiRow {
+	rows := []tuiRow{{Key: "Attributes", Header: true}}
+	names := make([]string, 0, len(record.Attributes))
+	for name := range record.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := record.Attributes[name]
+		rows = append(rows, tuiRow{Key: name, Value: tuiInlineValue(value), Text: tuiFullValue(value)})
+	}
+
+	relations := map[string]bool{}
+	for name := range record.Relationships {
+		relations[name] = true
+	}
+	for name := range resources.Relationships[resource] {
+		relations[name] = true
+	}
+	if len(relations) > 0 {
+		rows = append(rows, tuiRow{Key: "Relationships", Header: true})
+	}
+	for _, name := range sortedKeys(relations) {
+		rows = append(rows, tuiRelationshipRow(name, record.Relationships[name], resources.Relationships[resource][name], typeToResource))
+	}
+
+	if related := tuiRelatedLists(resource, record.ID, resources); len(related) > 0 {
+		rows = append(rows, tuiRow{Key: "Related lists", Header: true})
+		rows = append(rows, related...)
+	}
+	return rows
+}
+
+func tuiRelationshipRow(name string, relation jsonAPIRelationship, spec relationshipSpec, typeToResource map[string]string) tuiRow {
+	row := tuiRow{Key: name, Value: "-"}
+	target := func(serverType string) string {
+		if resource, ok := typeToResource[serverType]; ok {
+			return resource
+		}
+		if len(spec.Resources) == 1 {
+			return spec.Resources[0]
+		}
+		return ""
+	}
+	if relation.Data != nil {
+		row.Value = relation.Data.Type + " " + relation.Data.ID
+		row.Resource = target(relation.Data.Type)
+		row.ID = relation.Data.ID
+		return row
+	}
+	var identifiers []jsonAPIResourceIdentifier
+	if len(relation.raw) == 0 || json.Unmarshal(relation.raw, &identifiers) != nil || len(identifiers) == 0 {
+		return row
+	}
+	row.Value = fmt.Sprintf("%d %s", len(identifiers), identifiers[0].Type)
+	row.Resource = target(identifiers[0].Type)
+	for _, identifier := range identifiers {
+		row.IDs = append(row.IDs, identifier.ID)
+	}
+	return row
+}
+
+// tuiRelatedLists finds resources with a relationship that targets resource
+// and a list filter of the same name, e.g. time-cards filtered by
+// tender-job-schedule-shift from a shift. Lists with a filter named after the
+// resource count too, since not every inverse relationship is in the map
+// (tender-job-schedule-shifts by job-production-plan).
+func tuiRelatedLists(resource, id string, resources resourceMap) []tuiRow {
+	rows := []tuiRow{}
+	for _, other := range sortedKeys(resources.Resources) {
+		route := listCommandRoute(other)
+		if route == nil {
+			continue
+		}
+		names := []string{}
+		relations := resources.Relationships[other]
+		for _, name := range sortedKeys(relations) {
+			// Polymorphic relationships need a type to filter on as well.
+			if len(relations[name].Resources) == 1 && relations[name].Resources[0] == resource {
+				names = append(names, name)
+			}
+		}
+		if !containsString(names, singularize(resource)) {
+			names = append(names, singularize(resource))
+		}
+		for _, name := range names {
+			filter := routeFilterName(name, route.Filters)
+			if filter == "" {
+				continue
+			}
+			rows = append(rows, tuiRow{
+				Key:      other,
+				Value:    "where " + filter + " = " + id,
+				Resource: other,
+				Filters:  map[string]string{filter: id},
+			})
+		}
+	}
+	return rows
+}
+
+// tuiParseFilter turns filter input into API filters or a search. Input
+// with "=" is read as space-separated key=value pairs checked against the
+// list's filters; plain text becomes filter[q] when the list supports it and
+// a match on the loaded rows otherwise.
+func tuiParseFilter(input string, available []string) (map[string]string, string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, "", nil
+	}
+	if !strings.Contains(input, "=") {
+		if filter := routeFilterName("q", available); filter != "" {
+			return map[string]string{filter: input}, "", nil
+		}
+		return nil, input, nil
+	}
+	filters := map[string]string{}
+	for _, pair := range strings.Fields(input) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, "", fmt.Errorf("filter %q must look like key=value", pair)
+		}
+		filter := routeFilterName(key, available)
+		if filter == "" {
+			if len(available) == 0 {
+				return nil, "", fmt.Errorf("this list has no filters")
+			}
+			return nil, "", fmt.Errorf("unknown filter %q (available: %s)", key, strings.Join(available, ", "))
+		}
+		filters[filter] = value
+	}
+	return filters, "", nil
+}
+
+func tuiInlineValue(value any) string {
+	if value == nil {
+		return "null"
+	}
+	if text, ok := value.(string); ok {
+		return strings.Join(strings.Fields(text), " ")
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+func tuiFullValue(value any) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// visibleRows applies the pane's client-side match.
+func (p *tuiPane) visibleRows() []tuiRow {
+	if p.match == "" {
+		return p.rows
+	}
+	needle := strings.ToLower(p.match)
+	rows := []tuiRow{}
+	for _, row := range p.rows {
+		if strings.Contains(strings.ToLower(row.Key+" "+row.Value), needle) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (p *tuiPane) selected() (tuiRow, bool) {
+	rows := p.visibleRows()
+	if p.cursor < 0 || p.cursor >= len(rows) {
+		return tuiRow{}, false
+	}
+	return rows[p.cursor], true
+}
+
+// move shifts the cursor by delta, skipping section headers.
+func (p *tuiPane) move(delta int) {
+	rows := p.visibleRows()
+	if len(rows) == 0 {
+		p.cursor = 0
+		return
+	}
+	cursor := p.cursor + delta
+	if cursor < 0 {
+		cursor = 0
+	}
+	if cursor >= len(rows) {
+		cursor = len(rows) - 1
+	}
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+	for cursor >= 0 && cursor < len(rows) && rows[cursor].Header {
+		cursor += step
+	}
+	if cursor < 0 || cursor >= len(rows) {
+		return
+	}
+	p.cursor = cursor
+}
+
+func (a *tuiApp) handleKey(key string) {
+	if a.prompting {
+		a.handlePromptKey(key)
+		return
+	}
+	pane := a.current()
+	page := tuiBodyHeight(a.height)
+	a.status = ""
+	switch key {
+	case "q", "ctrl-c":
+		a.quit = true
+	case "?":
+		a.help = !a.help
+	case "j", "down":
+		pane.move(1)
+	case "k", "up":
+		pane.move(-1)
+	case "pgdn", " ":
+		pane.move(page)
+	case "pgup":
+		pane.move(-page)
+	case "g", "home":
+		pane.cursor = 0
+		pane.move(0)
+	case "G", "end":
+		pane.cursor = len(pane.visibleRows()) - 1
+		pane.move(0)
+	case "enter", "l", "right":
+		a.open()
+	case "esc", "h", "left", "backspace":
+		if pane.match != "" {
+			pane.match, pane.cursor = "", 0
+			return
+		}
+		a.pop()
+	case "/":
+		if pane.kind == tuiValuePane {
+			return
+		}
+		a.prompting, a.prompt = true, ""
+	case "n", "p":
+		if pane.kind != tuiListPane || pane.ids != nil {
+			return
+		}
+		offset := pane.offset
+		if key == "n" && pane.hasMore {
+			offset += a.pageSize
+		} else if key == "p" && offset > 0 {
+			offset -= a.pageSize
+			if offset < 0 {
+				offset = 0
+			}
+		} else {
+			return
+		}
+		previous := pane.offset
+		pane.offset = offset
+		if err := a.load(pane); err != nil {
+			pane.offset = previous
+			a.status = err.Error()
+		}
+	case "r":
+		if err := a.load(pane); err != nil {
+			a.status = err.Error()
+		}
+	case "o":
+		a.openWebPage()
+	}
+}
+
+func (a *tuiApp) handlePromptKey(key string) {
+	switch key {
+	case "ctrl-c":
+		a.quit = true
+	case "esc":
+		a.prompting = false
+	case "backspace":
+		if a.prompt != "" {
+			_, size := utf8.DecodeLastRuneInString(a.prompt)
+			a.prompt = a.prompt[:len(a.prompt)-size]
+		}
+	case "enter":
+		a.prompting = false
+		a.applyFilter(a.prompt)
+	default:
+		if utf8.RuneCountInString(key) == 1 && key >= " " {
+			a.prompt += key
+		}
+	}
+}
+
+func (a *tuiApp) applyFilter(input string) {
+	pane := a.current()
+	if pane.kind != tuiListPane || pane.ids != nil {
+		pane.match, pane.cursor = strings.TrimSpace(input), 0
+		pane.move(0)
+		return
+	}
+	var available []string
+	if route := listCommandRoute(pane.resource); route != nil {
+		available = route.Filters
+	}
+	filters, match, err := tuiParseFilter(input, available)
+	if err != nil {
+		a.status = err.Error()
+		return
+	}
+	previous := pane.filters
+	pane.filters, pane.match, pane.offset = filters, match, 0
+	if err := a.load(pane); err != nil {
+		pane.filters = previous
+		a.status = err.Error()
+	}
+}
+
+func (a *tuiApp) open() {
+	pane := a.current()
+	row, ok := pane.selected()
+	if !ok || !row.navigable() {
+		return
+	}
+	switch {
+	case row.Text != "":
+		a.stack = append(a.stack, &tuiPane{kind: tuiValuePane, title: pane.title + " › " + row.Key, rows: tuiTextRows(row.Text)})
+	case pane.kind == tuiResourcesPane:
+		a.push(&tuiPane{kind: tuiListPane, resource: row.Resource})
+	case row.ID != "":
+		a.push(&tuiPane{kind: tuiDetailPane, resource: row.Resource, id: row.ID})
+	case row.IDs != nil:
+		next := &tuiPane{kind: tuiListPane, resource: row.Resource, ids: row.IDs, title: pane.title + " › " + row.Key}
+		next.rows = a.identifierRows(row.Resource, row.IDs, pane.included)
+		a.push(next)
+	default:
+		next := &tuiPane{kind: tuiListPane, resource: row.Resource, filters: row.Filters}
+		next.title = row.Resource + " " + row.Value
+		a.push(next)
+	}
+}
+
+// identifierRows lists the records of a to-many relationship, labelled from
+// the parent response's included records when present.
+func (a *tuiApp) identifierRows(resource string, ids []string, included []jsonAPIResource) []tuiRow {
+	labels := a.resources.Resources[resource].LabelFields
+	byID := map[string]jsonAPIResource{}
+	for _, record := range included {
+		if a.typeToResource[record.Type] == resource {
+			byID[record.ID] = record
+		}
+	}
+	rows := make([]tuiRow, 0, len(ids))
+	for _, id := range ids {
+		row := tuiRow{Key: id, Resource: resource, ID: id}
+		if record, ok := byID[id]; ok {
+			row.Value = completionLabel(record.Attributes, labels)
+			row.Record = &record
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func tuiTextRows(text string) []tuiRow {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	rows := make([]tuiRow, 0, len(lines))
+	for _, line := range lines {
+		rows = append(rows, tuiRow{Key: line})
+	}
+	return rows
+}
+
+// openWebPage resolves the client URL of the record in view (or the
+// selected list row) and opens it in the browser.
+func (a *tuiApp) openWebPage() {
+	pane := a.current()
+	resource, record, included := pane.resource, pane.record, pane.included
+	if pane.kind == tuiListPane {
+		row, ok := pane.selected()
+		if !ok {
+			return
+		}
+		record = row.Record
+		if record == nil {
+			record = &jsonAPIResource{ID: row.ID}
+		}
+	}
+	if record == nil || resource == "" {
+		a.status = "Select a record to open its web page"
+		return
+	}
+	// The resolver reports authentication problems on stderr, which would
+	// draw over the screen; its error is shown in the status line instead.
+	errOut := a.cmd.ErrOrStderr()
+	a.cmd.SetErr(io.Discard)
+	urls, err := clientURLsForResources(a.cmd, resource, []jsonAPIResource{*record}, included)
+	a.cmd.SetErr(errOut)
+	if err != nil {
+		a.status = err.Error()
+		return
+	}
+	if err := openBrowser(urls[0]); err != nil {
+		a.status = fmt.Sprintf("%s  (could not open a browser: %v)", urls[0], err)
+		return
+	}
+	a.status = "Opened " + urls[0]
+}
+
+// tuiBodyHeight is the number of row lines on a screen of height lines,
+// leaving room for the title, a gap, the status, and the key hints.
+func tuiBodyHeight(height int) int {
+	if height-4 < 1 {
+		return 1
+	}
+	return height - 4
+}
+
+// render lays the screen out as exactly height lines of at most width
+// columns: title, body, status or filter prompt, and key hints.
+func (a *tuiApp) render(width, height int) []string {
+	pane := a.current()
+	crumbs := make([]string, 0, len(a.stack))
+	for _, p := range a.stack {
+		crumbs = append(crumbs, p.title)
+	}
+	title := "xbe › " + strings.Join(crumbs, " › ")
+	if pane.kind == tuiListPane && pane.ids == nil {
+		title += fmt.Sprintf("  [%d-%d]", pane.offset+1, pane.offset+len(pane.rows))
+	}
+	if count := utf8.RuneCountInString(title); count > width && width > 1 {
+		// Deep breadcrumbs keep the current pane in view.
+		title = "…" + string([]rune(title)[count-width+1:])
+	}
+	lines := []string{"\x1b[1m" + tuiFit(title, width) + "\x1b[0m", ""}
+
+	body := tuiBodyHeight(height)
+	var content []string
+	if a.help {
+		content = strings.Split(tuiKeyHelp, "\n")
+		for i := range content {
+			content[i] = tuiFit(content[i], width)
+		}
+	} else {
+		content = pane.renderRows(width, body)
+	}
+	for len(content) < body {
+		content = append(content, "")
+	}
+	lines = append(lines, content[:body]...)
+
+	switch {
+	case a.prompting:
+		lines = append(lines, tuiFit("Filter: "+a.prompt, width)+"\x1b[7m \x1b[0m")
+	case a.status != "":
+		lines = append(lines, tuiFit(a.status, width))
+	default:
+		lines = append(lines, tuiFit(pane.summary(), width))
+	}
+	lines = append(lines, "\x1b[2m"+tuiFit(tuiHints(pane.kind), width)+"\x1b[0m")
+	return lines[:height]
+}
+
+func (p *tuiPane) summary() string {
+	rows := p.visibleRows()
+	parts := []string{fmt.Sprintf("%d rows", len(rows))}
+	if len(p.filters) > 0 {
+		filters := []string{}
+		for _, key := range sortedKeys(p.filters) {
+			filters = append(filters, key+"="+p.filters[key])
+		}
+		parts = append(parts, "filter "+strings.Join(filters, " "))
+	}
+	if p.match != "" {
+		parts = append(parts, fmt.Sprintf("matching %q", p.match))
+	}
+	if p.hasMore {
+		parts = append(parts, "more with n")
+	}
+	return strings.Join(parts, "  ·  ")
+}
+
+func tuiHints(kind tuiPaneKind) string {
+	switch kind {
+	case tuiListPane:
+		return "enter open · / filter · n/p page · o web · r reload · esc back · ? help · q quit"
+	case tuiDetailPane:
+		return "enter follow · / match · o web · r reload · esc back · ? help · q quit"
+	case tuiValuePane:
+		return "esc back · q quit"
+	}
+	return "enter open · / match · ? help · q quit"
+}
+
+// renderRows draws the visible window of rows, scrolling to keep the
+// cursor in view.
+func (p *tuiPane) renderRows(width, height int) []string {
+	rows := p.visibleRows()
+	if len(rows) == 0 {
+		return []string{tuiFit("(no rows)", width)}
+	}
+	if p.cursor >= len(rows) {
+		p.cursor = len(rows) - 1
+	}
+	if p.cursor < p.top {
+		p.top = p.cursor
+	}
+	if p.cursor >= p.top+height {
+		p.top = p.cursor - height + 1
+	}
+	keyWidth := 0
+	for _, row := range rows {
+		if !row.Header && utf8.RuneCountInString(row.Key) > keyWidth {
+			keyWidth = utf8.RuneCountInString(row.Key)
+		}
+	}
+	if keyWidth > width/3 {
+		keyWidth = width / 3
+	}
+
+	lines := []string{}
+	for i := p.top; i < len(rows) && i < p.top+height; i++ {
+		row := rows[i]
+		var line string
+		switch {
+		case row.Header:
+			lines = append(lines, "\x1b[1;4m"+tuiFit(row.Key, width)+"\x1b[0m")
+			continue
+		case p.kind == tuiValuePane || row.Value == "" && p.kind == tuiResourcesPane:
+			line = row.Key
+		default:
+			marker := "  "
+			if row.navigable() && p.kind == tuiDetailPane && row.Text == "" {
+				marker = "→ "
+			}
+			line = marker + tuiPad(row.Key, keyWidth) + "  " + row.Value
+		}
+		line = tuiFit(line, width)
+		if i == p.cursor && p.kind != tuiValuePane {
+			line = "\x1b[7m" + tuiPad(line, width) + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// tuiFit truncates value to width runes.
+func tuiFit(value string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(value) <= width {
+		return value
+	}
+	runes := []rune(value)
+	if width == 1 {
+		return "…"
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+func tuiPad(value string, width int) string {
+	value = tuiFit(value, width)
+	if count := utf8.RuneCountInString(value); count < width {
+		value += strings.Repeat(" ", width-count)
+	}
+	return value
+}
+
+// tuiKeys splits raw terminal input into key names: escape sequences for
+// arrows and paging become "up", "pgdn", and so on; other input is returned
+// one character at a time.
+func tuiKeys(input string) []string {
+	sequences := map[string]string{
+		"\x1b[A": "up", "\x1bOA": "up",
+		"\x1b[B": "down", "\x1bOB": "down",
+		"\x1b[C": "right", "\x1bOC": "right",
+		"\x1b[D": "left", "\x1bOD": "left",
+		"\x1b[H": "home", "\x1bOH": "home", "\x1b[1~": "home",
+		"\x1b[F": "end", "\x1bOF": "end", "\x1b[4~": "end",
+		"\x1b[5~": "pgup", "\x1b[6~": "pgdn",
+	}
+	keys := []string{}
+	for input != "" {
+		if strings.HasPrefix(input, "\x1b[") || strings.HasPrefix(input, "\x1bO") {
+			end := 2
+			for end < len(input) && !(input[end] >= 0x40 && input[end] <= 0x7e) {
+				end++
+			}
+			if end < len(input) {
+				if name, ok := sequences[input[:end+1]]; ok {
+					keys = append(keys, name)
+				}
+				input = input[end+1:]
+				continue
+			}
+		}
+		r, size := utf8.DecodeRuneInString(input)
+		input = input[size:]
+		switch r {
+		case '\x1b':
+			keys = append(keys, "esc")
+		case '\r', '\n':
+			keys = append(keys, "enter")
+		case '\x7f', '\x08':
+			keys = append(keys, "backspace")
+		case '\x03':
+			keys = append(keys, "ctrl-c")
+		default:
+			if r >= ' ' {
+				keys = append(keys, string(r))
+			}
+		}
+	}
+	return keys
+}
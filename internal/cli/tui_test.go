@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTUIKeys(t *testing.T) {
+	keys := tuiKeys("j\x1b[A\x1b[6~\r\x1b\x7fé")
+	want := []string{"j", "up", "pgdn", "enter", "esc", "backspace", "é"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Fatalf("keys = %q, want %q", keys, want)
+	}
+}
+
+func TestTUIParseFilter(t *testing.T) {
+	available := []string{"broker", "job_production_plan", "q"}
+
+	filters, match, err := tuiParseFilter("broker=12 job-production-plan=7", available)
+	if err != nil || match != "" || !reflect.DeepEqual(filters, map[string]string{"broker": "12", "job_production_plan": "7"}) {
+		t.Errorf("pairs = %v %q %v", filters, match, err)
+	}
+	filters, _, _ = tuiParseFilter("acme", available)
+	if filters["q"] != "acme" {
+		t.Errorf("search with q = %v", filters)
+	}
+	if filters, match, _ = tuiParseFilter("acme", []string{"broker"}); filters != nil || match != "acme" {
+		t.Errorf("search without q = %v %q", filters, match)
+	}
+	if _, _, err := tuiParseFilter("status=active", available); err == nil {
+		t.Error("expected an error for an unknown filter")
+	}
+}
+
+func TestTUIDetailRows(t *testing.T) {
+	var record jsonAPIResource
+	if err := json.Unmarshal([]byte(`{"id":"7","type":"job-production-plans",
+		"attributes":{"job-name":"Main St"},
+		"relationships":{
+			"customer":{"data":{"type":"customers","id":"3"}},
+			"job-production-plan-material-types":{"data":[{"type":"job-production-plan-material-types","id":"4"},{"type":"job-production-plan-material-types","id":"5"}]}
+		}}`), &record); err != nil {
+		t.Fatal(err)
+	}
+	resources, err := loadResourceMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := newTUIApp(nil, nil, resources, 10)
+	rows := tuiDetailRows("job-production-plans", record, resources, app.typeToResource)
+
+	byKey := map[string]tuiRow{}
+	for _, row := range rows {
+		if _, seen := byKey[row.Key]; !seen {
+			byKey[row.Key] = row
+		}
+	}
+	if row := byKey["job-name"]; row.Value != "Main St" || row.Text != "Main St" {
+		t.Errorf("attribute row = %+v", row)
+	}
+	if row := byKey["customer"]; row.Resource != "customers" || row.ID != "3" {
+		t.Errorf("to-one row = %+v", row)
+	}
+	if row := byKey["job-production-plan-material-types"]; row.Resource != "job-production-plan-material-types" || !reflect.DeepEqual(row.IDs, []string{"4", "5"}) {
+		t.Errorf("to-many row = %+v", row)
+	}
+	if row := byKey["tender-job-schedule-shifts"]; row.Filters["job_production_plan"] != "7" {
+		t.Errorf("related list row = %+v", row)
+	}
+	if row := byKey["lineup-job-production-plans"]; row.Filters["job-production-plan"] != "7" {
+		t.Errorf("related list row = %+v", row)
+	}
+}
+
+func TestTUIRenderFitsScreen(t *testing.T) {
+	app := newTUIApp(nil, nil, resourceMap{}, 10)
+	pane := &tuiPane{kind: tuiListPane, resource: "brokers", title: "brokers"}
+	for _, id := range []string{"1", "2", "3", "4", "5", "6", "7", "8"} {
+		pane.rows = append(pane.rows, tuiRow{Key: id, Value: "a very long broker name that does not fit", Resource: "brokers", ID: id})
+	}
+	app.stack = []*tuiPane{pane}
+	pane.move(6)
+
+	lines := app.render(30, 8)
+	if len(lines) != 8 {
+		t.Fatalf("lines = %d, want 8", len(lines))
+	}
+	if pane.top != 3 {
+		t.Errorf("top = %d, want the cursor row scrolled into view", pane.top)
+	}
+}
@@ -15,6 +15,10 @@ List/show commands also support:
   --omit-null  Omit null values in JSON output (list/show only)
   --version-changes  Include version history (versioned resources only)
 
+List commands also support:
+  --all        Fetch every page and merge the results (uses --limit as page size)
+  --max-items  Fetch pages until N results are collected
+
 Tip: Use 'xbe knowledge resources --version-changes' to list resources that support version history.
 Tip: Optional feature gates for version history are auto-applied. See 'xbe knowledge resource <name>'.
 
@@ -26,10 +30,19 @@ Fields usage:
   xbe view projects list --status active     # Filter
   xbe view projects show 123                 # Show one
   xbe view projects show 123 --version-changes
-  xbe view projects list --json              # JSON output`,
+  xbe view projects list --json              # JSON output
+  xbe view projects list --all --json        # Every page, merged`,
 	Annotations: map[string]string{"group": GroupCore},
-	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
-		return applyVersionChangesContext(cmd)
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Cobra runs only the nearest persistent pre-run, so run the root's
+		// setup first.
+		if err := telemetryPreRun(cmd, args); err != nil {
+			return err
+		}
+		if err := applyVersionChangesContext(cmd); err != nil {
+			return err
+		}
+		return applyPaginationContext(cmd)
 	},
 }
 
@@ -37,4 +50,5 @@ func init() {
 	rootCmd.AddCommand(viewCmd)
 	viewCmd.PersistentFlags().Bool("version-changes", false, "Include version changes in responses (supported resources only)")
 	viewCmd.PersistentFlags().Bool("client-url", false, "Output client app URL(s) only")
+	initPaginationFlags(viewCmd)
 }
@@ -0,0 +1,378 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xbe-inc/xbe-cli/internal/api"
+)
+
+const (
+	defaultWatchInterval = 30 * time.Second
+	minWatchInterval     = time.Second
+)
+
+// attachWatchFlags adds --watch and --exec to every view list and show command.
+func attachWatchFlags(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	for _, child := range cmd.Commands() {
+		attachWatchFlags(child)
+	}
+	if !isWatchCommand(cmd) {
+		return
+	}
+	flags := cmd.Flags()
+	if flags.Lookup("watch") == nil {
+		flags.Duration("watch", 0, "Re-run every interval and print added/changed/removed records (--watch or --watch=10s; default 30s)")
+		flags.Lookup("watch").NoOptDefVal = defaultWatchInterval.String()
+	}
+	if flags.Lookup("exec") == nil {
+		flags.String("exec", "", "With --watch, run this shell command for each change (event JSON on stdin)")
+	}
+}
+
+func isWatchCommand(cmd *cobra.Command) bool {
+	if cmd.RunE == nil || (cmd.Name() != "list" && cmd.Name() != "show") {
+		return false
+	}
+	parent := cmd.Parent()
+	return parent != nil && parent.Parent() == viewCmd
+}
+
+func watchRequested(cmd *cobra.Command) bool {
+	flag := cmd.Flags().Lookup("watch")
+	return flag != nil && flag.Changed
+}
+
+type watchEvent struct {
+	Time    string                 `json:"time"`
+	Event   string                 `json:"event"`
+	Type    string                 `json:"type"`
+	ID      string                 `json:"id"`
+	Label   string                 `json:"label,omitempty"`
+	Changes map[string]watchChange `json:"changes,omitempty"`
+	Record  map[string]any         `json:"record,omitempty"`
+}
+
+type watchChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// watchRecord is the comparable state of one record: its attributes and
+// relationship IDs.
+type watchRecord struct {
+	Type   string
+	Fields map[string]any
+}
+
+type watcher struct {
+	cmd      *cobra.Command
+	args     []string
+	run      func(*cobra.Command, []string) error
+	interval time.Duration
+	execCmd  string
+	ndjson   bool
+	labels   []string
+	out      io.Writer
+}
+
+// runWatch re-runs a view command on an interval and reports the difference
+// between consecutive responses. The first run prints the normal output in
+// table mode and is the baseline; later runs print only events.
+func runWatch(cmd *cobra.Command, args []string, run func(*cobra.Command, []string) error) error {
+	interval, err := cmd.Flags().GetDuration("watch")
+	if err != nil {
+		return err
+	}
+	if interval < minWatchInterval {
+		return fmt.Errorf("%w: --watch interval must be at least %s", errInvalidInput, minWatchInterval)
+	}
+
+	w := &watcher{
+		cmd:      cmd,
+		args:     args,
+		run:      run,
+		interval: interval,
+		execCmd:  strings.TrimSpace(getStringFlag(cmd, "exec")),
+		ndjson:   getBoolFlag(cmd, "json"),
+		out:      cmd.OutOrStdout(),
+	}
+	if settings, ok := cmd.Context().Value(outputSettingsKey).(outputSettings); ok && (settings.Buffer != nil || settings.Stream != nil) {
+		if settings.JQ != "" || settings.Template != "" || (settings.Format != outputJSON && settings.Format != outputNDJSON) {
+			return fmt.Errorf("%w: --watch prints table or NDJSON events; use --json or --output ndjson (without --jq or templates)", errInvalidInput)
+		}
+		// Events stream as they happen instead of being buffered to the end.
+		w.out = settings.OriginalOut
+		cmd.SetOut(w.out)
+		if settings.Stream != nil {
+			_ = settings.Stream.Close()
+		}
+		lastOutputCmd = nil
+	}
+	if resources, err := loadResourceMap(); err == nil {
+		w.labels = resources.Resources[cmd.Parent().Name()].LabelFields
+	}
+	return w.loop(cmd.Context())
+}
+
+func (w *watcher) loop(ctx context.Context) error {
+	previous, err := w.poll(ctx, !w.ndjson)
+	if err != nil {
+		return err
+	}
+	// Only the baseline poll's errors need the structured error report;
+	// progress, poll errors and hook output go straight to stderr from here on.
+	if capture := activeErrorCapture; capture != nil && capture.cmd == w.cmd {
+		w.cmd.SetErr(capture.stderr)
+	}
+	fmt.Fprintf(w.cmd.ErrOrStderr(), "Watching %d records every %s (Ctrl-C to stop)\n", len(previous), w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		current, err := w.poll(ctx, false)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fmt.Fprintf(w.cmd.ErrOrStderr(), "watch: %v\n", err)
+			continue
+		}
+		for _, event := range diffWatchSnapshots(previous, current, w.labels, time.Now()) {
+			if err := w.emit(ctx, event); err != nil {
+				return err
+			}
+		}
+		previous = current
+	}
+}
+
+// poll runs the command once and snapshots the records of its response.
+func (w *watcher) poll(ctx context.Context, printOutput bool) (map[string]watchRecord, error) {
+	recorder := &api.ResponseRecorder{}
+	w.cmd.SetContext(api.WithResponseRecorder(ctx, recorder))
+	defer w.cmd.SetContext(ctx)
+
+	// Commands print their own errors to stderr; the watch loop reports
+	// them once instead.
+	var output bytes.Buffer
+	errOut := w.cmd.ErrOrStderr()
+	w.cmd.SetOut(&output)
+	w.cmd.SetErr(io.Discard)
+	err := w.run(w.cmd, w.args)
+	w.cmd.SetOut(w.out)
+	w.cmd.SetErr(errOut)
+	if err != nil {
+		return nil, err
+	}
+	if printOutput {
+		_, _ = w.out.Write(output.Bytes())
+	}
+	return watchSnapshot(recorder.Last())
+}
+
+// watchSnapshot indexes the primary records of a JSON:API document (a list
+// or a single record) by type and ID.
+func watchSnapshot(body []byte) (map[string]watchRecord, error) {
+	var document struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	var records []mirrorRecord
+	data := bytes.TrimSpace(document.Data)
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")):
+	case data[0] == '[':
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+	default:
+		var record mirrorRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	snapshot := make(map[string]watchRecord, len(records))
+	for _, record := range records {
+		fields := map[string]any{}
+		for name, value := range record.Attributes {
+			fields[name] = value
+		}
+		for name, relation := range record.Relationships {
+			if len(relation.Data) == 0 {
+				continue
+			}
+			var value any
+			if json.Unmarshal(relation.Data, &value) == nil {
+				fields[name] = watchRelationshipIDs(value)
+			}
+		}
+		snapshot[record.Type+"|"+record.ID] = watchRecord{Type: record.Type, Fields: fields}
+	}
+	return snapshot, nil
+}
+
+// watchRelationshipIDs reduces relationship data to its IDs so a change of target
+// shows as "12" -> "15" rather than two identifier objects.
+func watchRelationshipIDs(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		return typed["id"]
+	case []any:
+		ids := make([]any, 0, len(typed))
+		for _, item := range typed {
+			ids = append(ids, watchRelationshipIDs(item))
+		}
+		return ids
+	}
+	return value
+}
+
+func diffWatchSnapshots(previous, current map[string]watchRecord, labels []string, now time.Time) []watchEvent {
+	stamp := now.UTC().Format(time.RFC3339)
+	keys := map[string]bool{}
+	for key := range previous {
+		keys[key] = true
+	}
+	for key := range current {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	events := []watchEvent{}
+	for _, key := range sorted {
+		_, id, _ := strings.Cut(key, "|")
+		before, existed := previous[key]
+		after, exists := current[key]
+		switch {
+		case !existed:
+			events = append(events, watchEvent{Time: stamp, Event: "added", Type: after.Type, ID: id, Label: completionLabel(after.Fields, labels), Record: after.Fields})
+		case !exists:
+			events = append(events, watchEvent{Time: stamp, Event: "removed", Type: before.Type, ID: id, Label: completionLabel(before.Fields, labels)})
+		default:
+			changes := map[string]watchChange{}
+			for name := range mergeFieldNames(before.Fields, after.Fields) {
+				if !reflect.DeepEqual(before.Fields[name], after.Fields[name]) {
+					changes[name] = watchChange{From: before.Fields[name], To: after.Fields[name]}
+				}
+			}
+			if len(changes) > 0 {
+				events = append(events, watchEvent{Time: stamp, Event: "changed", Type: after.Type, ID: id, Label: completionLabel(after.Fields, labels), Changes: changes})
+			}
+		}
+	}
+	return events
+}
+
+func mergeFieldNames(a, b map[string]any) map[string]bool {
+	names := map[string]bool{}
+	for name := range a {
+		names[name] = true
+	}
+	for name := range b {
+		names[name] = true
+	}
+	return names
+}
+
+func (w *watcher) emit(ctx context.Context, event watchEvent) error {
+	if w.ndjson {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w.out, string(data))
+	} else {
+		fmt.Fprintln(w.out, formatWatchEvent(event))
+	}
+	if w.execCmd != "" {
+		w.runHook(ctx, event)
+	}
+	return nil
+}
+
+func formatWatchEvent(event watchEvent) string {
+	stamp := event.Time
+	if parsed, err := time.Parse(time.RFC3339, event.Time); err == nil {
+		stamp = parsed.Local().Format("15:04:05")
+	}
+	line := fmt.Sprintf("%s  %-7s  %s %s", stamp, strings.ToUpper(event.Event), event.Type, event.ID)
+	if event.Label != "" {
+		line += "  " + event.Label
+	}
+	names := make([]string, 0, len(event.Changes))
+	for name := range event.Changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		change := event.Changes[name]
+		line += fmt.Sprintf("\n          %s: %s -> %s", name, watchValue(change.From), watchValue(change.To))
+	}
+	return line
+}
+
+func watchValue(value any) string {
+	if value == nil {
+		return "null"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return truncateString(string(data), 80)
+}
+
+// runHook runs --exec for one event with the event JSON on stdin and its
+// kind, type, and ID in XBE_WATCH_EVENT, XBE_WATCH_TYPE, and XBE_WATCH_ID.
+// Hook output goes to stderr so it never mixes with an NDJSON event stream.
+func (w *watcher) runHook(ctx context.Context, event watchEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	var hook *exec.Cmd
+	if runtime.GOOS == "windows" {
+		hook = exec.CommandContext(ctx, "cmd", "/C", w.execCmd)
+	} else {
+		hook = exec.CommandContext(ctx, "sh", "-c", w.execCmd)
+	}
+	hook.Stdin = bytes.NewReader(data)
+	hook.Stdout = w.cmd.ErrOrStderr()
+	hook.Stderr = w.cmd.ErrOrStderr()
+	hook.Env = append(os.Environ(),
+		"XBE_WATCH_EVENT="+event.Event,
+		"XBE_WATCH_TYPE="+event.Type,
+		"XBE_WATCH_ID="+event.ID,
+	)
+	if err := hook.Run(); err != nil && ctx.Err() == nil {
+		fmt.Fprintf(w.cmd.ErrOrStderr(), "watch: --exec for %s %s %s: %v\n", event.Event, event.Type, event.ID, err)
+	}
+}
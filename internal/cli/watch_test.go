@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffWatchSnapshots(t *testing.T) {
+	before, err := watchSnapshot([]byte(`{"data":[
+		{"id":"1","type":"brokers","attributes":{"company-name":"Acme","is-active":true},"relationships":{"developer":{"data":{"type":"developers","id":"3"}}}},
+		{"id":"2","type":"brokers","attributes":{"company-name":"Beta"}}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := watchSnapshot([]byte(`{"data":[
+		{"id":"1","type":"brokers","attributes":{"company-name":"Acme","is-active":false},"relationships":{"developer":{"data":{"type":"developers","id":"4"}}}},
+		{"id":"5","type":"brokers","attributes":{"company-name":"Gamma"}}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := diffWatchSnapshots(before, after, []string{"company-name"}, time.Now())
+	if len(events) != 3 {
+		t.Fatalf("events = %+v", events)
+	}
+	changed, removed, added := events[0], events[1], events[2]
+	if changed.Event != "changed" || changed.ID != "1" || changed.Label != "Acme" || len(changed.Changes) != 2 {
+		t.Errorf("changed = %+v", changed)
+	}
+	if change := changed.Changes["developer"]; change.From != "3" || change.To != "4" {
+		t.Errorf("developer change = %+v", change)
+	}
+	if removed.Event != "removed" || removed.ID != "2" {
+		t.Errorf("removed = %+v", removed)
+	}
+	if added.Event != "added" || added.ID != "5" || added.Record["company-name"] != "Gamma" {
+		t.Errorf("added = %+v", added)
+	}
+
+	if events := diffWatchSnapshots(after, after, nil, time.Now()); len(events) != 0 {
+		t.Errorf("unchanged snapshot produced %+v", events)
+	}
+}
+
+func TestWatchSnapshotSingleRecord(t *testing.T) {
+	snapshot, err := watchSnapshot([]byte(`{"data":{"id":"7","type":"customers","attributes":{"company-name":"Acme"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record, ok := snapshot["customers|7"]; !ok || record.Fields["company-name"] != "Acme" {
+		t.Fatalf("snapshot = %+v", snapshot)
+	}
+}
@@ -0,0 +1,249 @@
+// Package config manages named CLI profiles stored in ~/.config/xbe/config.json.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ProfileEnv selects the active profile when --profile is not given.
+const ProfileEnv = "XBE_PROFILE"
+
+// ErrProfileNotFound is returned when a named profile does not exist.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile bundles connection settings and command defaults for an environment.
+type Profile struct {
+	BaseURL string `json:"base_url,omitempty"`
+	// TokenRef points at the token to use: "env:NAME" reads an environment
+	// variable, "store:KEY" (or a bare KEY) reads the auth store entry KEY, and
+	// an empty value uses the token stored for BaseURL.
+	TokenRef string `json:"token_ref,omitempty"`
+	Broker   string `json:"broker,omitempty"`
+	Customer string `json:"customer,omitempty"`
+	Output   string `json:"output,omitempty"`
+}
+
+// profilesConfig mirrors the profile keys in ~/.config/xbe/config.json
+type profilesConfig struct {
+	CurrentProfile string             `json:"current_profile,omitempty"`
+	Profiles       map[string]Profile `json:"profiles,omitempty"`
+}
+
+var (
+	cacheMu       sync.Mutex
+	cached        *profilesConfig
+	activeProfile string
+)
+
+// Path returns the location of the shared CLI config file.
+func Path() string {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "xbe", "config.json")
+}
+
+// SetActiveProfile overrides the active profile (e.g. from --profile).
+func SetActiveProfile(name string) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	activeProfile = strings.TrimSpace(name)
+}
+
+// ActiveProfileName returns the selected profile name with precedence:
+// --profile, then XBE_PROFILE, then current_profile from the config file.
+func ActiveProfileName() string {
+	cacheMu.Lock()
+	override := activeProfile
+	cacheMu.Unlock()
+	if override != "" {
+		return override
+	}
+	if value := strings.TrimSpace(os.Getenv(ProfileEnv)); value != "" {
+		return value
+	}
+	cfg, err := load()
+	if err != nil {
+		return ""
+	}
+	return cfg.CurrentProfile
+}
+
+// ActiveProfile returns the active profile, if one is selected.
+func ActiveProfile() (string, Profile, bool, error) {
+	name := ActiveProfileName()
+	if name == "" {
+		return "", Profile{}, false, nil
+	}
+	profile, err := GetProfile(name)
+	if err != nil {
+		return name, Profile{}, false, err
+	}
+	return name, profile, true, nil
+}
+
+// GetProfile returns the named profile.
+func GetProfile(name string) (Profile, error) {
+	cfg, err := load()
+	if err != nil {
+		return Profile{}, err
+	}
+	profile, ok := cfg.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+	return profile, nil
+}
+
+// ListProfiles returns all profiles sorted by name and the current profile name.
+func ListProfiles() ([]string, map[string]Profile, string, error) {
+	cfg, err := load()
+	if err != nil {
+		return nil, nil, "", err
+	}
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, cfg.Profiles, cfg.CurrentProfile, nil
+}
+
+// SaveProfile creates or replaces the named profile.
+func SaveProfile(name string, profile Profile) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("profile name is required")
+	}
+	return update(func(cfg *profilesConfig) error {
+		if cfg.Profiles == nil {
+			cfg.Profiles = map[string]Profile{}
+		}
+		cfg.Profiles[name] = profile
+		return nil
+	})
+}
+
+// DeleteProfile removes the named profile, clearing it as current if needed.
+func DeleteProfile(name string) error {
+	return update(func(cfg *profilesConfig) error {
+		if _, ok := cfg.Profiles[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+		}
+		delete(cfg.Profiles, name)
+		if cfg.CurrentProfile == name {
+			cfg.CurrentProfile = ""
+		}
+		return nil
+	})
+}
+
+// UseProfile makes the named profile current for future invocations.
+func UseProfile(name string) error {
+	return update(func(cfg *profilesConfig) error {
+		if _, ok := cfg.Profiles[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+		}
+		cfg.CurrentProfile = name
+		return nil
+	})
+}
+
+func load() (profilesConfig, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if cached != nil {
+		return *cached, nil
+	}
+	cfg := profilesConfig{}
+	content, err := os.ReadFile(Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			cached = &cfg
+			return cfg, nil
+		}
+		return cfg, err
+	}
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return profilesConfig{}, fmt.Errorf("parse config file %s: %w", Path(), err)
+	}
+	cached = &cfg
+	return cfg, nil
+}
+
+// update rewrites the profile keys while preserving the rest of the file
+// (tokens, telemetry, ...).
+func update(mutate func(cfg *profilesConfig) error) error {
+	path := Path()
+	if path == "" {
+		return errors.New("unable to determine config directory")
+	}
+
+	raw := map[string]json.RawMessage{}
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &raw); err != nil {
+			return fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+
+	cfg := profilesConfig{}
+	if value, ok := raw["current_profile"]; ok {
+		_ = json.Unmarshal(value, &cfg.CurrentProfile)
+	}
+	if value, ok := raw["profiles"]; ok {
+		if err := json.Unmarshal(value, &cfg.Profiles); err != nil {
+			return fmt.Errorf("parse profiles: %w", err)
+		}
+	}
+
+	if err := mutate(&cfg); err != nil {
+		return err
+	}
+
+	if cfg.CurrentProfile == "" {
+		delete(raw, "current_profile")
+	} else if value, err := json.Marshal(cfg.CurrentProfile); err == nil {
+		raw["current_profile"] = value
+	}
+	if len(cfg.Profiles) == 0 {
+		delete(raw, "profiles")
+	} else {
+		value, err := json.Marshal(cfg.Profiles)
+		if err != nil {
+			return err
+		}
+		raw["profiles"] = value
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return err
+	}
+
+	cacheMu.Lock()
+	cached = &cfg
+	cacheMu.Unlock()
+	return nil
+}
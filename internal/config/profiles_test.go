@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func useTempConfig(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv(ProfileEnv, "")
+	SetActiveProfile("")
+	cacheMu.Lock()
+	cached = nil
+	cacheMu.Unlock()
+	return filepath.Join(dir, "xbe", "config.json")
+}
+
+func TestSaveProfilePreservesOtherSections(t *testing.T) {
+	path := useTempConfig(t)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{"telemetry":{"enabled":true},"tokens":{"https://x":"abc"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SaveProfile("staging", Profile{BaseURL: "https://staging.x-b-e.com", Broker: "12"}); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	if err := UseProfile("staging"); err != nil {
+		t.Fatalf("use profile: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(content, &raw); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"telemetry", "tokens", "profiles", "current_profile"} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("expected %q to be present in config file", key)
+		}
+	}
+
+	name, profile, ok, err := ActiveProfile()
+	if err != nil || !ok {
+		t.Fatalf("expected active profile, got ok=%t err=%v", ok, err)
+	}
+	if name != "staging" || profile.Broker != "12" {
+		t.Errorf("unexpected active profile %q: %+v", name, profile)
+	}
+}
+
+func TestActiveProfilePrecedence(t *testing.T) {
+	useTempConfig(t)
+	for _, name := range []string{"a", "b", "c"} {
+		if err := SaveProfile(name, Profile{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := UseProfile("a"); err != nil {
+		t.Fatal(err)
+	}
+	if got := ActiveProfileName(); got != "a" {
+		t.Errorf("expected current profile a, got %q", got)
+	}
+	t.Setenv(ProfileEnv, "b")
+	if got := ActiveProfileName(); got != "b" {
+		t.Errorf("expected env profile b, got %q", got)
+	}
+	SetActiveProfile("c")
+	defer SetActiveProfile("")
+	if got := ActiveProfileName(); got != "c" {
+		t.Errorf("expected flag profile c, got %q", got)
+	}
+}
+
+func TestDeleteProfileClearsCurrent(t *testing.T) {
+	useTempConfig(t)
+	if err := SaveProfile("a", Profile{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := UseProfile("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteProfile("a"); err != nil {
+		t.Fatal(err)
+	}
+	if got := ActiveProfileName(); got != "" {
+		t.Errorf("expected no current profile, got %q", got)
+	}
+	if err := DeleteProfile("a"); err == nil {
+		t.Errorf("expected error deleting a missing profile")
+	}
+}
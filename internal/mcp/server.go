@@ -0,0 +1,348 @@
+// Package mcp implements the stdio transport of the Model Context Protocol:
+// newline-delimited JSON-RPC 2.0 messages carrying tool and resource requests.
+// The CLI supplies the tools and resources through a Handler.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ProtocolVersion is the newest protocol revision the server speaks.
+const ProtocolVersion = "2025-06-18"
+
+var supportedVersions = map[string]bool{
+	"2024-11-05": true,
+	"2025-03-26": true,
+	"2025-06-18": true,
+}
+
+// JSON-RPC error codes.
+const (
+	CodeParseError       = -32700
+	CodeInvalidRequest   = -32600
+	CodeMethodNotFound   = -32601
+	CodeInvalidParams    = -32602
+	CodeInternalError    = -32603
+	CodeResourceNotFound = -32002
+)
+
+// Error is a JSON-RPC error. Handlers return it to control the error code;
+// any other error is reported as an internal error.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Errorf builds a JSON-RPC error with the given code.
+func Errorf(code int, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Tool describes a callable tool.
+type Tool struct {
+	Name        string           `json:"name"`
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	InputSchema map[string]any   `json:"inputSchema"`
+	Annotations *ToolAnnotations `json:"annotations,omitempty"`
+}
+
+// ToolAnnotations are behavioural hints for clients.
+type ToolAnnotations struct {
+	Title           string `json:"title,omitempty"`
+	ReadOnlyHint    *bool  `json:"readOnlyHint,omitempty"`
+	DestructiveHint *bool  `json:"destructiveHint,omitempty"`
+	IdempotentHint  *bool  `json:"idempotentHint,omitempty"`
+	OpenWorldHint   *bool  `json:"openWorldHint,omitempty"`
+}
+
+// Content is a text content block.
+type Content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// ToolResult is the outcome of a tool call. Failures of the tool itself are
+// reported with IsError rather than as protocol errors.
+type ToolResult struct {
+	Content []Content `json:"content"`
+	IsError bool      `json:"isError,omitempty"`
+}
+
+// TextResult builds a single-block tool result.
+func TextResult(text string, isError bool) ToolResult {
+	return ToolResult{Content: []Content{{Type: "text", Text: text}}, IsError: isError}
+}
+
+// Resource describes a readable resource.
+type Resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+// ResourceTemplate describes a family of resources by URI template.
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+// ResourceContents is the text of a resource.
+type ResourceContents struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Text     string `json:"text"`
+}
+
+// Handler supplies the tools and resources served.
+type Handler interface {
+	Tools(ctx context.Context) ([]Tool, error)
+	CallTool(ctx context.Context, name string, arguments map[string]any) (ToolResult, error)
+	Resources(ctx context.Context) ([]Resource, error)
+	ResourceTemplates(ctx context.Context) ([]ResourceTemplate, error)
+	ReadResource(ctx context.Context, uri string) ([]ResourceContents, error)
+}
+
+// Server answers MCP requests read from a stream.
+type Server struct {
+	Name         string
+	Version      string
+	Instructions string
+	Handler      Handler
+	// PageSize limits list results per page; 0 returns everything at once.
+	PageSize int
+
+	writeMu sync.Mutex
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Serve processes requests from r until EOF or ctx is cancelled. Requests are
+// handled one at a time, in order.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	reader := bufio.NewReader(r)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if resp := s.handleMessage(ctx, line); resp != nil {
+				if writeErr := s.write(w, resp); writeErr != nil {
+					return writeErr
+				}
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func (s *Server) write(w io.Writer, resp *response) error {
+	payload, err := json.Marshal(resp)
+	if err != nil {
+		payload, _ = json.Marshal(&response{JSONRPC: "2.0", ID: resp.ID, Error: Errorf(CodeInternalError, "encode response: %v", err)})
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_, err = w.Write(append(payload, '\n'))
+	return err
+}
+
+func (s *Server) handleMessage(ctx context.Context, line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return &response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: Errorf(CodeParseError, "parse error: %v", err)}
+	}
+	isNotification := len(req.ID) == 0 || string(req.ID) == "null"
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		if isNotification {
+			return nil
+		}
+		return &response{JSONRPC: "2.0", ID: req.ID, Error: Errorf(CodeInvalidRequest, "invalid request")}
+	}
+
+	result, err := s.dispatch(ctx, req.Method, req.Params)
+	if isNotification {
+		return nil
+	}
+	if err != nil {
+		var rpcErr *Error
+		if !errors.As(err, &rpcErr) {
+			rpcErr = Errorf(CodeInternalError, "%v", err)
+		}
+		return &response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+	}
+	if result == nil {
+		result = struct{}{}
+	}
+	return &response{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func (s *Server) dispatch(ctx context.Context, method string, params json.RawMessage) (any, error) {
+	switch method {
+	case "initialize":
+		return s.initialize(params)
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		tools, err := s.Handler.Tools(ctx)
+		if err != nil {
+			return nil, err
+		}
+		page, next, err := paginate(tools, params, s.PageSize)
+		if err != nil {
+			return nil, err
+		}
+		return listResult("tools", page, next), nil
+	case "tools/call":
+		var call struct {
+			Name      string         `json:"name"`
+			Arguments map[string]any `json:"arguments"`
+		}
+		if err := decodeParams(params, &call); err != nil {
+			return nil, err
+		}
+		if call.Name == "" {
+			return nil, Errorf(CodeInvalidParams, "missing tool name")
+		}
+		return s.Handler.CallTool(ctx, call.Name, call.Arguments)
+	case "resources/list":
+		resources, err := s.Handler.Resources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		page, next, err := paginate(resources, params, s.PageSize)
+		if err != nil {
+			return nil, err
+		}
+		return listResult("resources", page, next), nil
+	case "resources/templates/list":
+		templates, err := s.Handler.ResourceTemplates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"resourceTemplates": templates}, nil
+	case "resources/read":
+		var read struct {
+			URI string `json:"uri"`
+		}
+		if err := decodeParams(params, &read); err != nil {
+			return nil, err
+		}
+		if read.URI == "" {
+			return nil, Errorf(CodeInvalidParams, "missing resource uri")
+		}
+		contents, err := s.Handler.ReadResource(ctx, read.URI)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"contents": contents}, nil
+	default:
+		if strings.HasPrefix(method, "notifications/") {
+			return nil, nil
+		}
+		return nil, Errorf(CodeMethodNotFound, "method not found: %s", method)
+	}
+}
+
+func (s *Server) initialize(params json.RawMessage) (any, error) {
+	var init struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if err := decodeParams(params, &init); err != nil {
+		return nil, err
+	}
+	version := ProtocolVersion
+	if supportedVersions[init.ProtocolVersion] {
+		version = init.ProtocolVersion
+	}
+	result := map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools":     map[string]any{"listChanged": false},
+			"resources": map[string]any{"listChanged": false, "subscribe": false},
+		},
+		"serverInfo": map[string]any{"name": s.Name, "version": s.Version},
+	}
+	if s.Instructions != "" {
+		result["instructions"] = s.Instructions
+	}
+	return result, nil
+}
+
+func listResult(key string, items any, nextCursor string) map[string]any {
+	result := map[string]any{key: items}
+	if nextCursor != "" {
+		result["nextCursor"] = nextCursor
+	}
+	return result
+}
+
+func decodeParams(params json.RawMessage, target any) error {
+	if len(params) == 0 || string(params) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params, target); err != nil {
+		return Errorf(CodeInvalidParams, "invalid params: %v", err)
+	}
+	return nil
+}
+
+// paginate returns one page of items. Cursors are opaque to clients; here
+// they are offsets.
+func paginate[T any](items []T, params json.RawMessage, pageSize int) ([]T, string, error) {
+	var list struct {
+		Cursor string `json:"cursor"`
+	}
+	if err := decodeParams(params, &list); err != nil {
+		return nil, "", err
+	}
+	start := 0
+	if list.Cursor != "" {
+		offset, err := strconv.Atoi(list.Cursor)
+		if err != nil || offset < 0 || offset > len(items) {
+			return nil, "", Errorf(CodeInvalidParams, "invalid cursor %q", list.Cursor)
+		}
+		start = offset
+	}
+	if pageSize <= 0 || start+pageSize >= len(items) {
+		return items[start:], "", nil
+	}
+	end := start + pageSize
+	return items[start:end], strconv.Itoa(end), nil
+}
@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type fakeHandler struct {
+	calls []map[string]any
+}
+
+func (h *fakeHandler) Tools(context.Context) ([]Tool, error) {
+	return []Tool{
+		{Name: "a", InputSchema: map[string]any{"type": "object"}},
+		{Name: "b", InputSchema: map[string]any{"type": "object"}},
+		{Name: "c", InputSchema: map[string]any{"type": "object"}},
+	}, nil
+}
+
+func (h *fakeHandler) CallTool(_ context.Context, name string, arguments map[string]any) (ToolResult, error) {
+	if name != "a" {
+		return ToolResult{}, Errorf(CodeInvalidParams, "unknown tool: %s", name)
+	}
+	h.calls = append(h.calls, arguments)
+	return TextResult("ok", false), nil
+}
+
+func (h *fakeHandler) Resources(context.Context) ([]Resource, error) {
+	return []Resource{{URI: "xbe://r", Name: "r"}}, nil
+}
+
+func (h *fakeHandler) ResourceTemplates(context.Context) ([]ResourceTemplate, error) {
+	return nil, nil
+}
+
+func (h *fakeHandler) ReadResource(_ context.Context, uri string) ([]ResourceContents, error) {
+	if uri != "xbe://r" {
+		return nil, Errorf(CodeResourceNotFound, "resource not found: %s", uri)
+	}
+	return []ResourceContents{{URI: uri, Text: "body"}}, nil
+}
+
+func serve(t *testing.T, server *Server, messages ...string) []map[string]any {
+	t.Helper()
+	var out bytes.Buffer
+	if err := server.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")), &out); err != nil {
+		t.Fatalf("serve: %v", err)
+	}
+	var responses []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var resp map[string]any
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("decode %q: %v", line, err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func TestServe(t *testing.T) {
+	handler := &fakeHandler{}
+	server := &Server{Name: "xbe", Version: "test", Handler: handler, PageSize: 2}
+	responses := serve(t, server,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/list","params":{"cursor":"2"}}`,
+		`{"jsonrpc":"2.0","id":"four","method":"tools/call","params":{"name":"a","arguments":{"x":1}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"z"}}`,
+		`{"jsonrpc":"2.0","id":6,"method":"resources/read","params":{"uri":"xbe://missing"}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"nope"}`,
+		`not json`,
+	)
+	if len(responses) != 8 {
+		t.Fatalf("expected 8 responses (notification has none), got %d", len(responses))
+	}
+
+	init := responses[0]["result"].(map[string]any)
+	if init["protocolVersion"] != "2024-11-05" {
+		t.Errorf("protocol version = %v", init["protocolVersion"])
+	}
+
+	first := responses[1]["result"].(map[string]any)
+	if len(first["tools"].([]any)) != 2 || first["nextCursor"] != "2" {
+		t.Errorf("first page = %v", first)
+	}
+	second := responses[2]["result"].(map[string]any)
+	if len(second["tools"].([]any)) != 1 || second["nextCursor"] != nil {
+		t.Errorf("second page = %v", second)
+	}
+
+	if responses[3]["id"] != "four" || len(handler.calls) != 1 || handler.calls[0]["x"] != float64(1) {
+		t.Errorf("tool call = %v, calls %v", responses[3], handler.calls)
+	}
+
+	wantCodes := []float64{CodeInvalidParams, CodeResourceNotFound, CodeMethodNotFound, CodeParseError}
+	for i, code := range wantCodes {
+		errObj, ok := responses[4+i]["error"].(map[string]any)
+		if !ok || errObj["code"] != code {
+			t.Errorf("response %d: want error code %v, got %v", 4+i, code, responses[4+i])
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Package policy restricts which commands the CLI may run, for example when it
+// is driven by an agent. Policies are JSON files selected with XBE_POLICY or
+// placed at ~/.config/xbe/policy.json.
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/xbe-inc/xbe-cli/internal/config"
+)
+
+// Env selects the policy file; it takes precedence over the default location.
+const Env = "XBE_POLICY"
+
+// ReadOnlyEnv enables read-only mode when set to a true value.
+const ReadOnlyEnv = "XBE_READ_ONLY"
+
+// ErrDenied is returned for commands the policy does not allow.
+var ErrDenied = errors.New("denied by policy")
+
+// Policy lists glob patterns over command paths such as
+// "do time-card-approvals create". Patterns also match the hyphenated form
+// "do time-card-approvals-create", so "do *-delete" covers every delete.
+type Policy struct {
+	// ReadOnly refuses every request other than GET/HEAD.
+	ReadOnly bool `json:"read_only"`
+	// Allow, when not empty, is the complete list of permitted commands.
+	Allow []string `json:"allow,omitempty"`
+	// Deny always wins over Allow.
+	Deny []string `json:"deny,omitempty"`
+	// RequireApproval commands prompt for interactive confirmation.
+	RequireApproval []string `json:"require_approval,omitempty"`
+
+	// Source is the file the policy was loaded from.
+	Source string `json:"-"`
+}
+
+// Decision is the outcome of checking a command against a policy.
+type Decision struct {
+	Allowed         bool
+	RequireApproval bool
+	Rule            string
+}
+
+// DefaultPath returns the default policy location.
+func DefaultPath() string {
+	configPath := config.Path()
+	if configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(configPath), "policy.json")
+}
+
+// Load reads the policy from XBE_POLICY or the default location. A missing
+// default file yields an empty policy; a missing XBE_POLICY file is an error
+// so a typo never silently disables the policy.
+func Load() (Policy, error) {
+	policyPath := strings.TrimSpace(os.Getenv(Env))
+	explicit := policyPath != ""
+	if !explicit {
+		policyPath = DefaultPath()
+	}
+
+	var p Policy
+	if policyPath != "" {
+		content, err := os.ReadFile(policyPath)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(content, &p); err != nil {
+				return Policy{}, fmt.Errorf("parse policy %s: %w", policyPath, err)
+			}
+			p.Source = policyPath
+			if err := p.validate(); err != nil {
+				return Policy{}, fmt.Errorf("policy %s: %w", policyPath, err)
+			}
+		case os.IsNotExist(err) && !explicit:
+		default:
+			return Policy{}, fmt.Errorf("read policy: %w", err)
+		}
+	}
+
+	if envTrue(os.Getenv(ReadOnlyEnv)) {
+		p.ReadOnly = true
+	}
+	return p, nil
+}
+
+func (p Policy) validate() error {
+	for _, patterns := range [][]string{p.Allow, p.Deny, p.RequireApproval} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(normalizePattern(pattern), ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Check decides whether the command path (without the leading "xbe") may run.
+func (p Policy) Check(commandPath string) Decision {
+	commandPath = normalizePath(commandPath)
+	if rule, ok := matchAny(p.Deny, commandPath); ok {
+		return Decision{Allowed: false, Rule: "deny " + rule}
+	}
+	if len(p.Allow) > 0 {
+		if _, ok := matchAny(p.Allow, commandPath); !ok {
+			return Decision{Allowed: false, Rule: "not in allow list"}
+		}
+	}
+	decision := Decision{Allowed: true}
+	if rule, ok := matchAny(p.RequireApproval, commandPath); ok {
+		decision.RequireApproval = true
+		decision.Rule = "require_approval " + rule
+	}
+	return decision
+}
+
+// Empty reports whether the policy restricts anything.
+func (p Policy) Empty() bool {
+	return !p.ReadOnly && len(p.Allow) == 0 && len(p.Deny) == 0 && len(p.RequireApproval) == 0
+}
+
+// Match reports whether pattern matches the command path, in either its
+// spaced or hyphenated form.
+func Match(pattern, commandPath string) bool {
+	pattern = normalizePattern(pattern)
+	commandPath = normalizePath(commandPath)
+	for _, candidate := range []string{commandPath, hyphenated(commandPath)} {
+		if ok, err := path.Match(pattern, candidate); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, commandPath string) (string, bool) {
+	for _, pattern := range patterns {
+		if Match(pattern, commandPath) {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+func normalizePath(commandPath string) string {
+	fields := strings.Fields(commandPath)
+	if len(fields) > 0 && fields[0] == "xbe" {
+		fields = fields[1:]
+	}
+	return strings.Join(fields, " ")
+}
+
+func normalizePattern(pattern string) string {
+	return normalizePath(pattern)
+}
+
+// hyphenated joins the last two words, e.g. "do cost-codes create" becomes
+// "do cost-codes-create".
+func hyphenated(commandPath string) string {
+	idx := strings.LastIndex(commandPath, " ")
+	if idx <= 0 {
+		return commandPath
+	}
+	return commandPath[:idx] + "-" + commandPath[idx+1:]
+}
+
+func envTrue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "1", "true", "yes", "on":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,88 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"do *-delete", "do cost-codes delete", true},
+		{"do *-delete", "xbe do cost-codes delete", true},
+		{"do * delete", "do cost-codes delete", true},
+		{"do *-delete", "do cost-codes create", false},
+		{"view *", "view brokers list", true},
+		{"view *", "do brokers create", false},
+		{"view * *", "view brokers list", true},
+		{"do time-card-approvals *", "do time-card-approvals create", true},
+	}
+	for _, tc := range cases {
+		if got := Match(tc.pattern, tc.path); got != tc.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tc.pattern, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	p := Policy{
+		Allow:           []string{"view * *", "do time-card-approvals *"},
+		Deny:            []string{"do *-delete"},
+		RequireApproval: []string{"do * update"},
+	}
+
+	if d := p.Check("view brokers list"); !d.Allowed || d.RequireApproval {
+		t.Errorf("view brokers list: %+v", d)
+	}
+	if d := p.Check("do time-card-approvals delete"); d.Allowed {
+		t.Errorf("deny should win over allow: %+v", d)
+	}
+	if d := p.Check("do brokers create"); d.Allowed {
+		t.Errorf("commands outside the allow list should be denied: %+v", d)
+	}
+	if d := p.Check("do time-card-approvals update"); !d.Allowed || !d.RequireApproval {
+		t.Errorf("update should require approval: %+v", d)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv(ReadOnlyEnv, "")
+
+	t.Setenv(Env, "")
+	p, err := Load()
+	if err != nil || !p.Empty() {
+		t.Fatalf("missing default policy should be empty: %+v, %v", p, err)
+	}
+
+	t.Setenv(Env, filepath.Join(dir, "missing.json"))
+	if _, err := Load(); err == nil {
+		t.Fatal("expected error for missing XBE_POLICY file")
+	}
+
+	policyPath := filepath.Join(dir, "policy.json")
+	if err := os.WriteFile(policyPath, []byte(`{"deny":["do *-delete"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(Env, policyPath)
+	t.Setenv(ReadOnlyEnv, "1")
+	p, err = Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.ReadOnly || len(p.Deny) != 1 || p.Source != policyPath {
+		t.Fatalf("unexpected policy: %+v", p)
+	}
+
+	if err := os.WriteFile(policyPath, []byte(`{"allow":["do [x"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(); err == nil {
+		t.Fatal("expected error for invalid pattern")
+	}
+}